@@ -232,12 +232,23 @@ func main() {
 		protected.GET("/feed/home", handleGetHomeFeed_Gin)
 		protected.GET("/feed/explore", handleGetExploreFeed_Gin)
 		protected.GET("/feed/reels", handleGetReelsFeed_Gin)
+		protected.POST("/reels/:id/watch", handleRecordReelWatch_Gin)
+		protected.POST("/feed/not-interested", handleMarkNotInterested_Gin)
+		protected.GET("/feed/not-interested", handleGetNotInterested_Gin)
+		protected.DELETE("/feed/not-interested/:id", handleUndoNotInterested_Gin)
+		protected.POST("/feed/impressions", handleRecordImpressions_Gin)
 
 		// Posts
 		protected.POST("/posts", handleCreatePost_Gin)
 		protected.POST("/posts/:id/like", handlePostLike_Gin)
 		protected.DELETE("/posts/:id/like", handlePostLike_Gin)
 		protected.DELETE("/posts/:id", handleDeletePost_Gin)
+		protected.GET("/posts/archive", handleGetArchivedPosts_Gin)
+		protected.GET("/posts/recently-deleted", handleGetRecentlyDeleted_Gin)
+		protected.POST("/posts/:id/restore", handleRestorePost_Gin)
+		protected.POST("/posts/:id/archive", handleArchivePost_Gin)
+		protected.DELETE("/posts/:id/archive", handleArchivePost_Gin)
+		protected.PUT("/posts/:id/comment-audience", handleUpdateCommentAudience_Gin)
 		protected.POST("/posts/:id/summarize", handleSummarizeCaption_Gin)
 
 		// Stories
@@ -251,10 +262,16 @@ func main() {
 		// Comments
 		protected.POST("/comments", handleCreateComment_Gin)
 		protected.GET("/posts/:id/comments", handleGetCommentsByPost_Gin)
+		protected.GET("/comments/:id/replies", handleGetCommentReplies_Gin)
 		protected.GET("/posts/:id", handleGetPost_Gin)
 		protected.DELETE("/comments/:id", handleDeleteComment_Gin)
 		protected.POST("/comments/:id/like", handleLikeComment_Gin)
 		protected.DELETE("/comments/:id/like", handleUnlikeComment_Gin)
+		protected.PUT("/comments/:id", handleEditComment_Gin)
+		protected.POST("/comments/:id/pin", handlePinComment_Gin)
+		protected.DELETE("/comments/:id/pin", handlePinComment_Gin)
+		protected.POST("/comments/:id/hide", handleHideComment_Gin)
+		protected.DELETE("/comments/:id/hide", handleHideComment_Gin)
 
 		// Users
 		protected.POST("/users/:id/follow", handleFollowUser_Gin)
@@ -263,6 +280,14 @@ func main() {
 		protected.GET("/users/:id/following", handleGetFollowingList_Gin)
 		protected.GET("/users/top", handleGetTopUsers_Gin)
 		protected.GET("/posts/:id/likes", handleGetPostLikers_Gin)
+		protected.POST("/posts/:id/hide-like-count", handleHideLikeCount_Gin)
+		protected.DELETE("/posts/:id/hide-like-count", handleHideLikeCount_Gin)
+		protected.POST("/posts/:id/send", handleSendPostToDirect_Gin)
+
+		// Insights (author only)
+		protected.POST("/posts/:id/profile-visit", handleRecordProfileVisit_Gin)
+		protected.GET("/posts/:id/insights", handleGetPostInsights_Gin)
+		protected.GET("/insights/account", handleGetAccountInsights_Gin)
 
 		// Profile
 		protected.GET("/users/:id", handleGetUserProfile_Gin)
@@ -274,6 +299,8 @@ func main() {
 		protected.PUT("/profile/edit", handleUpdateProfile_Gin)
 		protected.PUT("/users/complete-profile", handleCompleteProfile_Gin)
 		protected.PUT("/settings/privacy", handleSetPrivacy_Gin)
+		protected.PUT("/settings/comment-audience", handleSetDefaultCommentAudience_Gin)
+		protected.PUT("/settings/activity-status", handleSetActivityStatus_Gin)
 
 		protected.POST("/users/:id/block", handleBlockUser_Gin)
 		protected.DELETE("/users/:id/block", handleBlockUser_Gin)
@@ -297,6 +324,8 @@ func main() {
 		// Notification Settings
 		protected.PUT("/settings/notifications", handleUpdateNotificationSettings_Gin)
 		protected.GET("/settings/notifications", handleGetNotificationSettings_Gin)
+		protected.PUT("/settings/comment-filter", handleSetCommentFilter_Gin)
+		protected.GET("/settings/comment-filter", handleGetCommentFilter_Gin)
 
 		protected.POST("/collections", handleCreateCollection_Gin)
 		protected.GET("/collections", handleGetUserCollections_Gin)
@@ -305,6 +334,16 @@ func main() {
 		protected.DELETE("/collections/:id/posts/:post_id", handleUnsavePostFromCollection_Gin)
 		protected.DELETE("/collections/:id", handleDeleteCollection_Gin)
 		protected.PUT("/collections/:id", handleRenameCollection_Gin)
+		protected.GET("/collections/:id/details", handleGetCollection_Gin)
+		protected.PUT("/collections/:id/privacy", handleSetCollectionPrivacy_Gin)
+		protected.GET("/collections/:id/members", handleGetCollectionMembers_Gin)
+		protected.POST("/collections/:id/members", handleInviteCollectionMember_Gin)
+		protected.DELETE("/collections/:id/members/:user_id", handleRemoveCollectionMember_Gin)
+		protected.POST("/collections/:id/invite", handleRespondToCollectionInvite_Gin)
+		protected.GET("/collections/invites", handleGetCollectionInvites_Gin)
+		protected.PUT("/collections/:id/cover", handleSetCollectionCover_Gin)
+		protected.PUT("/collections/:id/order", handleReorderCollectionPosts_Gin)
+		protected.POST("/collections/move", handleMoveSavedPosts_Gin)
 
 		// Get collections for a specific post
 		protected.GET("/posts/:id/collections", handleGetCollectionsForPost_Gin)
@@ -317,6 +356,8 @@ func main() {
 		protected.GET("/conversations", handleGetConversations_Gin)
 		protected.GET("/conversations/:id/messages", handleGetMessages_Gin)
 		protected.GET("/conversations/:id/messages/search", handleSearchMessages_Gin)
+		protected.POST("/conversations/:id/read", handleMarkConversationRead_Gin)
+		protected.GET("/users/presence", handleGetPresence_Gin)
 
 		// Search
 		protected.GET("/search/users", handleSearchUsers_Gin)
@@ -334,6 +375,9 @@ func main() {
 
 		// Video call, delete, unsend
 		protected.DELETE("/messages/:id", handleUnsendMessage_Gin)
+		protected.POST("/messages/:id/reactions", handleReactToMessage_Gin)
+		protected.DELETE("/messages/:id/reactions", handleRemoveReaction_Gin)
+		protected.POST("/messages/:id/forward", handleForwardMessage_Gin)
 		protected.DELETE("/conversations/:id", handleDeleteConversation_Gin)
 		protected.GET("/conversations/:id/video_token", handleGetVideoToken_Gin)
 
@@ -946,7 +990,7 @@ func handleResetPassword(w http.ResponseWriter, r *http.Request) {
 // @Tags Posts
 // @Accept json
 // @Produce json
-// @Param request body object{caption=string,media_urls=[]string,comments_disabled=bool,is_reel=bool,collaborator_ids=[]int64,thumbnail_url=string} true "Post creation data"
+// @Param request body object{caption=string,media_urls=[]string,comments_disabled=bool,comment_audience=string,hide_like_count=bool,is_reel=bool,collaborator_ids=[]int64,thumbnail_url=string} true "Post creation data"
 // @Success 201 {object} object "Created post with all details"
 // @Failure 400 {object} object{error=string} "Bad request - At least one media URL is required"
 // @Failure 401 {object} object{error=string} "Unauthorized"
@@ -967,6 +1011,8 @@ func handleCreatePost_Gin(c *gin.Context) {
 		IsReel           bool     `json:"is_reel"`
 		CollaboratorIDs  []int64  `json:"collaborator_ids"` // Added
 		ThumbnailURL     string   `json:"thumbnail_url"`    // Added
+		CommentAudience  string   `json:"comment_audience"` // Empty uses the user's default
+		HideLikeCount    bool     `json:"hide_like_count"`
 	}
 
 	if err := c.ShouldBindJSON(&req); err != nil {
@@ -987,6 +1033,8 @@ func handleCreatePost_Gin(c *gin.Context) {
 		IsReel:           req.IsReel,
 		CollaboratorIds:  req.CollaboratorIDs, // Added
 		ThumbnailUrl:     req.ThumbnailURL,    // Added
+		CommentAudience:  req.CommentAudience,
+		HideLikeCount:    req.HideLikeCount,
 	}
 
 	grpcRes, err := postClient.CreatePost(c.Request.Context(), grpcReq)
@@ -1418,37 +1466,48 @@ func handleUnlikeComment_Gin(c *gin.Context) {
 	c.JSON(http.StatusOK, grpcRes)
 }
 
-// handleDeletePost_Gin godoc
-// @Summary Delete a post
-// @Description Delete a post (only owner can delete)
+// handleUpdateCommentAudience_Gin godoc
+// @Summary Change who can comment on a post
+// @Description Set the comment audience of your own post: everyone, following (people you follow), followers or off
 // @Tags Posts
 // @Accept json
 // @Produce json
 // @Param id path int true "Post ID"
-// @Success 200 {object} object{message=string} "Post deleted successfully"
-// @Failure 400 {object} object{error=string} "Bad request - Invalid post ID"
+// @Param request body object{audience=string} true "Comment audience"
+// @Success 200 {object} object{message=string,comment_audience=string} "Comment audience updated"
+// @Failure 400 {object} object{error=string} "Bad request - Invalid post ID or audience"
 // @Failure 401 {object} object{error=string} "Unauthorized"
-// @Failure 403 {object} object{error=string} "Forbidden - Not allowed to delete this post"
+// @Failure 403 {object} object{error=string} "Forbidden - Not the post author"
 // @Failure 404 {object} object{error=string} "Post not found"
 // @Failure 500 {object} object{error=string} "Internal server error"
 // @Security BearerAuth
-// @Router /posts/{id} [delete]
-func handleDeletePost_Gin(c *gin.Context) {
+// @Router /posts/{id}/comment-audience [put]
+func handleUpdateCommentAudience_Gin(c *gin.Context) {
 	userID, ok := c.Request.Context().Value(userIDKey).(int64)
 	if !ok {
 		c.JSON(http.StatusUnauthorized, gin.H{"error": "Failed to get user ID from token"})
 		return
 	}
 
-	postIDStr := c.Param("id")
-	postID, err := strconv.ParseInt(postIDStr, 10, 64)
+	postID, err := strconv.ParseInt(c.Param("id"), 10, 64)
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid post ID"})
 		return
 	}
 
-	grpcReq := &postPb.DeletePostRequest{PostId: postID, AdminUserId: userID}
-	grpcRes, err := postClient.DeletePost(c.Request.Context(), grpcReq)
+	var req struct {
+		Audience string `json:"audience"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
+		return
+	}
+
+	grpcRes, err := postClient.UpdateCommentAudience(c.Request.Context(), &postPb.UpdateCommentAudienceRequest{
+		UserId:   userID,
+		PostId:   postID,
+		Audience: req.Audience,
+	})
 	if err != nil {
 		grpcErr, _ := status.FromError(err)
 		c.JSON(gRPCToHTTPStatusCode(grpcErr.Code()), gin.H{"error": grpcErr.Message()})
@@ -1457,130 +1516,127 @@ func handleDeletePost_Gin(c *gin.Context) {
 	c.JSON(http.StatusOK, grpcRes)
 }
 
-// handleGetPost_Gin godoc
-// @Summary Get post by ID
-// @Description Get detailed information about a specific post including media, likes, comments count
+// handleRestorePost_Gin godoc
+// @Summary Restore a deleted post
+// @Description Restore one of your posts from Recently Deleted, with its likes and comments
 // @Tags Posts
 // @Accept json
 // @Produce json
 // @Param id path int true "Post ID"
-// @Success 200 {object} object "Post details with author info and engagement stats"
+// @Success 200 {object} object{message=string} "Post restored"
 // @Failure 400 {object} object{error=string} "Bad request - Invalid post ID"
 // @Failure 401 {object} object{error=string} "Unauthorized"
-// @Failure 404 {object} object{error=string} "Post not found"
+// @Failure 403 {object} object{error=string} "Forbidden - Not your post"
+// @Failure 404 {object} object{error=string} "Post not found in Recently Deleted"
 // @Failure 500 {object} object{error=string} "Internal server error"
 // @Security BearerAuth
-// @Router /posts/{id} [get]
-func handleGetPost_Gin(c *gin.Context) {
+// @Router /posts/{id}/restore [post]
+func handleRestorePost_Gin(c *gin.Context) {
 	userID, ok := c.Request.Context().Value(userIDKey).(int64)
 	if !ok {
 		c.JSON(http.StatusUnauthorized, gin.H{"error": "Failed to get user ID from token"})
 		return
 	}
 
-	postIDStr := c.Param("id")
-	postID, err := strconv.ParseInt(postIDStr, 10, 64)
+	postID, err := strconv.ParseInt(c.Param("id"), 10, 64)
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid post ID"})
 		return
 	}
 
-	grpcReq := &postPb.GetPostRequest{
-		PostId:   postID,
-		ViewerId: userID,
-	}
-
-	grpcRes, err := postClient.GetPost(c.Request.Context(), grpcReq)
+	grpcRes, err := postClient.RestorePost(c.Request.Context(), &postPb.RestorePostRequest{
+		UserId: userID,
+		PostId: postID,
+	})
 	if err != nil {
 		grpcErr, _ := status.FromError(err)
-		log.Printf("gRPC call to GetPost failed (%s): %v", grpcErr.Code(), grpcErr.Message())
 		c.JSON(gRPCToHTTPStatusCode(grpcErr.Code()), gin.H{"error": grpcErr.Message()})
 		return
 	}
-
 	c.JSON(http.StatusOK, grpcRes)
 }
 
-// handleGetCommentsByPost_Gin godoc
-// @Summary Get comments for a post
-// @Description Get paginated list of comments for a specific post
-// @Tags Comments
+// handleGetRecentlyDeleted_Gin godoc
+// @Summary Get recently deleted posts
+// @Description Get your deleted posts that can still be restored, most recently deleted first, with the time each one will be removed for good
+// @Tags Posts
 // @Accept json
 // @Produce json
-// @Param id path int true "Post ID"
-// @Param page query int false "Page number" default(1)
-// @Param limit query int false "Items per page" default(20)
-// @Success 200 {array} object "List of comments with user information"
-// @Failure 400 {object} object{error=string} "Bad request - Invalid post ID"
-// @Failure 404 {object} object{error=string} "Post not found"
+// @Param limit query int false "Number of posts per page (max 100)" default(20)
+// @Param cursor query string false "Cursor from the previous page's next_cursor"
+// @Success 200 {object} object{posts=[]object,next_cursor=string} "Recently deleted posts"
+// @Failure 400 {object} object{error=string} "Bad request - Invalid cursor"
+// @Failure 401 {object} object{error=string} "Unauthorized"
 // @Failure 500 {object} object{error=string} "Internal server error"
-// @Router /posts/{id}/comments [get]
-func handleGetCommentsByPost_Gin(c *gin.Context) {
-	postIDStr := c.Param("id")
-	postID, err := strconv.ParseInt(postIDStr, 10, 64)
-	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid post ID"})
+// @Security BearerAuth
+// @Router /posts/recently-deleted [get]
+func handleGetRecentlyDeleted_Gin(c *gin.Context) {
+	userID, ok := c.Request.Context().Value(userIDKey).(int64)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Failed to get user ID from token"})
 		return
 	}
 
-	// Get pagination params
-	pageStr := c.DefaultQuery("page", "1")
-	limitStr := c.DefaultQuery("limit", "20")
-
-	page, _ := strconv.Atoi(pageStr)
-	limit, _ := strconv.Atoi(limitStr)
-	offset := (page - 1) * limit
-
-	grpcReq := &postPb.GetCommentsByPostRequest{
-		PostId:     postID,
-		PageSize:   int32(limit),
-		PageOffset: int32(offset),
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+	if limit < 1 || limit > 100 {
+		limit = 20
 	}
 
-	grpcRes, err := postClient.GetCommentsByPost(c.Request.Context(), grpcReq)
+	grpcRes, err := postClient.GetRecentlyDeleted(c.Request.Context(), &postPb.GetRecentlyDeletedRequest{
+		UserId:   userID,
+		PageSize: int32(limit),
+		Cursor:   c.Query("cursor"),
+	})
 	if err != nil {
 		grpcErr, _ := status.FromError(err)
 		c.JSON(gRPCToHTTPStatusCode(grpcErr.Code()), gin.H{"error": grpcErr.Message()})
 		return
 	}
 
-	c.JSON(http.StatusOK, grpcRes.Comments)
+	posts := grpcRes.Posts
+	if posts == nil {
+		posts = []*postPb.DeletedPost{}
+	}
+	c.JSON(http.StatusOK, gin.H{"posts": posts, "next_cursor": grpcRes.NextCursor})
 }
 
-// handleGetUploadURL_Gin godoc
-// @Summary Get pre-signed upload URL
-// @Description Get a pre-signed URL for uploading media files to cloud storage
-// @Tags Media
+// handleArchivePost_Gin godoc
+// @Summary Archive or unarchive a post
+// @Description Archive (POST) hides your post from your profile, feeds and hashtag search while keeping its likes and comments. Unarchive (DELETE) restores it.
+// @Tags Posts
 // @Accept json
 // @Produce json
-// @Param filename query string true "Filename"
-// @Param type query string true "Content type (e.g., image/jpeg, video/mp4)"
-// @Success 200 {object} object{upload_url=string,media_url=string} "Pre-signed upload URL and final media URL"
-// @Failure 400 {object} object{error=string} "Bad request - Missing filename or type"
+// @Param id path int true "Post ID"
+// @Success 200 {object} object{message=string} "Post archived/restored"
+// @Failure 400 {object} object{error=string} "Bad request - Invalid post ID"
+// @Failure 401 {object} object{error=string} "Unauthorized"
+// @Failure 403 {object} object{error=string} "Forbidden - Not your post"
+// @Failure 404 {object} object{error=string} "Post not found"
 // @Failure 500 {object} object{error=string} "Internal server error"
-// @Router /media/upload-url [get]
-func handleGetUploadURL_Gin(c *gin.Context) {
-	var userID int64 = 0
-	if val, ok := c.Request.Context().Value(userIDKey).(int64); ok {
-		userID = val
+// @Security BearerAuth
+// @Router /posts/{id}/archive [post]
+// @Router /posts/{id}/archive [delete]
+func handleArchivePost_Gin(c *gin.Context) {
+	userID, ok := c.Request.Context().Value(userIDKey).(int64)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Failed to get user ID from token"})
+		return
 	}
 
-	// Get query params, e.g., /media/upload-url?filename=foo.jpg&type=image/jpeg
-	filename := c.Query("filename")
-	contentType := c.Query("type")
-
-	if filename == "" || contentType == "" {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Missing 'filename' or 'type' query parameters"})
+	postID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid post ID"})
 		return
 	}
 
-	grpcReq := &mediaPb.GetUploadURLRequest{
-		Filename:    filename,
-		ContentType: contentType,
-		UserId:      userID,
-	}
+	grpcReq := &postPb.ArchivePostRequest{UserId: userID, PostId: postID}
 
-	grpcRes, err := mediaClient.GetUploadURL(c.Request.Context(), grpcReq)
+	var grpcRes interface{}
+	if c.Request.Method == http.MethodPost {
+		grpcRes, err = postClient.ArchivePost(c.Request.Context(), grpcReq)
+	} else {
+		grpcRes, err = postClient.UnarchivePost(c.Request.Context(), grpcReq)
+	}
 	if err != nil {
 		grpcErr, _ := status.FromError(err)
 		c.JSON(gRPCToHTTPStatusCode(grpcErr.Code()), gin.H{"error": grpcErr.Message()})
@@ -1589,526 +1645,1806 @@ func handleGetUploadURL_Gin(c *gin.Context) {
 	c.JSON(http.StatusOK, grpcRes)
 }
 
-// handleGetMediaURL_Gin godoc
-// @Summary Get pre-signed media URL
-// @Description Get a pre-signed URL for accessing private media files
-// @Tags Media
+// handleGetArchivedPosts_Gin godoc
+// @Summary Get archived posts
+// @Description Get your archived posts, most recently archived first
+// @Tags Posts
 // @Accept json
 // @Produce json
-// @Param object_name query string true "Object name in storage"
-// @Param expiry_seconds query int false "URL expiry in seconds" default(3600)
-// @Success 200 {object} object{url=string} "Pre-signed access URL"
-// @Failure 400 {object} object{error=string} "Bad request - Missing object_name"
+// @Param limit query int false "Number of posts per page (max 100)" default(20)
+// @Param cursor query string false "Cursor from the previous page's next_cursor"
+// @Success 200 {object} object{posts=[]object,next_cursor=string} "Archived posts"
+// @Failure 400 {object} object{error=string} "Bad request - Invalid cursor"
+// @Failure 401 {object} object{error=string} "Unauthorized"
 // @Failure 500 {object} object{error=string} "Internal server error"
-// @Router /media/secure-url [get]
-func handleGetMediaURL_Gin(c *gin.Context) {
-	// Get query param, e.g., /media/secure-url?object_name=user-123/posts/abc.jpg
-	objectName := c.Query("object_name")
-
-	if objectName == "" {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Missing 'object_name' query parameter"})
+// @Security BearerAuth
+// @Router /posts/archive [get]
+func handleGetArchivedPosts_Gin(c *gin.Context) {
+	userID, ok := c.Request.Context().Value(userIDKey).(int64)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Failed to get user ID from token"})
 		return
 	}
 
-	// Optional expiry parameter (default handled by media-service)
-	expirySeconds, _ := strconv.Atoi(c.DefaultQuery("expiry_seconds", "3600"))
-
-	grpcReq := &mediaPb.GetMediaURLRequest{
-		ObjectName:    objectName,
-		ExpirySeconds: int32(expirySeconds),
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+	if limit < 1 || limit > 100 {
+		limit = 20
 	}
 
-	grpcRes, err := mediaClient.GetMediaURL(c.Request.Context(), grpcReq)
+	grpcRes, err := postClient.GetArchivedPosts(c.Request.Context(), &postPb.GetArchivedPostsRequest{
+		UserId:   userID,
+		PageSize: int32(limit),
+		Cursor:   c.Query("cursor"),
+	})
 	if err != nil {
 		grpcErr, _ := status.FromError(err)
 		c.JSON(gRPCToHTTPStatusCode(grpcErr.Code()), gin.H{"error": grpcErr.Message()})
 		return
 	}
-	c.JSON(http.StatusOK, grpcRes)
+
+	posts := grpcRes.Posts
+	if posts == nil {
+		posts = []*postPb.Post{}
+	}
+	c.JSON(http.StatusOK, gin.H{"posts": posts, "next_cursor": grpcRes.NextCursor})
 }
 
-// handleGenerateThumbnail_Gin godoc
-// @Summary Generate video thumbnail
-// @Description Generate a thumbnail image from a video at a specific timestamp
-// @Tags Media
+// handleEditComment_Gin godoc
+// @Summary Edit a comment
+// @Description Edit the content of your own comment
+// @Tags Comments
 // @Accept json
 // @Produce json
-// @Param request body object{object_name=string,timestamp_seconds=float64} true "Thumbnail generation data"
-// @Success 200 {object} object{thumbnail_url=string} "Generated thumbnail URL"
-// @Failure 400 {object} object{error=string} "Bad request - Missing object_name"
+// @Param id path int true "Comment ID"
+// @Param comment body object{content=string} true "New comment content"
+// @Success 200 {object} object "Updated comment"
+// @Failure 400 {object} object{error=string} "Bad request - Invalid comment ID or content"
 // @Failure 401 {object} object{error=string} "Unauthorized"
+// @Failure 403 {object} object{error=string} "Forbidden - Not your comment"
+// @Failure 404 {object} object{error=string} "Comment not found"
 // @Failure 500 {object} object{error=string} "Internal server error"
 // @Security BearerAuth
-// @Router /media/generate-thumbnail [post]
-func handleGenerateThumbnail_Gin(c *gin.Context) {
-	log.Println("=== THUMBNAIL GENERATION REQUEST ===")
-
+// @Router /comments/{id} [put]
+func handleEditComment_Gin(c *gin.Context) {
 	userID, ok := c.Request.Context().Value(userIDKey).(int64)
 	if !ok {
-		log.Println("❌ Failed to get user ID from token")
 		c.JSON(http.StatusUnauthorized, gin.H{"error": "Failed to get user ID from token"})
 		return
 	}
-	log.Printf("✅ User ID: %d", userID)
 
-	var req struct {
-		ObjectName       string  `json:"object_name"`
-		TimestampSeconds float64 `json:"timestamp_seconds"`
-	}
-	if err := c.ShouldBindJSON(&req); err != nil {
-		log.Printf("❌ Invalid request body: %v", err)
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	commentID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid comment ID"})
 		return
 	}
 
-	if req.ObjectName == "" {
-		log.Println("❌ Missing object_name field")
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Missing 'object_name' field"})
-		return
+	var req struct {
+		Content string `json:"content"`
 	}
-
-	log.Printf("Request data: object_name=%s, timestamp=%.2f", req.ObjectName, req.TimestampSeconds)
-
-	grpcReq := &mediaPb.GenerateThumbnailRequest{
-		ObjectName:       req.ObjectName,
-		UserId:           userID,
-		TimestampSeconds: req.TimestampSeconds,
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
+		return
 	}
 
-	log.Println("Calling media-service GenerateThumbnail RPC...")
-	grpcRes, err := mediaClient.GenerateThumbnail(c.Request.Context(), grpcReq)
+	grpcRes, err := postClient.EditComment(c.Request.Context(), &postPb.EditCommentRequest{
+		UserId:    userID,
+		CommentId: commentID,
+		Content:   req.Content,
+	})
 	if err != nil {
 		grpcErr, _ := status.FromError(err)
-		log.Printf("❌ gRPC error: %v", grpcErr.Message())
 		c.JSON(gRPCToHTTPStatusCode(grpcErr.Code()), gin.H{"error": grpcErr.Message()})
 		return
 	}
-
-	log.Printf("✅ Thumbnail generated: %s", grpcRes.ThumbnailUrl)
-	log.Println("=== THUMBNAIL GENERATION COMPLETE ===")
 	c.JSON(http.StatusOK, grpcRes)
 }
 
-// handleGetHomeFeed_Gin godoc
-// @Summary Get home feed
-// @Description Get personalized home feed with posts from followed users
-// @Tags Feed
+// handlePinComment_Gin godoc
+// @Summary Pin or unpin a comment
+// @Description Pin (POST) or unpin (DELETE) a comment on your own post. Up to 3 comments can be pinned per post.
+// @Tags Comments
 // @Accept json
 // @Produce json
-// @Param page query int false "Page number" default(1)
-// @Param limit query int false "Items per page (max 100)" default(20)
-// @Success 200 {object} object{posts=[]object} "List of posts from followed users"
+// @Param id path int true "Comment ID"
+// @Success 200 {object} object{message=string} "Comment pinned/unpinned"
+// @Failure 400 {object} object{error=string} "Bad request - Invalid comment ID or pin limit reached"
 // @Failure 401 {object} object{error=string} "Unauthorized"
+// @Failure 403 {object} object{error=string} "Forbidden - Not the post author"
+// @Failure 404 {object} object{error=string} "Comment not found"
 // @Failure 500 {object} object{error=string} "Internal server error"
 // @Security BearerAuth
-// @Router /feed/home [get]
-func handleGetHomeFeed_Gin(c *gin.Context) {
+// @Router /comments/{id}/pin [post]
+// @Router /comments/{id}/pin [delete]
+func handlePinComment_Gin(c *gin.Context) {
 	userID, ok := c.Request.Context().Value(userIDKey).(int64)
 	if !ok {
 		c.JSON(http.StatusUnauthorized, gin.H{"error": "Failed to get user ID from token"})
 		return
 	}
 
-	// Get pagination query params, e.g., /feed/home?page=1&limit=20
-	// We'll default to page 1, limit 20
-	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
-	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
-
-	if page < 1 {
-		page = 1
-	}
-	if limit < 1 || limit > 100 {
-		limit = 20
-	}
-
-	offset := (page - 1) * limit
-
-	grpcReq := &postPb.GetHomeFeedRequest{
-		UserId:     userID,
-		PageSize:   int32(limit),
-		PageOffset: int32(offset),
+	commentID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid comment ID"})
+		return
 	}
 
-	grpcRes, err := postClient.GetHomeFeed(c.Request.Context(), grpcReq)
+	grpcRes, err := postClient.PinComment(c.Request.Context(), &postPb.PinCommentRequest{
+		UserId:    userID,
+		CommentId: commentID,
+		Pinned:    c.Request.Method == http.MethodPost,
+	})
 	if err != nil {
 		grpcErr, _ := status.FromError(err)
-		log.Printf("gRPC call to GetHomeFeed failed (%s): %v", grpcErr.Code(), grpcErr.Message())
 		c.JSON(gRPCToHTTPStatusCode(grpcErr.Code()), gin.H{"error": grpcErr.Message()})
 		return
 	}
-
-	c.JSON(http.StatusOK, gin.H{"posts": grpcRes.Posts})
+	c.JSON(http.StatusOK, grpcRes)
 }
 
-// handleGetExploreFeed_Gin godoc
-// @Summary Get explore feed
-// @Description Get explore feed with trending and recommended posts from all users
-// @Tags Feed
+// handleHideComment_Gin godoc
+// @Summary Hide or unhide a comment
+// @Description Hide (POST) or unhide (DELETE) a comment on your own post. Hidden comments stay visible to the commenter only.
+// @Tags Comments
 // @Accept json
 // @Produce json
-// @Param page query int false "Page number" default(1)
-// @Param limit query int false "Items per page (max 100)" default(20)
-// @Success 200 {object} object{posts=[]object} "List of trending and recommended posts"
+// @Param id path int true "Comment ID"
+// @Success 200 {object} object{message=string} "Comment hidden/unhidden"
+// @Failure 400 {object} object{error=string} "Bad request - Invalid comment ID"
 // @Failure 401 {object} object{error=string} "Unauthorized"
+// @Failure 403 {object} object{error=string} "Forbidden - Not the post author"
+// @Failure 404 {object} object{error=string} "Comment not found"
 // @Failure 500 {object} object{error=string} "Internal server error"
 // @Security BearerAuth
-// @Router /feed/explore [get]
-func handleGetExploreFeed_Gin(c *gin.Context) {
+// @Router /comments/{id}/hide [post]
+// @Router /comments/{id}/hide [delete]
+func handleHideComment_Gin(c *gin.Context) {
 	userID, ok := c.Request.Context().Value(userIDKey).(int64)
 	if !ok {
 		c.JSON(http.StatusUnauthorized, gin.H{"error": "Failed to get user ID from token"})
 		return
 	}
 
-	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
-	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
-	if page < 1 {
-		page = 1
+	commentID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid comment ID"})
+		return
 	}
-	if limit < 1 || limit > 100 {
-		limit = 20
+
+	grpcRes, err := postClient.HideComment(c.Request.Context(), &postPb.HideCommentRequest{
+		UserId:    userID,
+		CommentId: commentID,
+		Hidden:    c.Request.Method == http.MethodPost,
+	})
+	if err != nil {
+		grpcErr, _ := status.FromError(err)
+		c.JSON(gRPCToHTTPStatusCode(grpcErr.Code()), gin.H{"error": grpcErr.Message()})
+		return
+	}
+	c.JSON(http.StatusOK, grpcRes)
+}
+
+// handleDeletePost_Gin godoc
+// @Summary Delete a post
+// @Description Move a post to Recently Deleted (only owner can delete). It can be restored for 30 days before it is removed for good.
+// @Tags Posts
+// @Accept json
+// @Produce json
+// @Param id path int true "Post ID"
+// @Success 200 {object} object{message=string} "Post moved to Recently Deleted"
+// @Failure 400 {object} object{error=string} "Bad request - Invalid post ID"
+// @Failure 401 {object} object{error=string} "Unauthorized"
+// @Failure 403 {object} object{error=string} "Forbidden - Not allowed to delete this post"
+// @Failure 404 {object} object{error=string} "Post not found"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Security BearerAuth
+// @Router /posts/{id} [delete]
+func handleDeletePost_Gin(c *gin.Context) {
+	userID, ok := c.Request.Context().Value(userIDKey).(int64)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Failed to get user ID from token"})
+		return
+	}
+
+	postIDStr := c.Param("id")
+	postID, err := strconv.ParseInt(postIDStr, 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid post ID"})
+		return
+	}
+
+	grpcReq := &postPb.DeletePostRequest{PostId: postID, AdminUserId: userID}
+	grpcRes, err := postClient.DeletePost(c.Request.Context(), grpcReq)
+	if err != nil {
+		grpcErr, _ := status.FromError(err)
+		c.JSON(gRPCToHTTPStatusCode(grpcErr.Code()), gin.H{"error": grpcErr.Message()})
+		return
+	}
+	c.JSON(http.StatusOK, grpcRes)
+}
+
+// handleGetPost_Gin godoc
+// @Summary Get post by ID
+// @Description Get detailed information about a specific post including media, likes, comments count
+// @Tags Posts
+// @Accept json
+// @Produce json
+// @Param id path int true "Post ID"
+// @Success 200 {object} object "Post details with author info and engagement stats"
+// @Failure 400 {object} object{error=string} "Bad request - Invalid post ID"
+// @Failure 401 {object} object{error=string} "Unauthorized"
+// @Failure 404 {object} object{error=string} "Post not found"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Security BearerAuth
+// @Router /posts/{id} [get]
+func handleGetPost_Gin(c *gin.Context) {
+	userID, ok := c.Request.Context().Value(userIDKey).(int64)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Failed to get user ID from token"})
+		return
+	}
+
+	postIDStr := c.Param("id")
+	postID, err := strconv.ParseInt(postIDStr, 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid post ID"})
+		return
+	}
+
+	grpcReq := &postPb.GetPostRequest{
+		PostId:   postID,
+		ViewerId: userID,
+	}
+
+	grpcRes, err := postClient.GetPost(c.Request.Context(), grpcReq)
+	if err != nil {
+		grpcErr, _ := status.FromError(err)
+		log.Printf("gRPC call to GetPost failed (%s): %v", grpcErr.Code(), grpcErr.Message())
+		c.JSON(gRPCToHTTPStatusCode(grpcErr.Code()), gin.H{"error": grpcErr.Message()})
+		return
+	}
+
+	c.JSON(http.StatusOK, grpcRes)
+}
+
+// handleGetCommentsByPost_Gin godoc
+// @Summary Get comments for a post
+// @Description Get a cursor-paginated list of top-level comments for a post. Replies are loaded per comment via /comments/{id}/replies.
+// @Tags Comments
+// @Accept json
+// @Produce json
+// @Param id path int true "Post ID"
+// @Param limit query int false "Items per page (max 100)" default(20)
+// @Param cursor query string false "Cursor from a previous response's next_cursor"
+// @Param sort query string false "Sort order: newest or top" default(newest)
+// @Param include_hidden query bool false "Post author only: include hidden comments for review" default(false)
+// @Success 200 {object} object{comments=[]object,next_cursor=string} "Page of comments with user information"
+// @Failure 400 {object} object{error=string} "Bad request - Invalid post ID, cursor or sort"
+// @Failure 401 {object} object{error=string} "Unauthorized"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Security BearerAuth
+// @Router /posts/{id}/comments [get]
+func handleGetCommentsByPost_Gin(c *gin.Context) {
+	userID, ok := c.Request.Context().Value(userIDKey).(int64)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Failed to get user ID from token"})
+		return
+	}
+
+	postIDStr := c.Param("id")
+	postID, err := strconv.ParseInt(postIDStr, 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid post ID"})
+		return
+	}
+
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+	if limit < 1 || limit > 100 {
+		limit = 20
+	}
+
+	grpcReq := &postPb.GetCommentsByPostRequest{
+		PostId:   postID,
+		PageSize: int32(limit),
+		ViewerId: userID,
+		Cursor:   c.Query("cursor"),
+		Sort:     c.DefaultQuery("sort", "newest"),
+		// Only takes effect when the viewer is the post author
+		IncludeHidden: c.Query("include_hidden") == "true",
+	}
+
+	grpcRes, err := postClient.GetCommentsByPost(c.Request.Context(), grpcReq)
+	if err != nil {
+		grpcErr, _ := status.FromError(err)
+		c.JSON(gRPCToHTTPStatusCode(grpcErr.Code()), gin.H{"error": grpcErr.Message()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"comments": grpcRes.Comments, "next_cursor": grpcRes.NextCursor})
+}
+
+// handleGetCommentReplies_Gin godoc
+// @Summary Get replies to a comment
+// @Description Get a cursor-paginated list of replies to a comment, oldest first
+// @Tags Comments
+// @Accept json
+// @Produce json
+// @Param id path int true "Comment ID"
+// @Param limit query int false "Items per page (max 100)" default(20)
+// @Param cursor query string false "Cursor from a previous response's next_cursor"
+// @Success 200 {object} object{comments=[]object,next_cursor=string} "Page of replies"
+// @Failure 400 {object} object{error=string} "Bad request - Invalid comment ID or cursor"
+// @Failure 401 {object} object{error=string} "Unauthorized"
+// @Failure 404 {object} object{error=string} "Comment not found"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Security BearerAuth
+// @Router /comments/{id}/replies [get]
+func handleGetCommentReplies_Gin(c *gin.Context) {
+	userID, ok := c.Request.Context().Value(userIDKey).(int64)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Failed to get user ID from token"})
+		return
+	}
+
+	commentID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid comment ID"})
+		return
+	}
+
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+	if limit < 1 || limit > 100 {
+		limit = 20
+	}
+
+	grpcRes, err := postClient.GetCommentReplies(c.Request.Context(), &postPb.GetCommentRepliesRequest{
+		CommentId: commentID,
+		ViewerId:  userID,
+		PageSize:  int32(limit),
+		Cursor:    c.Query("cursor"),
+	})
+	if err != nil {
+		grpcErr, _ := status.FromError(err)
+		c.JSON(gRPCToHTTPStatusCode(grpcErr.Code()), gin.H{"error": grpcErr.Message()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"comments": grpcRes.Comments, "next_cursor": grpcRes.NextCursor})
+}
+
+// handleGetUploadURL_Gin godoc
+// @Summary Get pre-signed upload URL
+// @Description Get a pre-signed URL for uploading media files to cloud storage
+// @Tags Media
+// @Accept json
+// @Produce json
+// @Param filename query string true "Filename"
+// @Param type query string true "Content type (e.g., image/jpeg, video/mp4)"
+// @Success 200 {object} object{upload_url=string,media_url=string} "Pre-signed upload URL and final media URL"
+// @Failure 400 {object} object{error=string} "Bad request - Missing filename or type"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Router /media/upload-url [get]
+func handleGetUploadURL_Gin(c *gin.Context) {
+	var userID int64 = 0
+	if val, ok := c.Request.Context().Value(userIDKey).(int64); ok {
+		userID = val
+	}
+
+	// Get query params, e.g., /media/upload-url?filename=foo.jpg&type=image/jpeg
+	filename := c.Query("filename")
+	contentType := c.Query("type")
+
+	if filename == "" || contentType == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Missing 'filename' or 'type' query parameters"})
+		return
+	}
+
+	grpcReq := &mediaPb.GetUploadURLRequest{
+		Filename:    filename,
+		ContentType: contentType,
+		UserId:      userID,
+	}
+
+	grpcRes, err := mediaClient.GetUploadURL(c.Request.Context(), grpcReq)
+	if err != nil {
+		grpcErr, _ := status.FromError(err)
+		c.JSON(gRPCToHTTPStatusCode(grpcErr.Code()), gin.H{"error": grpcErr.Message()})
+		return
+	}
+	c.JSON(http.StatusOK, grpcRes)
+}
+
+// handleGetMediaURL_Gin godoc
+// @Summary Get pre-signed media URL
+// @Description Get a pre-signed URL for accessing private media files
+// @Tags Media
+// @Accept json
+// @Produce json
+// @Param object_name query string true "Object name in storage"
+// @Param expiry_seconds query int false "URL expiry in seconds" default(3600)
+// @Success 200 {object} object{url=string} "Pre-signed access URL"
+// @Failure 400 {object} object{error=string} "Bad request - Missing object_name"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Router /media/secure-url [get]
+func handleGetMediaURL_Gin(c *gin.Context) {
+	// Get query param, e.g., /media/secure-url?object_name=user-123/posts/abc.jpg
+	objectName := c.Query("object_name")
+
+	if objectName == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Missing 'object_name' query parameter"})
+		return
+	}
+
+	// Optional expiry parameter (default handled by media-service)
+	expirySeconds, _ := strconv.Atoi(c.DefaultQuery("expiry_seconds", "3600"))
+
+	grpcReq := &mediaPb.GetMediaURLRequest{
+		ObjectName:    objectName,
+		ExpirySeconds: int32(expirySeconds),
+	}
+
+	grpcRes, err := mediaClient.GetMediaURL(c.Request.Context(), grpcReq)
+	if err != nil {
+		grpcErr, _ := status.FromError(err)
+		c.JSON(gRPCToHTTPStatusCode(grpcErr.Code()), gin.H{"error": grpcErr.Message()})
+		return
+	}
+	c.JSON(http.StatusOK, grpcRes)
+}
+
+// handleGenerateThumbnail_Gin godoc
+// @Summary Generate video thumbnail
+// @Description Generate a thumbnail image from a video at a specific timestamp
+// @Tags Media
+// @Accept json
+// @Produce json
+// @Param request body object{object_name=string,timestamp_seconds=float64} true "Thumbnail generation data"
+// @Success 200 {object} object{thumbnail_url=string} "Generated thumbnail URL"
+// @Failure 400 {object} object{error=string} "Bad request - Missing object_name"
+// @Failure 401 {object} object{error=string} "Unauthorized"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Security BearerAuth
+// @Router /media/generate-thumbnail [post]
+func handleGenerateThumbnail_Gin(c *gin.Context) {
+	log.Println("=== THUMBNAIL GENERATION REQUEST ===")
+
+	userID, ok := c.Request.Context().Value(userIDKey).(int64)
+	if !ok {
+		log.Println("❌ Failed to get user ID from token")
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Failed to get user ID from token"})
+		return
+	}
+	log.Printf("✅ User ID: %d", userID)
+
+	var req struct {
+		ObjectName       string  `json:"object_name"`
+		TimestampSeconds float64 `json:"timestamp_seconds"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.Printf("❌ Invalid request body: %v", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if req.ObjectName == "" {
+		log.Println("❌ Missing object_name field")
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Missing 'object_name' field"})
+		return
+	}
+
+	log.Printf("Request data: object_name=%s, timestamp=%.2f", req.ObjectName, req.TimestampSeconds)
+
+	grpcReq := &mediaPb.GenerateThumbnailRequest{
+		ObjectName:       req.ObjectName,
+		UserId:           userID,
+		TimestampSeconds: req.TimestampSeconds,
+	}
+
+	log.Println("Calling media-service GenerateThumbnail RPC...")
+	grpcRes, err := mediaClient.GenerateThumbnail(c.Request.Context(), grpcReq)
+	if err != nil {
+		grpcErr, _ := status.FromError(err)
+		log.Printf("❌ gRPC error: %v", grpcErr.Message())
+		c.JSON(gRPCToHTTPStatusCode(grpcErr.Code()), gin.H{"error": grpcErr.Message()})
+		return
+	}
+
+	log.Printf("✅ Thumbnail generated: %s", grpcRes.ThumbnailUrl)
+	log.Println("=== THUMBNAIL GENERATION COMPLETE ===")
+	c.JSON(http.StatusOK, grpcRes)
+}
+
+// handleGetHomeFeed_Gin godoc
+// @Summary Get home feed
+// @Description Get personalized home feed with posts from followed users, newest first or ranked by relevance
+// @Tags Feed
+// @Accept json
+// @Produce json
+// @Param limit query int false "Items per page (max 100)" default(20)
+// @Param cursor query string false "Cursor from the previous page's next_cursor"
+// @Param sort query string false "chronological or ranked" default(chronological)
+// @Success 200 {object} object{posts=[]object,next_cursor=string} "List of posts from followed users"
+// @Failure 400 {object} object{error=string} "Bad request - Invalid cursor or sort"
+// @Failure 401 {object} object{error=string} "Unauthorized"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Security BearerAuth
+// @Router /feed/home [get]
+func handleGetHomeFeed_Gin(c *gin.Context) {
+	userID, ok := c.Request.Context().Value(userIDKey).(int64)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Failed to get user ID from token"})
+		return
+	}
+
+	// Get pagination query params, e.g., /feed/home?limit=20&cursor=...&sort=ranked
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+	if limit < 1 || limit > 100 {
+		limit = 20
+	}
+
+	grpcReq := &postPb.GetHomeFeedRequest{
+		UserId:   userID,
+		PageSize: int32(limit),
+		Cursor:   c.Query("cursor"),
+		Sort:     c.Query("sort"),
+	}
+
+	grpcRes, err := postClient.GetHomeFeed(c.Request.Context(), grpcReq)
+	if err != nil {
+		grpcErr, _ := status.FromError(err)
+		log.Printf("gRPC call to GetHomeFeed failed (%s): %v", grpcErr.Code(), grpcErr.Message())
+		c.JSON(gRPCToHTTPStatusCode(grpcErr.Code()), gin.H{"error": grpcErr.Message()})
+		return
+	}
+
+	posts := grpcRes.Posts
+	if posts == nil {
+		posts = []*postPb.Post{}
+	}
+	c.JSON(http.StatusOK, gin.H{"posts": posts, "next_cursor": grpcRes.NextCursor})
+}
+
+// handleGetExploreFeed_Gin godoc
+// @Summary Get explore feed
+// @Description Get a personalized explore feed built from hashtags you engage with, posts liked by people you follow and trending posts. Posts already shown to you are skipped for a day.
+// @Tags Feed
+// @Accept json
+// @Produce json
+// @Param limit query int false "Items per page (max 100)" default(20)
+// @Param cursor query string false "Cursor from the previous page's next_cursor"
+// @Success 200 {object} object{posts=[]object,next_cursor=string} "List of recommended posts"
+// @Failure 400 {object} object{error=string} "Bad request - Invalid cursor"
+// @Failure 401 {object} object{error=string} "Unauthorized"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Security BearerAuth
+// @Router /feed/explore [get]
+func handleGetExploreFeed_Gin(c *gin.Context) {
+	userID, ok := c.Request.Context().Value(userIDKey).(int64)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Failed to get user ID from token"})
+		return
+	}
+
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+	if limit < 1 || limit > 100 {
+		limit = 20
+	}
+
+	grpcReq := &postPb.GetHomeFeedRequest{
+		UserId:   userID,
+		PageSize: int32(limit),
+		Cursor:   c.Query("cursor"),
+	}
+
+	grpcRes, err := postClient.GetExploreFeed(c.Request.Context(), grpcReq)
+	if err != nil {
+		grpcErr, _ := status.FromError(err)
+		log.Printf("gRPC call to GetExploreFeed failed (%s): %v", grpcErr.Code(), grpcErr.Message())
+		c.JSON(gRPCToHTTPStatusCode(grpcErr.Code()), gin.H{"error": grpcErr.Message()})
+		return
+	}
+
+	posts := grpcRes.Posts
+	if posts == nil {
+		posts = []*postPb.Post{}
+	}
+	c.JSON(http.StatusOK, gin.H{"posts": posts, "next_cursor": grpcRes.NextCursor})
+}
+
+// handleGetReelsFeed_Gin godoc
+// @Summary Get reels feed
+// @Description Get a feed of recent reels you haven't watched, ranked by how often they're watched to the end and how fresh they are
+// @Tags Feed
+// @Accept json
+// @Produce json
+// @Param limit query int false "Items per page (max 100)" default(20)
+// @Param cursor query string false "Cursor from the previous page's next_cursor"
+// @Success 200 {object} object{posts=[]object,next_cursor=string} "List of video reels"
+// @Failure 400 {object} object{error=string} "Bad request - Invalid cursor"
+// @Failure 401 {object} object{error=string} "Unauthorized"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Security BearerAuth
+// @Router /feed/reels [get]
+func handleGetReelsFeed_Gin(c *gin.Context) {
+	userID, ok := c.Request.Context().Value(userIDKey).(int64)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Failed to get user ID from token"})
+		return
+	}
+
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+	if limit < 1 || limit > 100 {
+		limit = 20
+	}
+
+	grpcReq := &postPb.GetHomeFeedRequest{
+		UserId:   userID,
+		PageSize: int32(limit),
+		Cursor:   c.Query("cursor"),
+	}
+
+	grpcRes, err := postClient.GetReelsFeed(c.Request.Context(), grpcReq)
+	if err != nil {
+		grpcErr, _ := status.FromError(err)
+		log.Printf("gRPC call to GetReelsFeed failed (%s): %v", grpcErr.Code(), grpcErr.Message())
+		c.JSON(gRPCToHTTPStatusCode(grpcErr.Code()), gin.H{"error": grpcErr.Message()})
+		return
+	}
+
+	posts := grpcRes.Posts
+	if posts == nil {
+		posts = []*postPb.Post{}
+	}
+	c.JSON(http.StatusOK, gin.H{"posts": posts, "next_cursor": grpcRes.NextCursor})
+}
+
+// handleRecordReelWatch_Gin godoc
+// @Summary Record a reel watch
+// @Description Report how a reel was watched: time watched, whether it finished, replays and skips. Drives reels feed ranking; watched reels aren't served again.
+// @Tags Feed
+// @Accept json
+// @Produce json
+// @Param id path int true "Post ID of the reel"
+// @Param request body object{watch_ms=int64,duration_ms=int64,completed=bool,replays=int32,skipped=bool} true "Watch details"
+// @Success 200 {object} object{message=string} "Watch recorded"
+// @Failure 400 {object} object{error=string} "Bad request - Invalid post ID, not a reel or negative values"
+// @Failure 401 {object} object{error=string} "Unauthorized"
+// @Failure 403 {object} object{error=string} "Forbidden - Reel not visible to you"
+// @Failure 404 {object} object{error=string} "Post not found"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Security BearerAuth
+// @Router /reels/{id}/watch [post]
+func handleRecordReelWatch_Gin(c *gin.Context) {
+	userID, ok := c.Request.Context().Value(userIDKey).(int64)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Failed to get user ID from token"})
+		return
+	}
+
+	postID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid post ID"})
+		return
+	}
+
+	var req struct {
+		WatchMs    int64 `json:"watch_ms"`
+		DurationMs int64 `json:"duration_ms"`
+		Completed  bool  `json:"completed"`
+		Replays    int32 `json:"replays"`
+		Skipped    bool  `json:"skipped"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
+		return
+	}
+
+	grpcRes, err := postClient.RecordReelWatch(c.Request.Context(), &postPb.RecordReelWatchRequest{
+		UserId:     userID,
+		PostId:     postID,
+		WatchMs:    req.WatchMs,
+		DurationMs: req.DurationMs,
+		Completed:  req.Completed,
+		Replays:    req.Replays,
+		Skipped:    req.Skipped,
+	})
+	if err != nil {
+		grpcErr, _ := status.FromError(err)
+		c.JSON(gRPCToHTTPStatusCode(grpcErr.Code()), gin.H{"error": grpcErr.Message()})
+		return
+	}
+	c.JSON(http.StatusOK, grpcRes)
+}
+
+// handleMarkNotInterested_Gin godoc
+// @Summary Mark content as not interested
+// @Description Stop seeing a post, an author or a hashtag in the explore and reels feeds. For kind "author", either author_id or a post_id by that author may be given. Marking the same thing twice returns the existing signal.
+// @Tags Feed
+// @Accept json
+// @Produce json
+// @Param request body object{kind=string,post_id=int64,author_id=int64,hashtag=string} true "Kind is post, author or hashtag"
+// @Success 200 {object} object{id=string,kind=string,post_id=int64,author_id=int64,author_username=string,hashtag=string,created_at=string} "Saved signal"
+// @Failure 400 {object} object{error=string} "Bad request - Unknown kind, missing target or own content"
+// @Failure 401 {object} object{error=string} "Unauthorized"
+// @Failure 404 {object} object{error=string} "Post or user not found"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Security BearerAuth
+// @Router /feed/not-interested [post]
+func handleMarkNotInterested_Gin(c *gin.Context) {
+	userID, ok := c.Request.Context().Value(userIDKey).(int64)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Failed to get user ID from token"})
+		return
+	}
+
+	var req struct {
+		Kind     string `json:"kind" binding:"required"`
+		PostID   int64  `json:"post_id"`
+		AuthorID int64  `json:"author_id"`
+		Hashtag  string `json:"hashtag"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
+		return
+	}
+
+	grpcRes, err := postClient.MarkNotInterested(c.Request.Context(), &postPb.MarkNotInterestedRequest{
+		UserId:   userID,
+		Kind:     req.Kind,
+		PostId:   req.PostID,
+		AuthorId: req.AuthorID,
+		Hashtag:  req.Hashtag,
+	})
+	if err != nil {
+		grpcErr, _ := status.FromError(err)
+		c.JSON(gRPCToHTTPStatusCode(grpcErr.Code()), gin.H{"error": grpcErr.Message()})
+		return
+	}
+	c.JSON(http.StatusOK, grpcRes)
+}
+
+// handleGetNotInterested_Gin godoc
+// @Summary List not interested signals
+// @Description Review the posts, authors and hashtags you marked as not interested, newest first
+// @Tags Feed
+// @Accept json
+// @Produce json
+// @Param limit query int false "Page size (default 20, max 100)"
+// @Param cursor query string false "Cursor from the previous page's next_cursor"
+// @Success 200 {object} object{signals=[]object,next_cursor=string} "Signals"
+// @Failure 400 {object} object{error=string} "Bad request - Invalid cursor"
+// @Failure 401 {object} object{error=string} "Unauthorized"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Security BearerAuth
+// @Router /feed/not-interested [get]
+func handleGetNotInterested_Gin(c *gin.Context) {
+	userID, ok := c.Request.Context().Value(userIDKey).(int64)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Failed to get user ID from token"})
+		return
+	}
+
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+	if limit < 1 || limit > 100 {
+		limit = 20
+	}
+
+	grpcRes, err := postClient.GetNotInterested(c.Request.Context(), &postPb.GetNotInterestedRequest{
+		UserId:   userID,
+		PageSize: int32(limit),
+		Cursor:   c.Query("cursor"),
+	})
+	if err != nil {
+		grpcErr, _ := status.FromError(err)
+		c.JSON(gRPCToHTTPStatusCode(grpcErr.Code()), gin.H{"error": grpcErr.Message()})
+		return
+	}
+
+	signals := grpcRes.Signals
+	if signals == nil {
+		signals = []*postPb.NotInterestedSignal{}
+	}
+	c.JSON(http.StatusOK, gin.H{"signals": signals, "next_cursor": grpcRes.NextCursor})
+}
+
+// handleUndoNotInterested_Gin godoc
+// @Summary Undo a not interested signal
+// @Description Remove a signal so its post, author or hashtag can appear in explore and reels again
+// @Tags Feed
+// @Accept json
+// @Produce json
+// @Param id path int true "Signal ID"
+// @Success 200 {object} object{message=string} "Signal removed"
+// @Failure 400 {object} object{error=string} "Bad request - Invalid signal ID"
+// @Failure 401 {object} object{error=string} "Unauthorized"
+// @Failure 404 {object} object{error=string} "Signal not found"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Security BearerAuth
+// @Router /feed/not-interested/{id} [delete]
+func handleUndoNotInterested_Gin(c *gin.Context) {
+	userID, ok := c.Request.Context().Value(userIDKey).(int64)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Failed to get user ID from token"})
+		return
+	}
+
+	signalID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid signal ID"})
+		return
+	}
+
+	grpcRes, err := postClient.UndoNotInterested(c.Request.Context(), &postPb.UndoNotInterestedRequest{
+		UserId:   userID,
+		SignalId: signalID,
+	})
+	if err != nil {
+		grpcErr, _ := status.FromError(err)
+		c.JSON(gRPCToHTTPStatusCode(grpcErr.Code()), gin.H{"error": grpcErr.Message()})
+		return
+	}
+	c.JSON(http.StatusOK, grpcRes)
+}
+
+// handleRecordImpressions_Gin godoc
+// @Summary Record post impressions
+// @Description Report posts that were shown on screen, for the authors' insights. Own posts and posts you can't see are skipped.
+// @Tags Insights
+// @Accept json
+// @Produce json
+// @Param request body object{surface=string,post_ids=[]int64} true "Surface is home, explore, reels, profile, hashtag or collection; at most 100 posts"
+// @Success 200 {object} object{recorded=int} "Impressions counted"
+// @Failure 400 {object} object{error=string} "Bad request - Unknown surface or too many posts"
+// @Failure 401 {object} object{error=string} "Unauthorized"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Security BearerAuth
+// @Router /feed/impressions [post]
+func handleRecordImpressions_Gin(c *gin.Context) {
+	userID, ok := c.Request.Context().Value(userIDKey).(int64)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Failed to get user ID from token"})
+		return
+	}
+
+	var req struct {
+		Surface string  `json:"surface" binding:"required"`
+		PostIDs []int64 `json:"post_ids"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
+		return
+	}
+
+	grpcRes, err := postClient.RecordImpressions(c.Request.Context(), &postPb.RecordImpressionsRequest{
+		UserId:  userID,
+		Surface: req.Surface,
+		PostIds: req.PostIDs,
+	})
+	if err != nil {
+		grpcErr, _ := status.FromError(err)
+		c.JSON(gRPCToHTTPStatusCode(grpcErr.Code()), gin.H{"error": grpcErr.Message()})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"recorded": grpcRes.Recorded})
+}
+
+// handleSendPostToDirect_Gin godoc
+// @Summary Send a post in direct messages
+// @Description Send a post to people (in your 1:1 conversation with each, started if needed) and to existing conversations, with an optional note. Each target succeeds or fails on its own: the post is only sent where everyone on the other end can see it.
+// @Tags Posts
+// @Accept json
+// @Produce json
+// @Param id path int true "Post ID"
+// @Param request body object{recipient_ids=[]int64,conversation_ids=[]string,message=string} true "Up to 20 recipients and conversations in total"
+// @Success 200 {object} object{results=[]object{recipient_id=int64,conversation_id=string,sent=bool,error=string}} "Result per recipient, then per conversation"
+// @Failure 400 {object} object{error=string} "Bad request - No or too many targets"
+// @Failure 401 {object} object{error=string} "Unauthorized"
+// @Failure 403 {object} object{error=string} "Forbidden - Cannot view this post"
+// @Failure 404 {object} object{error=string} "Post not found"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Security BearerAuth
+// @Router /posts/{id}/send [post]
+func handleSendPostToDirect_Gin(c *gin.Context) {
+	userID, ok := c.Request.Context().Value(userIDKey).(int64)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Failed to get user ID from token"})
+		return
+	}
+
+	postID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid post ID"})
+		return
+	}
+
+	var req struct {
+		RecipientIDs    []int64  `json:"recipient_ids"`
+		ConversationIDs []string `json:"conversation_ids"`
+		Message         string   `json:"message"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
+		return
+	}
+
+	grpcRes, err := postClient.SendPostToDirect(c.Request.Context(), &postPb.SendPostToDirectRequest{
+		UserId:          userID,
+		PostId:          postID,
+		RecipientIds:    req.RecipientIDs,
+		ConversationIds: req.ConversationIDs,
+		Message:         req.Message,
+	})
+	if err != nil {
+		grpcErr, _ := status.FromError(err)
+		c.JSON(gRPCToHTTPStatusCode(grpcErr.Code()), gin.H{"error": grpcErr.Message()})
+		return
+	}
+
+	results := grpcRes.Results
+	if results == nil {
+		results = []*postPb.DirectSendResult{}
+	}
+	c.JSON(http.StatusOK, gin.H{"results": results})
+}
+
+// handleRecordProfileVisit_Gin godoc
+// @Summary Record a profile visit from a post
+// @Description Report that the author's profile was opened from this post, for the author's insights
+// @Tags Insights
+// @Accept json
+// @Produce json
+// @Param id path int true "Post ID"
+// @Success 200 {object} object{message=string} "Visit recorded"
+// @Failure 400 {object} object{error=string} "Bad request - Invalid post ID"
+// @Failure 401 {object} object{error=string} "Unauthorized"
+// @Failure 403 {object} object{error=string} "Forbidden - Cannot view this post"
+// @Failure 404 {object} object{error=string} "Post not found"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Security BearerAuth
+// @Router /posts/{id}/profile-visit [post]
+func handleRecordProfileVisit_Gin(c *gin.Context) {
+	userID, ok := c.Request.Context().Value(userIDKey).(int64)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Failed to get user ID from token"})
+		return
+	}
+
+	postID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid post ID"})
+		return
+	}
+
+	grpcRes, err := postClient.RecordProfileVisit(c.Request.Context(), &postPb.RecordProfileVisitRequest{
+		UserId: userID,
+		PostId: postID,
+	})
+	if err != nil {
+		grpcErr, _ := status.FromError(err)
+		c.JSON(gRPCToHTTPStatusCode(grpcErr.Code()), gin.H{"error": grpcErr.Message()})
+		return
+	}
+	c.JSON(http.StatusOK, grpcRes)
+}
+
+// handleGetPostInsights_Gin godoc
+// @Summary Get post insights
+// @Description Lifetime impressions (by surface), reach, profile visits, likes, comments, saves and shares for one of your posts, with a daily series. Only the author can see them.
+// @Tags Insights
+// @Accept json
+// @Produce json
+// @Param id path int true "Post ID"
+// @Param days query int false "Length of the daily series (default 30, max 90)"
+// @Success 200 {object} object{post_id=int64,impressions=int64,reach=int64,profile_visits=int64,likes=int64,comments=int64,saves=int64,shares=int64,impressions_by_surface=object,days=[]object} "Post insights"
+// @Failure 400 {object} object{error=string} "Bad request - Invalid post ID"
+// @Failure 401 {object} object{error=string} "Unauthorized"
+// @Failure 403 {object} object{error=string} "Forbidden - Not the author"
+// @Failure 404 {object} object{error=string} "Post not found"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Security BearerAuth
+// @Router /posts/{id}/insights [get]
+func handleGetPostInsights_Gin(c *gin.Context) {
+	userID, ok := c.Request.Context().Value(userIDKey).(int64)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Failed to get user ID from token"})
+		return
+	}
+
+	postID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid post ID"})
+		return
+	}
+	days, _ := strconv.Atoi(c.DefaultQuery("days", "30"))
+
+	grpcRes, err := postClient.GetPostInsights(c.Request.Context(), &postPb.GetPostInsightsRequest{
+		UserId: userID,
+		PostId: postID,
+		Days:   int32(days),
+	})
+	if err != nil {
+		grpcErr, _ := status.FromError(err)
+		c.JSON(gRPCToHTTPStatusCode(grpcErr.Code()), gin.H{"error": grpcErr.Message()})
+		return
+	}
+	c.JSON(http.StatusOK, grpcRes)
+}
+
+// handleGetAccountInsights_Gin godoc
+// @Summary Get account insights
+// @Description Your account's impressions, reach, profile visits, likes, saves, shares and follower growth over a period, day by day, with your most seen posts
+// @Tags Insights
+// @Accept json
+// @Produce json
+// @Param days query int false "Length of the period (default 30, max 90)"
+// @Success 200 {object} object{follower_count=int64,followers_gained=int64,impressions=int64,reach=int64,profile_visits=int64,likes=int64,saves=int64,shares=int64,days=[]object,top_posts=[]object} "Account insights"
+// @Failure 401 {object} object{error=string} "Unauthorized"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Security BearerAuth
+// @Router /insights/account [get]
+func handleGetAccountInsights_Gin(c *gin.Context) {
+	userID, ok := c.Request.Context().Value(userIDKey).(int64)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Failed to get user ID from token"})
+		return
+	}
+
+	days, _ := strconv.Atoi(c.DefaultQuery("days", "30"))
+
+	grpcRes, err := postClient.GetAccountInsights(c.Request.Context(), &postPb.GetAccountInsightsRequest{
+		UserId: userID,
+		Days:   int32(days),
+	})
+	if err != nil {
+		grpcErr, _ := status.FromError(err)
+		c.JSON(gRPCToHTTPStatusCode(grpcErr.Code()), gin.H{"error": grpcErr.Message()})
+		return
+	}
+	c.JSON(http.StatusOK, grpcRes)
+}
+
+// handleGetUserProfile_Gin godoc
+// @Summary Get user profile
+// @Description Get complete user profile by username including bio, stats, and relationship status
+// @Tags Users
+// @Accept json
+// @Produce json
+// @Param id path string true "Username"
+// @Success 200 {object} object{user=object,post_count=int64,reel_count=int64} "User profile with post/reel counts"
+// @Failure 401 {object} object{error=string} "Unauthorized"
+// @Failure 404 {object} object{error=string} "User not found"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Security BearerAuth
+// @Router /users/{id} [get]
+func handleGetUserProfile_Gin(c *gin.Context) {
+	selfUserID, ok := c.Request.Context().Value(userIDKey).(int64)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Failed to get user ID from token"})
+		return
+	}
+	usernameToFind := c.Param("id") // Get username from URL
+
+	// --- 1. Get Profile Data from User-Service ---
+	userReq := &pb.GetUserProfileRequest{
+		Username:   usernameToFind,
+		SelfUserId: selfUserID,
+	}
+	userRes, err := client.GetUserProfile(c.Request.Context(), userReq)
+	if err != nil {
+		grpcErr, _ := status.FromError(err)
+		c.JSON(gRPCToHTTPStatusCode(grpcErr.Code()), gin.H{"error": grpcErr.Message()})
+		return
+	}
+
+	// --- 2. Get Post/Reel Counts from Post-Service (THIS IS THE FIX) ---
+	countReq := &postPb.GetUserContentCountRequest{UserId: userRes.UserId}
+	countRes, err := postClient.GetUserContentCount(c.Request.Context(), countReq)
+	if err != nil {
+		// Don't fail the whole request if this fails, just log it
+		log.Printf("Failed to get post counts: %v", err)
+	}
+
+	// --- 3. Aggregate the response ---
+	type ProfileResponse struct {
+		User      *pb.GetUserProfileResponse `json:"user"`
+		PostCount int64                      `json:"post_count"`
+		ReelCount int64                      `json:"reel_count"`
+	}
+
+	var postCount int64 = 0
+	var reelCount int64 = 0
+	if countRes != nil {
+		postCount = countRes.PostCount
+		reelCount = countRes.ReelCount
+	}
+
+	res := ProfileResponse{
+		User:      userRes,
+		PostCount: postCount,
+		ReelCount: reelCount,
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// handleGetUserPosts_Gin godoc
+// @Summary Get user's posts
+// @Description Get paginated list of posts created by a specific user
+// @Tags Users
+// @Accept json
+// @Produce json
+// @Param id path string true "Username"
+// @Param limit query int false "Items per page (max 100)" default(12)
+// @Param cursor query string false "Cursor from the previous page's next_cursor"
+// @Success 200 {object} object{posts=[]object,next_cursor=string} "List of user's posts"
+// @Failure 400 {object} object{error=string} "Bad request - Invalid cursor"
+// @Failure 401 {object} object{error=string} "Unauthorized"
+// @Failure 404 {object} object{error=string} "User not found"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Security BearerAuth
+// @Router /users/{id}/posts [get]
+func handleGetUserPosts_Gin(c *gin.Context) {
+	usernameToFind := c.Param("id")
+
+	userRes, err := client.GetUserProfile(c.Request.Context(), &pb.GetUserProfileRequest{Username: usernameToFind})
+	if err != nil {
+		grpcErr, _ := status.FromError(err)
+		c.JSON(gRPCToHTTPStatusCode(grpcErr.Code()), gin.H{"error": grpcErr.Message()})
+		return
+	}
+
+	// Get the current user ID from context
+	currentUserID, _ := c.Request.Context().Value(userIDKey).(int64)
+
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "12"))
+	if limit < 1 || limit > 100 {
+		limit = 12
+	}
+
+	// --- THIS IS THE FIX ---
+	grpcReq := &postPb.GetUserContentRequest{ // Was pb.
+		UserId:      userRes.UserId,
+		RequesterId: currentUserID,
+		PageSize:    int32(limit),
+		Cursor:      c.Query("cursor"),
+	}
+	// --- END FIX ---
+
+	grpcRes, err := postClient.GetUserPosts(c.Request.Context(), grpcReq)
+	if err != nil {
+		grpcErr, _ := status.FromError(err)
+		c.JSON(gRPCToHTTPStatusCode(grpcErr.Code()), gin.H{"error": grpcErr.Message()})
+		return
+	}
+
+	posts := grpcRes.Posts
+	if posts == nil {
+		posts = []*postPb.Post{}
+	}
+	c.JSON(http.StatusOK, gin.H{"posts": posts, "next_cursor": grpcRes.NextCursor})
+}
+
+// handleGetUserReels_Gin godoc
+// @Summary Get user's reels
+// @Description Get paginated list of video reels created by a specific user
+// @Tags Users
+// @Accept json
+// @Produce json
+// @Param id path string true "Username"
+// @Param limit query int false "Items per page (max 100)" default(12)
+// @Param cursor query string false "Cursor from the previous page's next_cursor"
+// @Success 200 {object} object{posts=[]object,next_cursor=string} "List of user's reels"
+// @Failure 400 {object} object{error=string} "Bad request - Invalid cursor"
+// @Failure 401 {object} object{error=string} "Unauthorized"
+// @Failure 404 {object} object{error=string} "User not found"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Security BearerAuth
+// @Router /users/{id}/reels [get]
+func handleGetUserReels_Gin(c *gin.Context) {
+	usernameToFind := c.Param("id")
+
+	userRes, err := client.GetUserProfile(c.Request.Context(), &pb.GetUserProfileRequest{Username: usernameToFind})
+	if err != nil {
+		grpcErr, _ := status.FromError(err)
+		c.JSON(gRPCToHTTPStatusCode(grpcErr.Code()), gin.H{"error": grpcErr.Message()})
+		return
+	}
+
+	// Get the current user ID from context
+	currentUserID, _ := c.Request.Context().Value(userIDKey).(int64)
+
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "12"))
+	if limit < 1 || limit > 100 {
+		limit = 12
+	}
+
+	// --- THIS IS THE FIX ---
+	grpcReq := &postPb.GetUserContentRequest{ // Was pb.
+		UserId:      userRes.UserId,
+		RequesterId: currentUserID,
+		PageSize:    int32(limit),
+		Cursor:      c.Query("cursor"),
+	}
+	// --- END FIX ---
+
+	grpcRes, err := postClient.GetUserReels(c.Request.Context(), grpcReq)
+	if err != nil {
+		grpcErr, _ := status.FromError(err)
+		c.JSON(gRPCToHTTPStatusCode(grpcErr.Code()), gin.H{"error": grpcErr.Message()})
+		return
+	}
+
+	posts := grpcRes.Posts
+	if posts == nil {
+		posts = []*postPb.Post{}
+	}
+	c.JSON(http.StatusOK, gin.H{"posts": posts, "next_cursor": grpcRes.NextCursor})
+}
+
+// handleCompleteProfile_Gin godoc
+// @Summary Complete user profile
+// @Description Complete user profile after registration (username, DOB, gender)
+// @Tags Users
+// @Accept json
+// @Produce json
+// @Param request body object{username=string,date_of_birth=string,gender=string} true "Profile completion data"
+// @Success 200 {object} object{message=string} "Profile completed successfully"
+// @Failure 400 {object} object{error=string} "Bad request - Invalid input"
+// @Failure 401 {object} object{error=string} "Unauthorized"
+// @Failure 409 {object} object{error=string} "Conflict - Username already taken"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Security BearerAuth
+// @Router /users/complete-profile [put]
+func handleCompleteProfile_Gin(c *gin.Context) {
+	userID, ok := c.Request.Context().Value(userIDKey).(int64)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Failed to get user ID from token"})
+		return
+	}
+
+	var req struct {
+		Username    string `json:"username"`
+		DateOfBirth string `json:"date_of_birth"`
+		Gender      string `json:"gender"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	grpcReq := &pb.CompleteProfileRequest{
+		UserId:      userID,
+		Username:    req.Username,
+		DateOfBirth: req.DateOfBirth,
+		Gender:      req.Gender,
+	}
+
+	grpcRes, err := client.CompleteProfile(c.Request.Context(), grpcReq)
+	if err != nil {
+		grpcErr, _ := status.FromError(err)
+		c.JSON(gRPCToHTTPStatusCode(grpcErr.Code()), gin.H{"error": grpcErr.Message()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": grpcRes.Message})
+}
+
+// handleUpdateProfile_Gin godoc
+// @Summary Update user profile
+// @Description Update user profile information (name, bio, gender, profile picture)
+// @Tags Users
+// @Accept json
+// @Produce json
+// @Param request body object{name=string,bio=string,gender=string,profile_picture_url=string} true "Profile update data"
+// @Success 200 {object} object "Updated profile information"
+// @Failure 400 {object} object{error=string} "Bad request - Invalid input"
+// @Failure 401 {object} object{error=string} "Unauthorized"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Security BearerAuth
+// @Router /profile/edit [put]
+func handleUpdateProfile_Gin(c *gin.Context) {
+	userID, ok := c.Request.Context().Value(userIDKey).(int64)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Failed to get user ID from token"})
+		return
+	}
+
+	var req struct {
+		Name              string `json:"name"`
+		Bio               string `json:"bio"`
+		Gender            string `json:"gender"`
+		ProfilePictureURL string `json:"profile_picture_url"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	grpcReq := &pb.UpdateUserProfileRequest{
+		UserId:            userID,
+		Name:              req.Name,
+		Bio:               req.Bio,
+		Gender:            req.Gender,
+		ProfilePictureUrl: req.ProfilePictureURL,
+	}
+
+	grpcRes, err := client.UpdateUserProfile(c.Request.Context(), grpcReq)
+	if err != nil {
+		grpcErr, _ := status.FromError(err)
+		c.JSON(gRPCToHTTPStatusCode(grpcErr.Code()), gin.H{"error": grpcErr.Message()})
+		return
+	}
+
+	c.JSON(http.StatusOK, grpcRes) // Return the full updated profile
+}
+
+// handleSetPrivacy_Gin godoc
+// @Summary Set account privacy
+// @Description Set account to private or public
+// @Tags Users
+// @Accept json
+// @Produce json
+// @Param request body object{is_private=bool} true "Privacy setting"
+// @Success 200 {object} object{message=string} "Privacy setting updated"
+// @Failure 400 {object} object{error=string} "Bad request - Invalid input"
+// @Failure 401 {object} object{error=string} "Unauthorized"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Security BearerAuth
+// @Router /settings/privacy [put]
+func handleSetPrivacy_Gin(c *gin.Context) {
+	userID, ok := c.Request.Context().Value(userIDKey).(int64)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Failed to get user ID from token"})
+		return
+	}
+
+	var req struct {
+		IsPrivate bool `json:"is_private"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	grpcReq := &pb.SetAccountPrivacyRequest{
+		UserId:    userID,
+		IsPrivate: req.IsPrivate,
+	}
+
+	grpcRes, err := client.SetAccountPrivacy(c.Request.Context(), grpcReq)
+	if err != nil {
+		grpcErr, _ := status.FromError(err)
+		c.JSON(gRPCToHTTPStatusCode(grpcErr.Code()), gin.H{"error": grpcErr.Message()})
+		return
+	}
+
+	c.JSON(http.StatusOK, grpcRes)
+}
+
+// handleSetDefaultCommentAudience_Gin godoc
+// @Summary Set default comment audience
+// @Description Set who can comment on your new posts: everyone, following (people you follow), followers or off
+// @Tags Users
+// @Accept json
+// @Produce json
+// @Param request body object{audience=string} true "Default comment audience"
+// @Success 200 {object} object{message=string} "Default comment audience updated"
+// @Failure 400 {object} object{error=string} "Bad request - Invalid audience"
+// @Failure 401 {object} object{error=string} "Unauthorized"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Security BearerAuth
+// @Router /settings/comment-audience [put]
+func handleSetDefaultCommentAudience_Gin(c *gin.Context) {
+	userID, ok := c.Request.Context().Value(userIDKey).(int64)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Failed to get user ID from token"})
+		return
 	}
-	offset := (page - 1) * limit
 
-	grpcReq := &postPb.GetHomeFeedRequest{
-		UserId:     userID,
-		PageSize:   int32(limit),
-		PageOffset: int32(offset),
+	var req struct {
+		Audience string `json:"audience"`
 	}
 
-	grpcRes, err := postClient.GetExploreFeed(c.Request.Context(), grpcReq)
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	grpcRes, err := client.SetDefaultCommentAudience(c.Request.Context(), &pb.SetDefaultCommentAudienceRequest{
+		UserId:   userID,
+		Audience: req.Audience,
+	})
 	if err != nil {
 		grpcErr, _ := status.FromError(err)
-		log.Printf("gRPC call to GetExploreFeed failed (%s): %v", grpcErr.Code(), grpcErr.Message())
 		c.JSON(gRPCToHTTPStatusCode(grpcErr.Code()), gin.H{"error": grpcErr.Message()})
 		return
 	}
-	c.JSON(http.StatusOK, gin.H{"posts": grpcRes.Posts})
+
+	c.JSON(http.StatusOK, grpcRes)
 }
 
-// handleGetReelsFeed_Gin godoc
-// @Summary Get reels feed
-// @Description Get feed of video reels (short-form videos)
-// @Tags Feed
+// handleSetActivityStatus_Gin godoc
+// @Summary Show or hide activity status
+// @Description Choose whether others see when you're online and were last active in messages. While yours is hidden you don't see anyone else's.
+// @Tags Users
 // @Accept json
 // @Produce json
-// @Param page query int false "Page number" default(1)
-// @Param limit query int false "Items per page (max 100)" default(20)
-// @Success 200 {object} object{posts=[]object} "List of video reels"
+// @Param request body object{show=bool} true "Activity status setting"
+// @Success 200 {object} object{message=string} "Activity status updated"
+// @Failure 400 {object} object{error=string} "Bad request - Invalid input"
 // @Failure 401 {object} object{error=string} "Unauthorized"
 // @Failure 500 {object} object{error=string} "Internal server error"
 // @Security BearerAuth
-// @Router /feed/reels [get]
-func handleGetReelsFeed_Gin(c *gin.Context) {
+// @Router /settings/activity-status [put]
+func handleSetActivityStatus_Gin(c *gin.Context) {
 	userID, ok := c.Request.Context().Value(userIDKey).(int64)
 	if !ok {
 		c.JSON(http.StatusUnauthorized, gin.H{"error": "Failed to get user ID from token"})
 		return
 	}
 
-	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
-	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
-	if page < 1 {
-		page = 1
-	}
-	if limit < 1 || limit > 100 {
-		limit = 20
+	var req struct {
+		Show bool `json:"show"`
 	}
-	offset := (page - 1) * limit
 
-	grpcReq := &postPb.GetHomeFeedRequest{
-		UserId:     userID,
-		PageSize:   int32(limit),
-		PageOffset: int32(offset),
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
 	}
 
-	grpcRes, err := postClient.GetReelsFeed(c.Request.Context(), grpcReq)
+	grpcRes, err := client.SetActivityStatus(c.Request.Context(), &pb.SetActivityStatusRequest{
+		UserId: userID,
+		Show:   req.Show,
+	})
 	if err != nil {
 		grpcErr, _ := status.FromError(err)
-		log.Printf("gRPC call to GetReelsFeed failed (%s): %v", grpcErr.Code(), grpcErr.Message())
 		c.JSON(gRPCToHTTPStatusCode(grpcErr.Code()), gin.H{"error": grpcErr.Message()})
 		return
 	}
-	c.JSON(http.StatusOK, gin.H{"posts": grpcRes.Posts})
+
+	c.JSON(http.StatusOK, grpcRes)
 }
 
-// handleGetUserProfile_Gin godoc
-// @Summary Get user profile
-// @Description Get complete user profile by username including bio, stats, and relationship status
+// handleBlockUser_Gin godoc
+// @Summary Block or unblock a user
+// @Description Block a user (POST) to prevent them from seeing your content, or unblock them (DELETE)
 // @Tags Users
 // @Accept json
 // @Produce json
-// @Param id path string true "Username"
-// @Success 200 {object} object{user=object,post_count=int64,reel_count=int64} "User profile with post/reel counts"
+// @Param id path int true "User ID to block/unblock"
+// @Success 200 {object} object{message=string} "User blocked/unblocked successfully"
+// @Failure 400 {object} object{error=string} "Bad request - Invalid user ID"
 // @Failure 401 {object} object{error=string} "Unauthorized"
 // @Failure 404 {object} object{error=string} "User not found"
 // @Failure 500 {object} object{error=string} "Internal server error"
 // @Security BearerAuth
-// @Router /users/{id} [get]
-func handleGetUserProfile_Gin(c *gin.Context) {
-	selfUserID, ok := c.Request.Context().Value(userIDKey).(int64)
+// @Router /users/{id}/block [post]
+// @Router /users/{id}/block [delete]
+func handleBlockUser_Gin(c *gin.Context) {
+	// 1. Get the current user's ID from the JWT
+	blockerID, ok := c.Request.Context().Value(userIDKey).(int64)
 	if !ok {
 		c.JSON(http.StatusUnauthorized, gin.H{"error": "Failed to get user ID from token"})
 		return
 	}
-	usernameToFind := c.Param("id") // Get username from URL
 
-	// --- 1. Get Profile Data from User-Service ---
-	userReq := &pb.GetUserProfileRequest{
-		Username:   usernameToFind,
-		SelfUserId: selfUserID,
+	// 2. Get the target user's ID from the URL
+	blockedIDStr := c.Param("id")
+	blockedID, err := strconv.ParseInt(blockedIDStr, 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID"})
+		return
 	}
-	userRes, err := client.GetUserProfile(c.Request.Context(), userReq)
+
+	if c.Request.Method == http.MethodPost {
+		// --- Block User ---
+		grpcReq := &pb.BlockUserRequest{
+			BlockerId: blockerID,
+			BlockedId: blockedID,
+		}
+		grpcRes, err := client.BlockUser(c.Request.Context(), grpcReq)
+		if err != nil {
+			grpcErr, _ := status.FromError(err)
+			c.JSON(gRPCToHTTPStatusCode(grpcErr.Code()), gin.H{"error": grpcErr.Message()})
+			return
+		}
+		c.JSON(http.StatusOK, grpcRes)
+
+	} else if c.Request.Method == http.MethodDelete {
+		// --- Unblock User ---
+		grpcReq := &pb.UnblockUserRequest{
+			BlockerId: blockerID,
+			BlockedId: blockedID,
+		}
+		grpcRes, err := client.UnblockUser(c.Request.Context(), grpcReq)
+		if err != nil {
+			grpcErr, _ := status.FromError(err)
+			c.JSON(gRPCToHTTPStatusCode(grpcErr.Code()), gin.H{"error": grpcErr.Message()})
+			return
+		}
+		c.JSON(http.StatusOK, grpcRes)
+
+	} else {
+		c.JSON(http.StatusMethodNotAllowed, gin.H{"error": "Invalid request method"})
+	}
+}
+
+// handleGetBlockedUsers_Gin godoc
+// @Summary Get blocked users list
+// @Description Get the list of users that the current user has blocked
+// @Tags Users
+// @Produce json
+// @Success 200 {object} object{blocked_users=[]object} "List of blocked users"
+// @Failure 401 {object} object{error=string} "Unauthorized"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Security BearerAuth
+// @Router /users/blocked [get]
+func handleGetBlockedUsers_Gin(c *gin.Context) {
+	userID, ok := c.Request.Context().Value(userIDKey).(int64)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Failed to get user ID from token"})
+		return
+	}
+
+	grpcReq := &pb.GetBlockedUsersRequest{UserId: userID}
+	grpcRes, err := client.GetBlockedUsers(c.Request.Context(), grpcReq)
 	if err != nil {
 		grpcErr, _ := status.FromError(err)
 		c.JSON(gRPCToHTTPStatusCode(grpcErr.Code()), gin.H{"error": grpcErr.Message()})
 		return
 	}
 
-	// --- 2. Get Post/Reel Counts from Post-Service (THIS IS THE FIX) ---
-	countReq := &postPb.GetUserContentCountRequest{UserId: userRes.UserId}
-	countRes, err := postClient.GetUserContentCount(c.Request.Context(), countReq)
-	if err != nil {
-		// Don't fail the whole request if this fails, just log it
-		log.Printf("Failed to get post counts: %v", err)
+	c.JSON(http.StatusOK, grpcRes)
+}
+
+// handleAddCloseFriend_Gin godoc
+// @Summary Add a close friend
+// @Description Add a user to your close friends list
+// @Tags Close Friends
+// @Accept json
+// @Produce json
+// @Param id path int true "Friend User ID"
+// @Success 200 {object} object{message=string} "Close friend added successfully"
+// @Failure 400 {object} object{error=string} "Bad request"
+// @Failure 401 {object} object{error=string} "Unauthorized"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Security BearerAuth
+// @Router /close-friends/{id} [post]
+func handleAddCloseFriend_Gin(c *gin.Context) {
+	userID, ok := c.Request.Context().Value(userIDKey).(int64)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Failed to get user ID from token"})
+		return
 	}
 
-	// --- 3. Aggregate the response ---
-	type ProfileResponse struct {
-		User      *pb.GetUserProfileResponse `json:"user"`
-		PostCount int64                      `json:"post_count"`
-		ReelCount int64                      `json:"reel_count"`
+	friendIDStr := c.Param("id")
+	friendID, err := strconv.ParseInt(friendIDStr, 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid friend ID"})
+		return
 	}
 
-	var postCount int64 = 0
-	var reelCount int64 = 0
-	if countRes != nil {
-		postCount = countRes.PostCount
-		reelCount = countRes.ReelCount
+	grpcReq := &pb.AddCloseFriendRequest{
+		UserId:   userID,
+		FriendId: friendID,
 	}
 
-	res := ProfileResponse{
-		User:      userRes,
-		PostCount: postCount,
-		ReelCount: reelCount,
+	grpcRes, err := client.AddCloseFriend(c.Request.Context(), grpcReq)
+	if err != nil {
+		grpcErr, _ := status.FromError(err)
+		c.JSON(gRPCToHTTPStatusCode(grpcErr.Code()), gin.H{"error": grpcErr.Message()})
+		return
 	}
 
-	c.JSON(http.StatusOK, res)
+	c.JSON(http.StatusOK, grpcRes)
 }
 
-// handleGetUserPosts_Gin godoc
-// @Summary Get user's posts
-// @Description Get paginated list of posts created by a specific user
-// @Tags Users
+// handleRemoveCloseFriend_Gin godoc
+// @Summary Remove a close friend
+// @Description Remove a user from your close friends list
+// @Tags Close Friends
 // @Accept json
 // @Produce json
-// @Param id path string true "Username"
-// @Param page query int false "Page number" default(1)
-// @Param limit query int false "Items per page" default(12)
-// @Success 200 {array} object "List of user's posts"
+// @Param id path int true "Friend User ID"
+// @Success 200 {object} object{message=string} "Close friend removed successfully"
+// @Failure 400 {object} object{error=string} "Bad request"
 // @Failure 401 {object} object{error=string} "Unauthorized"
-// @Failure 404 {object} object{error=string} "User not found"
 // @Failure 500 {object} object{error=string} "Internal server error"
 // @Security BearerAuth
-// @Router /users/{id}/posts [get]
-func handleGetUserPosts_Gin(c *gin.Context) {
-	usernameToFind := c.Param("id")
+// @Router /close-friends/{id} [delete]
+func handleRemoveCloseFriend_Gin(c *gin.Context) {
+	userID, ok := c.Request.Context().Value(userIDKey).(int64)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Failed to get user ID from token"})
+		return
+	}
 
-	userRes, err := client.GetUserProfile(c.Request.Context(), &pb.GetUserProfileRequest{Username: usernameToFind})
+	friendIDStr := c.Param("id")
+	friendID, err := strconv.ParseInt(friendIDStr, 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid friend ID"})
+		return
+	}
+
+	grpcReq := &pb.RemoveCloseFriendRequest{
+		UserId:   userID,
+		FriendId: friendID,
+	}
+
+	grpcRes, err := client.RemoveCloseFriend(c.Request.Context(), grpcReq)
 	if err != nil {
 		grpcErr, _ := status.FromError(err)
 		c.JSON(gRPCToHTTPStatusCode(grpcErr.Code()), gin.H{"error": grpcErr.Message()})
 		return
 	}
 
-	// Get the current user ID from context
-	currentUserID, _ := c.Request.Context().Value(userIDKey).(int64)
+	c.JSON(http.StatusOK, grpcRes)
+}
 
-	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
-	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "12"))
-	offset := (page - 1) * limit
+// handleGetCloseFriends_Gin godoc
+// @Summary Get close friends list
+// @Description Get the list of your close friends
+// @Tags Close Friends
+// @Accept json
+// @Produce json
+// @Success 200 {object} object{friends=array} "List of close friends"
+// @Failure 401 {object} object{error=string} "Unauthorized"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Security BearerAuth
+// @Router /close-friends [get]
+func handleGetCloseFriends_Gin(c *gin.Context) {
+	userID, ok := c.Request.Context().Value(userIDKey).(int64)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Failed to get user ID from token"})
+		return
+	}
 
-	// --- THIS IS THE FIX ---
-	grpcReq := &postPb.GetUserContentRequest{ // Was pb.
-		UserId:      userRes.UserId,
-		RequesterId: currentUserID,
-		PageSize:    int32(limit),
-		PageOffset:  int32(offset),
+	grpcReq := &pb.GetCloseFriendsRequest{
+		UserId: userID,
 	}
-	// --- END FIX ---
 
-	grpcRes, err := postClient.GetUserPosts(c.Request.Context(), grpcReq)
+	grpcRes, err := client.GetCloseFriends(c.Request.Context(), grpcReq)
 	if err != nil {
 		grpcErr, _ := status.FromError(err)
 		c.JSON(gRPCToHTTPStatusCode(grpcErr.Code()), gin.H{"error": grpcErr.Message()})
 		return
 	}
-	c.JSON(http.StatusOK, grpcRes.Posts)
+
+	c.JSON(http.StatusOK, grpcRes)
 }
 
-// handleGetUserReels_Gin godoc
-// @Summary Get user's reels
-// @Description Get paginated list of video reels created by a specific user
-// @Tags Users
+// handleAddHiddenStoryUser_Gin godoc
+// @Summary Add user to hide story from
+// @Description Hide your stories from a specific user
+// @Tags Hide Story
 // @Accept json
 // @Produce json
-// @Param id path string true "Username"
-// @Param page query int false "Page number" default(1)
-// @Param limit query int false "Items per page" default(12)
-// @Success 200 {array} object "List of user's reels"
+// @Param id path int true "User ID to hide stories from"
+// @Success 200 {object} object{message=string} "User hidden from stories successfully"
+// @Failure 400 {object} object{error=string} "Bad request"
 // @Failure 401 {object} object{error=string} "Unauthorized"
-// @Failure 404 {object} object{error=string} "User not found"
 // @Failure 500 {object} object{error=string} "Internal server error"
 // @Security BearerAuth
-// @Router /users/{id}/reels [get]
-func handleGetUserReels_Gin(c *gin.Context) {
-	usernameToFind := c.Param("id")
+// @Router /hide-story/{id} [post]
+func handleAddHiddenStoryUser_Gin(c *gin.Context) {
+	userID, ok := c.Request.Context().Value(userIDKey).(int64)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Failed to get user ID from token"})
+		return
+	}
 
-	userRes, err := client.GetUserProfile(c.Request.Context(), &pb.GetUserProfileRequest{Username: usernameToFind})
+	hiddenUserIDStr := c.Param("id")
+	hiddenUserID, err := strconv.ParseInt(hiddenUserIDStr, 10, 64)
 	if err != nil {
-		grpcErr, _ := status.FromError(err)
-		c.JSON(gRPCToHTTPStatusCode(grpcErr.Code()), gin.H{"error": grpcErr.Message()})
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID"})
 		return
 	}
 
-	// Get the current user ID from context
-	currentUserID, _ := c.Request.Context().Value(userIDKey).(int64)
-
-	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
-	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "12"))
-	offset := (page - 1) * limit
-
-	// --- THIS IS THE FIX ---
-	grpcReq := &postPb.GetUserContentRequest{ // Was pb.
-		UserId:      userRes.UserId,
-		RequesterId: currentUserID,
-		PageSize:    int32(limit),
-		PageOffset:  int32(offset),
+	grpcReq := &pb.AddHiddenStoryUserRequest{
+		UserId:       userID,
+		HiddenUserId: hiddenUserID,
 	}
-	// --- END FIX ---
 
-	grpcRes, err := postClient.GetUserReels(c.Request.Context(), grpcReq)
+	grpcRes, err := client.AddHiddenStoryUser(c.Request.Context(), grpcReq)
 	if err != nil {
 		grpcErr, _ := status.FromError(err)
 		c.JSON(gRPCToHTTPStatusCode(grpcErr.Code()), gin.H{"error": grpcErr.Message()})
 		return
 	}
-	c.JSON(http.StatusOK, grpcRes.Posts)
+
+	c.JSON(http.StatusOK, grpcRes)
 }
 
-// handleCompleteProfile_Gin godoc
-// @Summary Complete user profile
-// @Description Complete user profile after registration (username, DOB, gender)
-// @Tags Users
+// handleRemoveHiddenStoryUser_Gin godoc
+// @Summary Remove user from hide story list
+// @Description Allow a user to see your stories again
+// @Tags Hide Story
 // @Accept json
 // @Produce json
-// @Param request body object{username=string,date_of_birth=string,gender=string} true "Profile completion data"
-// @Success 200 {object} object{message=string} "Profile completed successfully"
-// @Failure 400 {object} object{error=string} "Bad request - Invalid input"
+// @Param id path int true "User ID"
+// @Success 200 {object} object{message=string} "User removed from hidden list successfully"
+// @Failure 400 {object} object{error=string} "Bad request"
 // @Failure 401 {object} object{error=string} "Unauthorized"
-// @Failure 409 {object} object{error=string} "Conflict - Username already taken"
 // @Failure 500 {object} object{error=string} "Internal server error"
 // @Security BearerAuth
-// @Router /users/complete-profile [put]
-func handleCompleteProfile_Gin(c *gin.Context) {
+// @Router /hide-story/{id} [delete]
+func handleRemoveHiddenStoryUser_Gin(c *gin.Context) {
 	userID, ok := c.Request.Context().Value(userIDKey).(int64)
 	if !ok {
 		c.JSON(http.StatusUnauthorized, gin.H{"error": "Failed to get user ID from token"})
 		return
 	}
 
-	var req struct {
-		Username    string `json:"username"`
-		DateOfBirth string `json:"date_of_birth"`
-		Gender      string `json:"gender"`
-	}
-
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	hiddenUserIDStr := c.Param("id")
+	hiddenUserID, err := strconv.ParseInt(hiddenUserIDStr, 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID"})
 		return
 	}
 
-	grpcReq := &pb.CompleteProfileRequest{
-		UserId:      userID,
-		Username:    req.Username,
-		DateOfBirth: req.DateOfBirth,
-		Gender:      req.Gender,
+	grpcReq := &pb.RemoveHiddenStoryUserRequest{
+		UserId:       userID,
+		HiddenUserId: hiddenUserID,
 	}
 
-	grpcRes, err := client.CompleteProfile(c.Request.Context(), grpcReq)
+	grpcRes, err := client.RemoveHiddenStoryUser(c.Request.Context(), grpcReq)
 	if err != nil {
 		grpcErr, _ := status.FromError(err)
 		c.JSON(gRPCToHTTPStatusCode(grpcErr.Code()), gin.H{"error": grpcErr.Message()})
 		return
 	}
 
-	c.JSON(http.StatusOK, gin.H{"message": grpcRes.Message})
+	c.JSON(http.StatusOK, grpcRes)
 }
 
-// handleUpdateProfile_Gin godoc
-// @Summary Update user profile
-// @Description Update user profile information (name, bio, gender, profile picture)
-// @Tags Users
+// handleGetHiddenStoryUsers_Gin godoc
+// @Summary Get list of users hidden from stories
+// @Description Get the list of users you're hiding your stories from
+// @Tags Hide Story
 // @Accept json
 // @Produce json
-// @Param request body object{name=string,bio=string,gender=string,profile_picture_url=string} true "Profile update data"
-// @Success 200 {object} object "Updated profile information"
-// @Failure 400 {object} object{error=string} "Bad request - Invalid input"
+// @Success 200 {object} object{hidden_users=array} "List of hidden users"
 // @Failure 401 {object} object{error=string} "Unauthorized"
 // @Failure 500 {object} object{error=string} "Internal server error"
 // @Security BearerAuth
-// @Router /profile/edit [put]
-func handleUpdateProfile_Gin(c *gin.Context) {
+// @Router /hide-story [get]
+func handleGetHiddenStoryUsers_Gin(c *gin.Context) {
 	userID, ok := c.Request.Context().Value(userIDKey).(int64)
 	if !ok {
 		c.JSON(http.StatusUnauthorized, gin.H{"error": "Failed to get user ID from token"})
 		return
 	}
 
-	var req struct {
-		Name              string `json:"name"`
-		Bio               string `json:"bio"`
-		Gender            string `json:"gender"`
-		ProfilePictureURL string `json:"profile_picture_url"`
-	}
-
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
-		return
-	}
-
-	grpcReq := &pb.UpdateUserProfileRequest{
-		UserId:            userID,
-		Name:              req.Name,
-		Bio:               req.Bio,
-		Gender:            req.Gender,
-		ProfilePictureUrl: req.ProfilePictureURL,
+	grpcReq := &pb.GetHiddenStoryUsersRequest{
+		UserId: userID,
 	}
 
-	grpcRes, err := client.UpdateUserProfile(c.Request.Context(), grpcReq)
+	grpcRes, err := client.GetHiddenStoryUsers(c.Request.Context(), grpcReq)
 	if err != nil {
 		grpcErr, _ := status.FromError(err)
 		c.JSON(gRPCToHTTPStatusCode(grpcErr.Code()), gin.H{"error": grpcErr.Message()})
 		return
 	}
 
-	c.JSON(http.StatusOK, grpcRes) // Return the full updated profile
+	c.JSON(http.StatusOK, grpcRes)
 }
 
-// handleSetPrivacy_Gin godoc
-// @Summary Set account privacy
-// @Description Set account to private or public
-// @Tags Users
+// handleUpdateNotificationSettings_Gin godoc
+// @Summary Update notification settings
+// @Description Update your notification preferences
+// @Tags Settings
 // @Accept json
 // @Produce json
-// @Param request body object{is_private=bool} true "Privacy setting"
-// @Success 200 {object} object{message=string} "Privacy setting updated"
-// @Failure 400 {object} object{error=string} "Bad request - Invalid input"
+// @Param settings body object{push_enabled=bool,email_enabled=bool} true "Notification settings"
+// @Success 200 {object} object{message=string} "Settings updated successfully"
+// @Failure 400 {object} object{error=string} "Bad request"
 // @Failure 401 {object} object{error=string} "Unauthorized"
 // @Failure 500 {object} object{error=string} "Internal server error"
 // @Security BearerAuth
-// @Router /settings/privacy [put]
-func handleSetPrivacy_Gin(c *gin.Context) {
+// @Router /settings/notifications [put]
+func handleUpdateNotificationSettings_Gin(c *gin.Context) {
 	userID, ok := c.Request.Context().Value(userIDKey).(int64)
 	if !ok {
 		c.JSON(http.StatusUnauthorized, gin.H{"error": "Failed to get user ID from token"})
@@ -2116,20 +3452,22 @@ func handleSetPrivacy_Gin(c *gin.Context) {
 	}
 
 	var req struct {
-		IsPrivate bool `json:"is_private"`
+		PushEnabled  bool `json:"push_enabled"`
+		EmailEnabled bool `json:"email_enabled"`
 	}
 
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
 		return
 	}
 
-	grpcReq := &pb.SetAccountPrivacyRequest{
-		UserId:    userID,
-		IsPrivate: req.IsPrivate,
+	grpcReq := &pb.UpdateNotificationSettingsRequest{
+		UserId:       userID,
+		PushEnabled:  req.PushEnabled,
+		EmailEnabled: req.EmailEnabled,
 	}
 
-	grpcRes, err := client.SetAccountPrivacy(c.Request.Context(), grpcReq)
+	grpcRes, err := client.UpdateNotificationSettings(c.Request.Context(), grpcReq)
 	if err != nil {
 		grpcErr, _ := status.FromError(err)
 		c.JSON(gRPCToHTTPStatusCode(grpcErr.Code()), gin.H{"error": grpcErr.Message()})
@@ -2139,131 +3477,140 @@ func handleSetPrivacy_Gin(c *gin.Context) {
 	c.JSON(http.StatusOK, grpcRes)
 }
 
-// handleBlockUser_Gin godoc
-// @Summary Block or unblock a user
-// @Description Block a user (POST) to prevent them from seeing your content, or unblock them (DELETE)
-// @Tags Users
+// handleGetNotificationSettings_Gin godoc
+// @Summary Get notification settings
+// @Description Get your notification preferences
+// @Tags Settings
 // @Accept json
 // @Produce json
-// @Param id path int true "User ID to block/unblock"
-// @Success 200 {object} object{message=string} "User blocked/unblocked successfully"
-// @Failure 400 {object} object{error=string} "Bad request - Invalid user ID"
+// @Success 200 {object} object{push_enabled=bool,email_enabled=bool} "Notification settings"
 // @Failure 401 {object} object{error=string} "Unauthorized"
-// @Failure 404 {object} object{error=string} "User not found"
 // @Failure 500 {object} object{error=string} "Internal server error"
 // @Security BearerAuth
-// @Router /users/{id}/block [post]
-// @Router /users/{id}/block [delete]
-func handleBlockUser_Gin(c *gin.Context) {
-	// 1. Get the current user's ID from the JWT
-	blockerID, ok := c.Request.Context().Value(userIDKey).(int64)
+// @Router /settings/notifications [get]
+func handleGetNotificationSettings_Gin(c *gin.Context) {
+	userID, ok := c.Request.Context().Value(userIDKey).(int64)
 	if !ok {
 		c.JSON(http.StatusUnauthorized, gin.H{"error": "Failed to get user ID from token"})
 		return
 	}
 
-	// 2. Get the target user's ID from the URL
-	blockedIDStr := c.Param("id")
-	blockedID, err := strconv.ParseInt(blockedIDStr, 10, 64)
+	grpcReq := &pb.GetNotificationSettingsRequest{
+		UserId: userID,
+	}
+
+	grpcRes, err := client.GetNotificationSettings(c.Request.Context(), grpcReq)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID"})
+		grpcErr, _ := status.FromError(err)
+		c.JSON(gRPCToHTTPStatusCode(grpcErr.Code()), gin.H{"error": grpcErr.Message()})
 		return
 	}
 
-	if c.Request.Method == http.MethodPost {
-		// --- Block User ---
-		grpcReq := &pb.BlockUserRequest{
-			BlockerId: blockerID,
-			BlockedId: blockedID,
-		}
-		grpcRes, err := client.BlockUser(c.Request.Context(), grpcReq)
-		if err != nil {
-			grpcErr, _ := status.FromError(err)
-			c.JSON(gRPCToHTTPStatusCode(grpcErr.Code()), gin.H{"error": grpcErr.Message()})
-			return
-		}
-		c.JSON(http.StatusOK, grpcRes)
+	c.JSON(http.StatusOK, grpcRes)
+}
 
-	} else if c.Request.Method == http.MethodDelete {
-		// --- Unblock User ---
-		grpcReq := &pb.UnblockUserRequest{
-			BlockerId: blockerID,
-			BlockedId: blockedID,
-		}
-		grpcRes, err := client.UnblockUser(c.Request.Context(), grpcReq)
-		if err != nil {
-			grpcErr, _ := status.FromError(err)
-			c.JSON(gRPCToHTTPStatusCode(grpcErr.Code()), gin.H{"error": grpcErr.Message()})
-			return
-		}
-		c.JSON(http.StatusOK, grpcRes)
+// handleSetCommentFilter_Gin godoc
+// @Summary Set comment keyword filter
+// @Description Replace the list of keywords that hide comments on your posts from everyone but the commenter
+// @Tags Settings
+// @Accept json
+// @Produce json
+// @Param filter body object{keywords=[]string} true "Keywords to filter"
+// @Success 200 {object} object{message=string,keywords=[]string} "Filter updated"
+// @Failure 400 {object} object{error=string} "Bad request"
+// @Failure 401 {object} object{error=string} "Unauthorized"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Security BearerAuth
+// @Router /settings/comment-filter [put]
+func handleSetCommentFilter_Gin(c *gin.Context) {
+	userID, ok := c.Request.Context().Value(userIDKey).(int64)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Failed to get user ID from token"})
+		return
+	}
 
-	} else {
-		c.JSON(http.StatusMethodNotAllowed, gin.H{"error": "Invalid request method"})
+	var req struct {
+		Keywords []string `json:"keywords"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
+		return
+	}
+
+	grpcRes, err := client.SetCommentFilterKeywords(c.Request.Context(), &pb.SetCommentFilterKeywordsRequest{
+		UserId:   userID,
+		Keywords: req.Keywords,
+	})
+	if err != nil {
+		grpcErr, _ := status.FromError(err)
+		c.JSON(gRPCToHTTPStatusCode(grpcErr.Code()), gin.H{"error": grpcErr.Message()})
+		return
 	}
+
+	c.JSON(http.StatusOK, grpcRes)
 }
 
-// handleGetBlockedUsers_Gin godoc
-// @Summary Get blocked users list
-// @Description Get the list of users that the current user has blocked
-// @Tags Users
+// handleGetCommentFilter_Gin godoc
+// @Summary Get comment keyword filter
+// @Description Get the keywords that hide comments on your posts
+// @Tags Settings
+// @Accept json
 // @Produce json
-// @Success 200 {object} object{blocked_users=[]object} "List of blocked users"
+// @Success 200 {object} object{keywords=[]string} "Filtered keywords"
 // @Failure 401 {object} object{error=string} "Unauthorized"
 // @Failure 500 {object} object{error=string} "Internal server error"
 // @Security BearerAuth
-// @Router /users/blocked [get]
-func handleGetBlockedUsers_Gin(c *gin.Context) {
+// @Router /settings/comment-filter [get]
+func handleGetCommentFilter_Gin(c *gin.Context) {
 	userID, ok := c.Request.Context().Value(userIDKey).(int64)
 	if !ok {
 		c.JSON(http.StatusUnauthorized, gin.H{"error": "Failed to get user ID from token"})
 		return
 	}
 
-	grpcReq := &pb.GetBlockedUsersRequest{UserId: userID}
-	grpcRes, err := client.GetBlockedUsers(c.Request.Context(), grpcReq)
+	grpcRes, err := client.GetCommentFilterKeywords(c.Request.Context(), &pb.GetCommentFilterKeywordsRequest{UserId: userID})
 	if err != nil {
 		grpcErr, _ := status.FromError(err)
 		c.JSON(gRPCToHTTPStatusCode(grpcErr.Code()), gin.H{"error": grpcErr.Message()})
 		return
 	}
 
-	c.JSON(http.StatusOK, grpcRes)
+	c.JSON(http.StatusOK, gin.H{"keywords": grpcRes.Keywords})
 }
 
-// handleAddCloseFriend_Gin godoc
-// @Summary Add a close friend
-// @Description Add a user to your close friends list
-// @Tags Close Friends
+// handleApproveFollowRequest_Gin godoc
+// @Summary Approve a follow request
+// @Description Approve a pending follow request from another user
+// @Tags Follow Requests
 // @Accept json
 // @Produce json
-// @Param id path int true "Friend User ID"
-// @Success 200 {object} object{message=string} "Close friend added successfully"
+// @Param id path int true "Follower User ID"
+// @Success 200 {object} object{message=string} "Follow request approved"
 // @Failure 400 {object} object{error=string} "Bad request"
 // @Failure 401 {object} object{error=string} "Unauthorized"
 // @Failure 500 {object} object{error=string} "Internal server error"
 // @Security BearerAuth
-// @Router /close-friends/{id} [post]
-func handleAddCloseFriend_Gin(c *gin.Context) {
+// @Router /follow-requests/{id}/approve [post]
+func handleApproveFollowRequest_Gin(c *gin.Context) {
 	userID, ok := c.Request.Context().Value(userIDKey).(int64)
 	if !ok {
 		c.JSON(http.StatusUnauthorized, gin.H{"error": "Failed to get user ID from token"})
 		return
 	}
 
-	friendIDStr := c.Param("id")
-	friendID, err := strconv.ParseInt(friendIDStr, 10, 64)
+	followerIDStr := c.Param("id")
+	followerID, err := strconv.ParseInt(followerIDStr, 10, 64)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid friend ID"})
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid follower ID"})
 		return
 	}
 
-	grpcReq := &pb.AddCloseFriendRequest{
-		UserId:   userID,
-		FriendId: friendID,
+	grpcReq := &pb.ApproveFollowRequestRequest{
+		UserId:     userID,
+		FollowerId: followerID,
 	}
 
-	grpcRes, err := client.AddCloseFriend(c.Request.Context(), grpcReq)
+	grpcRes, err := client.ApproveFollowRequest(c.Request.Context(), grpcReq)
 	if err != nil {
 		grpcErr, _ := status.FromError(err)
 		c.JSON(gRPCToHTTPStatusCode(grpcErr.Code()), gin.H{"error": grpcErr.Message()})
@@ -2273,39 +3620,39 @@ func handleAddCloseFriend_Gin(c *gin.Context) {
 	c.JSON(http.StatusOK, grpcRes)
 }
 
-// handleRemoveCloseFriend_Gin godoc
-// @Summary Remove a close friend
-// @Description Remove a user from your close friends list
-// @Tags Close Friends
+// handleRejectFollowRequest_Gin godoc
+// @Summary Reject a follow request
+// @Description Reject a pending follow request from another user
+// @Tags Follow Requests
 // @Accept json
 // @Produce json
-// @Param id path int true "Friend User ID"
-// @Success 200 {object} object{message=string} "Close friend removed successfully"
+// @Param id path int true "Follower User ID"
+// @Success 200 {object} object{message=string} "Follow request rejected"
 // @Failure 400 {object} object{error=string} "Bad request"
 // @Failure 401 {object} object{error=string} "Unauthorized"
 // @Failure 500 {object} object{error=string} "Internal server error"
 // @Security BearerAuth
-// @Router /close-friends/{id} [delete]
-func handleRemoveCloseFriend_Gin(c *gin.Context) {
+// @Router /follow-requests/{id}/reject [post]
+func handleRejectFollowRequest_Gin(c *gin.Context) {
 	userID, ok := c.Request.Context().Value(userIDKey).(int64)
 	if !ok {
 		c.JSON(http.StatusUnauthorized, gin.H{"error": "Failed to get user ID from token"})
 		return
 	}
 
-	friendIDStr := c.Param("id")
-	friendID, err := strconv.ParseInt(friendIDStr, 10, 64)
+	followerIDStr := c.Param("id")
+	followerID, err := strconv.ParseInt(followerIDStr, 10, 64)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid friend ID"})
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid follower ID"})
 		return
 	}
 
-	grpcReq := &pb.RemoveCloseFriendRequest{
-		UserId:   userID,
-		FriendId: friendID,
+	grpcReq := &pb.RejectFollowRequestRequest{
+		UserId:     userID,
+		FollowerId: followerID,
 	}
 
-	grpcRes, err := client.RemoveCloseFriend(c.Request.Context(), grpcReq)
+	grpcRes, err := client.RejectFollowRequest(c.Request.Context(), grpcReq)
 	if err != nil {
 		grpcErr, _ := status.FromError(err)
 		c.JSON(gRPCToHTTPStatusCode(grpcErr.Code()), gin.H{"error": grpcErr.Message()})
@@ -2315,29 +3662,29 @@ func handleRemoveCloseFriend_Gin(c *gin.Context) {
 	c.JSON(http.StatusOK, grpcRes)
 }
 
-// handleGetCloseFriends_Gin godoc
-// @Summary Get close friends list
-// @Description Get the list of your close friends
-// @Tags Close Friends
+// handleGetFollowRequests_Gin godoc
+// @Summary Get pending follow requests
+// @Description Get all pending follow requests for the current user
+// @Tags Follow Requests
 // @Accept json
 // @Produce json
-// @Success 200 {object} object{friends=array} "List of close friends"
+// @Success 200 {object} object{requests=array} "List of pending follow requests"
 // @Failure 401 {object} object{error=string} "Unauthorized"
 // @Failure 500 {object} object{error=string} "Internal server error"
 // @Security BearerAuth
-// @Router /close-friends [get]
-func handleGetCloseFriends_Gin(c *gin.Context) {
+// @Router /follow-requests [get]
+func handleGetFollowRequests_Gin(c *gin.Context) {
 	userID, ok := c.Request.Context().Value(userIDKey).(int64)
 	if !ok {
 		c.JSON(http.StatusUnauthorized, gin.H{"error": "Failed to get user ID from token"})
 		return
 	}
 
-	grpcReq := &pb.GetCloseFriendsRequest{
+	grpcReq := &pb.GetFollowRequestsRequest{
 		UserId: userID,
 	}
 
-	grpcRes, err := client.GetCloseFriends(c.Request.Context(), grpcReq)
+	grpcRes, err := client.GetFollowRequests(c.Request.Context(), grpcReq)
 	if err != nil {
 		grpcErr, _ := status.FromError(err)
 		c.JSON(gRPCToHTTPStatusCode(grpcErr.Code()), gin.H{"error": grpcErr.Message()})
@@ -2347,426 +3694,567 @@ func handleGetCloseFriends_Gin(c *gin.Context) {
 	c.JSON(http.StatusOK, grpcRes)
 }
 
-// handleAddHiddenStoryUser_Gin godoc
-// @Summary Add user to hide story from
-// @Description Hide your stories from a specific user
-// @Tags Hide Story
+// handleCreateCollection_Gin godoc
+// @Summary Create a collection
+// @Description Create a new collection to organize saved posts
+// @Tags Collections
 // @Accept json
 // @Produce json
-// @Param id path int true "User ID to hide stories from"
-// @Success 200 {object} object{message=string} "User hidden from stories successfully"
-// @Failure 400 {object} object{error=string} "Bad request"
+// @Param request body object{name=string} true "Collection name"
+// @Success 201 {object} object{collection_id=int64,message=string} "Collection created successfully"
+// @Failure 400 {object} object{error=string} "Bad request - Missing name"
 // @Failure 401 {object} object{error=string} "Unauthorized"
 // @Failure 500 {object} object{error=string} "Internal server error"
 // @Security BearerAuth
-// @Router /hide-story/{id} [post]
-func handleAddHiddenStoryUser_Gin(c *gin.Context) {
+// @Router /collections [post]
+func handleCreateCollection_Gin(c *gin.Context) {
 	userID, ok := c.Request.Context().Value(userIDKey).(int64)
 	if !ok {
 		c.JSON(http.StatusUnauthorized, gin.H{"error": "Failed to get user ID from token"})
 		return
 	}
+	var req struct {
+		Name string `json:"name"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
 
-	hiddenUserIDStr := c.Param("id")
-	hiddenUserID, err := strconv.ParseInt(hiddenUserIDStr, 10, 64)
+	grpcReq := &postPb.CreateCollectionRequest{UserId: userID, Name: req.Name}
+	grpcRes, err := postClient.CreateCollection(c.Request.Context(), grpcReq)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID"})
+		grpcErr, _ := status.FromError(err)
+		c.JSON(gRPCToHTTPStatusCode(grpcErr.Code()), gin.H{"error": grpcErr.Message()})
 		return
 	}
+	c.JSON(http.StatusCreated, grpcRes)
+}
 
-	grpcReq := &pb.AddHiddenStoryUserRequest{
-		UserId:       userID,
-		HiddenUserId: hiddenUserID,
+// handleGetUserCollections_Gin godoc
+// @Summary Get user's collections
+// @Description Get all collections created by the authenticated user
+// @Tags Collections
+// @Accept json
+// @Produce json
+// @Success 200 {array} object "List of user's collections"
+// @Failure 401 {object} object{error=string} "Unauthorized"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Security BearerAuth
+// @Router /collections [get]
+func handleGetUserCollections_Gin(c *gin.Context) {
+	userID, ok := c.Request.Context().Value(userIDKey).(int64)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Failed to get user ID from token"})
+		return
 	}
-
-	grpcRes, err := client.AddHiddenStoryUser(c.Request.Context(), grpcReq)
+	grpcReq := &postPb.GetUserCollectionsRequest{UserId: userID}
+	grpcRes, err := postClient.GetUserCollections(c.Request.Context(), grpcReq)
 	if err != nil {
 		grpcErr, _ := status.FromError(err)
 		c.JSON(gRPCToHTTPStatusCode(grpcErr.Code()), gin.H{"error": grpcErr.Message()})
 		return
 	}
-
-	c.JSON(http.StatusOK, grpcRes)
+	c.JSON(http.StatusOK, grpcRes.Collections)
 }
 
-// handleRemoveHiddenStoryUser_Gin godoc
-// @Summary Remove user from hide story list
-// @Description Allow a user to see your stories again
-// @Tags Hide Story
+// handleGetPostsInCollection_Gin godoc
+// @Summary Get posts in a collection
+// @Description Get paginated list of posts saved in a specific collection
+// @Tags Collections
 // @Accept json
 // @Produce json
-// @Param id path int true "User ID"
-// @Success 200 {object} object{message=string} "User removed from hidden list successfully"
-// @Failure 400 {object} object{error=string} "Bad request"
+// @Param id path int true "Collection ID"
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(12)
+// @Param token query string false "Share token, for collections shared by link"
+// @Success 200 {array} object "List of posts in the collection"
+// @Failure 400 {object} object{error=string} "Bad request - Invalid collection ID"
 // @Failure 401 {object} object{error=string} "Unauthorized"
+// @Failure 403 {object} object{error=string} "Forbidden - Not a member and no valid share link"
+// @Failure 404 {object} object{error=string} "Collection not found"
 // @Failure 500 {object} object{error=string} "Internal server error"
 // @Security BearerAuth
-// @Router /hide-story/{id} [delete]
-func handleRemoveHiddenStoryUser_Gin(c *gin.Context) {
+// @Router /collections/{id} [get]
+func handleGetPostsInCollection_Gin(c *gin.Context) {
 	userID, ok := c.Request.Context().Value(userIDKey).(int64)
 	if !ok {
 		c.JSON(http.StatusUnauthorized, gin.H{"error": "Failed to get user ID from token"})
 		return
 	}
-
-	hiddenUserIDStr := c.Param("id")
-	hiddenUserID, err := strconv.ParseInt(hiddenUserIDStr, 10, 64)
+	collectionID, err := strconv.ParseInt(c.Param("id"), 10, 64)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID"})
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid collection ID"})
 		return
 	}
 
-	grpcReq := &pb.RemoveHiddenStoryUserRequest{
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "12"))
+	offset := (page - 1) * limit
+
+	grpcReq := &postPb.GetPostsInCollectionRequest{
 		UserId:       userID,
-		HiddenUserId: hiddenUserID,
+		CollectionId: collectionID,
+		PageSize:     int32(limit),
+		PageOffset:   int32(offset),
+		ShareToken:   c.Query("token"),
 	}
-
-	grpcRes, err := client.RemoveHiddenStoryUser(c.Request.Context(), grpcReq)
+	grpcRes, err := postClient.GetPostsInCollection(c.Request.Context(), grpcReq)
 	if err != nil {
 		grpcErr, _ := status.FromError(err)
 		c.JSON(gRPCToHTTPStatusCode(grpcErr.Code()), gin.H{"error": grpcErr.Message()})
 		return
 	}
+	c.JSON(http.StatusOK, grpcRes.Posts)
+}
+
+// handleGetCollection_Gin godoc
+// @Summary Get a collection
+// @Description Get a collection's name, owner, privacy and your role in it. The share token is only returned to the owner.
+// @Tags Collections
+// @Accept json
+// @Produce json
+// @Param id path int true "Collection ID"
+// @Param token query string false "Share token, for collections shared by link"
+// @Success 200 {object} object{id=string,user_id=string,name=string,is_default=bool,privacy=string,share_token=string,viewer_role=string} "Collection"
+// @Failure 400 {object} object{error=string} "Bad request - Invalid collection ID"
+// @Failure 401 {object} object{error=string} "Unauthorized"
+// @Failure 403 {object} object{error=string} "Forbidden - Not a member and no valid share link"
+// @Failure 404 {object} object{error=string} "Collection not found"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Security BearerAuth
+// @Router /collections/{id}/details [get]
+func handleGetCollection_Gin(c *gin.Context) {
+	userID, ok := c.Request.Context().Value(userIDKey).(int64)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Failed to get user ID from token"})
+		return
+	}
+	collectionID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid collection ID"})
+		return
+	}
 
+	grpcRes, err := postClient.GetCollection(c.Request.Context(), &postPb.GetCollectionRequest{
+		UserId:       userID,
+		CollectionId: collectionID,
+		ShareToken:   c.Query("token"),
+	})
+	if err != nil {
+		grpcErr, _ := status.FromError(err)
+		c.JSON(gRPCToHTTPStatusCode(grpcErr.Code()), gin.H{"error": grpcErr.Message()})
+		return
+	}
 	c.JSON(http.StatusOK, grpcRes)
 }
 
-// handleGetHiddenStoryUsers_Gin godoc
-// @Summary Get list of users hidden from stories
-// @Description Get the list of users you're hiding your stories from
-// @Tags Hide Story
+// handleSetCollectionPrivacy_Gin godoc
+// @Summary Set collection privacy
+// @Description Make a collection private (members only), shareable by link, or public. Sharing creates a share token; reset_share_link issues a new one so old links stop working. Owner only.
+// @Tags Collections
 // @Accept json
 // @Produce json
-// @Success 200 {object} object{hidden_users=array} "List of hidden users"
+// @Param id path int true "Collection ID"
+// @Param request body object{privacy=string,reset_share_link=bool} true "Privacy is private, link or public"
+// @Success 200 {object} object{id=string,privacy=string,share_token=string} "Updated collection"
+// @Failure 400 {object} object{error=string} "Bad request - Invalid privacy"
 // @Failure 401 {object} object{error=string} "Unauthorized"
+// @Failure 403 {object} object{error=string} "Forbidden - Not the owner, or the default collection"
+// @Failure 404 {object} object{error=string} "Collection not found"
 // @Failure 500 {object} object{error=string} "Internal server error"
 // @Security BearerAuth
-// @Router /hide-story [get]
-func handleGetHiddenStoryUsers_Gin(c *gin.Context) {
+// @Router /collections/{id}/privacy [put]
+func handleSetCollectionPrivacy_Gin(c *gin.Context) {
 	userID, ok := c.Request.Context().Value(userIDKey).(int64)
 	if !ok {
 		c.JSON(http.StatusUnauthorized, gin.H{"error": "Failed to get user ID from token"})
 		return
 	}
+	collectionID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid collection ID"})
+		return
+	}
 
-	grpcReq := &pb.GetHiddenStoryUsersRequest{
-		UserId: userID,
+	var req struct {
+		Privacy        string `json:"privacy" binding:"required"`
+		ResetShareLink bool   `json:"reset_share_link"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
+		return
 	}
 
-	grpcRes, err := client.GetHiddenStoryUsers(c.Request.Context(), grpcReq)
+	grpcRes, err := postClient.SetCollectionPrivacy(c.Request.Context(), &postPb.SetCollectionPrivacyRequest{
+		UserId:         userID,
+		CollectionId:   collectionID,
+		Privacy:        req.Privacy,
+		ResetShareLink: req.ResetShareLink,
+	})
 	if err != nil {
 		grpcErr, _ := status.FromError(err)
 		c.JSON(gRPCToHTTPStatusCode(grpcErr.Code()), gin.H{"error": grpcErr.Message()})
 		return
 	}
-
 	c.JSON(http.StatusOK, grpcRes)
 }
 
-// handleUpdateNotificationSettings_Gin godoc
-// @Summary Update notification settings
-// @Description Update your notification preferences
-// @Tags Settings
+// handleGetCollectionMembers_Gin godoc
+// @Summary Get collection members
+// @Description List the owner and members of a collection. Pending invites are only shown to the owner.
+// @Tags Collections
 // @Accept json
 // @Produce json
-// @Param settings body object{push_enabled=bool,email_enabled=bool} true "Notification settings"
-// @Success 200 {object} object{message=string} "Settings updated successfully"
-// @Failure 400 {object} object{error=string} "Bad request"
+// @Param id path int true "Collection ID"
+// @Success 200 {object} object{members=[]object} "Members, owner first"
+// @Failure 400 {object} object{error=string} "Bad request - Invalid collection ID"
 // @Failure 401 {object} object{error=string} "Unauthorized"
+// @Failure 403 {object} object{error=string} "Forbidden - Not a member"
+// @Failure 404 {object} object{error=string} "Collection not found"
 // @Failure 500 {object} object{error=string} "Internal server error"
 // @Security BearerAuth
-// @Router /settings/notifications [put]
-func handleUpdateNotificationSettings_Gin(c *gin.Context) {
+// @Router /collections/{id}/members [get]
+func handleGetCollectionMembers_Gin(c *gin.Context) {
 	userID, ok := c.Request.Context().Value(userIDKey).(int64)
 	if !ok {
 		c.JSON(http.StatusUnauthorized, gin.H{"error": "Failed to get user ID from token"})
 		return
 	}
-
-	var req struct {
-		PushEnabled  bool `json:"push_enabled"`
-		EmailEnabled bool `json:"email_enabled"`
-	}
-
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
+	collectionID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid collection ID"})
 		return
 	}
 
-	grpcReq := &pb.UpdateNotificationSettingsRequest{
+	grpcRes, err := postClient.GetCollectionMembers(c.Request.Context(), &postPb.GetCollectionMembersRequest{
 		UserId:       userID,
-		PushEnabled:  req.PushEnabled,
-		EmailEnabled: req.EmailEnabled,
-	}
-
-	grpcRes, err := client.UpdateNotificationSettings(c.Request.Context(), grpcReq)
+		CollectionId: collectionID,
+	})
 	if err != nil {
 		grpcErr, _ := status.FromError(err)
 		c.JSON(gRPCToHTTPStatusCode(grpcErr.Code()), gin.H{"error": grpcErr.Message()})
 		return
 	}
-
-	c.JSON(http.StatusOK, grpcRes)
+	c.JSON(http.StatusOK, gin.H{"members": grpcRes.Members})
 }
 
-// handleGetNotificationSettings_Gin godoc
-// @Summary Get notification settings
-// @Description Get your notification preferences
-// @Tags Settings
+// handleInviteCollectionMember_Gin godoc
+// @Summary Invite a collection member
+// @Description Invite a user to a collection as an editor (can add and remove posts) or a viewer. Inviting an existing member changes their role. Owner only.
+// @Tags Collections
 // @Accept json
 // @Produce json
-// @Success 200 {object} object{push_enabled=bool,email_enabled=bool} "Notification settings"
+// @Param id path int true "Collection ID"
+// @Param request body object{user_id=int64,role=string} true "Role is editor or viewer"
+// @Success 200 {object} object{collection_id=string,user_id=int64,role=string,status=string} "Invite"
+// @Failure 400 {object} object{error=string} "Bad request - Invalid role or inviting yourself"
 // @Failure 401 {object} object{error=string} "Unauthorized"
+// @Failure 403 {object} object{error=string} "Forbidden - Not the owner, the default collection, or a blocked user"
+// @Failure 404 {object} object{error=string} "Collection or user not found"
 // @Failure 500 {object} object{error=string} "Internal server error"
 // @Security BearerAuth
-// @Router /settings/notifications [get]
-func handleGetNotificationSettings_Gin(c *gin.Context) {
+// @Router /collections/{id}/members [post]
+func handleInviteCollectionMember_Gin(c *gin.Context) {
 	userID, ok := c.Request.Context().Value(userIDKey).(int64)
 	if !ok {
 		c.JSON(http.StatusUnauthorized, gin.H{"error": "Failed to get user ID from token"})
 		return
 	}
+	collectionID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid collection ID"})
+		return
+	}
 
-	grpcReq := &pb.GetNotificationSettingsRequest{
-		UserId: userID,
+	var req struct {
+		UserID int64  `json:"user_id" binding:"required"`
+		Role   string `json:"role" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
+		return
 	}
 
-	grpcRes, err := client.GetNotificationSettings(c.Request.Context(), grpcReq)
+	grpcRes, err := postClient.InviteCollectionMember(c.Request.Context(), &postPb.InviteCollectionMemberRequest{
+		UserId:       userID,
+		CollectionId: collectionID,
+		InviteeId:    req.UserID,
+		Role:         req.Role,
+	})
 	if err != nil {
 		grpcErr, _ := status.FromError(err)
 		c.JSON(gRPCToHTTPStatusCode(grpcErr.Code()), gin.H{"error": grpcErr.Message()})
 		return
 	}
-
 	c.JSON(http.StatusOK, grpcRes)
 }
 
-// handleApproveFollowRequest_Gin godoc
-// @Summary Approve a follow request
-// @Description Approve a pending follow request from another user
-// @Tags Follow Requests
+// handleRemoveCollectionMember_Gin godoc
+// @Summary Remove a collection member
+// @Description The owner can remove any member or cancel an invite; members can remove themselves to leave
+// @Tags Collections
 // @Accept json
 // @Produce json
-// @Param id path int true "Follower User ID"
-// @Success 200 {object} object{message=string} "Follow request approved"
-// @Failure 400 {object} object{error=string} "Bad request"
+// @Param id path int true "Collection ID"
+// @Param user_id path int true "Member's user ID"
+// @Success 200 {object} object{message=string} "Member removed"
+// @Failure 400 {object} object{error=string} "Bad request - Invalid ID, or the owner leaving"
 // @Failure 401 {object} object{error=string} "Unauthorized"
+// @Failure 403 {object} object{error=string} "Forbidden - Not the owner"
+// @Failure 404 {object} object{error=string} "Collection or member not found"
 // @Failure 500 {object} object{error=string} "Internal server error"
 // @Security BearerAuth
-// @Router /follow-requests/{id}/approve [post]
-func handleApproveFollowRequest_Gin(c *gin.Context) {
+// @Router /collections/{id}/members/{user_id} [delete]
+func handleRemoveCollectionMember_Gin(c *gin.Context) {
 	userID, ok := c.Request.Context().Value(userIDKey).(int64)
 	if !ok {
 		c.JSON(http.StatusUnauthorized, gin.H{"error": "Failed to get user ID from token"})
 		return
 	}
-
-	followerIDStr := c.Param("id")
-	followerID, err := strconv.ParseInt(followerIDStr, 10, 64)
+	collectionID, err := strconv.ParseInt(c.Param("id"), 10, 64)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid follower ID"})
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid collection ID"})
 		return
 	}
-
-	grpcReq := &pb.ApproveFollowRequestRequest{
-		UserId:     userID,
-		FollowerId: followerID,
+	memberID, err := strconv.ParseInt(c.Param("user_id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID"})
+		return
 	}
 
-	grpcRes, err := client.ApproveFollowRequest(c.Request.Context(), grpcReq)
+	grpcRes, err := postClient.RemoveCollectionMember(c.Request.Context(), &postPb.RemoveCollectionMemberRequest{
+		UserId:       userID,
+		CollectionId: collectionID,
+		MemberId:     memberID,
+	})
 	if err != nil {
 		grpcErr, _ := status.FromError(err)
 		c.JSON(gRPCToHTTPStatusCode(grpcErr.Code()), gin.H{"error": grpcErr.Message()})
 		return
 	}
-
 	c.JSON(http.StatusOK, grpcRes)
 }
 
-// handleRejectFollowRequest_Gin godoc
-// @Summary Reject a follow request
-// @Description Reject a pending follow request from another user
-// @Tags Follow Requests
+// handleRespondToCollectionInvite_Gin godoc
+// @Summary Accept or decline a collection invite
+// @Description Accepting adds the collection to your collections with the invited role; declining drops the invite
+// @Tags Collections
 // @Accept json
 // @Produce json
-// @Param id path int true "Follower User ID"
-// @Success 200 {object} object{message=string} "Follow request rejected"
-// @Failure 400 {object} object{error=string} "Bad request"
+// @Param id path int true "Collection ID"
+// @Param request body object{accept=bool} true "Whether to accept"
+// @Success 200 {object} object{message=string} "Invite accepted or declined"
+// @Failure 400 {object} object{error=string} "Bad request - Invalid collection ID"
 // @Failure 401 {object} object{error=string} "Unauthorized"
+// @Failure 404 {object} object{error=string} "Invite not found"
 // @Failure 500 {object} object{error=string} "Internal server error"
 // @Security BearerAuth
-// @Router /follow-requests/{id}/reject [post]
-func handleRejectFollowRequest_Gin(c *gin.Context) {
+// @Router /collections/{id}/invite [post]
+func handleRespondToCollectionInvite_Gin(c *gin.Context) {
 	userID, ok := c.Request.Context().Value(userIDKey).(int64)
 	if !ok {
 		c.JSON(http.StatusUnauthorized, gin.H{"error": "Failed to get user ID from token"})
 		return
 	}
-
-	followerIDStr := c.Param("id")
-	followerID, err := strconv.ParseInt(followerIDStr, 10, 64)
+	collectionID, err := strconv.ParseInt(c.Param("id"), 10, 64)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid follower ID"})
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid collection ID"})
 		return
 	}
 
-	grpcReq := &pb.RejectFollowRequestRequest{
-		UserId:     userID,
-		FollowerId: followerID,
+	var req struct {
+		Accept bool `json:"accept"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
+		return
 	}
 
-	grpcRes, err := client.RejectFollowRequest(c.Request.Context(), grpcReq)
+	grpcRes, err := postClient.RespondToCollectionInvite(c.Request.Context(), &postPb.RespondToCollectionInviteRequest{
+		UserId:       userID,
+		CollectionId: collectionID,
+		Accept:       req.Accept,
+	})
 	if err != nil {
 		grpcErr, _ := status.FromError(err)
 		c.JSON(gRPCToHTTPStatusCode(grpcErr.Code()), gin.H{"error": grpcErr.Message()})
 		return
 	}
-
 	c.JSON(http.StatusOK, grpcRes)
 }
 
-// handleGetFollowRequests_Gin godoc
-// @Summary Get pending follow requests
-// @Description Get all pending follow requests for the current user
-// @Tags Follow Requests
+// handleGetCollectionInvites_Gin godoc
+// @Summary Get pending collection invites
+// @Description List collections you've been invited to and haven't answered yet, newest first
+// @Tags Collections
 // @Accept json
 // @Produce json
-// @Success 200 {object} object{requests=array} "List of pending follow requests"
+// @Success 200 {object} object{invites=[]object} "Pending invites"
 // @Failure 401 {object} object{error=string} "Unauthorized"
 // @Failure 500 {object} object{error=string} "Internal server error"
 // @Security BearerAuth
-// @Router /follow-requests [get]
-func handleGetFollowRequests_Gin(c *gin.Context) {
+// @Router /collections/invites [get]
+func handleGetCollectionInvites_Gin(c *gin.Context) {
 	userID, ok := c.Request.Context().Value(userIDKey).(int64)
 	if !ok {
 		c.JSON(http.StatusUnauthorized, gin.H{"error": "Failed to get user ID from token"})
 		return
 	}
 
-	grpcReq := &pb.GetFollowRequestsRequest{
-		UserId: userID,
-	}
-
-	grpcRes, err := client.GetFollowRequests(c.Request.Context(), grpcReq)
+	grpcRes, err := postClient.GetCollectionInvites(c.Request.Context(), &postPb.GetCollectionInvitesRequest{UserId: userID})
 	if err != nil {
 		grpcErr, _ := status.FromError(err)
 		c.JSON(gRPCToHTTPStatusCode(grpcErr.Code()), gin.H{"error": grpcErr.Message()})
 		return
 	}
-
-	c.JSON(http.StatusOK, grpcRes)
+	c.JSON(http.StatusOK, gin.H{"invites": grpcRes.Invites})
 }
 
-// handleCreateCollection_Gin godoc
-// @Summary Create a collection
-// @Description Create a new collection to organize saved posts
+// handleSetCollectionCover_Gin godoc
+// @Summary Set collection cover
+// @Description Pick one of the collection's posts as its cover, or send post_id 0 to go back to the automatic cover (the top of the collection). Owners and editors only.
 // @Tags Collections
 // @Accept json
 // @Produce json
-// @Param request body object{name=string} true "Collection name"
-// @Success 201 {object} object{collection_id=int64,message=string} "Collection created successfully"
-// @Failure 400 {object} object{error=string} "Bad request - Missing name"
+// @Param id path int true "Collection ID"
+// @Param request body object{post_id=int} true "Cover post, or 0"
+// @Success 200 {object} object{id=string,cover_post_id=int,cover_image_urls=[]string,post_count=int} "Updated collection"
+// @Failure 400 {object} object{error=string} "Bad request - Post not in the collection"
 // @Failure 401 {object} object{error=string} "Unauthorized"
+// @Failure 403 {object} object{error=string} "Forbidden - Can't edit this collection"
+// @Failure 404 {object} object{error=string} "Collection not found"
 // @Failure 500 {object} object{error=string} "Internal server error"
 // @Security BearerAuth
-// @Router /collections [post]
-func handleCreateCollection_Gin(c *gin.Context) {
+// @Router /collections/{id}/cover [put]
+func handleSetCollectionCover_Gin(c *gin.Context) {
 	userID, ok := c.Request.Context().Value(userIDKey).(int64)
 	if !ok {
 		c.JSON(http.StatusUnauthorized, gin.H{"error": "Failed to get user ID from token"})
 		return
 	}
+	collectionID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid collection ID"})
+		return
+	}
+
 	var req struct {
-		Name string `json:"name"`
+		PostID int64 `json:"post_id"`
 	}
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
 		return
 	}
 
-	grpcReq := &postPb.CreateCollectionRequest{UserId: userID, Name: req.Name}
-	grpcRes, err := postClient.CreateCollection(c.Request.Context(), grpcReq)
+	grpcRes, err := postClient.SetCollectionCover(c.Request.Context(), &postPb.SetCollectionCoverRequest{
+		UserId:       userID,
+		CollectionId: collectionID,
+		PostId:       req.PostID,
+	})
 	if err != nil {
 		grpcErr, _ := status.FromError(err)
 		c.JSON(gRPCToHTTPStatusCode(grpcErr.Code()), gin.H{"error": grpcErr.Message()})
 		return
 	}
-	c.JSON(http.StatusCreated, grpcRes)
+	c.JSON(http.StatusOK, grpcRes)
 }
 
-// handleGetUserCollections_Gin godoc
-// @Summary Get user's collections
-// @Description Get all collections created by the authenticated user
+// handleReorderCollectionPosts_Gin godoc
+// @Summary Reorder saved posts
+// @Description Send saved posts in their new order, first on top. Only the listed posts move, swapping places among themselves, so a client can send just the page it shows. Owners and editors only.
 // @Tags Collections
 // @Accept json
 // @Produce json
-// @Success 200 {array} object "List of user's collections"
+// @Param id path int true "Collection ID"
+// @Param request body object{post_ids=[]int} true "Posts in their new order (max 100)"
+// @Success 200 {object} object{message=string} "Collection reordered"
+// @Failure 400 {object} object{error=string} "Bad request - Posts not in the collection or repeated"
 // @Failure 401 {object} object{error=string} "Unauthorized"
+// @Failure 403 {object} object{error=string} "Forbidden - Can't edit this collection"
+// @Failure 404 {object} object{error=string} "Collection not found"
 // @Failure 500 {object} object{error=string} "Internal server error"
 // @Security BearerAuth
-// @Router /collections [get]
-func handleGetUserCollections_Gin(c *gin.Context) {
+// @Router /collections/{id}/order [put]
+func handleReorderCollectionPosts_Gin(c *gin.Context) {
 	userID, ok := c.Request.Context().Value(userIDKey).(int64)
 	if !ok {
 		c.JSON(http.StatusUnauthorized, gin.H{"error": "Failed to get user ID from token"})
 		return
 	}
-	grpcReq := &postPb.GetUserCollectionsRequest{UserId: userID}
-	grpcRes, err := postClient.GetUserCollections(c.Request.Context(), grpcReq)
+	collectionID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid collection ID"})
+		return
+	}
+
+	var req struct {
+		PostIDs []int64 `json:"post_ids" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
+		return
+	}
+
+	grpcRes, err := postClient.ReorderCollectionPosts(c.Request.Context(), &postPb.ReorderCollectionPostsRequest{
+		UserId:       userID,
+		CollectionId: collectionID,
+		PostIds:      req.PostIDs,
+	})
 	if err != nil {
 		grpcErr, _ := status.FromError(err)
 		c.JSON(gRPCToHTTPStatusCode(grpcErr.Code()), gin.H{"error": grpcErr.Message()})
 		return
 	}
-	c.JSON(http.StatusOK, grpcRes.Collections)
+	c.JSON(http.StatusOK, grpcRes)
 }
 
-// handleGetPostsInCollection_Gin godoc
-// @Summary Get posts in a collection
-// @Description Get paginated list of posts saved in a specific collection
+// handleMoveSavedPosts_Gin godoc
+// @Summary Move or copy saved posts between collections
+// @Description Moves (or with copy, copies) posts from one collection to the top of another in a single transaction. Every post must be in the source collection, or nothing changes. Posts already in the target are skipped.
 // @Tags Collections
 // @Accept json
 // @Produce json
-// @Param id path int true "Collection ID"
-// @Param page query int false "Page number" default(1)
-// @Param limit query int false "Items per page" default(12)
-// @Success 200 {array} object "List of posts in the collection"
-// @Failure 400 {object} object{error=string} "Bad request - Invalid collection ID"
+// @Param request body object{from_collection_id=int,to_collection_id=int,post_ids=[]int,copy=bool} true "Posts to move (max 100)"
+// @Success 200 {object} object{moved=int,skipped=int} "How many posts were added and how many were already there"
+// @Failure 400 {object} object{error=string} "Bad request - Posts not in the source collection"
 // @Failure 401 {object} object{error=string} "Unauthorized"
-// @Failure 403 {object} object{error=string} "Forbidden - Not your collection"
+// @Failure 403 {object} object{error=string} "Forbidden - Can't edit one of the collections"
 // @Failure 404 {object} object{error=string} "Collection not found"
 // @Failure 500 {object} object{error=string} "Internal server error"
 // @Security BearerAuth
-// @Router /collections/{id} [get]
-func handleGetPostsInCollection_Gin(c *gin.Context) {
+// @Router /collections/move [post]
+func handleMoveSavedPosts_Gin(c *gin.Context) {
 	userID, ok := c.Request.Context().Value(userIDKey).(int64)
 	if !ok {
 		c.JSON(http.StatusUnauthorized, gin.H{"error": "Failed to get user ID from token"})
 		return
 	}
-	collectionID, err := strconv.ParseInt(c.Param("id"), 10, 64)
-	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid collection ID"})
+
+	var req struct {
+		FromCollectionID int64   `json:"from_collection_id" binding:"required"`
+		ToCollectionID   int64   `json:"to_collection_id" binding:"required"`
+		PostIDs          []int64 `json:"post_ids" binding:"required"`
+		Copy             bool    `json:"copy"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
 		return
 	}
 
-	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
-	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "12"))
-	offset := (page - 1) * limit
-
-	grpcReq := &postPb.GetPostsInCollectionRequest{
-		UserId:       userID,
-		CollectionId: collectionID,
-		PageSize:     int32(limit),
-		PageOffset:   int32(offset),
-	}
-	grpcRes, err := postClient.GetPostsInCollection(c.Request.Context(), grpcReq)
+	grpcRes, err := postClient.MoveSavedPosts(c.Request.Context(), &postPb.MoveSavedPostsRequest{
+		UserId:           userID,
+		FromCollectionId: req.FromCollectionID,
+		ToCollectionId:   req.ToCollectionID,
+		PostIds:          req.PostIDs,
+		Copy:             req.Copy,
+	})
 	if err != nil {
 		grpcErr, _ := status.FromError(err)
 		c.JSON(gRPCToHTTPStatusCode(grpcErr.Code()), gin.H{"error": grpcErr.Message()})
 		return
 	}
-	c.JSON(http.StatusOK, grpcRes.Posts)
+	c.JSON(http.StatusOK, grpcRes)
 }
 
 // handleGetCollectionsForPost_Gin godoc
@@ -3090,15 +4578,15 @@ func handleCreateConversation_Gin(c *gin.Context) {
 }
 
 // handleSendMessage_Gin godoc
-// @Summary Send a text message
-// @Description Send a text message in a conversation
+// @Summary Send a message
+// @Description Send a text message, story reply, profile card or location in a conversation. type defaults to text; story_reply, profile and location carry the matching object; a story reply's media is taken from the story. reply_to_message_id quotes an earlier message in the conversation. Media goes through /messages/media and posts through /posts/{id}/send.
 // @Tags Messages
 // @Accept json
 // @Produce json
 // @Param id path string true "Conversation ID"
-// @Param request body object{content=string} true "Message content"
+// @Param request body object{content=string,type=string,reply_to_message_id=string,story_reply=object{story_id=int,story_owner_id=int},profile=object{user_id=int},location=object{name=string,address=string,latitude=number,longitude=number}} true "Message content and payload"
 // @Success 201 {object} object "Sent message details"
-// @Failure 400 {object} object{error=string} "Bad request - Missing content"
+// @Failure 400 {object} object{error=string} "Bad request - Missing content or invalid payload"
 // @Failure 401 {object} object{error=string} "Unauthorized"
 // @Failure 403 {object} object{error=string} "Forbidden - Not a participant"
 // @Failure 404 {object} object{error=string} "Conversation not found"
@@ -3116,18 +4604,44 @@ func handleSendMessage_Gin(c *gin.Context) {
 	convoID := c.Param("id")
 
 	var req struct {
-		Content string `json:"content"`
+		Content          string                 `json:"content"`
+		Type             string                 `json:"type"`
+		ReplyToMessageID string                 `json:"reply_to_message_id"`
+		StoryReply       *messagePb.StoryReply  `json:"story_reply"`
+		Profile          *messagePb.ProfileCard `json:"profile"`
+		Location         *messagePb.Location    `json:"location"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
+		return
 	}
 
-	if err := c.ShouldBindJSON(&req); err != nil || req.Content == "" {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Missing 'content' in request body"})
+	// Media and shared posts have their own endpoints; system messages are server-only
+	switch req.Type {
+	case "", "text", "story_reply", "profile", "location":
+	default:
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Unsupported message type"})
 		return
 	}
 
+	// Only the fields the client may set are passed through
 	grpcReq := &messagePb.SendMessageRequest{
-		SenderId:       senderID,
-		ConversationId: convoID,
-		Content:        req.Content,
+		SenderId:         senderID,
+		ConversationId:   convoID,
+		Content:          req.Content,
+		Type:             req.Type,
+		ReplyToMessageId: req.ReplyToMessageID,
+		Location:         req.Location,
+	}
+	if req.StoryReply != nil {
+		grpcReq.StoryReply = &messagePb.StoryReply{StoryId: req.StoryReply.StoryId, StoryOwnerId: req.StoryReply.StoryOwnerId}
+	}
+	if grpcReq.Type == "" {
+		grpcReq.Type = "text"
+	}
+	if req.Profile != nil {
+		grpcReq.Profile = &messagePb.ProfileCard{UserId: req.Profile.UserId}
 	}
 
 	grpcRes, err := messageClient.SendMessage(c.Request.Context(), grpcReq)
@@ -3150,6 +4664,7 @@ func handleSendMessage_Gin(c *gin.Context) {
 // @Param id path string true "Conversation ID"
 // @Param file formData file true "Media file (image/video/gif)"
 // @Param content formData string false "Optional text content"
+// @Param reply_to_message_id formData string false "Message in the conversation this one replies to"
 // @Success 201 {object} object "Sent message with media details"
 // @Failure 400 {object} object{error=string} "Bad request - Missing file or unsupported media type"
 // @Failure 401 {object} object{error=string} "Unauthorized"
@@ -3225,11 +4740,12 @@ func handleSendMessageWithMedia_Gin(c *gin.Context) {
 
 	// Send message with media URL
 	grpcReq := &messagePb.SendMessageRequest{
-		SenderId:       senderID,
-		ConversationId: convoID,
-		Content:        content,
-		MediaUrl:       uploadRes.MediaUrl,
-		MediaType:      mediaType,
+		SenderId:         senderID,
+		ConversationId:   convoID,
+		Content:          content,
+		MediaUrl:         uploadRes.MediaUrl,
+		MediaType:        mediaType,
+		ReplyToMessageId: c.PostForm("reply_to_message_id"),
 	}
 
 	grpcRes, err := messageClient.SendMessage(c.Request.Context(), grpcReq)
@@ -3249,9 +4765,10 @@ func handleSendMessageWithMedia_Gin(c *gin.Context) {
 // @Tags Messages
 // @Accept json
 // @Produce json
-// @Param page query int false "Page number" default(1)
-// @Param limit query int false "Items per page" default(20)
-// @Success 200 {array} object "List of conversations with last message"
+// @Param limit query int false "Items per page (max 100)" default(20)
+// @Param cursor query string false "Cursor from the previous page's next_cursor"
+// @Success 200 {object} object{conversations=[]object,next_cursor=string} "Conversations with last message, most recently active first"
+// @Failure 400 {object} object{error=string} "Bad request - Invalid cursor"
 // @Failure 401 {object} object{error=string} "Unauthorized"
 // @Failure 500 {object} object{error=string} "Internal server error"
 // @Security BearerAuth
@@ -3264,20 +4781,15 @@ func handleGetConversations_Gin(c *gin.Context) {
 	}
 
 	// Get pagination params
-	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
 	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
-	if page < 1 {
-		page = 1
-	}
-	if limit < 1 {
+	if limit < 1 || limit > 100 {
 		limit = 20
 	}
-	offset := (page - 1) * limit
 
-	grpcReq := &messagePb.GetConversationsRequest{
-		UserId:     userID,
-		PageSize:   int32(limit),
-		PageOffset: int32(offset),
+	grpcReq := &messagePb.GetConversationsRequest{
+		UserId:   userID,
+		PageSize: int32(limit),
+		Cursor:   c.Query("cursor"),
 	}
 
 	grpcRes, err := messageClient.GetConversations(c.Request.Context(), grpcReq)
@@ -3288,7 +4800,11 @@ func handleGetConversations_Gin(c *gin.Context) {
 		return
 	}
 
-	c.JSON(http.StatusOK, grpcRes.Conversations)
+	conversations := grpcRes.Conversations
+	if conversations == nil {
+		conversations = []*messagePb.Conversation{}
+	}
+	c.JSON(http.StatusOK, gin.H{"conversations": conversations, "next_cursor": grpcRes.NextCursor})
 }
 
 // handleGetMessages_Gin godoc
@@ -3347,6 +4863,106 @@ func handleGetMessages_Gin(c *gin.Context) {
 	c.JSON(http.StatusOK, grpcRes.Messages)
 }
 
+// handleMarkConversationRead_Gin godoc
+// @Summary Mark a conversation read
+// @Description Move the user's read pointer up to a message (the latest by default). The other participants get a read_receipt event over the WebSocket.
+// @Tags Messages
+// @Accept json
+// @Produce json
+// @Param id path string true "Conversation ID"
+// @Param request body object{message_id=string} false "Read up to and including this message"
+// @Success 200 {object} object{user_id=int,last_delivered_message_id=string,last_read_message_id=string} "The user's read state"
+// @Failure 400 {object} object{error=string} "Bad request - Invalid ID"
+// @Failure 401 {object} object{error=string} "Unauthorized"
+// @Failure 403 {object} object{error=string} "Forbidden - Not a participant"
+// @Failure 404 {object} object{error=string} "Message not found"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Security BearerAuth
+// @Router /conversations/{id}/read [post]
+func handleMarkConversationRead_Gin(c *gin.Context) {
+	userID, ok := c.Request.Context().Value(userIDKey).(int64)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Failed to get user ID from token"})
+		return
+	}
+
+	// The body is optional
+	var req struct {
+		MessageID string `json:"message_id"`
+	}
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
+			return
+		}
+	}
+
+	grpcRes, err := messageClient.MarkConversationRead(c.Request.Context(), &messagePb.MarkConversationReadRequest{
+		UserId:         userID,
+		ConversationId: c.Param("id"),
+		MessageId:      req.MessageID,
+	})
+	if err != nil {
+		grpcErr, _ := status.FromError(err)
+		log.Printf("gRPC call to MarkConversationRead failed (%s): %v", grpcErr.Code(), grpcErr.Message())
+		c.JSON(gRPCToHTTPStatusCode(grpcErr.Code()), gin.H{"error": grpcErr.Message()})
+		return
+	}
+
+	c.JSON(http.StatusOK, grpcRes)
+}
+
+// handleGetPresence_Gin godoc
+// @Summary Get online status
+// @Description Whether users you share a conversation with are online and when they were last active. Anyone else, users hiding their activity status, blocked users and everyone while you hide your own show as offline with no last seen.
+// @Tags Messages
+// @Accept json
+// @Produce json
+// @Param ids query string true "Comma-separated user IDs (max 200)"
+// @Success 200 {object} object{presences=[]object{user_id=int,online=bool,last_seen_at=string}} "Presence in request order"
+// @Failure 400 {object} object{error=string} "Bad request - Invalid user IDs"
+// @Failure 401 {object} object{error=string} "Unauthorized"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Security BearerAuth
+// @Router /users/presence [get]
+func handleGetPresence_Gin(c *gin.Context) {
+	userID, ok := c.Request.Context().Value(userIDKey).(int64)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Failed to get user ID from token"})
+		return
+	}
+
+	var userIDs []int64
+	for _, idStr := range strings.Split(c.Query("ids"), ",") {
+		if idStr = strings.TrimSpace(idStr); idStr == "" {
+			continue
+		}
+		id, err := strconv.ParseInt(idStr, 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID format"})
+			return
+		}
+		userIDs = append(userIDs, id)
+	}
+	if len(userIDs) == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ids is required"})
+		return
+	}
+
+	grpcRes, err := messageClient.GetPresence(c.Request.Context(), &messagePb.GetPresenceRequest{
+		ViewerId: userID,
+		UserIds:  userIDs,
+	})
+	if err != nil {
+		grpcErr, _ := status.FromError(err)
+		log.Printf("gRPC call to GetPresence failed (%s): %v", grpcErr.Code(), grpcErr.Message())
+		c.JSON(gRPCToHTTPStatusCode(grpcErr.Code()), gin.H{"error": grpcErr.Message()})
+		return
+	}
+
+	c.JSON(http.StatusOK, grpcRes)
+}
+
 // handleSearchMessages_Gin godoc
 // @Summary Search messages in a conversation
 // @Description Search for messages containing specific text in a conversation
@@ -3737,10 +5353,11 @@ func handleUnbanUser_Gin(c *gin.Context) {
 // @Tags Admin
 // @Accept json
 // @Produce json
-// @Param page query int false "Page number" default(1)
-// @Param limit query int false "Items per page" default(50)
+// @Param limit query int false "Items per page (max 100)" default(50)
+// @Param cursor query string false "Cursor from the previous page's next_cursor"
 // @Param unresolved_only query bool false "Show only unresolved reports" default(true)
-// @Success 200 {array} object "List of post reports"
+// @Success 200 {object} object{reports=[]object,next_cursor=string} "List of post reports, newest first"
+// @Failure 400 {object} object{error=string} "Bad request - Invalid cursor"
 // @Failure 401 {object} object{error=string} "Unauthorized"
 // @Failure 403 {object} object{error=string} "Forbidden - Admin access required"
 // @Failure 500 {object} object{error=string} "Internal server error"
@@ -3748,13 +5365,15 @@ func handleUnbanUser_Gin(c *gin.Context) {
 // @Router /admin/reports/posts [get]
 func handleGetPostReports_Gin(c *gin.Context) {
 	// Pagination and filtering
-	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
 	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "50"))
+	if limit < 1 || limit > 100 {
+		limit = 50
+	}
 	unresolvedOnly, _ := strconv.ParseBool(c.DefaultQuery("unresolved_only", "true"))
 
 	grpcReq := &reportPb.GetReportsRequest{
 		PageSize:       int32(limit),
-		PageOffset:     int32((page - 1) * limit),
+		Cursor:         c.Query("cursor"),
 		UnresolvedOnly: unresolvedOnly,
 	}
 
@@ -3765,7 +5384,11 @@ func handleGetPostReports_Gin(c *gin.Context) {
 		return
 	}
 
-	c.JSON(http.StatusOK, grpcRes.Reports)
+	reports := grpcRes.Reports
+	if reports == nil {
+		reports = []*reportPb.PostReport{}
+	}
+	c.JSON(http.StatusOK, gin.H{"reports": reports, "next_cursor": grpcRes.NextCursor})
 }
 
 // handleGetUserReports_Gin godoc
@@ -3774,10 +5397,11 @@ func handleGetPostReports_Gin(c *gin.Context) {
 // @Tags Admin
 // @Accept json
 // @Produce json
-// @Param page query int false "Page number" default(1)
-// @Param limit query int false "Items per page" default(50)
+// @Param limit query int false "Items per page (max 100)" default(50)
+// @Param cursor query string false "Cursor from the previous page's next_cursor"
 // @Param unresolved_only query bool false "Show only unresolved reports" default(true)
-// @Success 200 {array} object "List of user reports"
+// @Success 200 {object} object{reports=[]object,next_cursor=string} "List of user reports, newest first"
+// @Failure 400 {object} object{error=string} "Bad request - Invalid cursor"
 // @Failure 401 {object} object{error=string} "Unauthorized"
 // @Failure 403 {object} object{error=string} "Forbidden - Admin access required"
 // @Failure 500 {object} object{error=string} "Internal server error"
@@ -3785,13 +5409,15 @@ func handleGetPostReports_Gin(c *gin.Context) {
 // @Router /admin/reports/users [get]
 func handleGetUserReports_Gin(c *gin.Context) {
 	// Pagination and filtering
-	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
 	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "50"))
+	if limit < 1 || limit > 100 {
+		limit = 50
+	}
 	unresolvedOnly, _ := strconv.ParseBool(c.DefaultQuery("unresolved_only", "true"))
 
 	grpcReq := &reportPb.GetReportsRequest{
 		PageSize:       int32(limit),
-		PageOffset:     int32((page - 1) * limit),
+		Cursor:         c.Query("cursor"),
 		UnresolvedOnly: unresolvedOnly,
 	}
 
@@ -3802,7 +5428,11 @@ func handleGetUserReports_Gin(c *gin.Context) {
 		return
 	}
 
-	c.JSON(http.StatusOK, grpcRes.Reports)
+	reports := grpcRes.Reports
+	if reports == nil {
+		reports = []*reportPb.UserReport{}
+	}
+	c.JSON(http.StatusOK, gin.H{"reports": reports, "next_cursor": grpcRes.NextCursor})
 }
 
 // handleResolvePostReport_Gin godoc
@@ -4106,10 +5736,10 @@ func handleResolveVerification_Gin(c *gin.Context) {
 // @Accept json
 // @Produce json
 // @Param name path string true "Hashtag name (without #)"
-// @Param page query int false "Page number" default(1)
-// @Param limit query int false "Items per page" default(20)
-// @Success 200 {object} object{posts=[]object,total_post_count=int64} "Posts with the hashtag"
-// @Failure 400 {object} object{error=string} "Bad request - Hashtag name required"
+// @Param limit query int false "Items per page (max 100)" default(20)
+// @Param cursor query string false "Cursor from the previous page's next_cursor"
+// @Success 200 {object} object{posts=[]object,total_post_count=int64,next_cursor=string} "Posts with the hashtag, newest first"
+// @Failure 400 {object} object{error=string} "Bad request - Hashtag name required or invalid cursor"
 // @Failure 401 {object} object{error=string} "Unauthorized"
 // @Failure 500 {object} object{error=string} "Internal server error"
 // @Security BearerAuth
@@ -4121,19 +5751,15 @@ func handleSearchHashtag_Gin(c *gin.Context) {
 		return
 	}
 
-	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
 	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
-	if page < 1 {
-		page = 1
-	}
-	if limit < 1 {
+	if limit < 1 || limit > 100 {
 		limit = 20
 	}
 
 	grpcReq := &hashtagPb.SearchByHashtagRequest{
 		HashtagName: strings.ToLower(hashtagName),
 		PageSize:    int32(limit),
-		PageOffset:  int32((page - 1) * limit),
+		Cursor:      c.Query("cursor"),
 	}
 
 	grpcRes, err := hashtagClient.SearchByHashtag(c.Request.Context(), grpcReq)
@@ -4143,7 +5769,15 @@ func handleSearchHashtag_Gin(c *gin.Context) {
 		return
 	}
 
-	c.JSON(http.StatusOK, grpcRes) // Returns { "posts": [...], "total_post_count": X }
+	posts := grpcRes.Posts
+	if posts == nil {
+		posts = []*postPb.Post{}
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"posts":            posts,
+		"total_post_count": grpcRes.TotalPostCount,
+		"next_cursor":      grpcRes.NextCursor,
+	})
 }
 
 // handleTrendingHashtags_Gin godoc
@@ -4217,6 +5851,132 @@ func handleUnsendMessage_Gin(c *gin.Context) {
 	c.JSON(http.StatusOK, grpcRes)
 }
 
+// handleReactToMessage_Gin godoc
+// @Summary React to a message
+// @Description Put an emoji on a message. Each user has one reaction per message; reacting again replaces it.
+// @Tags Messages
+// @Accept json
+// @Produce json
+// @Param id path string true "Message ID"
+// @Param request body object{emoji=string} true "A single emoji"
+// @Success 200 {object} object{message_id=string,conversation_id=string,reactions=[]object{emoji=string,count=int,user_ids=[]int}} "The message's reactions"
+// @Failure 400 {object} object{error=string} "Bad request - Not an emoji or a system message"
+// @Failure 401 {object} object{error=string} "Unauthorized"
+// @Failure 403 {object} object{error=string} "Forbidden - Not a participant"
+// @Failure 404 {object} object{error=string} "Message not found"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Security BearerAuth
+// @Router /messages/{id}/reactions [post]
+func handleReactToMessage_Gin(c *gin.Context) {
+	userID, ok := c.Request.Context().Value(userIDKey).(int64)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Failed to get user ID from token"})
+		return
+	}
+
+	var req struct {
+		Emoji string `json:"emoji" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "emoji is required"})
+		return
+	}
+
+	grpcRes, err := messageClient.ReactToMessage(c.Request.Context(), &messagePb.ReactToMessageRequest{
+		UserId:    userID,
+		MessageId: c.Param("id"),
+		Emoji:     req.Emoji,
+	})
+	if err != nil {
+		grpcErr, _ := status.FromError(err)
+		c.JSON(gRPCToHTTPStatusCode(grpcErr.Code()), gin.H{"error": grpcErr.Message()})
+		return
+	}
+
+	c.JSON(http.StatusOK, grpcRes)
+}
+
+// handleRemoveReaction_Gin godoc
+// @Summary Remove your reaction
+// @Description Remove your reaction from a message
+// @Tags Messages
+// @Accept json
+// @Produce json
+// @Param id path string true "Message ID"
+// @Success 200 {object} object{message_id=string,conversation_id=string,reactions=[]object{emoji=string,count=int,user_ids=[]int}} "The message's reactions"
+// @Failure 400 {object} object{error=string} "Bad request - Invalid message ID"
+// @Failure 401 {object} object{error=string} "Unauthorized"
+// @Failure 403 {object} object{error=string} "Forbidden - Not a participant"
+// @Failure 404 {object} object{error=string} "Message not found"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Security BearerAuth
+// @Router /messages/{id}/reactions [delete]
+func handleRemoveReaction_Gin(c *gin.Context) {
+	userID, ok := c.Request.Context().Value(userIDKey).(int64)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Failed to get user ID from token"})
+		return
+	}
+
+	grpcRes, err := messageClient.RemoveReaction(c.Request.Context(), &messagePb.RemoveReactionRequest{
+		UserId:    userID,
+		MessageId: c.Param("id"),
+	})
+	if err != nil {
+		grpcErr, _ := status.FromError(err)
+		c.JSON(gRPCToHTTPStatusCode(grpcErr.Code()), gin.H{"error": grpcErr.Message()})
+		return
+	}
+
+	c.JSON(http.StatusOK, grpcRes)
+}
+
+// handleForwardMessage_Gin godoc
+// @Summary Forward a message
+// @Description Copy a message into other conversations you're in. Text, media, profile cards and locations can be forwarded; posts are shared again through /posts/{id}/send.
+// @Tags Messages
+// @Accept json
+// @Produce json
+// @Param id path string true "Message ID"
+// @Param request body object{conversation_ids=[]string} true "Target conversations (max 20)"
+// @Success 201 {object} object{messages=[]object} "The new messages, one per conversation"
+// @Failure 400 {object} object{error=string} "Bad request - Invalid IDs, too many conversations or a message that can't be forwarded"
+// @Failure 401 {object} object{error=string} "Unauthorized"
+// @Failure 403 {object} object{error=string} "Forbidden - Not a participant"
+// @Failure 404 {object} object{error=string} "Message not found"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Security BearerAuth
+// @Router /messages/{id}/forward [post]
+func handleForwardMessage_Gin(c *gin.Context) {
+	userID, ok := c.Request.Context().Value(userIDKey).(int64)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Failed to get user ID from token"})
+		return
+	}
+
+	var req struct {
+		ConversationIDs []string `json:"conversation_ids" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "conversation_ids is required"})
+		return
+	}
+
+	grpcRes, err := messageClient.ForwardMessage(c.Request.Context(), &messagePb.ForwardMessageRequest{
+		UserId:          userID,
+		MessageId:       c.Param("id"),
+		ConversationIds: req.ConversationIDs,
+	})
+	if err != nil {
+		grpcErr, _ := status.FromError(err)
+		log.Printf("gRPC call to ForwardMessage failed (%s): %v", grpcErr.Code(), grpcErr.Message())
+		c.JSON(gRPCToHTTPStatusCode(grpcErr.Code()), gin.H{"error": grpcErr.Message()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, grpcRes)
+}
+
 // handleDeleteConversation_Gin godoc
 // @Summary Delete a conversation
 // @Description Delete a conversation for the current user (only removes it from their view)
@@ -4379,7 +6139,10 @@ func handleCheckUsername_Gin(c *gin.Context) {
 // @Accept json
 // @Produce json
 // @Param id path string true "Username"
-// @Success 200 {array} object "List of posts where user is tagged"
+// @Param limit query int false "Items per page (max 100)" default(20)
+// @Param cursor query string false "Cursor from the previous page's next_cursor"
+// @Success 200 {object} object{posts=[]object,next_cursor=string} "List of posts where user is tagged"
+// @Failure 400 {object} object{error=string} "Bad request - Invalid cursor"
 // @Failure 401 {object} object{error=string} "Unauthorized"
 // @Failure 404 {object} object{error=string} "User not found"
 // @Failure 500 {object} object{error=string} "Internal server error"
@@ -4396,20 +6159,34 @@ func handleGetUserTaggedPosts_Gin(c *gin.Context) {
 		return
 	}
 
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+	if limit < 1 || limit > 100 {
+		limit = 20
+	}
+
 	// 2. Call Post Service
 	grpcReq := &postPb.GetUserContentRequest{
 		UserId:      userRes.UserId,
 		RequesterId: requesterID,
-		PageSize:    20,
-		PageOffset:  0,
+		PageSize:    int32(limit),
+		Cursor:      c.Query("cursor"),
 	}
 	res, err := postClient.GetUserTaggedPosts(c.Request.Context(), grpcReq)
 	if err != nil {
-		// handle error
+		grpcErr, _ := status.FromError(err)
+		if grpcErr.Code() == codes.InvalidArgument {
+			c.JSON(http.StatusBadRequest, gin.H{"error": grpcErr.Message()})
+			return
+		}
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch tagged posts"})
 		return
 	}
-	c.JSON(http.StatusOK, res.Posts)
+
+	posts := res.Posts
+	if posts == nil {
+		posts = []*postPb.Post{}
+	}
+	c.JSON(http.StatusOK, gin.H{"posts": posts, "next_cursor": res.NextCursor})
 }
 
 // handleGetFollowersList_Gin godoc
@@ -4494,26 +6271,99 @@ func handleGetFollowingList_Gin(c *gin.Context) {
 
 // handleGetPostLikers_Gin godoc
 // @Summary Get post likes
-// @Description Get list of users who liked a specific post
+// @Description Get a cursor-paginated list of users who liked a post, newest first, with the viewer's follow state. The like count is omitted for non-authors when the author hid it.
 // @Tags Posts
 // @Accept json
 // @Produce json
 // @Param id path int true "Post ID"
-// @Success 200 {array} object "List of users who liked the post"
-// @Failure 400 {object} object{error=string} "Bad request - Invalid post ID"
+// @Param limit query int false "Number of likers per page (max 100)" default(50)
+// @Param cursor query string false "Cursor from the previous page's next_cursor"
+// @Success 200 {object} object{likers=[]object,next_cursor=string,like_count=int,like_count_hidden=bool} "Users who liked the post"
+// @Failure 400 {object} object{error=string} "Bad request - Invalid post ID or cursor"
 // @Failure 401 {object} object{error=string} "Unauthorized"
+// @Failure 403 {object} object{error=string} "Forbidden - Cannot view this post"
 // @Failure 404 {object} object{error=string} "Post not found"
 // @Failure 500 {object} object{error=string} "Internal server error"
 // @Security BearerAuth
 // @Router /posts/{id}/likes [get]
 func handleGetPostLikers_Gin(c *gin.Context) {
-	postIDStr := c.Param("id")
+	userID, ok := c.Request.Context().Value(userIDKey).(int64)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Failed to get user ID from token"})
+		return
+	}
+
+	postID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid post ID"})
+		return
+	}
+
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "50"))
+	if limit < 1 || limit > 100 {
+		limit = 50
+	}
+
+	grpcRes, err := postClient.GetPostLikers(c.Request.Context(), &postPb.GetPostLikersRequest{
+		PostId:   postID,
+		ViewerId: userID,
+		PageSize: int32(limit),
+		Cursor:   c.Query("cursor"),
+	})
+	if err != nil {
+		grpcErr, _ := status.FromError(err)
+		c.JSON(gRPCToHTTPStatusCode(grpcErr.Code()), gin.H{"error": grpcErr.Message()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"likers":            grpcRes.Likers,
+		"next_cursor":       grpcRes.NextCursor,
+		"like_count":        grpcRes.LikeCount,
+		"like_count_hidden": grpcRes.LikeCountHidden,
+	})
+}
+
+// handleHideLikeCount_Gin godoc
+// @Summary Hide or show a post's like count
+// @Description Hide (POST) or show (DELETE) the like count of your own post to other users
+// @Tags Posts
+// @Accept json
+// @Produce json
+// @Param id path int true "Post ID"
+// @Success 200 {object} object{message=string} "Setting updated"
+// @Failure 400 {object} object{error=string} "Bad request - Invalid post ID"
+// @Failure 401 {object} object{error=string} "Unauthorized"
+// @Failure 403 {object} object{error=string} "Forbidden - Not the post author"
+// @Failure 404 {object} object{error=string} "Post not found"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Security BearerAuth
+// @Router /posts/{id}/hide-like-count [post]
+// @Router /posts/{id}/hide-like-count [delete]
+func handleHideLikeCount_Gin(c *gin.Context) {
+	userID, ok := c.Request.Context().Value(userIDKey).(int64)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Failed to get user ID from token"})
+		return
+	}
+
+	postID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid post ID"})
+		return
+	}
 
-	// For now, return empty array as this requires querying post_likes table
-	// which needs to be added to post-service proto
-	// TODO: Add GetPostLikers RPC to post-service
-	_ = postIDStr
-	c.JSON(http.StatusOK, []map[string]interface{}{})
+	grpcRes, err := postClient.SetHideLikeCount(c.Request.Context(), &postPb.SetHideLikeCountRequest{
+		UserId: userID,
+		PostId: postID,
+		Hidden: c.Request.Method == http.MethodPost,
+	})
+	if err != nil {
+		grpcErr, _ := status.FromError(err)
+		c.JSON(gRPCToHTTPStatusCode(grpcErr.Code()), gin.H{"error": grpcErr.Message()})
+		return
+	}
+	c.JSON(http.StatusOK, grpcRes)
 }
 
 // handleGetTopUsers_Gin godoc
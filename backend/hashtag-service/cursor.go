@@ -0,0 +1,53 @@
+package main
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+)
+
+// taggedPostCursor marks where a page of a hashtag's posts ended: when the
+// last post was tagged, and its ID to break ties. Clients only see it encoded.
+type taggedPostCursor struct {
+	TaggedAt time.Time `json:"t"`
+	PostID   int64     `json:"id"`
+}
+
+func encodeCursor(c taggedPostCursor) string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// decodeCursor returns nil for an empty token, i.e. the newest posts
+func decodeCursor(token string) (*taggedPostCursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "Invalid cursor")
+	}
+	var c taggedPostCursor
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "Invalid cursor")
+	}
+	return &c, nil
+}
+
+func normalizePageSize(size int32) int {
+	if size <= 0 {
+		return defaultPageSize
+	}
+	if size > maxPageSize {
+		return maxPageSize
+	}
+	return int(size)
+}
@@ -4,6 +4,7 @@ go 1.25.3
 
 require (
 	github.com/hoshibmatchi/post-service v0.0.0
+	github.com/rabbitmq/amqp091-go v1.10.0
 	google.golang.org/grpc v1.76.0
 	google.golang.org/protobuf v1.36.10
 	gorm.io/driver/postgres v1.6.0
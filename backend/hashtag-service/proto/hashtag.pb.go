@@ -88,7 +88,8 @@ type SearchByHashtagRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	HashtagName   string                 `protobuf:"bytes,1,opt,name=hashtag_name,json=hashtagName,proto3" json:"hashtag_name,omitempty"`
 	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageOffset    int32                  `protobuf:"varint,3,opt,name=page_offset,json=pageOffset,proto3" json:"page_offset,omitempty"`
+	PageOffset    int32                  `protobuf:"varint,3,opt,name=page_offset,json=pageOffset,proto3" json:"page_offset,omitempty"` // Deprecated: ignored, use cursor
+	Cursor        string                 `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`                            // next_cursor from the previous page
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -144,11 +145,19 @@ func (x *SearchByHashtagRequest) GetPageOffset() int32 {
 	return 0
 }
 
+func (x *SearchByHashtagRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
 type SearchByHashtagResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// We can re-use the Post message from post.proto
 	Posts          []*proto.Post `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts,omitempty"`
 	TotalPostCount int64         `protobuf:"varint,2,opt,name=total_post_count,json=totalPostCount,proto3" json:"total_post_count,omitempty"`
+	NextCursor     string        `protobuf:"bytes,3,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"` // Empty on the last page
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -197,6 +206,13 @@ func (x *SearchByHashtagResponse) GetTotalPostCount() int64 {
 	return 0
 }
 
+func (x *SearchByHashtagResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
 // --- GetTrendingHashtags ---
 type GetTrendingHashtagsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -383,6 +399,163 @@ func (x *AddHashtagsToPostResponse) GetMessage() string {
 	return ""
 }
 
+// --- GetRelatedPosts (Internal) ---
+type GetRelatedPostsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostIds       []int64                `protobuf:"varint,1,rep,packed,name=post_ids,json=postIds,proto3" json:"post_ids,omitempty"` // Posts the viewer engaged with
+	SinceDays     int32                  `protobuf:"varint,2,opt,name=since_days,json=sinceDays,proto3" json:"since_days,omitempty"`  // Only posts tagged in the last N days (default 14)
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`                           // Max results (default 200)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRelatedPostsRequest) Reset() {
+	*x = GetRelatedPostsRequest{}
+	mi := &file_hashtag_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRelatedPostsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRelatedPostsRequest) ProtoMessage() {}
+
+func (x *GetRelatedPostsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hashtag_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRelatedPostsRequest.ProtoReflect.Descriptor instead.
+func (*GetRelatedPostsRequest) Descriptor() ([]byte, []int) {
+	return file_hashtag_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetRelatedPostsRequest) GetPostIds() []int64 {
+	if x != nil {
+		return x.PostIds
+	}
+	return nil
+}
+
+func (x *GetRelatedPostsRequest) GetSinceDays() int32 {
+	if x != nil {
+		return x.SinceDays
+	}
+	return 0
+}
+
+func (x *GetRelatedPostsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type RelatedPost struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	PostId         int64                  `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	SharedHashtags int32                  `protobuf:"varint,2,opt,name=shared_hashtags,json=sharedHashtags,proto3" json:"shared_hashtags,omitempty"` // Weighted by how often each hashtag appears in post_ids
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RelatedPost) Reset() {
+	*x = RelatedPost{}
+	mi := &file_hashtag_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RelatedPost) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RelatedPost) ProtoMessage() {}
+
+func (x *RelatedPost) ProtoReflect() protoreflect.Message {
+	mi := &file_hashtag_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RelatedPost.ProtoReflect.Descriptor instead.
+func (*RelatedPost) Descriptor() ([]byte, []int) {
+	return file_hashtag_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *RelatedPost) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *RelatedPost) GetSharedHashtags() int32 {
+	if x != nil {
+		return x.SharedHashtags
+	}
+	return 0
+}
+
+type GetRelatedPostsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Posts         []*RelatedPost         `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRelatedPostsResponse) Reset() {
+	*x = GetRelatedPostsResponse{}
+	mi := &file_hashtag_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRelatedPostsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRelatedPostsResponse) ProtoMessage() {}
+
+func (x *GetRelatedPostsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hashtag_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRelatedPostsResponse.ProtoReflect.Descriptor instead.
+func (*GetRelatedPostsResponse) Descriptor() ([]byte, []int) {
+	return file_hashtag_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetRelatedPostsResponse) GetPosts() []*RelatedPost {
+	if x != nil {
+		return x.Posts
+	}
+	return nil
+}
+
 var File_hashtag_proto protoreflect.FileDescriptor
 
 const file_hashtag_proto_rawDesc = "" +
@@ -393,16 +566,19 @@ const file_hashtag_proto_rawDesc = "" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1d\n" +
 	"\n" +
-	"post_count\x18\x03 \x01(\x03R\tpostCount\"y\n" +
+	"post_count\x18\x03 \x01(\x03R\tpostCount\"\x91\x01\n" +
 	"\x16SearchByHashtagRequest\x12!\n" +
 	"\fhashtag_name\x18\x01 \x01(\tR\vhashtagName\x12\x1b\n" +
 	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1f\n" +
 	"\vpage_offset\x18\x03 \x01(\x05R\n" +
-	"pageOffset\"e\n" +
+	"pageOffset\x12\x16\n" +
+	"\x06cursor\x18\x04 \x01(\tR\x06cursor\"\x86\x01\n" +
 	"\x17SearchByHashtagResponse\x12 \n" +
 	"\x05posts\x18\x01 \x03(\v2\n" +
 	".post.PostR\x05posts\x12(\n" +
-	"\x10total_post_count\x18\x02 \x01(\x03R\x0etotalPostCount\"2\n" +
+	"\x10total_post_count\x18\x02 \x01(\x03R\x0etotalPostCount\x12\x1f\n" +
+	"\vnext_cursor\x18\x03 \x01(\tR\n" +
+	"nextCursor\"2\n" +
 	"\x1aGetTrendingHashtagsRequest\x12\x14\n" +
 	"\x05limit\x18\x01 \x01(\x05R\x05limit\"K\n" +
 	"\x1bGetTrendingHashtagsResponse\x12,\n" +
@@ -411,11 +587,22 @@ const file_hashtag_proto_rawDesc = "" +
 	"\apost_id\x18\x01 \x01(\x03R\x06postId\x12#\n" +
 	"\rhashtag_names\x18\x02 \x03(\tR\fhashtagNames\"5\n" +
 	"\x19AddHashtagsToPostResponse\x12\x18\n" +
-	"\amessage\x18\x01 \x01(\tR\amessage2\xa4\x02\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"h\n" +
+	"\x16GetRelatedPostsRequest\x12\x19\n" +
+	"\bpost_ids\x18\x01 \x03(\x03R\apostIds\x12\x1d\n" +
+	"\n" +
+	"since_days\x18\x02 \x01(\x05R\tsinceDays\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"O\n" +
+	"\vRelatedPost\x12\x17\n" +
+	"\apost_id\x18\x01 \x01(\x03R\x06postId\x12'\n" +
+	"\x0fshared_hashtags\x18\x02 \x01(\x05R\x0esharedHashtags\"E\n" +
+	"\x17GetRelatedPostsResponse\x12*\n" +
+	"\x05posts\x18\x01 \x03(\v2\x14.hashtag.RelatedPostR\x05posts2\xfa\x02\n" +
 	"\x0eHashtagService\x12T\n" +
 	"\x0fSearchByHashtag\x12\x1f.hashtag.SearchByHashtagRequest\x1a .hashtag.SearchByHashtagResponse\x12`\n" +
 	"\x13GetTrendingHashtags\x12#.hashtag.GetTrendingHashtagsRequest\x1a$.hashtag.GetTrendingHashtagsResponse\x12Z\n" +
-	"\x11AddHashtagsToPost\x12!.hashtag.AddHashtagsToPostRequest\x1a\".hashtag.AddHashtagsToPostResponseB/Z-github.com/hoshibmatchi/hashtag-service/protob\x06proto3"
+	"\x11AddHashtagsToPost\x12!.hashtag.AddHashtagsToPostRequest\x1a\".hashtag.AddHashtagsToPostResponse\x12T\n" +
+	"\x0fGetRelatedPosts\x12\x1f.hashtag.GetRelatedPostsRequest\x1a .hashtag.GetRelatedPostsResponseB/Z-github.com/hoshibmatchi/hashtag-service/protob\x06proto3"
 
 var (
 	file_hashtag_proto_rawDescOnce sync.Once
@@ -429,7 +616,7 @@ func file_hashtag_proto_rawDescGZIP() []byte {
 	return file_hashtag_proto_rawDescData
 }
 
-var file_hashtag_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_hashtag_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_hashtag_proto_goTypes = []any{
 	(*Hashtag)(nil),                     // 0: hashtag.Hashtag
 	(*SearchByHashtagRequest)(nil),      // 1: hashtag.SearchByHashtagRequest
@@ -438,22 +625,28 @@ var file_hashtag_proto_goTypes = []any{
 	(*GetTrendingHashtagsResponse)(nil), // 4: hashtag.GetTrendingHashtagsResponse
 	(*AddHashtagsToPostRequest)(nil),    // 5: hashtag.AddHashtagsToPostRequest
 	(*AddHashtagsToPostResponse)(nil),   // 6: hashtag.AddHashtagsToPostResponse
-	(*proto.Post)(nil),                  // 7: post.Post
+	(*GetRelatedPostsRequest)(nil),      // 7: hashtag.GetRelatedPostsRequest
+	(*RelatedPost)(nil),                 // 8: hashtag.RelatedPost
+	(*GetRelatedPostsResponse)(nil),     // 9: hashtag.GetRelatedPostsResponse
+	(*proto.Post)(nil),                  // 10: post.Post
 }
 var file_hashtag_proto_depIdxs = []int32{
-	7, // 0: hashtag.SearchByHashtagResponse.posts:type_name -> post.Post
-	0, // 1: hashtag.GetTrendingHashtagsResponse.hashtags:type_name -> hashtag.Hashtag
-	1, // 2: hashtag.HashtagService.SearchByHashtag:input_type -> hashtag.SearchByHashtagRequest
-	3, // 3: hashtag.HashtagService.GetTrendingHashtags:input_type -> hashtag.GetTrendingHashtagsRequest
-	5, // 4: hashtag.HashtagService.AddHashtagsToPost:input_type -> hashtag.AddHashtagsToPostRequest
-	2, // 5: hashtag.HashtagService.SearchByHashtag:output_type -> hashtag.SearchByHashtagResponse
-	4, // 6: hashtag.HashtagService.GetTrendingHashtags:output_type -> hashtag.GetTrendingHashtagsResponse
-	6, // 7: hashtag.HashtagService.AddHashtagsToPost:output_type -> hashtag.AddHashtagsToPostResponse
-	5, // [5:8] is the sub-list for method output_type
-	2, // [2:5] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	10, // 0: hashtag.SearchByHashtagResponse.posts:type_name -> post.Post
+	0,  // 1: hashtag.GetTrendingHashtagsResponse.hashtags:type_name -> hashtag.Hashtag
+	8,  // 2: hashtag.GetRelatedPostsResponse.posts:type_name -> hashtag.RelatedPost
+	1,  // 3: hashtag.HashtagService.SearchByHashtag:input_type -> hashtag.SearchByHashtagRequest
+	3,  // 4: hashtag.HashtagService.GetTrendingHashtags:input_type -> hashtag.GetTrendingHashtagsRequest
+	5,  // 5: hashtag.HashtagService.AddHashtagsToPost:input_type -> hashtag.AddHashtagsToPostRequest
+	7,  // 6: hashtag.HashtagService.GetRelatedPosts:input_type -> hashtag.GetRelatedPostsRequest
+	2,  // 7: hashtag.HashtagService.SearchByHashtag:output_type -> hashtag.SearchByHashtagResponse
+	4,  // 8: hashtag.HashtagService.GetTrendingHashtags:output_type -> hashtag.GetTrendingHashtagsResponse
+	6,  // 9: hashtag.HashtagService.AddHashtagsToPost:output_type -> hashtag.AddHashtagsToPostResponse
+	9,  // 10: hashtag.HashtagService.GetRelatedPosts:output_type -> hashtag.GetRelatedPostsResponse
+	7,  // [7:11] is the sub-list for method output_type
+	3,  // [3:7] is the sub-list for method input_type
+	3,  // [3:3] is the sub-list for extension type_name
+	3,  // [3:3] is the sub-list for extension extendee
+	0,  // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_hashtag_proto_init() }
@@ -467,7 +660,7 @@ func file_hashtag_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_hashtag_proto_rawDesc), len(file_hashtag_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   7,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
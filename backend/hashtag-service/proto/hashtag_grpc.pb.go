@@ -22,6 +22,7 @@ const (
 	HashtagService_SearchByHashtag_FullMethodName     = "/hashtag.HashtagService/SearchByHashtag"
 	HashtagService_GetTrendingHashtags_FullMethodName = "/hashtag.HashtagService/GetTrendingHashtags"
 	HashtagService_AddHashtagsToPost_FullMethodName   = "/hashtag.HashtagService/AddHashtagsToPost"
+	HashtagService_GetRelatedPosts_FullMethodName     = "/hashtag.HashtagService/GetRelatedPosts"
 )
 
 // HashtagServiceClient is the client API for HashtagService service.
@@ -35,6 +36,9 @@ type HashtagServiceClient interface {
 	GetTrendingHashtags(ctx context.Context, in *GetTrendingHashtagsRequest, opts ...grpc.CallOption) (*GetTrendingHashtagsResponse, error)
 	// --- Internal RPC (called by worker-service) ---
 	AddHashtagsToPost(ctx context.Context, in *AddHashtagsToPostRequest, opts ...grpc.CallOption) (*AddHashtagsToPostResponse, error)
+	// --- Internal RPC (called by post-service for explore) ---
+	// Recent posts sharing hashtags with the given posts, most overlap first
+	GetRelatedPosts(ctx context.Context, in *GetRelatedPostsRequest, opts ...grpc.CallOption) (*GetRelatedPostsResponse, error)
 }
 
 type hashtagServiceClient struct {
@@ -75,6 +79,16 @@ func (c *hashtagServiceClient) AddHashtagsToPost(ctx context.Context, in *AddHas
 	return out, nil
 }
 
+func (c *hashtagServiceClient) GetRelatedPosts(ctx context.Context, in *GetRelatedPostsRequest, opts ...grpc.CallOption) (*GetRelatedPostsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRelatedPostsResponse)
+	err := c.cc.Invoke(ctx, HashtagService_GetRelatedPosts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // HashtagServiceServer is the server API for HashtagService service.
 // All implementations must embed UnimplementedHashtagServiceServer
 // for forward compatibility.
@@ -86,6 +100,9 @@ type HashtagServiceServer interface {
 	GetTrendingHashtags(context.Context, *GetTrendingHashtagsRequest) (*GetTrendingHashtagsResponse, error)
 	// --- Internal RPC (called by worker-service) ---
 	AddHashtagsToPost(context.Context, *AddHashtagsToPostRequest) (*AddHashtagsToPostResponse, error)
+	// --- Internal RPC (called by post-service for explore) ---
+	// Recent posts sharing hashtags with the given posts, most overlap first
+	GetRelatedPosts(context.Context, *GetRelatedPostsRequest) (*GetRelatedPostsResponse, error)
 	mustEmbedUnimplementedHashtagServiceServer()
 }
 
@@ -105,6 +122,9 @@ func (UnimplementedHashtagServiceServer) GetTrendingHashtags(context.Context, *G
 func (UnimplementedHashtagServiceServer) AddHashtagsToPost(context.Context, *AddHashtagsToPostRequest) (*AddHashtagsToPostResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddHashtagsToPost not implemented")
 }
+func (UnimplementedHashtagServiceServer) GetRelatedPosts(context.Context, *GetRelatedPostsRequest) (*GetRelatedPostsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRelatedPosts not implemented")
+}
 func (UnimplementedHashtagServiceServer) mustEmbedUnimplementedHashtagServiceServer() {}
 func (UnimplementedHashtagServiceServer) testEmbeddedByValue()                        {}
 
@@ -180,6 +200,24 @@ func _HashtagService_AddHashtagsToPost_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HashtagService_GetRelatedPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRelatedPostsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HashtagServiceServer).GetRelatedPosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HashtagService_GetRelatedPosts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HashtagServiceServer).GetRelatedPosts(ctx, req.(*GetRelatedPostsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // HashtagService_ServiceDesc is the grpc.ServiceDesc for HashtagService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -199,6 +237,10 @@ var HashtagService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AddHashtagsToPost",
 			Handler:    _HashtagService_AddHashtagsToPost_Handler,
 		},
+		{
+			MethodName: "GetRelatedPosts",
+			Handler:    _HashtagService_GetRelatedPosts_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "hashtag.proto",
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Conversation list paging. The inbox is ordered by last activity, so a page
+// ends at the updated_at of its last conversation.
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+)
+
+type conversationCursor struct {
+	UpdatedAt time.Time `json:"t"`
+	ID        uint      `json:"id"`
+}
+
+func encodeCursor(c conversationCursor) string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// decodeCursor returns nil for an empty token, i.e. the most recent conversations
+func decodeCursor(token string) (*conversationCursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "Invalid cursor")
+	}
+	var c conversationCursor
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "Invalid cursor")
+	}
+	return &c, nil
+}
+
+func normalizePageSize(size int32) int {
+	if size <= 0 {
+		return defaultPageSize
+	}
+	if size > maxPageSize {
+		return maxPageSize
+	}
+	return int(size)
+}
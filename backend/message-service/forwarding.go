@@ -0,0 +1,137 @@
+package main
+
+import (
+	"context"
+	"log"
+	"strconv"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+
+	pb "github.com/hoshibmatchi/message-service/proto"
+)
+
+// Forwarding copies a message into other conversations as a new message from
+// the forwarder. Media is copied by reference; the stored object is shared.
+// Copies are marked forwarded but don't say where they came from.
+
+const maxForwardTargets = 20
+
+// forwardableTypes are the message types that can be forwarded. Shared posts
+// must go through post-service again so the post's privacy is checked against
+// the new conversation, and story replies and system messages only make
+// sense where they were written.
+var forwardableTypes = map[string]bool{
+	messageTypeText:     true,
+	messageTypeMedia:    true,
+	messageTypeProfile:  true,
+	messageTypeLocation: true,
+}
+
+// --- GRPC: ForwardMessage ---
+func (s *server) ForwardMessage(ctx context.Context, req *pb.ForwardMessageRequest) (*pb.ForwardMessageResponse, error) {
+	msgID, _ := strconv.ParseUint(req.MessageId, 10, 64)
+	if msgID == 0 {
+		return nil, status.Error(codes.InvalidArgument, "Invalid message ID format")
+	}
+	if len(req.ConversationIds) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "conversation_ids is required")
+	}
+	if len(req.ConversationIds) > maxForwardTargets {
+		return nil, status.Errorf(codes.InvalidArgument, "Cannot forward to more than %d conversations at once", maxForwardTargets)
+	}
+
+	// 1. The original, which the user must be able to see
+	var original Message
+	if err := s.db.First(&original, msgID).Error; err == gorm.ErrRecordNotFound {
+		return nil, status.Error(codes.NotFound, "Message not found")
+	} else if err != nil {
+		log.Printf("Failed to get message %d: %v", msgID, err)
+		return nil, status.Error(codes.Internal, "Failed to forward message")
+	}
+	var participantCount int64
+	s.db.Model(&Participant{}).Where("conversation_id = ? AND user_id = ?", original.ConversationID, req.UserId).Count(&participantCount)
+	if participantCount == 0 {
+		return nil, status.Error(codes.PermissionDenied, "User is not a participant of this conversation")
+	}
+
+	msgType := original.Type
+	if msgType == "" {
+		msgType = messageTypeText
+	}
+	if msgType == messageTypeSharedPost {
+		return nil, status.Error(codes.InvalidArgument, "Share the post again to send it to another conversation")
+	}
+	if !forwardableTypes[msgType] {
+		return nil, status.Error(codes.InvalidArgument, "This message can't be forwarded")
+	}
+
+	// 2. Targets, in request order without repeats, all of which the user is in
+	targetIDs := make([]uint, 0, len(req.ConversationIds))
+	seen := make(map[uint]bool, len(req.ConversationIds))
+	for _, id := range req.ConversationIds {
+		convoID, _ := strconv.ParseUint(id, 10, 64)
+		if convoID == 0 {
+			return nil, status.Error(codes.InvalidArgument, "Invalid conversation ID format")
+		}
+		if !seen[uint(convoID)] {
+			seen[uint(convoID)] = true
+			targetIDs = append(targetIDs, uint(convoID))
+		}
+	}
+	var memberOf []uint
+	if err := s.db.Model(&Participant{}).Where("user_id = ? AND conversation_id IN ?", req.UserId, targetIDs).Pluck("conversation_id", &memberOf).Error; err != nil {
+		log.Printf("Failed to check forward targets for user %d: %v", req.UserId, err)
+		return nil, status.Error(codes.Internal, "Failed to forward message")
+	}
+	if len(memberOf) != len(targetIDs) {
+		return nil, status.Error(codes.PermissionDenied, "User is not a participant of every target conversation")
+	}
+
+	// 3. Save every copy and touch its conversation, all or nothing
+	copies := make([]Message, len(targetIDs))
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		for i, convoID := range targetIDs {
+			copies[i] = Message{
+				ConversationID:  convoID,
+				SenderID:        req.UserId,
+				Content:         original.Content,
+				MediaURL:        original.MediaURL,
+				MediaType:       original.MediaType,
+				Type:            msgType,
+				Payload:         original.Payload,
+				ForwardedFromID: original.ID,
+			}
+			if err := tx.Create(&copies[i]).Error; err != nil {
+				return err
+			}
+			if err := tx.Model(&Conversation{}).Where("id = ?", convoID).Update("updated_at", time.Now()).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		log.Printf("Failed to forward message %d: %v", msgID, err)
+		return nil, status.Error(codes.Internal, "Failed to forward message")
+	}
+
+	// 4. Each copy is a new message in its conversation
+	messages := make([]*pb.Message, 0, len(copies))
+	for i := range copies {
+		if _, err := s.advanceReadState(ctx, copies[i].ConversationID, req.UserId, copies[i].ID, copies[i].ID, false); err != nil {
+			log.Printf("Failed to advance read state of sender %d: %v", req.UserId, err)
+		}
+		grpcMessage, err := s.gormToGrpcMessage(ctx, &copies[i])
+		if err != nil {
+			log.Printf("Failed to convert message %d to gRPC: %v", copies[i].ID, err)
+			continue
+		}
+		s.publishMessage(ctx, grpcMessage)
+		messages = append(messages, grpcMessage)
+	}
+
+	return &pb.ForwardMessageResponse{Messages: messages}, nil
+}
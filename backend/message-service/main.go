@@ -11,6 +11,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/go-redis/redis/v8"
@@ -25,6 +26,8 @@ import (
 
 	// This service's generated proto
 	pb "github.com/hoshibmatchi/message-service/proto"
+	// Story service proto (for gRPC client)
+	storyPb "github.com/hoshibmatchi/story-service/proto"
 	// User service proto (for gRPC client)
 	userPb "github.com/hoshibmatchi/user-service/proto"
 )
@@ -45,6 +48,9 @@ type Participant struct {
 	ConversationID uint  `gorm:"primaryKey"`
 	UserID         int64 `gorm:"primaryKey"`
 	JoinedAt       time.Time
+	// Read receipts (see receipts.go): newest message delivered to / seen by this user
+	LastDeliveredMessageID uint `gorm:"default:0"`
+	LastReadMessageID      uint `gorm:"default:0"`
 }
 
 // Message is a single message within a conversation.
@@ -55,6 +61,19 @@ type Message struct {
 	Content        string // Text content (can be empty if media-only)
 	MediaURL       string // URL of image/gif/video (optional)
 	MediaType      string // "image", "gif", "video" (optional)
+	Type           string `gorm:"type:varchar(20);default:'text'"`
+	Payload        string `gorm:"type:text"` // JSON for structured types, e.g. the SharedPost preview
+	// Both 0 if unset
+	ReplyToMessageID uint `gorm:"default:0"` // The message this one replies to (see replies.go)
+	ForwardedFromID  uint `gorm:"default:0"` // The message this one was forwarded from (see forwarding.go)
+}
+
+// MessageReaction is one user's emoji on a message; a user has at most one per message.
+type MessageReaction struct {
+	MessageID uint   `gorm:"primaryKey"`
+	UserID    int64  `gorm:"primaryKey"`
+	Emoji     string `gorm:"type:varchar(32);not null"`
+	CreatedAt time.Time
 }
 
 type HiddenConversation struct {
@@ -73,17 +92,66 @@ var upgrader = websocket.Upgrader{
 	},
 }
 
-// Client is a WebSocket client
+// Client is a WebSocket client: one connection from one device
 type Client struct {
-	conn     *websocket.Conn
-	send     chan []byte
-	userID   int64
-	convoIDs map[string]bool // Set of conversation IDs this client is listening to
+	id     uint64 // Connection ID, unique within this instance
+	conn   *websocket.Conn
+	send   chan []byte
+	userID int64
+
+	mu       sync.Mutex // Guards everything below; readPump and the Redis listener both use it
+	closed   bool
+	convoIDs map[string]bool      // Set of conversation IDs this client is listening to
+	typing   map[string]time.Time // Conversation ID -> when our last typing_start went out
+	username string               // Looked up on first use, for typing events
+}
+
+// deliver queues a frame without blocking. It reports false if the client's
+// buffer is full or it has already gone.
+func (c *Client) deliver(frame []byte) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.closed {
+		return false
+	}
+	select {
+	case c.send <- frame:
+		return true
+	default:
+		return false
+	}
+}
+
+// closeSend closes the send channel once, which ends writePump
+func (c *Client) closeSend() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !c.closed {
+		c.closed = true
+		close(c.send)
+	}
+}
+
+func (c *Client) isSubscribed(convoID string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.convoIDs[convoID]
+}
+
+func (c *Client) isClosed() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.closed
 }
 
-// Hub maintains the set of active clients and broadcasts messages
+// Hub maintains the set of active clients and broadcasts messages. A user can
+// be connected from several tabs and devices at once, so clients are keyed by
+// connection and each user has a set of them.
 type Hub struct {
-	clients    sync.Map // Thread-safe map of [int64]*Client (userID -> Client)
+	mu         sync.RWMutex
+	nextID     uint64                       // Last connection ID handed out; atomic
+	clients    map[uint64]*Client           // Connection ID -> Client
+	byUser     map[int64]map[uint64]*Client // User ID -> that user's connections
 	register   chan *Client
 	unregister chan *Client
 }
@@ -91,6 +159,8 @@ type Hub struct {
 // newHub creates a new Hub
 func newHub() *Hub {
 	return &Hub{
+		clients:    make(map[uint64]*Client),
+		byUser:     make(map[int64]map[uint64]*Client),
 		register:   make(chan *Client),
 		unregister: make(chan *Client),
 	}
@@ -101,25 +171,75 @@ func (h *Hub) run() {
 	for {
 		select {
 		case client := <-h.register:
-			h.clients.Store(client.userID, client)
-			log.Printf("Client registered: %d", client.userID)
+			devices := h.add(client)
+			log.Printf("Client registered: user %d, connection %d (%d connected)", client.userID, client.id, devices)
 		case client := <-h.unregister:
-			if _, ok := h.clients.Load(client.userID); ok {
-				h.clients.Delete(client.userID)
-				close(client.send)
-				log.Printf("Client unregistered: %d", client.userID)
+			if h.remove(client) {
+				log.Printf("Client unregistered: user %d, connection %d", client.userID, client.id)
 			}
 		}
 	}
 }
 
+// newConnectionID hands out the ID for a new Client
+func (h *Hub) newConnectionID() uint64 {
+	return atomic.AddUint64(&h.nextID, 1)
+}
+
+// add registers a connection and returns how many its user now has
+func (h *Hub) add(client *Client) int {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.clients[client.id] = client
+	if h.byUser[client.userID] == nil {
+		h.byUser[client.userID] = make(map[uint64]*Client)
+	}
+	h.byUser[client.userID][client.id] = client
+	return len(h.byUser[client.userID])
+}
+
+// remove drops one connection and closes its send channel. It reports false
+// if the connection was already gone; the user's other connections stay.
+func (h *Hub) remove(client *Client) bool {
+	h.mu.Lock()
+	if _, ok := h.clients[client.id]; !ok {
+		h.mu.Unlock()
+		return false
+	}
+	delete(h.clients, client.id)
+	if conns := h.byUser[client.userID]; conns != nil {
+		delete(conns, client.id)
+		if len(conns) == 0 {
+			delete(h.byUser, client.userID)
+		}
+	}
+	h.mu.Unlock()
+
+	client.closeSend()
+	return true
+}
+
+// all returns every connected client
+func (h *Hub) all() []*Client {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	clients := make([]*Client, 0, len(h.clients))
+	for _, client := range h.clients {
+		clients = append(clients, client)
+	}
+	return clients
+}
+
 // server struct holds our database, cache, and client connections
 type server struct {
 	pb.UnimplementedMessageServiceServer
-	db         *gorm.DB                 // Postgres connection
-	rdb        *redis.Client            // Redis connection
-	userClient userPb.UserServiceClient // gRPC client for user-service
-	hub        *Hub                     // Hub for managing WebSocket clients
+	db          *gorm.DB                   // Postgres connection
+	rdb         *redis.Client              // Redis connection
+	userClient  userPb.UserServiceClient   // gRPC client for user-service
+	storyClient storyPb.StoryServiceClient // gRPC client for story-service (story replies)
+	hub         *Hub                       // Hub for managing WebSocket clients
+	instanceID  string                     // Tells this instance's presence tokens apart (see presence.go)
+	presenceMu  sync.Mutex                 // Orders heartbeats and disconnects (see presence.go)
 }
 
 func main() {
@@ -157,8 +277,17 @@ func main() {
 		log.Fatalf("Failed to connect to message-db after retries: %v", err)
 	}
 
+	// Participants got read receipt pointers; the first time the columns are added,
+	// count everything already in each conversation as delivered and read
+	backfillReceipts := !db.Migrator().HasColumn(&Participant{}, "last_read_message_id")
 	db.AutoMigrate(&Conversation{}, &Participant{}, &Message{})
+	if backfillReceipts {
+		if err := backfillReadState(db); err != nil {
+			log.Printf("Failed to backfill read receipts: %v", err)
+		}
+	}
 	db.AutoMigrate(&HiddenConversation{})
+	db.AutoMigrate(&MessageReaction{})
 
 	// --- Step 2: Connect to Redis ---
 	rdb := redis.NewClient(&redis.Options{
@@ -180,19 +309,31 @@ func main() {
 	userClient := userPb.NewUserServiceClient(userConn)
 	log.Println("Successfully connected to user-service")
 
+	// --- Step 3b: Connect to Story Service (gRPC Client) ---
+	storyConn, err := grpc.Dial("story-service:9002", grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatalf("Failed to connect to story-service: %v", err)
+	}
+	defer storyConn.Close()
+	storyClient := storyPb.NewStoryServiceClient(storyConn)
+	log.Println("Successfully connected to story-service")
+
 	// --- Step 4: Create Hub and Server Struct ---
 	hub := newHub()
 	go hub.run() // Start the hub's event loop in a goroutine
 
 	s := &server{
-		db:         db,
-		rdb:        rdb,
-		userClient: userClient,
-		hub:        hub,
+		db:          db,
+		rdb:         rdb,
+		userClient:  userClient,
+		storyClient: storyClient,
+		hub:         hub,
+		instanceID:  newInstanceID(),
 	}
 
 	// --- Step 5: Start Redis Pub/Sub Listener ---
 	go s.listenForRealtimeMessages() // Start in a goroutine
+	go s.runPresenceHeartbeat()
 
 	// --- Step 6: Start gRPC Server (in a goroutine) ---
 	lis, err := net.Listen("tcp", ":9003") // Port 9003 for gRPC
@@ -319,6 +460,7 @@ func (s *server) CreateConversation(ctx context.Context, req *pb.CreateConversat
 	}
 
 	log.Printf("Created new conversation (ID: %d)", newConversation.ID)
+	s.publishMembership(ctx, newConversation.ID, allParticipantIDs, true)
 
 	// --- Step 4: Convert to gRPC response and return ---
 	// This helper function will fetch participant user data
@@ -369,6 +511,7 @@ func (s *server) gormToGrpcConversation(ctx context.Context, convo *Conversation
 	} else {
 		// Success, convert the last message
 		lastMessage, _ = s.gormToGrpcMessage(ctx, &lastMessageGORM)
+		s.attachReplyPreviews(ctx, []*pb.Message{lastMessage})
 	}
 	// --- END FIX ---
 
@@ -408,6 +551,16 @@ func (s *server) SendMessage(ctx context.Context, req *pb.SendMessageRequest) (*
 		MediaURL:       req.MediaUrl,
 		MediaType:      req.MediaType,
 	}
+	if err := s.applyMessagePayload(ctx, req, &newMessage); err != nil {
+		return nil, err
+	}
+	if req.ReplyToMessageId != "" {
+		replyToID, err := s.replyTarget(uint(convoID), req.ReplyToMessageId)
+		if err != nil {
+			return nil, err
+		}
+		newMessage.ReplyToMessageID = replyToID
+	}
 
 	// We use a transaction to save the message AND update the conversation's timestamp
 	err := s.db.Transaction(func(tx *gorm.DB) error {
@@ -429,6 +582,11 @@ func (s *server) SendMessage(ctx context.Context, req *pb.SendMessageRequest) (*
 		return nil, status.Error(codes.Internal, "Failed to send message")
 	}
 
+	// The sender has obviously seen everything up to their own message
+	if _, err := s.advanceReadState(ctx, uint(convoID), req.SenderId, newMessage.ID, newMessage.ID, false); err != nil {
+		log.Printf("Failed to advance read state of sender %d: %v", req.SenderId, err)
+	}
+
 	// --- Step 3: Publish to Redis Pub/Sub for Real-Time (Solution 4.2) ---
 	// Convert to gRPC response first, as this is what we'll send
 	grpcMessage, err := s.gormToGrpcMessage(ctx, &newMessage)
@@ -436,19 +594,8 @@ func (s *server) SendMessage(ctx context.Context, req *pb.SendMessageRequest) (*
 		// Log the error, but don't fail the send. The message is saved.
 		log.Printf("Failed to convert message %d to gRPC: %v", newMessage.ID, err)
 	} else {
-		// Marshal the gRPC message to JSON
-		msgBody, err := json.Marshal(grpcMessage)
-		if err != nil {
-			log.Printf("Failed to marshal message %d for redis: %v", newMessage.ID, err)
-		} else {
-			// Publish to a dynamic channel for this specific conversation
-			channelName := fmt.Sprintf("chat:%s", req.ConversationId)
-			if err := s.rdb.Publish(ctx, channelName, msgBody).Err(); err != nil {
-				log.Printf("Failed to publish message to redis channel %s: %v", channelName, err)
-			} else {
-				log.Printf("Published message to redis channel %s", channelName)
-			}
-		}
+		s.attachReplyPreviews(ctx, []*pb.Message{grpcMessage})
+		s.publishMessage(ctx, grpcMessage)
 	}
 
 	// --- Step 4: Return the created message ---
@@ -463,6 +610,8 @@ func (s *server) SendMessage(ctx context.Context, req *pb.SendMessageRequest) (*
 			SenderUsername: "...", // Denormalization failed
 			MediaUrl:       newMessage.MediaURL,
 			MediaType:      newMessage.MediaType,
+			Type:           newMessage.Type,
+			SharedPost:     req.SharedPost,
 		}
 	}
 
@@ -471,6 +620,25 @@ func (s *server) SendMessage(ctx context.Context, req *pb.SendMessageRequest) (*
 	}, nil
 }
 
+// publishMessage pushes a saved message to the conversation's Redis channel
+func (s *server) publishMessage(ctx context.Context, grpcMessage *pb.Message) {
+	if grpcMessage == nil {
+		return
+	}
+	msgBody, err := json.Marshal(grpcMessage)
+	if err != nil {
+		log.Printf("Failed to marshal message %s for redis: %v", grpcMessage.Id, err)
+		return
+	}
+	// Publish to a dynamic channel for this specific conversation
+	channelName := fmt.Sprintf("chat:%s", grpcMessage.ConversationId)
+	if err := s.rdb.Publish(ctx, channelName, msgBody).Err(); err != nil {
+		log.Printf("Failed to publish message to redis channel %s: %v", channelName, err)
+	} else {
+		log.Printf("Published message to redis channel %s", channelName)
+	}
+}
+
 // gormToGrpcMessage converts a GORM Message to its gRPC representation
 func (s *server) gormToGrpcMessage(ctx context.Context, msg *Message) (*pb.Message, error) {
 	// 1. Get sender's user data
@@ -482,7 +650,7 @@ func (s *server) gormToGrpcMessage(ctx context.Context, msg *Message) (*pb.Messa
 	}
 
 	// 2. Assemble and return
-	return &pb.Message{
+	grpcMessage := &pb.Message{
 		Id:             strconv.FormatUint(uint64(msg.ID), 10),
 		ConversationId: strconv.FormatUint(uint64(msg.ConversationID), 10),
 		SenderId:       strconv.FormatInt(msg.SenderID, 10),
@@ -491,7 +659,21 @@ func (s *server) gormToGrpcMessage(ctx context.Context, msg *Message) (*pb.Messa
 		SenderUsername: userData.Username,
 		MediaUrl:       msg.MediaURL,
 		MediaType:      msg.MediaType,
-	}, nil
+		Type:           msg.Type,
+		Forwarded:      msg.ForwardedFromID != 0,
+	}
+	if grpcMessage.Type == "" {
+		grpcMessage.Type = messageTypeText
+	}
+
+	// 3. Structured types carry their payload
+	s.renderMessagePayload(ctx, msg, grpcMessage)
+
+	// 4. Replies name the message they answer; attachReplyPreviews quotes it
+	if msg.ReplyToMessageID != 0 {
+		grpcMessage.ReplyTo = &pb.ReplyPreview{MessageId: strconv.FormatUint(uint64(msg.ReplyToMessageID), 10)}
+	}
+	return grpcMessage, nil
 }
 
 func (s *server) GetMessages(ctx context.Context, req *pb.GetMessagesRequest) (*pb.GetMessagesResponse, error) {
@@ -533,10 +715,25 @@ func (s *server) GetMessages(ctx context.Context, req *pb.GetMessagesRequest) (*
 		}
 		grpcMessages = append(grpcMessages, grpcMsg)
 	}
+	s.attachReplyPreviews(ctx, grpcMessages)
+	s.attachReactions(grpcMessages)
 
 	// Note: The frontend will receive these in reverse-chronological order
 	// and should display them accordingly (e.g., prepending to a list).
 
+	// --- Step 4: Fetching the history delivers it; reading is marked separately ---
+	var newestID uint
+	for _, msg := range messages {
+		if msg.ID > newestID {
+			newestID = msg.ID
+		}
+	}
+	if newestID > 0 {
+		if _, err := s.advanceReadState(ctx, uint(convoID), req.UserId, newestID, 0, true); err != nil {
+			log.Printf("Failed to mark convo %d delivered for user %d: %v", convoID, req.UserId, err)
+		}
+	}
+
 	return &pb.GetMessagesResponse{
 		Messages: grpcMessages,
 	}, nil
@@ -587,6 +784,9 @@ func (s *server) SearchMessages(ctx context.Context, req *pb.SearchMessagesReque
 		grpcMessages = append(grpcMessages, grpcMsg)
 	}
 
+	s.attachReplyPreviews(ctx, grpcMessages)
+	s.attachReactions(grpcMessages)
+
 	log.Printf("Found %d messages matching query '%s' in conversation %s", len(grpcMessages), req.Query, req.ConversationId)
 
 	return &pb.SearchMessagesResponse{
@@ -594,9 +794,88 @@ func (s *server) SearchMessages(ctx context.Context, req *pb.SearchMessagesReque
 	}, nil
 }
 
+// --- GRPC: GetDirectMessageCounts (internal) ---
+// Counts messages exchanged in 1:1 conversations between the user and each peer.
+// post-service uses this as one of the affinity signals for feed ranking.
+func (s *server) GetDirectMessageCounts(ctx context.Context, req *pb.GetDirectMessageCountsRequest) (*pb.GetDirectMessageCountsResponse, error) {
+	if len(req.PeerIds) == 0 {
+		return &pb.GetDirectMessageCountsResponse{Counts: map[int64]int32{}}, nil
+	}
+	if len(req.PeerIds) > 500 {
+		return nil, status.Error(codes.InvalidArgument, "Too many peer IDs (max 500)")
+	}
+	sinceDays := req.SinceDays
+	if sinceDays <= 0 {
+		sinceDays = 30
+	}
+	since := time.Now().AddDate(0, 0, -int(sinceDays))
+
+	var rows []struct {
+		PeerID int64
+		Count  int32
+	}
+	err := s.db.Table("participants AS me").
+		Select("peer.user_id AS peer_id, COUNT(messages.id) AS count").
+		Joins("JOIN participants AS peer ON peer.conversation_id = me.conversation_id AND peer.user_id IN ?", req.PeerIds).
+		Joins("JOIN conversations ON conversations.id = me.conversation_id AND conversations.is_group = ? AND conversations.deleted_at IS NULL", false).
+		Joins("JOIN messages ON messages.conversation_id = me.conversation_id AND messages.deleted_at IS NULL AND messages.created_at > ?", since).
+		Where("me.user_id = ?", req.UserId).
+		Group("peer.user_id").
+		Scan(&rows).Error
+	if err != nil {
+		log.Printf("Failed to count direct messages for user %d: %v", req.UserId, err)
+		return nil, status.Error(codes.Internal, "Failed to count messages")
+	}
+
+	counts := make(map[int64]int32, len(rows))
+	for _, row := range rows {
+		counts[row.PeerID] = row.Count
+	}
+	return &pb.GetDirectMessageCountsResponse{Counts: counts}, nil
+}
+
+// --- GRPC: GetConversationParticipants (internal) ---
+func (s *server) GetConversationParticipants(ctx context.Context, req *pb.GetConversationParticipantsRequest) (*pb.GetConversationParticipantsResponse, error) {
+	convoID, _ := strconv.ParseUint(req.ConversationId, 10, 64)
+	if convoID == 0 {
+		return nil, status.Error(codes.InvalidArgument, "Invalid conversation ID format")
+	}
+
+	var convo Conversation
+	if err := s.db.First(&convo, convoID).Error; err != nil {
+		return nil, status.Error(codes.NotFound, "Conversation not found")
+	}
+
+	var participantIDs []int64
+	if err := s.db.Model(&Participant{}).Where("conversation_id = ?", convoID).Pluck("user_id", &participantIDs).Error; err != nil {
+		return nil, status.Error(codes.Internal, "Failed to get participant IDs")
+	}
+
+	others := make([]int64, 0, len(participantIDs))
+	isParticipant := false
+	for _, id := range participantIDs {
+		if id == req.UserId {
+			isParticipant = true
+		} else {
+			others = append(others, id)
+		}
+	}
+	if !isParticipant {
+		return nil, status.Error(codes.PermissionDenied, "User is not a participant of this conversation")
+	}
+
+	return &pb.GetConversationParticipantsResponse{ParticipantIds: others, IsGroup: convo.IsGroup}, nil
+}
+
 func (s *server) GetConversations(ctx context.Context, req *pb.GetConversationsRequest) (*pb.GetConversationsResponse, error) {
 	log.Printf("GetConversations request received for user %d", req.UserId)
 
+	cursor, err := decodeCursor(req.Cursor)
+	if err != nil {
+		return nil, err
+	}
+	pageSize := normalizePageSize(req.PageSize)
+
 	// Find all Conversation IDs the user is a part of
 	var conversationIDs []uint
 	if err := s.db.Model(&Participant{}).
@@ -610,19 +889,39 @@ func (s *server) GetConversations(ctx context.Context, req *pb.GetConversationsR
 		return &pb.GetConversationsResponse{Conversations: []*pb.Conversation{}}, nil
 	}
 
-	// Fetch those conversations, sorted by most recent activity
+	// Fetch those conversations, sorted by most recent activity.
 	var conversations []Conversation
-	query := s.db.Where("id IN ?", conversationIDs).
-		Order("updated_at DESC").
-		Limit(int(req.PageSize)).
-		Offset(int(req.PageOffset))
+	query := s.db.Where("id IN ?", conversationIDs)
+	if cursor != nil {
+		query = query.Where("updated_at < ? OR (updated_at = ? AND id < ?)", cursor.UpdatedAt, cursor.UpdatedAt, cursor.ID)
+	}
 
-	if err := query.Find(&conversations).Error; err != nil {
+	if err := query.Order("updated_at DESC, id DESC").Limit(pageSize + 1).Find(&conversations).Error; err != nil {
 		log.Printf("Failed to get conversations for user %d: %v", req.UserId, err)
 		return nil, status.Error(codes.Internal, "Failed to retrieve conversations")
 	}
 
+	nextCursor := ""
+	if len(conversations) > pageSize {
+		conversations = conversations[:pageSize]
+		last := conversations[len(conversations)-1]
+		nextCursor = encodeCursor(conversationCursor{UpdatedAt: last.UpdatedAt, ID: last.ID})
+	}
+
 	// --- Step 4: Convert GORM models to gRPC responses ---
+	pageIDs := make([]uint, 0, len(conversations))
+	for _, convo := range conversations {
+		pageIDs = append(pageIDs, convo.ID)
+	}
+	unread, err := s.unreadCounts(pageIDs, req.UserId)
+	if err != nil {
+		log.Printf("Failed to count unread messages for user %d: %v", req.UserId, err)
+	}
+	readStates, err := s.otherReadStates(pageIDs, req.UserId)
+	if err != nil {
+		log.Printf("Failed to get read states for user %d: %v", req.UserId, err)
+	}
+
 	var grpcConversations []*pb.Conversation
 	for _, convo := range conversations {
 		grpcConvo, err := s.gormToGrpcConversation(ctx, &convo)
@@ -630,11 +929,14 @@ func (s *server) GetConversations(ctx context.Context, req *pb.GetConversationsR
 			log.Printf("Failed to convert conversation %d: %v", convo.ID, err)
 			continue
 		}
+		grpcConvo.UnreadCount = unread[convo.ID]
+		grpcConvo.ReadStates = readStates[convo.ID]
 		grpcConversations = append(grpcConversations, grpcConvo)
 	}
 
 	return &pb.GetConversationsResponse{
 		Conversations: grpcConversations,
+		NextCursor:    nextCursor,
 	}, nil
 }
 
@@ -669,25 +971,35 @@ func (s *server) handleWebSocket(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	client := &Client{
+		id:       s.hub.newConnectionID(),
+		conn:     conn,
+		send:     make(chan []byte, 256),
+		userID:   userID,
+		convoIDs: make(map[string]bool),
+		typing:   make(map[string]time.Time),
+	}
+	// Register before loading conversations so a join published in between isn't missed
+	s.hub.register <- client
+
 	// Get all conversation IDs for this user
 	convoIDs, err := s.getConversationIDsForUser(userID)
 	if err != nil {
 		log.Printf("Failed to get convo IDs for user %d: %v", userID, err)
+		s.hub.unregister <- client
 		conn.Close()
 		return
 	}
-
-	client := &Client{
-		conn:     conn,
-		send:     make(chan []byte, 256),
-		userID:   userID,
-		convoIDs: convoIDs,
+	client.mu.Lock()
+	for convoID := range convoIDs {
+		client.convoIDs[convoID] = true
 	}
-	s.hub.register <- client
+	client.mu.Unlock()
+	s.connectPresence(context.Background(), client)
 
 	// Start goroutines to handle reading and writing for this client
 	go client.writePump()
-	go client.readPump(s.hub)
+	go client.readPump(s)
 }
 
 // validateJWTToken validates the JWT token and returns the user ID
@@ -739,68 +1051,78 @@ func (s *server) getConversationIDsForUser(userID int64) (map[string]bool, error
 // listenForRealtimeMessages is the Redis subscriber (Solution 4.2)
 func (s *server) listenForRealtimeMessages() {
 	log.Println("Redis Pub/Sub listener started...")
-	// Subscribe to all chat channels
+	// Subscribe to all chat channels, plus membership changes
 	pubsub := s.rdb.PSubscribe(context.Background(), "chat:*")
 	defer pubsub.Close()
+	if err := pubsub.Subscribe(context.Background(), membershipChannel); err != nil {
+		log.Printf("Failed to subscribe to %s: %v", membershipChannel, err)
+	}
 
 	ch := pubsub.Channel()
 
 	for msg := range ch {
 		log.Printf("Received message from Redis channel %s", msg.Channel)
 
+		if msg.Channel == membershipChannel {
+			s.applyMembership(msg.Payload)
+			continue
+		}
+
 		// We don't need to parse msg.Channel, we just need the payload
-		// The payload is the JSON of the pb.Message we sent from SendMessage
-		var grpcMessage pb.Message
-		if err := json.Unmarshal([]byte(msg.Payload), &grpcMessage); err != nil {
+		// The payload is a pb.Message from SendMessage or an event (see protocol.go)
+		var header realtimeHeader
+		if err := json.Unmarshal([]byte(msg.Payload), &header); err != nil {
 			log.Printf("Failed to unmarshal message from redis: %v", err)
 			continue
 		}
 
-		convoID := grpcMessage.ConversationId
+		convoID := header.ConversationID
 
-		// Find all clients who are part of this conversation
-		s.hub.clients.Range(func(key, value interface{}) bool {
-			client, ok := value.(*Client)
-			if !ok {
-				return true // continue
+		// Find every connection, on every device, that is part of this conversation
+		for _, client := range s.hub.all() {
+			// Nobody needs their own typing or presence events
+			if (header.Type == eventTyping || header.Type == eventPresence) && header.UserID == client.userID {
+				continue
 			}
 
 			// If the client is subscribed to this conversation
-			if _, subscribed := client.convoIDs[convoID]; subscribed {
+			if client.isSubscribed(convoID) {
 				// Send the message
-				select {
-				case client.send <- []byte(msg.Payload):
-				default:
+				if !client.deliver([]byte(msg.Payload)) {
 					// Failed to send, client buffer is full
-					log.Printf("Failed to send to client %d, closing", client.userID)
-					close(client.send)
-					s.hub.clients.Delete(client.userID)
+					log.Printf("Failed to send to user %d on connection %d, closing", client.userID, client.id)
+					s.hub.remove(client)
 				}
 			}
-			return true
-		})
+		}
 	}
 }
 
 // --- WebSocket Client Helper Methods ---
 
-// readPump pumps messages from the WebSocket connection to the hub.
-func (c *Client) readPump(hub *Hub) {
+// readPump pumps frames from the WebSocket connection to handleClientFrame.
+func (c *Client) readPump(s *server) {
 	defer func() {
-		hub.unregister <- c
+		s.stopTyping(context.Background(), c)
+		// Removed right here rather than through the hub's loop, so the
+		// connection is closed before its presence token is dropped
+		if s.hub.remove(c) {
+			log.Printf("Client unregistered: user %d, connection %d", c.userID, c.id)
+		}
+		s.disconnectPresence(context.Background(), c)
 		c.conn.Close()
 	}()
-	// Set read limits, etc. (omitted for brevity)
+	c.conn.SetReadLimit(maxFrameSize)
 	for {
-		// Read message from client (e.g., "ping", "user is typing")
-		_, _, err := c.conn.ReadMessage()
+		// Read a frame from the client (see protocol.go)
+		_, data, err := c.conn.ReadMessage()
 		if err != nil {
 			if websocket.IsUnexpectedCloseError(err, websocket.CloseGoingAway, websocket.CloseAbnormalClosure) {
 				log.Printf("WebSocket read error: %v", err)
 			}
 			break
 		}
-		// We don't process client messages for now, just keep connection alive
+		s.handleClientFrame(c, data)
 	}
 }
 
@@ -1017,15 +1339,13 @@ func (s *server) AddParticipant(ctx context.Context, req *pb.AddParticipantReque
 		log.Printf("Failed to add participant: %v", err)
 		return nil, status.Error(codes.Internal, "Failed to add participant")
 	}
+	s.publishMembership(ctx, uint(convoID), []int64{req.ParticipantId}, true)
 
 	// Create system message
-	systemMessage := fmt.Sprintf("User %d added user %d to the conversation", req.UserId, req.ParticipantId)
-	msg := Message{
-		ConversationID: uint(convoID),
-		SenderID:       req.UserId,
-		Content:        systemMessage,
-	}
-	s.db.Create(&msg)
+	systemMessage := s.createSystemMessage(ctx, uint(convoID), req.UserId, &pb.SystemEvent{
+		Event:    eventParticipantAdded,
+		TargetId: req.ParticipantId,
+	}).GetContent()
 
 	// Notify via Redis Pub/Sub
 	notification := map[string]interface{}{
@@ -1080,13 +1400,10 @@ func (s *server) RemoveParticipant(ctx context.Context, req *pb.RemoveParticipan
 	}
 
 	// Create system message
-	systemMessage := fmt.Sprintf("User %d removed user %d from the group", req.UserId, req.ParticipantId)
-	msg := Message{
-		ConversationID: uint(convoID),
-		SenderID:       req.UserId,
-		Content:        systemMessage,
-	}
-	s.db.Create(&msg)
+	systemMessage := s.createSystemMessage(ctx, uint(convoID), req.UserId, &pb.SystemEvent{
+		Event:    eventParticipantRemoved,
+		TargetId: req.ParticipantId,
+	}).GetContent()
 
 	// Notify via Redis Pub/Sub
 	notification := map[string]interface{}{
@@ -1101,6 +1418,7 @@ func (s *server) RemoveParticipant(ctx context.Context, req *pb.RemoveParticipan
 	if err := s.rdb.Publish(ctx, channelName, msgBody).Err(); err != nil {
 		log.Printf("Failed to publish participant_removed event: %v", err)
 	}
+	s.publishMembership(ctx, uint(convoID), []int64{req.ParticipantId}, false)
 
 	return &pb.RemoveParticipantResponse{Message: "Participant removed successfully"}, nil
 }
@@ -1148,14 +1466,19 @@ func (s *server) UpdateGroupInfo(ctx context.Context, req *pb.UpdateGroupInfoReq
 		return nil, status.Error(codes.Internal, "Failed to update group info")
 	}
 
-	// Create system message
-	systemMessage := fmt.Sprintf("User %d updated the group info", req.UserId)
-	msg := Message{
-		ConversationID: uint(convoID),
-		SenderID:       req.UserId,
-		Content:        systemMessage,
+	// Create system messages, one per change
+	var systemMessage string
+	if req.GroupName != "" {
+		systemMessage = s.createSystemMessage(ctx, uint(convoID), req.UserId, &pb.SystemEvent{
+			Event:     eventGroupRenamed,
+			GroupName: req.GroupName,
+		}).GetContent()
+	}
+	if req.GroupImageUrl != "" {
+		systemMessage = s.createSystemMessage(ctx, uint(convoID), req.UserId, &pb.SystemEvent{
+			Event: eventGroupPhotoChanged,
+		}).GetContent()
 	}
-	s.db.Create(&msg)
 
 	// Notify via Redis Pub/Sub
 	notification := map[string]interface{}{
@@ -1204,13 +1527,9 @@ func (s *server) LeaveGroup(ctx context.Context, req *pb.LeaveGroupRequest) (*pb
 	}
 
 	// Create system message
-	systemMessage := fmt.Sprintf("User %d left the group", req.UserId)
-	msg := Message{
-		ConversationID: uint(convoID),
-		SenderID:       req.UserId,
-		Content:        systemMessage,
-	}
-	s.db.Create(&msg)
+	systemMessage := s.createSystemMessage(ctx, uint(convoID), req.UserId, &pb.SystemEvent{
+		Event: eventParticipantLeft,
+	}).GetContent()
 
 	// Notify via Redis Pub/Sub
 	notification := map[string]interface{}{
@@ -1224,6 +1543,7 @@ func (s *server) LeaveGroup(ctx context.Context, req *pb.LeaveGroupRequest) (*pb
 	if err := s.rdb.Publish(ctx, channelName, msgBody).Err(); err != nil {
 		log.Printf("Failed to publish participant_left event: %v", err)
 	}
+	s.publishMembership(ctx, uint(convoID), []int64{req.UserId}, false)
 
 	// Check if group is empty - optionally delete it
 	var remainingCount int64
@@ -0,0 +1,450 @@
+package main
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/go-redis/redis/v8"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	pb "github.com/hoshibmatchi/message-service/proto"
+	userPb "github.com/hoshibmatchi/user-service/proto"
+)
+
+// setupTestDB creates an in-memory SQLite database for testing
+func setupTestDB() (*gorm.DB, error) {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		return nil, err
+	}
+
+	// Run migrations
+	db.AutoMigrate(&Conversation{}, &Participant{}, &Message{})
+	db.AutoMigrate(&HiddenConversation{})
+	db.AutoMigrate(&MessageReaction{})
+
+	return db, nil
+}
+
+// unreachableRedis stands in for Redis; publishing to it fails and is only logged
+func unreachableRedis() *redis.Client {
+	return redis.NewClient(&redis.Options{Addr: "127.0.0.1:1", MaxRetries: -1})
+}
+
+// fakeUserClient stubs the user-service calls message-service makes.
+type fakeUserClient struct {
+	userPb.UserServiceClient
+	blocked      map[int64]bool // Users blocked in either direction with the viewer
+	statusHidden map[int64]bool // Users who hide their activity status
+
+	userDataCalls    int
+	userSummaryCalls int
+}
+
+func (f *fakeUserClient) GetUserData(ctx context.Context, in *userPb.GetUserDataRequest, opts ...grpc.CallOption) (*userPb.GetUserDataResponse, error) {
+	f.userDataCalls++
+	return &userPb.GetUserDataResponse{
+		Username:             "user" + strconv.FormatInt(in.UserId, 10),
+		ActivityStatusHidden: f.statusHidden[in.UserId],
+	}, nil
+}
+
+func (f *fakeUserClient) GetUserSummaries(ctx context.Context, in *userPb.GetUserSummariesRequest, opts ...grpc.CallOption) (*userPb.GetUserSummariesResponse, error) {
+	f.userSummaryCalls++
+	res := &userPb.GetUserSummariesResponse{}
+	for _, id := range in.UserIds {
+		if f.blocked[id] {
+			continue
+		}
+		res.Users = append(res.Users, &userPb.UserSummary{
+			User:                 &userPb.UserInfo{UserId: id, Username: "user" + strconv.FormatInt(id, 10)},
+			ActivityStatusHidden: f.statusHidden[id],
+		})
+	}
+	return res, nil
+}
+
+// addConversation creates a conversation with the given participants
+func addConversation(t *testing.T, db *gorm.DB, userIDs ...int64) Conversation {
+	t.Helper()
+	convo := Conversation{IsGroup: len(userIDs) > 2}
+	if err := db.Create(&convo).Error; err != nil {
+		t.Fatalf("Failed to create conversation: %v", err)
+	}
+	for _, userID := range userIDs {
+		db.Create(&Participant{ConversationID: convo.ID, UserID: userID})
+	}
+	return convo
+}
+
+func TestPresenceVisibleTo(t *testing.T) {
+	db, err := setupTestDB()
+	if err != nil {
+		t.Fatalf("Failed to setup test database: %v", err)
+	}
+	users := &fakeUserClient{blocked: map[int64]bool{4: true}, statusHidden: map[int64]bool{3: true}}
+	s := &server{db: db, userClient: users}
+	ctx := context.Background()
+
+	// The viewer (1) talks to 2, 3 and 4; 5 is only in a conversation without them
+	addConversation(t, db, 1, 2)
+	addConversation(t, db, 1, 3, 4)
+	addConversation(t, db, 5, 6)
+
+	visible, err := s.presenceVisibleTo(ctx, 1, []int64{2, 3, 4, 5, 7})
+	if err != nil {
+		t.Fatalf("presenceVisibleTo failed: %v", err)
+	}
+	if len(visible) != 1 || !visible[2] {
+		t.Errorf("Expected only user 2 to be visible, got %v", visible)
+	}
+
+	// Hiding your own status hides everyone else's
+	users.statusHidden[1] = true
+	visible, err = s.presenceVisibleTo(ctx, 1, []int64{2})
+	if err != nil {
+		t.Fatalf("presenceVisibleTo failed: %v", err)
+	}
+	if len(visible) != 0 {
+		t.Errorf("Expected nothing visible to a viewer hiding their status, got %v", visible)
+	}
+}
+
+func TestAttachReplyPreviews(t *testing.T) {
+	db, err := setupTestDB()
+	if err != nil {
+		t.Fatalf("Failed to setup test database: %v", err)
+	}
+	users := &fakeUserClient{}
+	s := &server{db: db, userClient: users}
+	ctx := context.Background()
+
+	convo := addConversation(t, db, 1, 2)
+	text := Message{ConversationID: convo.ID, SenderID: 1, Content: "see you at eight", Type: messageTypeText}
+	photo := Message{ConversationID: convo.ID, SenderID: 2, Type: messageTypeMedia, MediaType: "image"}
+	unsent := Message{ConversationID: convo.ID, SenderID: 2, Content: "oops", Type: messageTypeText}
+	for _, msg := range []*Message{&text, &photo, &unsent} {
+		db.Create(msg)
+	}
+	db.Delete(&unsent)
+
+	var page []*pb.Message
+	for _, original := range []Message{text, photo, unsent, text} {
+		reply := Message{ConversationID: convo.ID, SenderID: 1, Content: "ok", ReplyToMessageID: original.ID}
+		db.Create(&reply)
+		grpcMsg, _ := s.gormToGrpcMessage(ctx, &reply)
+		page = append(page, grpcMsg)
+	}
+	plain, _ := s.gormToGrpcMessage(ctx, &text)
+	page = append(page, plain)
+
+	users.userDataCalls = 0
+	s.attachReplyPreviews(ctx, page)
+
+	if users.userSummaryCalls != 1 || users.userDataCalls != 0 {
+		t.Errorf("Expected one batched username lookup, got %d summary and %d user data calls", users.userSummaryCalls, users.userDataCalls)
+	}
+	if got := page[0].ReplyTo; got.Snippet != "see you at eight" || got.SenderUsername != "user1" || got.Unsent {
+		t.Errorf("Unexpected preview of a text message: %+v", got)
+	}
+	if got := page[1].ReplyTo; got.Snippet != "Photo" || got.SenderUsername != "user2" || got.Type != messageTypeMedia {
+		t.Errorf("Unexpected preview of a photo: %+v", got)
+	}
+	if got := page[2].ReplyTo; !got.Unsent || got.Snippet != "" {
+		t.Errorf("Expected the unsent original to show as unsent, got %+v", got)
+	}
+	if got := page[3].ReplyTo; got.Snippet != "see you at eight" {
+		t.Errorf("Expected a repeated original to be quoted too, got %+v", got)
+	}
+	if page[4].ReplyTo != nil {
+		t.Errorf("Expected no preview on a message that isn't a reply, got %+v", page[4].ReplyTo)
+	}
+}
+
+func TestValidReaction(t *testing.T) {
+	tests := []struct {
+		emoji string
+		want  bool
+	}{
+		{"👍", true},
+		{"❤️", true},
+		{"👍🏽", true},
+		{"👨‍👩‍👧", true},
+		{"", false},
+		{"a", false},
+		{"ok", false},
+		{"👍 ", false},
+		{"1", false},
+		{"日", false},
+		{"👍👍👍👍👍👍👍👍👍", false},
+		{"\xff", false},
+	}
+	for _, tt := range tests {
+		if got := validReaction(tt.emoji); got != tt.want {
+			t.Errorf("validReaction(%q) = %v, want %v", tt.emoji, got, tt.want)
+		}
+	}
+}
+
+func TestReplySnippet(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  Message
+		want string
+	}{
+		{"text", Message{Type: messageTypeText, Content: "hello"}, "hello"},
+		{"media with caption", Message{Type: messageTypeMedia, MediaType: "video", Content: "look"}, "look"},
+		{"photo", Message{Type: messageTypeMedia, MediaType: "image"}, "Photo"},
+		{"video", Message{Type: messageTypeMedia, MediaType: "video"}, "Video"},
+		{"gif", Message{Type: messageTypeMedia, MediaType: "gif"}, "GIF"},
+		{"shared post", Message{Type: messageTypeSharedPost}, "Post"},
+		{"profile", Message{Type: messageTypeProfile}, "Profile"},
+		{"location", Message{Type: messageTypeLocation}, "Location"},
+		{"empty text", Message{Type: messageTypeText}, ""},
+	}
+	for _, tt := range tests {
+		if got := replySnippet(&tt.msg); got != tt.want {
+			t.Errorf("%s: replySnippet() = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestTruncateRunes(t *testing.T) {
+	tests := []struct {
+		s    string
+		n    int
+		want string
+	}{
+		{"hello", 5, "hello"},
+		{"hello", 10, "hello"},
+		{"hello world", 5, "hell…"},
+		{"こんにちは世界", 4, "こんに…"},
+		{"", 3, ""},
+	}
+	for _, tt := range tests {
+		if got := truncateRunes(tt.s, tt.n); got != tt.want {
+			t.Errorf("truncateRunes(%q, %d) = %q, want %q", tt.s, tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestForwardMessage(t *testing.T) {
+	db, err := setupTestDB()
+	if err != nil {
+		t.Fatalf("Failed to setup test database: %v", err)
+	}
+	s := &server{db: db, userClient: &fakeUserClient{}, rdb: unreachableRedis()}
+	ctx := context.Background()
+
+	source := addConversation(t, db, 1, 2)
+	mine := addConversation(t, db, 1, 3)
+	group := addConversation(t, db, 1, 3, 4)
+	notMine := addConversation(t, db, 2, 3)
+
+	text := Message{ConversationID: source.ID, SenderID: 2, Content: "meet at the station", Type: messageTypeText}
+	post := Message{ConversationID: source.ID, SenderID: 2, Type: messageTypeSharedPost, Payload: "{}"}
+	storyReply := Message{ConversationID: source.ID, SenderID: 2, Content: "nice", Type: messageTypeStoryReply}
+	elsewhere := Message{ConversationID: notMine.ID, SenderID: 2, Content: "secret", Type: messageTypeText}
+	for _, msg := range []*Message{&text, &post, &storyReply, &elsewhere} {
+		db.Create(msg)
+	}
+	id := func(n uint) string { return strconv.FormatUint(uint64(n), 10) }
+
+	tests := []struct {
+		name    string
+		userID  int64
+		message Message
+		targets []uint
+		code    codes.Code
+	}{
+		{"original in someone else's conversation", 1, elsewhere, []uint{mine.ID}, codes.PermissionDenied},
+		{"target the user isn't in", 1, text, []uint{mine.ID, notMine.ID}, codes.PermissionDenied},
+		{"shared post", 1, post, []uint{mine.ID}, codes.InvalidArgument},
+		{"story reply", 1, storyReply, []uint{mine.ID}, codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		req := &pb.ForwardMessageRequest{UserId: tt.userID, MessageId: id(tt.message.ID)}
+		for _, target := range tt.targets {
+			req.ConversationIds = append(req.ConversationIds, id(target))
+		}
+		if _, err := s.ForwardMessage(ctx, req); status.Code(err) != tt.code {
+			t.Errorf("%s: expected %v, got %v", tt.name, tt.code, err)
+		}
+	}
+	var count int64
+	db.Model(&Message{}).Where("forwarded_from_id <> 0").Count(&count)
+	if count != 0 {
+		t.Fatalf("Expected rejected forwards to save nothing, got %d copies", count)
+	}
+
+	// A repeated target gets one copy
+	res, err := s.ForwardMessage(ctx, &pb.ForwardMessageRequest{
+		UserId:          1,
+		MessageId:       id(text.ID),
+		ConversationIds: []string{id(group.ID), id(mine.ID), id(group.ID)},
+	})
+	if err != nil {
+		t.Fatalf("ForwardMessage failed: %v", err)
+	}
+	if len(res.Messages) != 2 || res.Messages[0].ConversationId != id(group.ID) || res.Messages[1].ConversationId != id(mine.ID) {
+		t.Fatalf("Expected one copy per target in request order, got %v", res.Messages)
+	}
+	for _, msg := range res.Messages {
+		if !msg.Forwarded || msg.SenderId != "1" || msg.Content != text.Content {
+			t.Errorf("Expected a forwarded copy from the forwarder, got %+v", msg)
+		}
+	}
+}
+
+func TestReactToMessage(t *testing.T) {
+	db, err := setupTestDB()
+	if err != nil {
+		t.Fatalf("Failed to setup test database: %v", err)
+	}
+	s := &server{db: db, userClient: &fakeUserClient{}, rdb: unreachableRedis()}
+	ctx := context.Background()
+
+	convo := addConversation(t, db, 1, 2)
+	msg := Message{ConversationID: convo.ID, SenderID: 2, Content: "hi", Type: messageTypeText}
+	db.Create(&msg)
+	msgID := strconv.FormatUint(uint64(msg.ID), 10)
+
+	if _, err := s.ReactToMessage(ctx, &pb.ReactToMessageRequest{UserId: 1, MessageId: msgID, Emoji: "👍"}); err != nil {
+		t.Fatalf("ReactToMessage failed: %v", err)
+	}
+	if _, err := s.ReactToMessage(ctx, &pb.ReactToMessageRequest{UserId: 2, MessageId: msgID, Emoji: "👍"}); err != nil {
+		t.Fatalf("ReactToMessage failed: %v", err)
+	}
+
+	// Reacting again replaces the user's reaction
+	res, err := s.ReactToMessage(ctx, &pb.ReactToMessageRequest{UserId: 1, MessageId: msgID, Emoji: "😂"})
+	if err != nil {
+		t.Fatalf("ReactToMessage failed: %v", err)
+	}
+	var count int64
+	db.Model(&MessageReaction{}).Where("message_id = ? AND user_id = ?", msg.ID, 1).Count(&count)
+	if count != 1 {
+		t.Errorf("Expected one reaction per user, got %d", count)
+	}
+	if len(res.Reactions) != 2 {
+		t.Fatalf("Expected two emoji, got %v", res.Reactions)
+	}
+	for _, summary := range res.Reactions {
+		if summary.Count != 1 {
+			t.Errorf("Expected each emoji once, got %s x%d", summary.Emoji, summary.Count)
+		}
+	}
+
+	if _, err := s.ReactToMessage(ctx, &pb.ReactToMessageRequest{UserId: 3, MessageId: msgID, Emoji: "👍"}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("Expected PermissionDenied for a non-participant, got %v", err)
+	}
+	if _, err := s.ReactToMessage(ctx, &pb.ReactToMessageRequest{UserId: 1, MessageId: msgID, Emoji: "lol"}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected InvalidArgument for text, got %v", err)
+	}
+}
+
+func TestAdvanceReadState(t *testing.T) {
+	db, err := setupTestDB()
+	if err != nil {
+		t.Fatalf("Failed to setup test database: %v", err)
+	}
+	s := &server{db: db, rdb: unreachableRedis()}
+	ctx := context.Background()
+
+	convo := addConversation(t, db, 1, 2)
+	var ids []uint
+	for i := 0; i < 4; i++ {
+		msg := Message{ConversationID: convo.ID, SenderID: 2, Content: "hi", Type: messageTypeText}
+		db.Create(&msg)
+		ids = append(ids, msg.ID)
+	}
+	unread := func() int32 {
+		t.Helper()
+		counts, err := s.unreadCounts([]uint{convo.ID}, 1)
+		if err != nil {
+			t.Fatalf("unreadCounts failed: %v", err)
+		}
+		return counts[convo.ID]
+	}
+	if got := unread(); got != 4 {
+		t.Fatalf("Expected 4 unread, got %d", got)
+	}
+
+	// Reading implies delivery
+	participant, err := s.advanceReadState(ctx, convo.ID, 1, 0, ids[2], true)
+	if err != nil {
+		t.Fatalf("advanceReadState failed: %v", err)
+	}
+	if participant.LastReadMessageID != ids[2] || participant.LastDeliveredMessageID != ids[2] {
+		t.Errorf("Expected both pointers at %d, got %+v", ids[2], participant)
+	}
+	if got := unread(); got != 1 {
+		t.Errorf("Expected 1 unread, got %d", got)
+	}
+
+	// An older read doesn't rewind, but delivery can still move ahead alone
+	participant, err = s.advanceReadState(ctx, convo.ID, 1, ids[3], ids[0], false)
+	if err != nil {
+		t.Fatalf("advanceReadState failed: %v", err)
+	}
+	if participant.LastReadMessageID != ids[2] || participant.LastDeliveredMessageID != ids[3] {
+		t.Errorf("Expected read at %d and delivered at %d, got %+v", ids[2], ids[3], participant)
+	}
+	if got := unread(); got != 1 {
+		t.Errorf("Expected 1 unread after a stale read, got %d", got)
+	}
+
+	// The user's own messages never count as unread
+	own := Message{ConversationID: convo.ID, SenderID: 1, Content: "hey", Type: messageTypeText}
+	db.Create(&own)
+	if got := unread(); got != 1 {
+		t.Errorf("Expected own messages not to count, got %d unread", got)
+	}
+}
+
+func TestBackfillReadState(t *testing.T) {
+	db, err := setupTestDB()
+	if err != nil {
+		t.Fatalf("Failed to setup test database: %v", err)
+	}
+	s := &server{db: db}
+
+	busy := addConversation(t, db, 1, 2)
+	empty := addConversation(t, db, 1, 3)
+	var newest uint
+	for i := 0; i < 3; i++ {
+		msg := Message{ConversationID: busy.ID, SenderID: 2, Content: "hi", Type: messageTypeText}
+		db.Create(&msg)
+		newest = msg.ID
+	}
+
+	if err := backfillReadState(db); err != nil {
+		t.Fatalf("backfillReadState failed: %v", err)
+	}
+
+	var participants []Participant
+	db.Where("conversation_id = ?", busy.ID).Find(&participants)
+	for _, participant := range participants {
+		if participant.LastReadMessageID != newest || participant.LastDeliveredMessageID != newest {
+			t.Errorf("Expected user %d caught up to %d, got %+v", participant.UserID, newest, participant)
+		}
+	}
+	var untouched Participant
+	db.Where("conversation_id = ? AND user_id = ?", empty.ID, 1).First(&untouched)
+	if untouched.LastReadMessageID != 0 || untouched.LastDeliveredMessageID != 0 {
+		t.Errorf("Expected an empty conversation to stay at 0, got %+v", untouched)
+	}
+
+	counts, err := s.unreadCounts([]uint{busy.ID, empty.ID}, 1)
+	if err != nil {
+		t.Fatalf("unreadCounts failed: %v", err)
+	}
+	if len(counts) != 0 {
+		t.Errorf("Expected nothing unread after the backfill, got %v", counts)
+	}
+}
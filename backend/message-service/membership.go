@@ -0,0 +1,82 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"strconv"
+)
+
+// A connection subscribes to its user's conversations when it opens. Joins and
+// leaves after that are published here so every instance can update its live
+// connections; the channel is outside chat:* and never reaches clients as is.
+const membershipChannel = "chat-membership"
+
+// Frames telling a user's connections about the change (see protocol.go)
+const (
+	frameConversationJoined = "conversation_joined"
+	frameConversationLeft   = "conversation_left"
+)
+
+type membershipEvent struct {
+	ConversationID string  `json:"conversation_id"`
+	UserIDs        []int64 `json:"user_ids"`
+	Joined         bool    `json:"joined"`
+}
+
+// publishMembership announces that users joined or left a conversation. Publish
+// joins before anything the new members should see, and leaves after anything
+// the departing ones should.
+func (s *server) publishMembership(ctx context.Context, convoID uint, userIDs []int64, joined bool) {
+	body, _ := json.Marshal(membershipEvent{
+		ConversationID: strconv.FormatUint(uint64(convoID), 10),
+		UserIDs:        userIDs,
+		Joined:         joined,
+	})
+	if err := s.rdb.Publish(ctx, membershipChannel, body).Err(); err != nil {
+		log.Printf("Failed to publish membership change for convo %d: %v", convoID, err)
+	}
+}
+
+// applyMembership updates this instance's connections of the affected users
+func (s *server) applyMembership(payload string) {
+	var event membershipEvent
+	if err := json.Unmarshal([]byte(payload), &event); err != nil {
+		log.Printf("Failed to unmarshal membership event: %v", err)
+		return
+	}
+
+	frameType := frameConversationLeft
+	if event.Joined {
+		frameType = frameConversationJoined
+	}
+	for _, userID := range event.UserIDs {
+		for _, client := range s.hub.userClients(userID) {
+			client.setSubscribed(event.ConversationID, event.Joined)
+			client.reply(replyFrame{Type: frameType, ConversationIDs: []string{event.ConversationID}})
+		}
+	}
+}
+
+// setSubscribed starts or stops pushing a conversation to this connection
+func (c *Client) setSubscribed(convoID string, subscribed bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if subscribed {
+		c.convoIDs[convoID] = true
+	} else {
+		delete(c.convoIDs, convoID)
+		delete(c.typing, convoID)
+	}
+}
+
+// userClients returns every connection of one user on this instance
+func (h *Hub) userClients(userID int64) []*Client {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	clients := make([]*Client, 0, len(h.byUser[userID]))
+	for _, client := range h.byUser[userID] {
+		clients = append(clients, client)
+	}
+	return clients
+}
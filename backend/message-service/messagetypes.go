@@ -0,0 +1,266 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+
+	pb "github.com/hoshibmatchi/message-service/proto"
+	storyPb "github.com/hoshibmatchi/story-service/proto"
+	userPb "github.com/hoshibmatchi/user-service/proto"
+)
+
+// Message types. Structured types keep their pb payload as JSON in
+// Message.Payload; older rows have no type and read as text.
+const (
+	messageTypeText       = "text"
+	messageTypeMedia      = "media"
+	messageTypeSharedPost = "shared_post"
+	messageTypeStoryReply = "story_reply"
+	messageTypeProfile    = "profile"
+	messageTypeLocation   = "location"
+	messageTypeSystem     = "system"
+)
+
+// System events written into group conversations
+const (
+	eventParticipantAdded   = "participant_added"
+	eventParticipantRemoved = "participant_removed"
+	eventParticipantLeft    = "participant_left"
+	eventGroupRenamed       = "group_renamed"
+	eventGroupPhotoChanged  = "group_photo_changed"
+)
+
+const storyLifetime = 24 * time.Hour
+
+// storyReplyExpired reports whether the story a reply was about has run out.
+// Replies saved before the story's expiry was recorded count from when they
+// were sent, which is no later than the story's.
+func storyReplyExpired(reply *pb.StoryReply, sentAt time.Time) bool {
+	if expiresAt, err := time.Parse(time.RFC3339, reply.ExpiresAt); err == nil {
+		return !time.Now().Before(expiresAt)
+	}
+	return time.Since(sentAt) > storyLifetime
+}
+
+// applyMessagePayload validates a send request by type and fills in the
+// message's type and payload
+func (s *server) applyMessagePayload(ctx context.Context, req *pb.SendMessageRequest, msg *Message) error {
+	msgType := req.Type
+	if msgType == "" {
+		msgType = messageTypeText
+		if req.MediaUrl != "" {
+			msgType = messageTypeMedia
+		}
+	}
+	if req.MediaUrl != "" && msgType != messageTypeMedia {
+		return status.Error(codes.InvalidArgument, "media_url is only allowed on media messages")
+	}
+
+	var payload interface{}
+	switch msgType {
+	case messageTypeText:
+		if strings.TrimSpace(req.Content) == "" {
+			return status.Error(codes.InvalidArgument, "Message content is required")
+		}
+
+	case messageTypeMedia:
+		if req.MediaUrl == "" {
+			return status.Error(codes.InvalidArgument, "media_url is required for media messages")
+		}
+		if req.MediaType != "image" && req.MediaType != "gif" && req.MediaType != "video" {
+			return status.Error(codes.InvalidArgument, "media_type must be image, gif or video")
+		}
+
+	case messageTypeSharedPost:
+		if req.SharedPost == nil || req.SharedPost.PostId == 0 {
+			return status.Error(codes.InvalidArgument, "shared_post is required for shared_post messages")
+		}
+		payload = req.SharedPost
+
+	case messageTypeStoryReply:
+		reply := req.StoryReply
+		if reply == nil || reply.StoryId == 0 || reply.StoryOwnerId == 0 {
+			return status.Error(codes.InvalidArgument, "story_reply with story_id and story_owner_id is required")
+		}
+		if strings.TrimSpace(req.Content) == "" {
+			return status.Error(codes.InvalidArgument, "Reply content is required")
+		}
+		if reply.StoryOwnerId == req.SenderId {
+			return status.Error(codes.InvalidArgument, "You can't reply to your own story")
+		}
+		// Replies go to the story's owner, so they must be in the conversation
+		var ownerCount int64
+		s.db.Model(&Participant{}).Where("conversation_id = ? AND user_id = ?", msg.ConversationID, reply.StoryOwnerId).Count(&ownerCount)
+		if ownerCount == 0 {
+			return status.Error(codes.InvalidArgument, "The story's owner is not in this conversation")
+		}
+		// The story must still be up and visible to the sender; its media comes from story-service
+		storyRes, err := s.storyClient.GetStory(ctx, &storyPb.GetStoryRequest{ViewerId: req.SenderId, StoryId: reply.StoryId})
+		if status.Code(err) == codes.NotFound {
+			return status.Error(codes.NotFound, "Story not found")
+		} else if err != nil {
+			log.Printf("Failed to get story %d for a reply: %v", reply.StoryId, err)
+			return status.Error(codes.Internal, "Failed to load story")
+		}
+		if storyRes.Story.AuthorId != reply.StoryOwnerId {
+			return status.Error(codes.InvalidArgument, "story_owner_id doesn't match the story")
+		}
+		payload = &pb.StoryReply{
+			StoryId:      reply.StoryId,
+			StoryOwnerId: reply.StoryOwnerId,
+			MediaUrl:     storyRes.Story.MediaUrl,
+			MediaType:    storyRes.Story.MediaType,
+			ExpiresAt:    storyRes.Story.ExpiresAt,
+		}
+
+	case messageTypeProfile:
+		if req.Profile == nil || req.Profile.UserId == 0 {
+			return status.Error(codes.InvalidArgument, "profile with user_id is required for profile messages")
+		}
+		userData, err := s.userClient.GetUserData(ctx, &userPb.GetUserDataRequest{UserId: req.Profile.UserId})
+		if status.Code(err) == codes.NotFound {
+			return status.Error(codes.NotFound, "User not found")
+		} else if err != nil {
+			log.Printf("Failed to get user data for profile card %d: %v", req.Profile.UserId, err)
+			return status.Error(codes.Internal, "Failed to load profile")
+		}
+		payload = &pb.ProfileCard{
+			UserId:            req.Profile.UserId,
+			Username:          userData.Username,
+			ProfilePictureUrl: userData.ProfilePictureUrl,
+			IsVerified:        userData.IsVerified,
+		}
+
+	case messageTypeLocation:
+		loc := req.Location
+		if loc == nil || strings.TrimSpace(loc.Name) == "" {
+			return status.Error(codes.InvalidArgument, "location with a name is required for location messages")
+		}
+		if loc.Latitude < -90 || loc.Latitude > 90 || loc.Longitude < -180 || loc.Longitude > 180 {
+			return status.Error(codes.InvalidArgument, "Invalid coordinates")
+		}
+		payload = loc
+
+	case messageTypeSystem:
+		return status.Error(codes.InvalidArgument, "System messages are written by the server")
+
+	default:
+		return status.Error(codes.InvalidArgument, "Unknown message type")
+	}
+
+	msg.Type = msgType
+	if payload != nil {
+		body, err := json.Marshal(payload)
+		if err != nil {
+			return status.Error(codes.InvalidArgument, "Invalid message payload")
+		}
+		msg.Payload = string(body)
+	}
+	return nil
+}
+
+// renderMessagePayload decodes a structured message's payload into its pb
+// field, refreshing whatever may have changed since it was sent
+func (s *server) renderMessagePayload(ctx context.Context, msg *Message, out *pb.Message) {
+	var err error
+	switch msg.Type {
+	case messageTypeSharedPost:
+		out.SharedPost = &pb.SharedPost{}
+		err = json.Unmarshal([]byte(msg.Payload), out.SharedPost)
+
+	case messageTypeStoryReply:
+		out.StoryReply = &pb.StoryReply{}
+		err = json.Unmarshal([]byte(msg.Payload), out.StoryReply)
+		out.StoryReply.Expired = storyReplyExpired(out.StoryReply, msg.CreatedAt)
+
+	case messageTypeProfile:
+		out.Profile = &pb.ProfileCard{}
+		if err = json.Unmarshal([]byte(msg.Payload), out.Profile); err == nil {
+			// Show the current username and picture; keep the snapshot if the lookup fails
+			if userData, lookupErr := s.userClient.GetUserData(ctx, &userPb.GetUserDataRequest{UserId: out.Profile.UserId}); lookupErr == nil {
+				out.Profile.Username = userData.Username
+				out.Profile.ProfilePictureUrl = userData.ProfilePictureUrl
+				out.Profile.IsVerified = userData.IsVerified
+			}
+		}
+
+	case messageTypeLocation:
+		out.Location = &pb.Location{}
+		err = json.Unmarshal([]byte(msg.Payload), out.Location)
+
+	case messageTypeSystem:
+		out.System = &pb.SystemEvent{}
+		if err = json.Unmarshal([]byte(msg.Payload), out.System); err == nil {
+			out.System.ActorUsername = out.SenderUsername
+			if out.System.TargetId != 0 {
+				out.System.TargetUsername = s.usernameOf(ctx, out.System.TargetId)
+			}
+			out.Content = systemEventText(out.System)
+		}
+	}
+	if err != nil {
+		log.Printf("Failed to decode %s payload of message %d: %v", msg.Type, msg.ID, err)
+	}
+}
+
+// usernameOf looks up a username for display, with a placeholder on failure
+func (s *server) usernameOf(ctx context.Context, userID int64) string {
+	userData, err := s.userClient.GetUserData(ctx, &userPb.GetUserDataRequest{UserId: userID})
+	if err != nil {
+		log.Printf("Failed to get user data for user %d: %v", userID, err)
+		return "Unknown"
+	}
+	return userData.Username
+}
+
+// systemEventText is how a system event reads in the chat
+func systemEventText(event *pb.SystemEvent) string {
+	switch event.Event {
+	case eventParticipantAdded:
+		return fmt.Sprintf("%s added %s", event.ActorUsername, event.TargetUsername)
+	case eventParticipantRemoved:
+		return fmt.Sprintf("%s removed %s", event.ActorUsername, event.TargetUsername)
+	case eventParticipantLeft:
+		return fmt.Sprintf("%s left the group", event.ActorUsername)
+	case eventGroupRenamed:
+		return fmt.Sprintf("%s named the group %s", event.ActorUsername, event.GroupName)
+	case eventGroupPhotoChanged:
+		return fmt.Sprintf("%s changed the group photo", event.ActorUsername)
+	}
+	return ""
+}
+
+// createSystemMessage writes a system event into a conversation and pushes it
+// to live clients like any other message
+func (s *server) createSystemMessage(ctx context.Context, convoID uint, actorID int64, event *pb.SystemEvent) *pb.Message {
+	event.ActorId = actorID
+	payload, _ := json.Marshal(event)
+	msg := Message{
+		ConversationID: convoID,
+		SenderID:       actorID,
+		Type:           messageTypeSystem,
+		Payload:        string(payload),
+	}
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&msg).Error; err != nil {
+			return err
+		}
+		return tx.Model(&Conversation{}).Where("id = ?", convoID).Update("updated_at", time.Now()).Error
+	})
+	if err != nil {
+		log.Printf("Failed to save %s event in conversation %d: %v", event.Event, convoID, err)
+		return nil
+	}
+
+	grpcMessage, _ := s.gormToGrpcMessage(ctx, &msg)
+	s.publishMessage(ctx, grpcMessage)
+	return grpcMessage
+}
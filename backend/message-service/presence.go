@@ -0,0 +1,273 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/hoshibmatchi/message-service/proto"
+	userPb "github.com/hoshibmatchi/user-service/proto"
+)
+
+// Presence. Every open WebSocket connection keeps a token in its user's
+// presence:<id> sorted set, scored by when the token expires. Each instance
+// refreshes the tokens of its own connections every presenceHeartbeat, so a
+// user is online while any token, from any instance, is still in the future.
+// last_seen:<id> is moved forward on every heartbeat and on disconnect.
+//
+// A clean disconnect that leaves no tokens pushes an offline event at once. If
+// an instance dies its tokens just run out after presenceTTL; nobody is told,
+// but GetPresence reports the users offline from then on.
+
+const (
+	presenceHeartbeat = 20 * time.Second
+	presenceTTL       = 60 * time.Second // A few missed heartbeats before a token runs out
+	maxPresenceIDs    = 200
+	eventPresence     = "presence"
+)
+
+// presenceEvent is fanned out to every conversation of the user
+type presenceEvent struct {
+	V              int    `json:"v"`
+	Type           string `json:"type"`
+	ConversationID string `json:"conversation_id"`
+	UserID         int64  `json:"user_id"`
+	Online         bool   `json:"online"`
+	LastSeenAt     string `json:"last_seen_at,omitempty"`
+}
+
+func presenceKey(userID int64) string {
+	return fmt.Sprintf("presence:%d", userID)
+}
+
+func lastSeenKey(userID int64) string {
+	return fmt.Sprintf("last_seen:%d", userID)
+}
+
+// newInstanceID names this process so its presence tokens don't collide with
+// another instance's connection IDs
+func newInstanceID() string {
+	host, _ := os.Hostname()
+	return fmt.Sprintf("%s-%d", host, os.Getpid())
+}
+
+func (s *server) presenceToken(c *Client) string {
+	return fmt.Sprintf("%s:%d", s.instanceID, c.id)
+}
+
+// runPresenceHeartbeat keeps this instance's connections online
+func (s *server) runPresenceHeartbeat() {
+	ticker := time.NewTicker(presenceHeartbeat)
+	defer ticker.Stop()
+	for range ticker.C {
+		s.touchPresence(context.Background(), s.hub.all())
+	}
+}
+
+// touchPresence refreshes the tokens of the given connections and drops any
+// of their users' tokens that have run out. It holds presenceMu so a
+// connection that closes meanwhile can't have its token put back after
+// disconnectPresence dropped it.
+func (s *server) touchPresence(ctx context.Context, clients []*Client) {
+	if len(clients) == 0 {
+		return
+	}
+	s.presenceMu.Lock()
+	defer s.presenceMu.Unlock()
+	now := time.Now()
+	expires := float64(now.Add(presenceTTL).Unix())
+	pipe := s.rdb.Pipeline()
+	for _, c := range clients {
+		if c.isClosed() {
+			continue
+		}
+		key := presenceKey(c.userID)
+		pipe.ZAdd(ctx, key, &redis.Z{Score: expires, Member: s.presenceToken(c)})
+		pipe.ZRemRangeByScore(ctx, key, "-inf", strconv.FormatInt(now.Unix(), 10))
+		pipe.Expire(ctx, key, presenceTTL)
+		pipe.Set(ctx, lastSeenKey(c.userID), now.Unix(), 0)
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		log.Printf("Failed to refresh presence for %d connections: %v", len(clients), err)
+	}
+}
+
+// isOnline reports whether the user has a live token on any instance
+func (s *server) isOnline(ctx context.Context, userID int64) (bool, error) {
+	live, err := s.rdb.ZCount(ctx, presenceKey(userID), fmt.Sprintf("(%d", time.Now().Unix()), "+inf").Result()
+	return live > 0, err
+}
+
+// connectPresence records a new connection and tells the user's conversations
+// if they just came online
+func (s *server) connectPresence(ctx context.Context, c *Client) {
+	wasOnline, err := s.isOnline(ctx, c.userID)
+	s.touchPresence(ctx, []*Client{c})
+	if err != nil {
+		log.Printf("Failed to check presence of user %d: %v", c.userID, err)
+		return
+	}
+	if !wasOnline {
+		s.publishPresence(ctx, c.userID, true, time.Time{})
+	}
+}
+
+// disconnectPresence drops a closed connection's token and tells the user's
+// conversations if it was their last one. The connection must already be
+// closed, so heartbeats after this skip it.
+func (s *server) disconnectPresence(ctx context.Context, c *Client) {
+	now := time.Now()
+	s.presenceMu.Lock()
+	pipe := s.rdb.Pipeline()
+	pipe.ZRem(ctx, presenceKey(c.userID), s.presenceToken(c))
+	pipe.Set(ctx, lastSeenKey(c.userID), now.Unix(), 0)
+	_, err := pipe.Exec(ctx)
+	var online bool
+	if err == nil {
+		online, err = s.isOnline(ctx, c.userID)
+	}
+	s.presenceMu.Unlock()
+	if err != nil {
+		log.Printf("Failed to clear presence of user %d on connection %d: %v", c.userID, c.id, err)
+		return
+	}
+	if !online {
+		s.publishPresence(ctx, c.userID, false, now)
+	}
+}
+
+// publishPresence sends a presence event to each of the user's conversations,
+// unless they hide their activity status
+func (s *server) publishPresence(ctx context.Context, userID int64, online bool, lastSeen time.Time) {
+	if s.activityStatusHidden(ctx, userID) {
+		return
+	}
+	convoIDs, err := s.getConversationIDsForUser(userID)
+	if err != nil {
+		log.Printf("Failed to get convo IDs for user %d: %v", userID, err)
+		return
+	}
+
+	event := presenceEvent{
+		V:      protocolVersion,
+		Type:   eventPresence,
+		UserID: userID,
+		Online: online,
+	}
+	if !online {
+		event.LastSeenAt = lastSeen.UTC().Format(time.RFC3339)
+	}
+	for convoID := range convoIDs {
+		event.ConversationID = convoID
+		msgBody, _ := json.Marshal(event)
+		channelName := fmt.Sprintf("chat:%s", convoID)
+		if err := s.rdb.Publish(ctx, channelName, msgBody).Err(); err != nil {
+			log.Printf("Failed to publish presence event: %v", err)
+		}
+	}
+}
+
+// activityStatusHidden checks the user's setting. When it can't be read the
+// status is treated as hidden.
+func (s *server) activityStatusHidden(ctx context.Context, userID int64) bool {
+	userData, err := s.userClient.GetUserData(ctx, &userPb.GetUserDataRequest{UserId: userID})
+	if err != nil {
+		log.Printf("Failed to get user data for user %d: %v", userID, err)
+		return true
+	}
+	return userData.ActivityStatusHidden
+}
+
+// presenceVisibleTo picks the users whose presence the viewer may see: people
+// they share a conversation with, who haven't hidden their activity status.
+// Hiding your own activity status also hides everyone else's from you.
+func (s *server) presenceVisibleTo(ctx context.Context, viewerID int64, userIDs []int64) (map[int64]bool, error) {
+	visible := make(map[int64]bool, len(userIDs))
+	if len(userIDs) == 0 || s.activityStatusHidden(ctx, viewerID) {
+		return visible, nil
+	}
+
+	var contacts []int64
+	if err := s.db.Table("participants AS mine").
+		Joins("JOIN participants AS other ON other.conversation_id = mine.conversation_id").
+		Where("mine.user_id = ? AND other.user_id IN ?", viewerID, userIDs).
+		Distinct().Pluck("other.user_id", &contacts).Error; err != nil {
+		log.Printf("Failed to get conversation contacts of user %d: %v", viewerID, err)
+		return nil, status.Error(codes.Internal, "Failed to get presence")
+	}
+	if len(contacts) == 0 {
+		return visible, nil
+	}
+
+	// Summaries leave out blocked, banned and unknown users
+	summaries, err := s.userClient.GetUserSummaries(ctx, &userPb.GetUserSummariesRequest{UserIds: contacts, ViewerId: viewerID})
+	if err != nil {
+		log.Printf("Failed to get user summaries for presence: %v", err)
+		return nil, status.Error(codes.Internal, "Failed to get presence")
+	}
+	for _, summary := range summaries.Users {
+		if !summary.ActivityStatusHidden {
+			visible[summary.User.UserId] = true
+		}
+	}
+	return visible, nil
+}
+
+// --- GRPC: GetPresence ---
+func (s *server) GetPresence(ctx context.Context, req *pb.GetPresenceRequest) (*pb.GetPresenceResponse, error) {
+	if len(req.UserIds) > maxPresenceIDs {
+		return nil, status.Errorf(codes.InvalidArgument, "Cannot look up more than %d users at once", maxPresenceIDs)
+	}
+	presences := make([]*pb.Presence, 0, len(req.UserIds))
+	if len(req.UserIds) == 0 {
+		return &pb.GetPresenceResponse{Presences: presences}, nil
+	}
+
+	visible, err := s.presenceVisibleTo(ctx, req.ViewerId, req.UserIds)
+	if err != nil {
+		return nil, err
+	}
+
+	liveSince := fmt.Sprintf("(%d", time.Now().Unix())
+	liveTokens := make(map[int64]*redis.IntCmd, len(visible))
+	lastSeen := make(map[int64]*redis.StringCmd, len(visible))
+	if len(visible) > 0 {
+		pipe := s.rdb.Pipeline()
+		for userID := range visible {
+			liveTokens[userID] = pipe.ZCount(ctx, presenceKey(userID), liveSince, "+inf")
+			lastSeen[userID] = pipe.Get(ctx, lastSeenKey(userID))
+		}
+		// redis.Nil only means someone was never seen
+		if _, err := pipe.Exec(ctx); err != nil && err != redis.Nil {
+			log.Printf("Failed to read presence: %v", err)
+			return nil, status.Error(codes.Internal, "Failed to get presence")
+		}
+	}
+
+	seen := make(map[int64]bool, len(req.UserIds))
+	for _, userID := range req.UserIds {
+		if seen[userID] {
+			continue
+		}
+		seen[userID] = true
+		presence := &pb.Presence{UserId: userID}
+		if visible[userID] {
+			if liveTokens[userID].Val() > 0 {
+				presence.Online = true
+			} else if lastSeenUnix, err := lastSeen[userID].Int64(); err == nil {
+				presence.LastSeenAt = time.Unix(lastSeenUnix, 0).UTC().Format(time.RFC3339)
+			}
+		}
+		presences = append(presences, presence)
+	}
+
+	return &pb.GetPresenceResponse{Presences: presences}, nil
+}
@@ -29,11 +29,13 @@ type Conversation struct {
 	// List of users in the chat
 	Participants []*proto.GetUserDataResponse `protobuf:"bytes,2,rep,name=participants,proto3" json:"participants,omitempty"`
 	// The last message sent
-	LastMessage   *Message `protobuf:"bytes,3,opt,name=last_message,json=lastMessage,proto3" json:"last_message,omitempty"`
-	CreatedAt     string   `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	IsGroup       bool     `protobuf:"varint,5,opt,name=is_group,json=isGroup,proto3" json:"is_group,omitempty"`
-	GroupName     string   `protobuf:"bytes,6,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"`               // Empty if not a group
-	GroupImageUrl string   `protobuf:"bytes,7,opt,name=group_image_url,json=groupImageUrl,proto3" json:"group_image_url,omitempty"` // Group profile picture
+	LastMessage   *Message     `protobuf:"bytes,3,opt,name=last_message,json=lastMessage,proto3" json:"last_message,omitempty"`
+	CreatedAt     string       `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	IsGroup       bool         `protobuf:"varint,5,opt,name=is_group,json=isGroup,proto3" json:"is_group,omitempty"`
+	GroupName     string       `protobuf:"bytes,6,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"`               // Empty if not a group
+	GroupImageUrl string       `protobuf:"bytes,7,opt,name=group_image_url,json=groupImageUrl,proto3" json:"group_image_url,omitempty"` // Group profile picture
+	UnreadCount   int32        `protobuf:"varint,8,opt,name=unread_count,json=unreadCount,proto3" json:"unread_count,omitempty"`        // Messages from others after the viewer's read pointer
+	ReadStates    []*ReadState `protobuf:"bytes,9,rep,name=read_states,json=readStates,proto3" json:"read_states,omitempty"`            // The other participants' pointers
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -117,6 +119,84 @@ func (x *Conversation) GetGroupImageUrl() string {
 	return ""
 }
 
+func (x *Conversation) GetUnreadCount() int32 {
+	if x != nil {
+		return x.UnreadCount
+	}
+	return 0
+}
+
+func (x *Conversation) GetReadStates() []*ReadState {
+	if x != nil {
+		return x.ReadStates
+	}
+	return nil
+}
+
+// How far a participant has got in a conversation. Messages with IDs up to
+// last_delivered_message_id reached one of their devices; up to
+// last_read_message_id they have seen. Both only move forward, and a read
+// message is always delivered. "0" means none yet.
+type ReadState struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	UserId                 int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	LastDeliveredMessageId string                 `protobuf:"bytes,2,opt,name=last_delivered_message_id,json=lastDeliveredMessageId,proto3" json:"last_delivered_message_id,omitempty"`
+	LastReadMessageId      string                 `protobuf:"bytes,3,opt,name=last_read_message_id,json=lastReadMessageId,proto3" json:"last_read_message_id,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *ReadState) Reset() {
+	*x = ReadState{}
+	mi := &file_message_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadState) ProtoMessage() {}
+
+func (x *ReadState) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadState.ProtoReflect.Descriptor instead.
+func (*ReadState) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ReadState) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ReadState) GetLastDeliveredMessageId() string {
+	if x != nil {
+		return x.LastDeliveredMessageId
+	}
+	return ""
+}
+
+func (x *ReadState) GetLastReadMessageId() string {
+	if x != nil {
+		return x.LastReadMessageId
+	}
+	return ""
+}
+
 // Represents a single chat message
 type Message struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
@@ -128,13 +208,24 @@ type Message struct {
 	SenderUsername string                 `protobuf:"bytes,6,opt,name=sender_username,json=senderUsername,proto3" json:"sender_username,omitempty"` // Denormalized
 	MediaUrl       string                 `protobuf:"bytes,7,opt,name=media_url,json=mediaUrl,proto3" json:"media_url,omitempty"`                   // URL of image/gif/video (optional)
 	MediaType      string                 `protobuf:"bytes,8,opt,name=media_type,json=mediaType,proto3" json:"media_type,omitempty"`                // "image", "gif", "video" (optional)
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// "text", "media", "shared_post", "story_reply", "profile", "location" or
+	// "system". Exactly one of the payloads below is set for the structured types.
+	Type          string             `protobuf:"bytes,9,opt,name=type,proto3" json:"type,omitempty"`
+	SharedPost    *SharedPost        `protobuf:"bytes,10,opt,name=shared_post,json=sharedPost,proto3" json:"shared_post,omitempty"`
+	StoryReply    *StoryReply        `protobuf:"bytes,11,opt,name=story_reply,json=storyReply,proto3" json:"story_reply,omitempty"` // content is the reply itself
+	Profile       *ProfileCard       `protobuf:"bytes,12,opt,name=profile,proto3" json:"profile,omitempty"`
+	Location      *Location          `protobuf:"bytes,13,opt,name=location,proto3" json:"location,omitempty"`
+	System        *SystemEvent       `protobuf:"bytes,14,opt,name=system,proto3" json:"system,omitempty"`                  // content is the event as text, e.g. "alice added bob"
+	ReplyTo       *ReplyPreview      `protobuf:"bytes,15,opt,name=reply_to,json=replyTo,proto3" json:"reply_to,omitempty"` // Set when this message replies to another one
+	Reactions     []*ReactionSummary `protobuf:"bytes,16,rep,name=reactions,proto3" json:"reactions,omitempty"`            // Most used first
+	Forwarded     bool               `protobuf:"varint,17,opt,name=forwarded,proto3" json:"forwarded,omitempty"`           // Copied from another message; the original isn't disclosed
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Message) Reset() {
 	*x = Message{}
-	mi := &file_message_proto_msgTypes[1]
+	mi := &file_message_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -146,7 +237,7 @@ func (x *Message) String() string {
 func (*Message) ProtoMessage() {}
 
 func (x *Message) ProtoReflect() protoreflect.Message {
-	mi := &file_message_proto_msgTypes[1]
+	mi := &file_message_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -159,7 +250,7 @@ func (x *Message) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Message.ProtoReflect.Descriptor instead.
 func (*Message) Descriptor() ([]byte, []int) {
-	return file_message_proto_rawDescGZIP(), []int{1}
+	return file_message_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *Message) GetId() string {
@@ -218,88 +309,96 @@ func (x *Message) GetMediaType() string {
 	return ""
 }
 
-// --- GetConversations ---
-type GetConversationsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // From JWT
-	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageOffset    int32                  `protobuf:"varint,3,opt,name=page_offset,json=pageOffset,proto3" json:"page_offset,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Message) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
 }
 
-func (x *GetConversationsRequest) Reset() {
-	*x = GetConversationsRequest{}
-	mi := &file_message_proto_msgTypes[2]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Message) GetSharedPost() *SharedPost {
+	if x != nil {
+		return x.SharedPost
+	}
+	return nil
 }
 
-func (x *GetConversationsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Message) GetStoryReply() *StoryReply {
+	if x != nil {
+		return x.StoryReply
+	}
+	return nil
 }
 
-func (*GetConversationsRequest) ProtoMessage() {}
+func (x *Message) GetProfile() *ProfileCard {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
 
-func (x *GetConversationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_message_proto_msgTypes[2]
+func (x *Message) GetLocation() *Location {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Location
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use GetConversationsRequest.ProtoReflect.Descriptor instead.
-func (*GetConversationsRequest) Descriptor() ([]byte, []int) {
-	return file_message_proto_rawDescGZIP(), []int{2}
+func (x *Message) GetSystem() *SystemEvent {
+	if x != nil {
+		return x.System
+	}
+	return nil
 }
 
-func (x *GetConversationsRequest) GetUserId() int64 {
+func (x *Message) GetReplyTo() *ReplyPreview {
 	if x != nil {
-		return x.UserId
+		return x.ReplyTo
 	}
-	return 0
+	return nil
 }
 
-func (x *GetConversationsRequest) GetPageSize() int32 {
+func (x *Message) GetReactions() []*ReactionSummary {
 	if x != nil {
-		return x.PageSize
+		return x.Reactions
 	}
-	return 0
+	return nil
 }
 
-func (x *GetConversationsRequest) GetPageOffset() int32 {
+func (x *Message) GetForwarded() bool {
 	if x != nil {
-		return x.PageOffset
+		return x.Forwarded
 	}
-	return 0
+	return false
 }
 
-type GetConversationsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Conversations []*Conversation        `protobuf:"bytes,1,rep,name=conversations,proto3" json:"conversations,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// The message being replied to, as quoted above the reply
+type ReplyPreview struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	MessageId      string                 `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	SenderId       string                 `protobuf:"bytes,2,opt,name=sender_id,json=senderId,proto3" json:"sender_id,omitempty"`
+	SenderUsername string                 `protobuf:"bytes,3,opt,name=sender_username,json=senderUsername,proto3" json:"sender_username,omitempty"`
+	Type           string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Snippet        string                 `protobuf:"bytes,5,opt,name=snippet,proto3" json:"snippet,omitempty"` // The start of the text, or what was sent, e.g. "Photo"
+	Unsent         bool                   `protobuf:"varint,6,opt,name=unsent,proto3" json:"unsent,omitempty"`  // The original was unsent; only message_id is set
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *GetConversationsResponse) Reset() {
-	*x = GetConversationsResponse{}
+func (x *ReplyPreview) Reset() {
+	*x = ReplyPreview{}
 	mi := &file_message_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetConversationsResponse) String() string {
+func (x *ReplyPreview) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetConversationsResponse) ProtoMessage() {}
+func (*ReplyPreview) ProtoMessage() {}
 
-func (x *GetConversationsResponse) ProtoReflect() protoreflect.Message {
+func (x *ReplyPreview) ProtoReflect() protoreflect.Message {
 	mi := &file_message_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -311,109 +410,77 @@ func (x *GetConversationsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetConversationsResponse.ProtoReflect.Descriptor instead.
-func (*GetConversationsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReplyPreview.ProtoReflect.Descriptor instead.
+func (*ReplyPreview) Descriptor() ([]byte, []int) {
 	return file_message_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *GetConversationsResponse) GetConversations() []*Conversation {
+func (x *ReplyPreview) GetMessageId() string {
 	if x != nil {
-		return x.Conversations
+		return x.MessageId
 	}
-	return nil
-}
-
-// --- GetMessages ---
-type GetMessagesRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	UserId         int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                        // From JWT (for validation)
-	ConversationId string                 `protobuf:"bytes,2,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"` // From URL
-	PageSize       int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageOffset     int32                  `protobuf:"varint,4,opt,name=page_offset,json=pageOffset,proto3" json:"page_offset,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
-}
-
-func (x *GetMessagesRequest) Reset() {
-	*x = GetMessagesRequest{}
-	mi := &file_message_proto_msgTypes[4]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GetMessagesRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return ""
 }
 
-func (*GetMessagesRequest) ProtoMessage() {}
-
-func (x *GetMessagesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_message_proto_msgTypes[4]
+func (x *ReplyPreview) GetSenderId() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.SenderId
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetMessagesRequest.ProtoReflect.Descriptor instead.
-func (*GetMessagesRequest) Descriptor() ([]byte, []int) {
-	return file_message_proto_rawDescGZIP(), []int{4}
+	return ""
 }
 
-func (x *GetMessagesRequest) GetUserId() int64 {
+func (x *ReplyPreview) GetSenderUsername() string {
 	if x != nil {
-		return x.UserId
+		return x.SenderUsername
 	}
-	return 0
+	return ""
 }
 
-func (x *GetMessagesRequest) GetConversationId() string {
+func (x *ReplyPreview) GetType() string {
 	if x != nil {
-		return x.ConversationId
+		return x.Type
 	}
 	return ""
 }
 
-func (x *GetMessagesRequest) GetPageSize() int32 {
+func (x *ReplyPreview) GetSnippet() string {
 	if x != nil {
-		return x.PageSize
+		return x.Snippet
 	}
-	return 0
+	return ""
 }
 
-func (x *GetMessagesRequest) GetPageOffset() int32 {
+func (x *ReplyPreview) GetUnsent() bool {
 	if x != nil {
-		return x.PageOffset
+		return x.Unsent
 	}
-	return 0
+	return false
 }
 
-type GetMessagesResponse struct {
+type ReactionSummary struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Messages      []*Message             `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+	Emoji         string                 `protobuf:"bytes,1,opt,name=emoji,proto3" json:"emoji,omitempty"`
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	UserIds       []int64                `protobuf:"varint,3,rep,packed,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"` // Who reacted with it, oldest first
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMessagesResponse) Reset() {
-	*x = GetMessagesResponse{}
-	mi := &file_message_proto_msgTypes[5]
+func (x *ReactionSummary) Reset() {
+	*x = ReactionSummary{}
+	mi := &file_message_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMessagesResponse) String() string {
+func (x *ReactionSummary) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMessagesResponse) ProtoMessage() {}
+func (*ReactionSummary) ProtoMessage() {}
 
-func (x *GetMessagesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_message_proto_msgTypes[5]
+func (x *ReactionSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -424,45 +491,62 @@ func (x *GetMessagesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMessagesResponse.ProtoReflect.Descriptor instead.
-func (*GetMessagesResponse) Descriptor() ([]byte, []int) {
-	return file_message_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use ReactionSummary.ProtoReflect.Descriptor instead.
+func (*ReactionSummary) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *GetMessagesResponse) GetMessages() []*Message {
+func (x *ReactionSummary) GetEmoji() string {
 	if x != nil {
-		return x.Messages
+		return x.Emoji
+	}
+	return ""
+}
+
+func (x *ReactionSummary) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *ReactionSummary) GetUserIds() []int64 {
+	if x != nil {
+		return x.UserIds
 	}
 	return nil
 }
 
-// --- SendMessage ---
-type SendMessageRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	SenderId       int64                  `protobuf:"varint,1,opt,name=sender_id,json=senderId,proto3" json:"sender_id,omitempty"` // From JWT
-	ConversationId string                 `protobuf:"bytes,2,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
-	Content        string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`                      // Text content (optional if media is present)
-	MediaUrl       string                 `protobuf:"bytes,4,opt,name=media_url,json=mediaUrl,proto3" json:"media_url,omitempty"`    // URL of uploaded media (optional)
-	MediaType      string                 `protobuf:"bytes,5,opt,name=media_type,json=mediaType,proto3" json:"media_type,omitempty"` // "image", "gif", "video" (optional)
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+// A post sent into a conversation. Clients render it as a preview card and open
+// the post itself on tap; the preview is a snapshot from when it was sent.
+type SharedPost struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	PostId           int64                  `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	AuthorId         int64                  `protobuf:"varint,2,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	AuthorUsername   string                 `protobuf:"bytes,3,opt,name=author_username,json=authorUsername,proto3" json:"author_username,omitempty"`
+	AuthorProfileUrl string                 `protobuf:"bytes,4,opt,name=author_profile_url,json=authorProfileUrl,proto3" json:"author_profile_url,omitempty"`
+	ThumbnailUrl     string                 `protobuf:"bytes,5,opt,name=thumbnail_url,json=thumbnailUrl,proto3" json:"thumbnail_url,omitempty"` // Thumbnail for reels, first media item otherwise
+	Caption          string                 `protobuf:"bytes,6,opt,name=caption,proto3" json:"caption,omitempty"`                               // Trimmed for the preview
+	IsReel           bool                   `protobuf:"varint,7,opt,name=is_reel,json=isReel,proto3" json:"is_reel,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *SendMessageRequest) Reset() {
-	*x = SendMessageRequest{}
-	mi := &file_message_proto_msgTypes[6]
+func (x *SharedPost) Reset() {
+	*x = SharedPost{}
+	mi := &file_message_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SendMessageRequest) String() string {
+func (x *SharedPost) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendMessageRequest) ProtoMessage() {}
+func (*SharedPost) ProtoMessage() {}
 
-func (x *SendMessageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_message_proto_msgTypes[6]
+func (x *SharedPost) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -473,67 +557,171 @@ func (x *SendMessageRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendMessageRequest.ProtoReflect.Descriptor instead.
-func (*SendMessageRequest) Descriptor() ([]byte, []int) {
-	return file_message_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use SharedPost.ProtoReflect.Descriptor instead.
+func (*SharedPost) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *SendMessageRequest) GetSenderId() int64 {
+func (x *SharedPost) GetPostId() int64 {
 	if x != nil {
-		return x.SenderId
+		return x.PostId
 	}
 	return 0
 }
 
-func (x *SendMessageRequest) GetConversationId() string {
+func (x *SharedPost) GetAuthorId() int64 {
 	if x != nil {
-		return x.ConversationId
+		return x.AuthorId
+	}
+	return 0
+}
+
+func (x *SharedPost) GetAuthorUsername() string {
+	if x != nil {
+		return x.AuthorUsername
 	}
 	return ""
 }
 
-func (x *SendMessageRequest) GetContent() string {
+func (x *SharedPost) GetAuthorProfileUrl() string {
 	if x != nil {
-		return x.Content
+		return x.AuthorProfileUrl
 	}
 	return ""
 }
 
-func (x *SendMessageRequest) GetMediaUrl() string {
+func (x *SharedPost) GetThumbnailUrl() string {
 	if x != nil {
-		return x.MediaUrl
+		return x.ThumbnailUrl
 	}
 	return ""
 }
 
-func (x *SendMessageRequest) GetMediaType() string {
+func (x *SharedPost) GetCaption() string {
 	if x != nil {
-		return x.MediaType
+		return x.Caption
 	}
 	return ""
 }
 
-type SendMessageResponse struct {
+func (x *SharedPost) GetIsReel() bool {
+	if x != nil {
+		return x.IsReel
+	}
+	return false
+}
+
+// A reply to someone's story, sent to the story's owner
+type StoryReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Message       *Message               `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"` // The newly created message
+	StoryId       int64                  `protobuf:"varint,1,opt,name=story_id,json=storyId,proto3" json:"story_id,omitempty"`
+	StoryOwnerId  int64                  `protobuf:"varint,2,opt,name=story_owner_id,json=storyOwnerId,proto3" json:"story_owner_id,omitempty"`
+	MediaUrl      string                 `protobuf:"bytes,3,opt,name=media_url,json=mediaUrl,proto3" json:"media_url,omitempty"`
+	MediaType     string                 `protobuf:"bytes,4,opt,name=media_type,json=mediaType,proto3" json:"media_type,omitempty"` // "image" or "video"
+	Expired       bool                   `protobuf:"varint,5,opt,name=expired,proto3" json:"expired,omitempty"`                     // Stories last 24 hours; clients show a placeholder after that
+	ExpiresAt     string                 `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // RFC3339, when the story ran out
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SendMessageResponse) Reset() {
-	*x = SendMessageResponse{}
+func (x *StoryReply) Reset() {
+	*x = StoryReply{}
+	mi := &file_message_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StoryReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StoryReply) ProtoMessage() {}
+
+func (x *StoryReply) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StoryReply.ProtoReflect.Descriptor instead.
+func (*StoryReply) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *StoryReply) GetStoryId() int64 {
+	if x != nil {
+		return x.StoryId
+	}
+	return 0
+}
+
+func (x *StoryReply) GetStoryOwnerId() int64 {
+	if x != nil {
+		return x.StoryOwnerId
+	}
+	return 0
+}
+
+func (x *StoryReply) GetMediaUrl() string {
+	if x != nil {
+		return x.MediaUrl
+	}
+	return ""
+}
+
+func (x *StoryReply) GetMediaType() string {
+	if x != nil {
+		return x.MediaType
+	}
+	return ""
+}
+
+func (x *StoryReply) GetExpired() bool {
+	if x != nil {
+		return x.Expired
+	}
+	return false
+}
+
+func (x *StoryReply) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+// A user's profile sent as a card. Filled in from user-service when read,
+// so it shows the current username and picture.
+type ProfileCard struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	UserId            int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username          string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	ProfilePictureUrl string                 `protobuf:"bytes,3,opt,name=profile_picture_url,json=profilePictureUrl,proto3" json:"profile_picture_url,omitempty"`
+	IsVerified        bool                   `protobuf:"varint,4,opt,name=is_verified,json=isVerified,proto3" json:"is_verified,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ProfileCard) Reset() {
+	*x = ProfileCard{}
 	mi := &file_message_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SendMessageResponse) String() string {
+func (x *ProfileCard) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendMessageResponse) ProtoMessage() {}
+func (*ProfileCard) ProtoMessage() {}
 
-func (x *SendMessageResponse) ProtoReflect() protoreflect.Message {
+func (x *ProfileCard) ProtoReflect() protoreflect.Message {
 	mi := &file_message_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -545,43 +733,63 @@ func (x *SendMessageResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendMessageResponse.ProtoReflect.Descriptor instead.
-func (*SendMessageResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ProfileCard.ProtoReflect.Descriptor instead.
+func (*ProfileCard) Descriptor() ([]byte, []int) {
 	return file_message_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *SendMessageResponse) GetMessage() *Message {
+func (x *ProfileCard) GetUserId() int64 {
 	if x != nil {
-		return x.Message
+		return x.UserId
 	}
-	return nil
+	return 0
 }
 
-// --- CreateConversation ---
-type CreateConversationRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	CreatorId      int64                  `protobuf:"varint,1,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`                       // From JWT
-	ParticipantIds []int64                `protobuf:"varint,2,rep,packed,name=participant_ids,json=participantIds,proto3" json:"participant_ids,omitempty"` // List of *other* user IDs
-	GroupName      string                 `protobuf:"bytes,3,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"`                        // If present, creates a group
-	GroupImageUrl  string                 `protobuf:"bytes,4,opt,name=group_image_url,json=groupImageUrl,proto3" json:"group_image_url,omitempty"`          // Group profile picture
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *ProfileCard) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
 }
 
-func (x *CreateConversationRequest) Reset() {
-	*x = CreateConversationRequest{}
+func (x *ProfileCard) GetProfilePictureUrl() string {
+	if x != nil {
+		return x.ProfilePictureUrl
+	}
+	return ""
+}
+
+func (x *ProfileCard) GetIsVerified() bool {
+	if x != nil {
+		return x.IsVerified
+	}
+	return false
+}
+
+type Location struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Address       string                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"` // Optional
+	Latitude      float64                `protobuf:"fixed64,3,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude     float64                `protobuf:"fixed64,4,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Location) Reset() {
+	*x = Location{}
 	mi := &file_message_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateConversationRequest) String() string {
+func (x *Location) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateConversationRequest) ProtoMessage() {}
+func (*Location) ProtoMessage() {}
 
-func (x *CreateConversationRequest) ProtoReflect() protoreflect.Message {
+func (x *Location) ProtoReflect() protoreflect.Message {
 	mi := &file_message_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -593,63 +801,1245 @@ func (x *CreateConversationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateConversationRequest.ProtoReflect.Descriptor instead.
-func (*CreateConversationRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use Location.ProtoReflect.Descriptor instead.
+func (*Location) Descriptor() ([]byte, []int) {
 	return file_message_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *CreateConversationRequest) GetCreatorId() int64 {
+func (x *Location) GetName() string {
 	if x != nil {
-		return x.CreatorId
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Location) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Location) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
 	}
 	return 0
 }
 
-func (x *CreateConversationRequest) GetParticipantIds() []int64 {
+func (x *Location) GetLongitude() float64 {
 	if x != nil {
-		return x.ParticipantIds
+		return x.Longitude
+	}
+	return 0
+}
+
+// Something that happened in a conversation, written by the server
+type SystemEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// "participant_added", "participant_removed", "participant_left",
+	// "group_renamed" or "group_photo_changed"
+	Event          string `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	ActorId        int64  `protobuf:"varint,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	ActorUsername  string `protobuf:"bytes,3,opt,name=actor_username,json=actorUsername,proto3" json:"actor_username,omitempty"`
+	TargetId       int64  `protobuf:"varint,4,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"` // Added or removed user
+	TargetUsername string `protobuf:"bytes,5,opt,name=target_username,json=targetUsername,proto3" json:"target_username,omitempty"`
+	GroupName      string `protobuf:"bytes,6,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"` // New name, for "group_renamed"
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SystemEvent) Reset() {
+	*x = SystemEvent{}
+	mi := &file_message_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemEvent) ProtoMessage() {}
+
+func (x *SystemEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemEvent.ProtoReflect.Descriptor instead.
+func (*SystemEvent) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SystemEvent) GetEvent() string {
+	if x != nil {
+		return x.Event
+	}
+	return ""
+}
+
+func (x *SystemEvent) GetActorId() int64 {
+	if x != nil {
+		return x.ActorId
+	}
+	return 0
+}
+
+func (x *SystemEvent) GetActorUsername() string {
+	if x != nil {
+		return x.ActorUsername
+	}
+	return ""
+}
+
+func (x *SystemEvent) GetTargetId() int64 {
+	if x != nil {
+		return x.TargetId
+	}
+	return 0
+}
+
+func (x *SystemEvent) GetTargetUsername() string {
+	if x != nil {
+		return x.TargetUsername
+	}
+	return ""
+}
+
+func (x *SystemEvent) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+// --- GetConversations ---
+type GetConversationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // From JWT
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageOffset    int32                  `protobuf:"varint,3,opt,name=page_offset,json=pageOffset,proto3" json:"page_offset,omitempty"` // Deprecated: ignored, use cursor
+	Cursor        string                 `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`                            // next_cursor from the previous page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetConversationsRequest) Reset() {
+	*x = GetConversationsRequest{}
+	mi := &file_message_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetConversationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConversationsRequest) ProtoMessage() {}
+
+func (x *GetConversationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConversationsRequest.ProtoReflect.Descriptor instead.
+func (*GetConversationsRequest) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetConversationsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetConversationsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetConversationsRequest) GetPageOffset() int32 {
+	if x != nil {
+		return x.PageOffset
+	}
+	return 0
+}
+
+func (x *GetConversationsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type GetConversationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Conversations []*Conversation        `protobuf:"bytes,1,rep,name=conversations,proto3" json:"conversations,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"` // Empty on the last page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetConversationsResponse) Reset() {
+	*x = GetConversationsResponse{}
+	mi := &file_message_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetConversationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConversationsResponse) ProtoMessage() {}
+
+func (x *GetConversationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConversationsResponse.ProtoReflect.Descriptor instead.
+func (*GetConversationsResponse) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetConversationsResponse) GetConversations() []*Conversation {
+	if x != nil {
+		return x.Conversations
+	}
+	return nil
+}
+
+func (x *GetConversationsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+// --- GetMessages ---
+type GetMessagesRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                        // From JWT (for validation)
+	ConversationId string                 `protobuf:"bytes,2,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"` // From URL
+	PageSize       int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageOffset     int32                  `protobuf:"varint,4,opt,name=page_offset,json=pageOffset,proto3" json:"page_offset,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetMessagesRequest) Reset() {
+	*x = GetMessagesRequest{}
+	mi := &file_message_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMessagesRequest) ProtoMessage() {}
+
+func (x *GetMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMessagesRequest.ProtoReflect.Descriptor instead.
+func (*GetMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetMessagesRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetMessagesRequest) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *GetMessagesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetMessagesRequest) GetPageOffset() int32 {
+	if x != nil {
+		return x.PageOffset
+	}
+	return 0
+}
+
+type GetMessagesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Messages      []*Message             `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMessagesResponse) Reset() {
+	*x = GetMessagesResponse{}
+	mi := &file_message_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMessagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMessagesResponse) ProtoMessage() {}
+
+func (x *GetMessagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMessagesResponse.ProtoReflect.Descriptor instead.
+func (*GetMessagesResponse) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetMessagesResponse) GetMessages() []*Message {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+// --- SendMessage ---
+type SendMessageRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SenderId       int64                  `protobuf:"varint,1,opt,name=sender_id,json=senderId,proto3" json:"sender_id,omitempty"` // From JWT
+	ConversationId string                 `protobuf:"bytes,2,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	Content        string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`                      // Text content (optional if media is present)
+	MediaUrl       string                 `protobuf:"bytes,4,opt,name=media_url,json=mediaUrl,proto3" json:"media_url,omitempty"`    // URL of uploaded media (optional)
+	MediaType      string                 `protobuf:"bytes,5,opt,name=media_type,json=mediaType,proto3" json:"media_type,omitempty"` // "image", "gif", "video" (optional)
+	// "text", "media", "story_reply", "profile" or "location". Defaults to
+	// "media" when media_url is set and "text" otherwise. "system" messages are
+	// only written by the server.
+	Type string `protobuf:"bytes,6,opt,name=type,proto3" json:"type,omitempty"`
+	// Internal: only post-service sends posts, after checking every participant can see them
+	SharedPost       *SharedPost  `protobuf:"bytes,7,opt,name=shared_post,json=sharedPost,proto3" json:"shared_post,omitempty"`
+	StoryReply       *StoryReply  `protobuf:"bytes,8,opt,name=story_reply,json=storyReply,proto3" json:"story_reply,omitempty"`
+	Profile          *ProfileCard `protobuf:"bytes,9,opt,name=profile,proto3" json:"profile,omitempty"` // Only user_id is read; the rest is filled in
+	Location         *Location    `protobuf:"bytes,10,opt,name=location,proto3" json:"location,omitempty"`
+	ReplyToMessageId string       `protobuf:"bytes,11,opt,name=reply_to_message_id,json=replyToMessageId,proto3" json:"reply_to_message_id,omitempty"` // Optional; must be in the same conversation
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SendMessageRequest) Reset() {
+	*x = SendMessageRequest{}
+	mi := &file_message_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendMessageRequest) ProtoMessage() {}
+
+func (x *SendMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendMessageRequest.ProtoReflect.Descriptor instead.
+func (*SendMessageRequest) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SendMessageRequest) GetSenderId() int64 {
+	if x != nil {
+		return x.SenderId
+	}
+	return 0
+}
+
+func (x *SendMessageRequest) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *SendMessageRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *SendMessageRequest) GetMediaUrl() string {
+	if x != nil {
+		return x.MediaUrl
+	}
+	return ""
+}
+
+func (x *SendMessageRequest) GetMediaType() string {
+	if x != nil {
+		return x.MediaType
+	}
+	return ""
+}
+
+func (x *SendMessageRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *SendMessageRequest) GetSharedPost() *SharedPost {
+	if x != nil {
+		return x.SharedPost
+	}
+	return nil
+}
+
+func (x *SendMessageRequest) GetStoryReply() *StoryReply {
+	if x != nil {
+		return x.StoryReply
+	}
+	return nil
+}
+
+func (x *SendMessageRequest) GetProfile() *ProfileCard {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+func (x *SendMessageRequest) GetLocation() *Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *SendMessageRequest) GetReplyToMessageId() string {
+	if x != nil {
+		return x.ReplyToMessageId
+	}
+	return ""
+}
+
+type SendMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       *Message               `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"` // The newly created message
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendMessageResponse) Reset() {
+	*x = SendMessageResponse{}
+	mi := &file_message_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendMessageResponse) ProtoMessage() {}
+
+func (x *SendMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendMessageResponse.ProtoReflect.Descriptor instead.
+func (*SendMessageResponse) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SendMessageResponse) GetMessage() *Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+// --- CreateConversation ---
+type CreateConversationRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	CreatorId      int64                  `protobuf:"varint,1,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`                       // From JWT
+	ParticipantIds []int64                `protobuf:"varint,2,rep,packed,name=participant_ids,json=participantIds,proto3" json:"participant_ids,omitempty"` // List of *other* user IDs
+	GroupName      string                 `protobuf:"bytes,3,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"`                        // If present, creates a group
+	GroupImageUrl  string                 `protobuf:"bytes,4,opt,name=group_image_url,json=groupImageUrl,proto3" json:"group_image_url,omitempty"`          // Group profile picture
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateConversationRequest) Reset() {
+	*x = CreateConversationRequest{}
+	mi := &file_message_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateConversationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateConversationRequest) ProtoMessage() {}
+
+func (x *CreateConversationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateConversationRequest.ProtoReflect.Descriptor instead.
+func (*CreateConversationRequest) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CreateConversationRequest) GetCreatorId() int64 {
+	if x != nil {
+		return x.CreatorId
+	}
+	return 0
+}
+
+func (x *CreateConversationRequest) GetParticipantIds() []int64 {
+	if x != nil {
+		return x.ParticipantIds
+	}
+	return nil
+}
+
+func (x *CreateConversationRequest) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+func (x *CreateConversationRequest) GetGroupImageUrl() string {
+	if x != nil {
+		return x.GroupImageUrl
+	}
+	return ""
+}
+
+// --- UnsendMessage ---
+type UnsendMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // From JWT
+	MessageId     string                 `protobuf:"bytes,2,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnsendMessageRequest) Reset() {
+	*x = UnsendMessageRequest{}
+	mi := &file_message_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnsendMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsendMessageRequest) ProtoMessage() {}
+
+func (x *UnsendMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsendMessageRequest.ProtoReflect.Descriptor instead.
+func (*UnsendMessageRequest) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *UnsendMessageRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UnsendMessageRequest) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+type UnsendMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnsendMessageResponse) Reset() {
+	*x = UnsendMessageResponse{}
+	mi := &file_message_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnsendMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsendMessageResponse) ProtoMessage() {}
+
+func (x *UnsendMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsendMessageResponse.ProtoReflect.Descriptor instead.
+func (*UnsendMessageResponse) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *UnsendMessageResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// --- DeleteConversation ---
+type DeleteConversationRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // From JWT
+	ConversationId string                 `protobuf:"bytes,2,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DeleteConversationRequest) Reset() {
+	*x = DeleteConversationRequest{}
+	mi := &file_message_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteConversationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteConversationRequest) ProtoMessage() {}
+
+func (x *DeleteConversationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteConversationRequest.ProtoReflect.Descriptor instead.
+func (*DeleteConversationRequest) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *DeleteConversationRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *DeleteConversationRequest) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+type DeleteConversationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteConversationResponse) Reset() {
+	*x = DeleteConversationResponse{}
+	mi := &file_message_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteConversationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteConversationResponse) ProtoMessage() {}
+
+func (x *DeleteConversationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteConversationResponse.ProtoReflect.Descriptor instead.
+func (*DeleteConversationResponse) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *DeleteConversationResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// --- GetVideoCallToken ---
+type GetVideoCallTokenRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // From JWT
+	ConversationId string                 `protobuf:"bytes,2,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetVideoCallTokenRequest) Reset() {
+	*x = GetVideoCallTokenRequest{}
+	mi := &file_message_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVideoCallTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVideoCallTokenRequest) ProtoMessage() {}
+
+func (x *GetVideoCallTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVideoCallTokenRequest.ProtoReflect.Descriptor instead.
+func (*GetVideoCallTokenRequest) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetVideoCallTokenRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetVideoCallTokenRequest) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+type GetVideoCallTokenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`                 // The token for the video SDK
+	RoomId        string                 `protobuf:"bytes,2,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"` // The room ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVideoCallTokenResponse) Reset() {
+	*x = GetVideoCallTokenResponse{}
+	mi := &file_message_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVideoCallTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVideoCallTokenResponse) ProtoMessage() {}
+
+func (x *GetVideoCallTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVideoCallTokenResponse.ProtoReflect.Descriptor instead.
+func (*GetVideoCallTokenResponse) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetVideoCallTokenResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *GetVideoCallTokenResponse) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+// --- Group Management ---
+type AddParticipantRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // From JWT - must be group member
+	ConversationId string                 `protobuf:"bytes,2,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	ParticipantId  int64                  `protobuf:"varint,3,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"` // User to add
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AddParticipantRequest) Reset() {
+	*x = AddParticipantRequest{}
+	mi := &file_message_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddParticipantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddParticipantRequest) ProtoMessage() {}
+
+func (x *AddParticipantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddParticipantRequest.ProtoReflect.Descriptor instead.
+func (*AddParticipantRequest) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *AddParticipantRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AddParticipantRequest) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *AddParticipantRequest) GetParticipantId() int64 {
+	if x != nil {
+		return x.ParticipantId
+	}
+	return 0
+}
+
+type AddParticipantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddParticipantResponse) Reset() {
+	*x = AddParticipantResponse{}
+	mi := &file_message_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddParticipantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddParticipantResponse) ProtoMessage() {}
+
+func (x *AddParticipantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddParticipantResponse.ProtoReflect.Descriptor instead.
+func (*AddParticipantResponse) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *AddParticipantResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type RemoveParticipantRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // From JWT - must be group member
+	ConversationId string                 `protobuf:"bytes,2,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	ParticipantId  int64                  `protobuf:"varint,3,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"` // User to remove
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RemoveParticipantRequest) Reset() {
+	*x = RemoveParticipantRequest{}
+	mi := &file_message_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveParticipantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveParticipantRequest) ProtoMessage() {}
+
+func (x *RemoveParticipantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveParticipantRequest.ProtoReflect.Descriptor instead.
+func (*RemoveParticipantRequest) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *RemoveParticipantRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *RemoveParticipantRequest) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *RemoveParticipantRequest) GetParticipantId() int64 {
+	if x != nil {
+		return x.ParticipantId
+	}
+	return 0
+}
+
+type RemoveParticipantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveParticipantResponse) Reset() {
+	*x = RemoveParticipantResponse{}
+	mi := &file_message_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveParticipantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveParticipantResponse) ProtoMessage() {}
+
+func (x *RemoveParticipantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveParticipantResponse.ProtoReflect.Descriptor instead.
+func (*RemoveParticipantResponse) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *RemoveParticipantResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type UpdateGroupInfoRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // From JWT - must be group member
+	ConversationId string                 `protobuf:"bytes,2,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	GroupName      string                 `protobuf:"bytes,3,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"`
+	GroupImageUrl  string                 `protobuf:"bytes,4,opt,name=group_image_url,json=groupImageUrl,proto3" json:"group_image_url,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateGroupInfoRequest) Reset() {
+	*x = UpdateGroupInfoRequest{}
+	mi := &file_message_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateGroupInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGroupInfoRequest) ProtoMessage() {}
+
+func (x *UpdateGroupInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGroupInfoRequest.ProtoReflect.Descriptor instead.
+func (*UpdateGroupInfoRequest) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *UpdateGroupInfoRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UpdateGroupInfoRequest) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *UpdateGroupInfoRequest) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+func (x *UpdateGroupInfoRequest) GetGroupImageUrl() string {
+	if x != nil {
+		return x.GroupImageUrl
+	}
+	return ""
+}
+
+type UpdateGroupInfoResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateGroupInfoResponse) Reset() {
+	*x = UpdateGroupInfoResponse{}
+	mi := &file_message_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateGroupInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGroupInfoResponse) ProtoMessage() {}
+
+func (x *UpdateGroupInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *CreateConversationRequest) GetGroupName() string {
-	if x != nil {
-		return x.GroupName
-	}
-	return ""
+// Deprecated: Use UpdateGroupInfoResponse.ProtoReflect.Descriptor instead.
+func (*UpdateGroupInfoResponse) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *CreateConversationRequest) GetGroupImageUrl() string {
+func (x *UpdateGroupInfoResponse) GetMessage() string {
 	if x != nil {
-		return x.GroupImageUrl
+		return x.Message
 	}
 	return ""
 }
 
-// --- UnsendMessage ---
-type UnsendMessageRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // From JWT
-	MessageId     string                 `protobuf:"bytes,2,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type LeaveGroupRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // From JWT
+	ConversationId string                 `protobuf:"bytes,2,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *UnsendMessageRequest) Reset() {
-	*x = UnsendMessageRequest{}
-	mi := &file_message_proto_msgTypes[9]
+func (x *LeaveGroupRequest) Reset() {
+	*x = LeaveGroupRequest{}
+	mi := &file_message_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UnsendMessageRequest) String() string {
+func (x *LeaveGroupRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnsendMessageRequest) ProtoMessage() {}
+func (*LeaveGroupRequest) ProtoMessage() {}
 
-func (x *UnsendMessageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_message_proto_msgTypes[9]
+func (x *LeaveGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -660,47 +2050,47 @@ func (x *UnsendMessageRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnsendMessageRequest.ProtoReflect.Descriptor instead.
-func (*UnsendMessageRequest) Descriptor() ([]byte, []int) {
-	return file_message_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use LeaveGroupRequest.ProtoReflect.Descriptor instead.
+func (*LeaveGroupRequest) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *UnsendMessageRequest) GetUserId() int64 {
+func (x *LeaveGroupRequest) GetUserId() int64 {
 	if x != nil {
 		return x.UserId
 	}
 	return 0
 }
 
-func (x *UnsendMessageRequest) GetMessageId() string {
+func (x *LeaveGroupRequest) GetConversationId() string {
 	if x != nil {
-		return x.MessageId
+		return x.ConversationId
 	}
 	return ""
 }
 
-type UnsendMessageResponse struct {
+type LeaveGroupResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UnsendMessageResponse) Reset() {
-	*x = UnsendMessageResponse{}
-	mi := &file_message_proto_msgTypes[10]
+func (x *LeaveGroupResponse) Reset() {
+	*x = LeaveGroupResponse{}
+	mi := &file_message_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UnsendMessageResponse) String() string {
+func (x *LeaveGroupResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnsendMessageResponse) ProtoMessage() {}
+func (*LeaveGroupResponse) ProtoMessage() {}
 
-func (x *UnsendMessageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_message_proto_msgTypes[10]
+func (x *LeaveGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -711,42 +2101,43 @@ func (x *UnsendMessageResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnsendMessageResponse.ProtoReflect.Descriptor instead.
-func (*UnsendMessageResponse) Descriptor() ([]byte, []int) {
-	return file_message_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use LeaveGroupResponse.ProtoReflect.Descriptor instead.
+func (*LeaveGroupResponse) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *UnsendMessageResponse) GetMessage() string {
+func (x *LeaveGroupResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-// --- DeleteConversation ---
-type DeleteConversationRequest struct {
+// --- SearchMessages ---
+type SearchMessagesRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
-	UserId         int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // From JWT
+	UserId         int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // From JWT (for validation)
 	ConversationId string                 `protobuf:"bytes,2,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	Query          string                 `protobuf:"bytes,3,opt,name=query,proto3" json:"query,omitempty"` // Search query
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
-func (x *DeleteConversationRequest) Reset() {
-	*x = DeleteConversationRequest{}
-	mi := &file_message_proto_msgTypes[11]
+func (x *SearchMessagesRequest) Reset() {
+	*x = SearchMessagesRequest{}
+	mi := &file_message_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteConversationRequest) String() string {
+func (x *SearchMessagesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteConversationRequest) ProtoMessage() {}
+func (*SearchMessagesRequest) ProtoMessage() {}
 
-func (x *DeleteConversationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_message_proto_msgTypes[11]
+func (x *SearchMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -757,47 +2148,54 @@ func (x *DeleteConversationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteConversationRequest.ProtoReflect.Descriptor instead.
-func (*DeleteConversationRequest) Descriptor() ([]byte, []int) {
-	return file_message_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use SearchMessagesRequest.ProtoReflect.Descriptor instead.
+func (*SearchMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *DeleteConversationRequest) GetUserId() int64 {
+func (x *SearchMessagesRequest) GetUserId() int64 {
 	if x != nil {
 		return x.UserId
 	}
 	return 0
 }
 
-func (x *DeleteConversationRequest) GetConversationId() string {
+func (x *SearchMessagesRequest) GetConversationId() string {
 	if x != nil {
 		return x.ConversationId
 	}
 	return ""
 }
 
-type DeleteConversationResponse struct {
+func (x *SearchMessagesRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+type SearchMessagesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Messages      []*Message             `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteConversationResponse) Reset() {
-	*x = DeleteConversationResponse{}
-	mi := &file_message_proto_msgTypes[12]
+func (x *SearchMessagesResponse) Reset() {
+	*x = SearchMessagesResponse{}
+	mi := &file_message_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteConversationResponse) String() string {
+func (x *SearchMessagesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteConversationResponse) ProtoMessage() {}
+func (*SearchMessagesResponse) ProtoMessage() {}
 
-func (x *DeleteConversationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_message_proto_msgTypes[12]
+func (x *SearchMessagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -808,42 +2206,43 @@ func (x *DeleteConversationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteConversationResponse.ProtoReflect.Descriptor instead.
-func (*DeleteConversationResponse) Descriptor() ([]byte, []int) {
-	return file_message_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use SearchMessagesResponse.ProtoReflect.Descriptor instead.
+func (*SearchMessagesResponse) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *DeleteConversationResponse) GetMessage() string {
+func (x *SearchMessagesResponse) GetMessages() []*Message {
 	if x != nil {
-		return x.Message
+		return x.Messages
 	}
-	return ""
+	return nil
 }
 
-// --- GetVideoCallToken ---
-type GetVideoCallTokenRequest struct {
+// --- MarkConversationRead ---
+type MarkConversationReadRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	UserId         int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // From JWT
 	ConversationId string                 `protobuf:"bytes,2,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	MessageId      string                 `protobuf:"bytes,3,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"` // Read up to and including this message; empty means the latest
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
-func (x *GetVideoCallTokenRequest) Reset() {
-	*x = GetVideoCallTokenRequest{}
-	mi := &file_message_proto_msgTypes[13]
+func (x *MarkConversationReadRequest) Reset() {
+	*x = MarkConversationReadRequest{}
+	mi := &file_message_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetVideoCallTokenRequest) String() string {
+func (x *MarkConversationReadRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetVideoCallTokenRequest) ProtoMessage() {}
+func (*MarkConversationReadRequest) ProtoMessage() {}
 
-func (x *GetVideoCallTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_message_proto_msgTypes[13]
+func (x *MarkConversationReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -854,48 +2253,56 @@ func (x *GetVideoCallTokenRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetVideoCallTokenRequest.ProtoReflect.Descriptor instead.
-func (*GetVideoCallTokenRequest) Descriptor() ([]byte, []int) {
-	return file_message_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use MarkConversationReadRequest.ProtoReflect.Descriptor instead.
+func (*MarkConversationReadRequest) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *GetVideoCallTokenRequest) GetUserId() int64 {
+func (x *MarkConversationReadRequest) GetUserId() int64 {
 	if x != nil {
 		return x.UserId
 	}
 	return 0
 }
 
-func (x *GetVideoCallTokenRequest) GetConversationId() string {
+func (x *MarkConversationReadRequest) GetConversationId() string {
 	if x != nil {
 		return x.ConversationId
 	}
 	return ""
 }
 
-type GetVideoCallTokenResponse struct {
+func (x *MarkConversationReadRequest) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+type GetDirectMessageCountsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`                 // The token for the video SDK
-	RoomId        string                 `protobuf:"bytes,2,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"` // The room ID
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PeerIds       []int64                `protobuf:"varint,2,rep,packed,name=peer_ids,json=peerIds,proto3" json:"peer_ids,omitempty"`
+	SinceDays     int32                  `protobuf:"varint,3,opt,name=since_days,json=sinceDays,proto3" json:"since_days,omitempty"` // Only count messages from the last N days (default 30)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetVideoCallTokenResponse) Reset() {
-	*x = GetVideoCallTokenResponse{}
-	mi := &file_message_proto_msgTypes[14]
+func (x *GetDirectMessageCountsRequest) Reset() {
+	*x = GetDirectMessageCountsRequest{}
+	mi := &file_message_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetVideoCallTokenResponse) String() string {
+func (x *GetDirectMessageCountsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetVideoCallTokenResponse) ProtoMessage() {}
+func (*GetDirectMessageCountsRequest) ProtoMessage() {}
 
-func (x *GetVideoCallTokenResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_message_proto_msgTypes[14]
+func (x *GetDirectMessageCountsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -906,50 +2313,99 @@ func (x *GetVideoCallTokenResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetVideoCallTokenResponse.ProtoReflect.Descriptor instead.
-func (*GetVideoCallTokenResponse) Descriptor() ([]byte, []int) {
-	return file_message_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use GetDirectMessageCountsRequest.ProtoReflect.Descriptor instead.
+func (*GetDirectMessageCountsRequest) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *GetVideoCallTokenResponse) GetToken() string {
+func (x *GetDirectMessageCountsRequest) GetUserId() int64 {
 	if x != nil {
-		return x.Token
+		return x.UserId
 	}
-	return ""
+	return 0
 }
 
-func (x *GetVideoCallTokenResponse) GetRoomId() string {
+func (x *GetDirectMessageCountsRequest) GetPeerIds() []int64 {
 	if x != nil {
-		return x.RoomId
+		return x.PeerIds
 	}
-	return ""
+	return nil
 }
 
-// --- Group Management ---
-type AddParticipantRequest struct {
+func (x *GetDirectMessageCountsRequest) GetSinceDays() int32 {
+	if x != nil {
+		return x.SinceDays
+	}
+	return 0
+}
+
+type GetDirectMessageCountsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Counts        map[int64]int32        `protobuf:"bytes,1,rep,name=counts,proto3" json:"counts,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"` // peer_id -> messages exchanged; peers with none are omitted
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDirectMessageCountsResponse) Reset() {
+	*x = GetDirectMessageCountsResponse{}
+	mi := &file_message_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDirectMessageCountsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDirectMessageCountsResponse) ProtoMessage() {}
+
+func (x *GetDirectMessageCountsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDirectMessageCountsResponse.ProtoReflect.Descriptor instead.
+func (*GetDirectMessageCountsResponse) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetDirectMessageCountsResponse) GetCounts() map[int64]int32 {
+	if x != nil {
+		return x.Counts
+	}
+	return nil
+}
+
+type GetConversationParticipantsRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
-	UserId         int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // From JWT - must be group member
+	UserId         int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // Must be a participant
 	ConversationId string                 `protobuf:"bytes,2,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
-	ParticipantId  int64                  `protobuf:"varint,3,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"` // User to add
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
-func (x *AddParticipantRequest) Reset() {
-	*x = AddParticipantRequest{}
-	mi := &file_message_proto_msgTypes[15]
+func (x *GetConversationParticipantsRequest) Reset() {
+	*x = GetConversationParticipantsRequest{}
+	mi := &file_message_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddParticipantRequest) String() string {
+func (x *GetConversationParticipantsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddParticipantRequest) ProtoMessage() {}
+func (*GetConversationParticipantsRequest) ProtoMessage() {}
 
-func (x *AddParticipantRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_message_proto_msgTypes[15]
+func (x *GetConversationParticipantsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -960,54 +2416,101 @@ func (x *AddParticipantRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddParticipantRequest.ProtoReflect.Descriptor instead.
-func (*AddParticipantRequest) Descriptor() ([]byte, []int) {
-	return file_message_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use GetConversationParticipantsRequest.ProtoReflect.Descriptor instead.
+func (*GetConversationParticipantsRequest) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *AddParticipantRequest) GetUserId() int64 {
+func (x *GetConversationParticipantsRequest) GetUserId() int64 {
 	if x != nil {
 		return x.UserId
 	}
 	return 0
 }
 
-func (x *AddParticipantRequest) GetConversationId() string {
+func (x *GetConversationParticipantsRequest) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+type GetConversationParticipantsResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ParticipantIds []int64                `protobuf:"varint,1,rep,packed,name=participant_ids,json=participantIds,proto3" json:"participant_ids,omitempty"` // Everyone except user_id
+	IsGroup        bool                   `protobuf:"varint,2,opt,name=is_group,json=isGroup,proto3" json:"is_group,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetConversationParticipantsResponse) Reset() {
+	*x = GetConversationParticipantsResponse{}
+	mi := &file_message_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetConversationParticipantsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConversationParticipantsResponse) ProtoMessage() {}
+
+func (x *GetConversationParticipantsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConversationParticipantsResponse.ProtoReflect.Descriptor instead.
+func (*GetConversationParticipantsResponse) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetConversationParticipantsResponse) GetParticipantIds() []int64 {
 	if x != nil {
-		return x.ConversationId
+		return x.ParticipantIds
 	}
-	return ""
+	return nil
 }
 
-func (x *AddParticipantRequest) GetParticipantId() int64 {
+func (x *GetConversationParticipantsResponse) GetIsGroup() bool {
 	if x != nil {
-		return x.ParticipantId
+		return x.IsGroup
 	}
-	return 0
+	return false
 }
 
-type AddParticipantResponse struct {
+// --- GetPresence ---
+type GetPresenceRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	ViewerId      int64                  `protobuf:"varint,1,opt,name=viewer_id,json=viewerId,proto3" json:"viewer_id,omitempty"` // From JWT
+	UserIds       []int64                `protobuf:"varint,2,rep,packed,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddParticipantResponse) Reset() {
-	*x = AddParticipantResponse{}
-	mi := &file_message_proto_msgTypes[16]
+func (x *GetPresenceRequest) Reset() {
+	*x = GetPresenceRequest{}
+	mi := &file_message_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddParticipantResponse) String() string {
+func (x *GetPresenceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddParticipantResponse) ProtoMessage() {}
+func (*GetPresenceRequest) ProtoMessage() {}
 
-func (x *AddParticipantResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_message_proto_msgTypes[16]
+func (x *GetPresenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1018,42 +2521,49 @@ func (x *AddParticipantResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddParticipantResponse.ProtoReflect.Descriptor instead.
-func (*AddParticipantResponse) Descriptor() ([]byte, []int) {
-	return file_message_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use GetPresenceRequest.ProtoReflect.Descriptor instead.
+func (*GetPresenceRequest) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *AddParticipantResponse) GetMessage() string {
+func (x *GetPresenceRequest) GetViewerId() int64 {
 	if x != nil {
-		return x.Message
+		return x.ViewerId
 	}
-	return ""
+	return 0
 }
 
-type RemoveParticipantRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	UserId         int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // From JWT - must be group member
-	ConversationId string                 `protobuf:"bytes,2,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
-	ParticipantId  int64                  `protobuf:"varint,3,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"` // User to remove
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *GetPresenceRequest) GetUserIds() []int64 {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
 }
 
-func (x *RemoveParticipantRequest) Reset() {
-	*x = RemoveParticipantRequest{}
-	mi := &file_message_proto_msgTypes[17]
+type Presence struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Online        bool                   `protobuf:"varint,2,opt,name=online,proto3" json:"online,omitempty"`
+	LastSeenAt    string                 `protobuf:"bytes,3,opt,name=last_seen_at,json=lastSeenAt,proto3" json:"last_seen_at,omitempty"` // RFC 3339; empty while online, if never seen, or if hidden
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Presence) Reset() {
+	*x = Presence{}
+	mi := &file_message_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveParticipantRequest) String() string {
+func (x *Presence) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveParticipantRequest) ProtoMessage() {}
+func (*Presence) ProtoMessage() {}
 
-func (x *RemoveParticipantRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_message_proto_msgTypes[17]
+func (x *Presence) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1064,54 +2574,56 @@ func (x *RemoveParticipantRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveParticipantRequest.ProtoReflect.Descriptor instead.
-func (*RemoveParticipantRequest) Descriptor() ([]byte, []int) {
-	return file_message_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use Presence.ProtoReflect.Descriptor instead.
+func (*Presence) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *RemoveParticipantRequest) GetUserId() int64 {
+func (x *Presence) GetUserId() int64 {
 	if x != nil {
 		return x.UserId
 	}
 	return 0
 }
 
-func (x *RemoveParticipantRequest) GetConversationId() string {
+func (x *Presence) GetOnline() bool {
 	if x != nil {
-		return x.ConversationId
+		return x.Online
 	}
-	return ""
+	return false
 }
 
-func (x *RemoveParticipantRequest) GetParticipantId() int64 {
+func (x *Presence) GetLastSeenAt() string {
 	if x != nil {
-		return x.ParticipantId
+		return x.LastSeenAt
 	}
-	return 0
+	return ""
 }
 
-type RemoveParticipantResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+type GetPresenceResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// In request order, once per user. Users who hide their activity status, users blocked by
+	// or blocking the viewer and unknown IDs show as offline with no last seen.
+	Presences     []*Presence `protobuf:"bytes,1,rep,name=presences,proto3" json:"presences,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RemoveParticipantResponse) Reset() {
-	*x = RemoveParticipantResponse{}
-	mi := &file_message_proto_msgTypes[18]
+func (x *GetPresenceResponse) Reset() {
+	*x = GetPresenceResponse{}
+	mi := &file_message_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveParticipantResponse) String() string {
+func (x *GetPresenceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveParticipantResponse) ProtoMessage() {}
+func (*GetPresenceResponse) ProtoMessage() {}
 
-func (x *RemoveParticipantResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_message_proto_msgTypes[18]
+func (x *GetPresenceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1122,43 +2634,43 @@ func (x *RemoveParticipantResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveParticipantResponse.ProtoReflect.Descriptor instead.
-func (*RemoveParticipantResponse) Descriptor() ([]byte, []int) {
-	return file_message_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use GetPresenceResponse.ProtoReflect.Descriptor instead.
+func (*GetPresenceResponse) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *RemoveParticipantResponse) GetMessage() string {
+func (x *GetPresenceResponse) GetPresences() []*Presence {
 	if x != nil {
-		return x.Message
+		return x.Presences
 	}
-	return ""
+	return nil
 }
 
-type UpdateGroupInfoRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	UserId         int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // From JWT - must be group member
-	ConversationId string                 `protobuf:"bytes,2,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
-	GroupName      string                 `protobuf:"bytes,3,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"`
-	GroupImageUrl  string                 `protobuf:"bytes,4,opt,name=group_image_url,json=groupImageUrl,proto3" json:"group_image_url,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+// --- Reactions ---
+type ReactToMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // From JWT
+	MessageId     string                 `protobuf:"bytes,2,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	Emoji         string                 `protobuf:"bytes,3,opt,name=emoji,proto3" json:"emoji,omitempty"` // A single emoji
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateGroupInfoRequest) Reset() {
-	*x = UpdateGroupInfoRequest{}
-	mi := &file_message_proto_msgTypes[19]
+func (x *ReactToMessageRequest) Reset() {
+	*x = ReactToMessageRequest{}
+	mi := &file_message_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateGroupInfoRequest) String() string {
+func (x *ReactToMessageRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateGroupInfoRequest) ProtoMessage() {}
+func (*ReactToMessageRequest) ProtoMessage() {}
 
-func (x *UpdateGroupInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_message_proto_msgTypes[19]
+func (x *ReactToMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1169,61 +2681,55 @@ func (x *UpdateGroupInfoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateGroupInfoRequest.ProtoReflect.Descriptor instead.
-func (*UpdateGroupInfoRequest) Descriptor() ([]byte, []int) {
-	return file_message_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use ReactToMessageRequest.ProtoReflect.Descriptor instead.
+func (*ReactToMessageRequest) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *UpdateGroupInfoRequest) GetUserId() int64 {
+func (x *ReactToMessageRequest) GetUserId() int64 {
 	if x != nil {
 		return x.UserId
 	}
 	return 0
 }
 
-func (x *UpdateGroupInfoRequest) GetConversationId() string {
-	if x != nil {
-		return x.ConversationId
-	}
-	return ""
-}
-
-func (x *UpdateGroupInfoRequest) GetGroupName() string {
+func (x *ReactToMessageRequest) GetMessageId() string {
 	if x != nil {
-		return x.GroupName
+		return x.MessageId
 	}
 	return ""
 }
 
-func (x *UpdateGroupInfoRequest) GetGroupImageUrl() string {
+func (x *ReactToMessageRequest) GetEmoji() string {
 	if x != nil {
-		return x.GroupImageUrl
+		return x.Emoji
 	}
 	return ""
 }
 
-type UpdateGroupInfoResponse struct {
+type RemoveReactionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // From JWT
+	MessageId     string                 `protobuf:"bytes,2,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateGroupInfoResponse) Reset() {
-	*x = UpdateGroupInfoResponse{}
-	mi := &file_message_proto_msgTypes[20]
+func (x *RemoveReactionRequest) Reset() {
+	*x = RemoveReactionRequest{}
+	mi := &file_message_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateGroupInfoResponse) String() string {
+func (x *RemoveReactionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateGroupInfoResponse) ProtoMessage() {}
+func (*RemoveReactionRequest) ProtoMessage() {}
 
-func (x *UpdateGroupInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_message_proto_msgTypes[20]
+func (x *RemoveReactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1234,41 +2740,50 @@ func (x *UpdateGroupInfoResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateGroupInfoResponse.ProtoReflect.Descriptor instead.
-func (*UpdateGroupInfoResponse) Descriptor() ([]byte, []int) {
-	return file_message_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use RemoveReactionRequest.ProtoReflect.Descriptor instead.
+func (*RemoveReactionRequest) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *UpdateGroupInfoResponse) GetMessage() string {
+func (x *RemoveReactionRequest) GetUserId() int64 {
 	if x != nil {
-		return x.Message
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *RemoveReactionRequest) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
 	}
 	return ""
 }
 
-type LeaveGroupRequest struct {
+// A message's reactions after a change
+type MessageReactions struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
-	UserId         int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // From JWT
+	MessageId      string                 `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
 	ConversationId string                 `protobuf:"bytes,2,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	Reactions      []*ReactionSummary     `protobuf:"bytes,3,rep,name=reactions,proto3" json:"reactions,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
-func (x *LeaveGroupRequest) Reset() {
-	*x = LeaveGroupRequest{}
-	mi := &file_message_proto_msgTypes[21]
+func (x *MessageReactions) Reset() {
+	*x = MessageReactions{}
+	mi := &file_message_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LeaveGroupRequest) String() string {
+func (x *MessageReactions) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LeaveGroupRequest) ProtoMessage() {}
+func (*MessageReactions) ProtoMessage() {}
 
-func (x *LeaveGroupRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_message_proto_msgTypes[21]
+func (x *MessageReactions) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1279,94 +2794,57 @@ func (x *LeaveGroupRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LeaveGroupRequest.ProtoReflect.Descriptor instead.
-func (*LeaveGroupRequest) Descriptor() ([]byte, []int) {
-	return file_message_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use MessageReactions.ProtoReflect.Descriptor instead.
+func (*MessageReactions) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *LeaveGroupRequest) GetUserId() int64 {
+func (x *MessageReactions) GetMessageId() string {
 	if x != nil {
-		return x.UserId
+		return x.MessageId
 	}
-	return 0
+	return ""
 }
 
-func (x *LeaveGroupRequest) GetConversationId() string {
+func (x *MessageReactions) GetConversationId() string {
 	if x != nil {
 		return x.ConversationId
 	}
 	return ""
 }
 
-type LeaveGroupResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *LeaveGroupResponse) Reset() {
-	*x = LeaveGroupResponse{}
-	mi := &file_message_proto_msgTypes[22]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *LeaveGroupResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*LeaveGroupResponse) ProtoMessage() {}
-
-func (x *LeaveGroupResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_message_proto_msgTypes[22]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use LeaveGroupResponse.ProtoReflect.Descriptor instead.
-func (*LeaveGroupResponse) Descriptor() ([]byte, []int) {
-	return file_message_proto_rawDescGZIP(), []int{22}
-}
-
-func (x *LeaveGroupResponse) GetMessage() string {
+func (x *MessageReactions) GetReactions() []*ReactionSummary {
 	if x != nil {
-		return x.Message
+		return x.Reactions
 	}
-	return ""
+	return nil
 }
 
-// --- SearchMessages ---
-type SearchMessagesRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	UserId         int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // From JWT (for validation)
-	ConversationId string                 `protobuf:"bytes,2,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
-	Query          string                 `protobuf:"bytes,3,opt,name=query,proto3" json:"query,omitempty"` // Search query
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+// --- ForwardMessage ---
+type ForwardMessageRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserId          int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // From JWT; must be in the message's conversation and every target
+	MessageId       string                 `protobuf:"bytes,2,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	ConversationIds []string               `protobuf:"bytes,3,rep,name=conversation_ids,json=conversationIds,proto3" json:"conversation_ids,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *SearchMessagesRequest) Reset() {
-	*x = SearchMessagesRequest{}
-	mi := &file_message_proto_msgTypes[23]
+func (x *ForwardMessageRequest) Reset() {
+	*x = ForwardMessageRequest{}
+	mi := &file_message_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SearchMessagesRequest) String() string {
+func (x *ForwardMessageRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchMessagesRequest) ProtoMessage() {}
+func (*ForwardMessageRequest) ProtoMessage() {}
 
-func (x *SearchMessagesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_message_proto_msgTypes[23]
+func (x *ForwardMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1377,54 +2855,54 @@ func (x *SearchMessagesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchMessagesRequest.ProtoReflect.Descriptor instead.
-func (*SearchMessagesRequest) Descriptor() ([]byte, []int) {
-	return file_message_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use ForwardMessageRequest.ProtoReflect.Descriptor instead.
+func (*ForwardMessageRequest) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *SearchMessagesRequest) GetUserId() int64 {
+func (x *ForwardMessageRequest) GetUserId() int64 {
 	if x != nil {
 		return x.UserId
 	}
 	return 0
 }
 
-func (x *SearchMessagesRequest) GetConversationId() string {
+func (x *ForwardMessageRequest) GetMessageId() string {
 	if x != nil {
-		return x.ConversationId
+		return x.MessageId
 	}
 	return ""
 }
 
-func (x *SearchMessagesRequest) GetQuery() string {
+func (x *ForwardMessageRequest) GetConversationIds() []string {
 	if x != nil {
-		return x.Query
+		return x.ConversationIds
 	}
-	return ""
+	return nil
 }
 
-type SearchMessagesResponse struct {
+type ForwardMessageResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Messages      []*Message             `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+	Messages      []*Message             `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"` // One per target conversation, in request order
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SearchMessagesResponse) Reset() {
-	*x = SearchMessagesResponse{}
-	mi := &file_message_proto_msgTypes[24]
+func (x *ForwardMessageResponse) Reset() {
+	*x = ForwardMessageResponse{}
+	mi := &file_message_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SearchMessagesResponse) String() string {
+func (x *ForwardMessageResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchMessagesResponse) ProtoMessage() {}
+func (*ForwardMessageResponse) ProtoMessage() {}
 
-func (x *SearchMessagesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_message_proto_msgTypes[24]
+func (x *ForwardMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_message_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1435,12 +2913,12 @@ func (x *SearchMessagesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchMessagesResponse.ProtoReflect.Descriptor instead.
-func (*SearchMessagesResponse) Descriptor() ([]byte, []int) {
-	return file_message_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use ForwardMessageResponse.ProtoReflect.Descriptor instead.
+func (*ForwardMessageResponse) Descriptor() ([]byte, []int) {
+	return file_message_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *SearchMessagesResponse) GetMessages() []*Message {
+func (x *ForwardMessageResponse) GetMessages() []*Message {
 	if x != nil {
 		return x.Messages
 	}
@@ -1452,7 +2930,7 @@ var File_message_proto protoreflect.FileDescriptor
 const file_message_proto_rawDesc = "" +
 	"\n" +
 	"\rmessage.proto\x12\amessage\x1a\n" +
-	"user.proto\"\x93\x02\n" +
+	"user.proto\"\xeb\x02\n" +
 	"\fConversation\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12=\n" +
 	"\fparticipants\x18\x02 \x03(\v2\x19.user.GetUserDataResponseR\fparticipants\x123\n" +
@@ -1462,7 +2940,14 @@ const file_message_proto_rawDesc = "" +
 	"\bis_group\x18\x05 \x01(\bR\aisGroup\x12\x1d\n" +
 	"\n" +
 	"group_name\x18\x06 \x01(\tR\tgroupName\x12&\n" +
-	"\x0fgroup_image_url\x18\a \x01(\tR\rgroupImageUrl\"\xf7\x01\n" +
+	"\x0fgroup_image_url\x18\a \x01(\tR\rgroupImageUrl\x12!\n" +
+	"\funread_count\x18\b \x01(\x05R\vunreadCount\x123\n" +
+	"\vread_states\x18\t \x03(\v2\x12.message.ReadStateR\n" +
+	"readStates\"\x90\x01\n" +
+	"\tReadState\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x129\n" +
+	"\x19last_delivered_message_id\x18\x02 \x01(\tR\x16lastDeliveredMessageId\x12/\n" +
+	"\x14last_read_message_id\x18\x03 \x01(\tR\x11lastReadMessageId\"\x8c\x05\n" +
 	"\aMessage\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
 	"\x0fconversation_id\x18\x02 \x01(\tR\x0econversationId\x12\x1b\n" +
@@ -1472,14 +2957,79 @@ const file_message_proto_rawDesc = "" +
 	"\x0fsender_username\x18\x06 \x01(\tR\x0esenderUsername\x12\x1b\n" +
 	"\tmedia_url\x18\a \x01(\tR\bmediaUrl\x12\x1d\n" +
 	"\n" +
-	"media_type\x18\b \x01(\tR\tmediaType\"p\n" +
+	"media_type\x18\b \x01(\tR\tmediaType\x12\x12\n" +
+	"\x04type\x18\t \x01(\tR\x04type\x124\n" +
+	"\vshared_post\x18\n" +
+	" \x01(\v2\x13.message.SharedPostR\n" +
+	"sharedPost\x124\n" +
+	"\vstory_reply\x18\v \x01(\v2\x13.message.StoryReplyR\n" +
+	"storyReply\x12.\n" +
+	"\aprofile\x18\f \x01(\v2\x14.message.ProfileCardR\aprofile\x12-\n" +
+	"\blocation\x18\r \x01(\v2\x11.message.LocationR\blocation\x12,\n" +
+	"\x06system\x18\x0e \x01(\v2\x14.message.SystemEventR\x06system\x120\n" +
+	"\breply_to\x18\x0f \x01(\v2\x15.message.ReplyPreviewR\areplyTo\x126\n" +
+	"\treactions\x18\x10 \x03(\v2\x18.message.ReactionSummaryR\treactions\x12\x1c\n" +
+	"\tforwarded\x18\x11 \x01(\bR\tforwarded\"\xb9\x01\n" +
+	"\fReplyPreview\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x01 \x01(\tR\tmessageId\x12\x1b\n" +
+	"\tsender_id\x18\x02 \x01(\tR\bsenderId\x12'\n" +
+	"\x0fsender_username\x18\x03 \x01(\tR\x0esenderUsername\x12\x12\n" +
+	"\x04type\x18\x04 \x01(\tR\x04type\x12\x18\n" +
+	"\asnippet\x18\x05 \x01(\tR\asnippet\x12\x16\n" +
+	"\x06unsent\x18\x06 \x01(\bR\x06unsent\"X\n" +
+	"\x0fReactionSummary\x12\x14\n" +
+	"\x05emoji\x18\x01 \x01(\tR\x05emoji\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\x12\x19\n" +
+	"\buser_ids\x18\x03 \x03(\x03R\auserIds\"\xf1\x01\n" +
+	"\n" +
+	"SharedPost\x12\x17\n" +
+	"\apost_id\x18\x01 \x01(\x03R\x06postId\x12\x1b\n" +
+	"\tauthor_id\x18\x02 \x01(\x03R\bauthorId\x12'\n" +
+	"\x0fauthor_username\x18\x03 \x01(\tR\x0eauthorUsername\x12,\n" +
+	"\x12author_profile_url\x18\x04 \x01(\tR\x10authorProfileUrl\x12#\n" +
+	"\rthumbnail_url\x18\x05 \x01(\tR\fthumbnailUrl\x12\x18\n" +
+	"\acaption\x18\x06 \x01(\tR\acaption\x12\x17\n" +
+	"\ais_reel\x18\a \x01(\bR\x06isReel\"\xc2\x01\n" +
+	"\n" +
+	"StoryReply\x12\x19\n" +
+	"\bstory_id\x18\x01 \x01(\x03R\astoryId\x12$\n" +
+	"\x0estory_owner_id\x18\x02 \x01(\x03R\fstoryOwnerId\x12\x1b\n" +
+	"\tmedia_url\x18\x03 \x01(\tR\bmediaUrl\x12\x1d\n" +
+	"\n" +
+	"media_type\x18\x04 \x01(\tR\tmediaType\x12\x18\n" +
+	"\aexpired\x18\x05 \x01(\bR\aexpired\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x06 \x01(\tR\texpiresAt\"\x93\x01\n" +
+	"\vProfileCard\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12.\n" +
+	"\x13profile_picture_url\x18\x03 \x01(\tR\x11profilePictureUrl\x12\x1f\n" +
+	"\vis_verified\x18\x04 \x01(\bR\n" +
+	"isVerified\"r\n" +
+	"\bLocation\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\aaddress\x18\x02 \x01(\tR\aaddress\x12\x1a\n" +
+	"\blatitude\x18\x03 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x04 \x01(\x01R\tlongitude\"\xca\x01\n" +
+	"\vSystemEvent\x12\x14\n" +
+	"\x05event\x18\x01 \x01(\tR\x05event\x12\x19\n" +
+	"\bactor_id\x18\x02 \x01(\x03R\aactorId\x12%\n" +
+	"\x0eactor_username\x18\x03 \x01(\tR\ractorUsername\x12\x1b\n" +
+	"\ttarget_id\x18\x04 \x01(\x03R\btargetId\x12'\n" +
+	"\x0ftarget_username\x18\x05 \x01(\tR\x0etargetUsername\x12\x1d\n" +
+	"\n" +
+	"group_name\x18\x06 \x01(\tR\tgroupName\"\x88\x01\n" +
 	"\x17GetConversationsRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1b\n" +
 	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1f\n" +
 	"\vpage_offset\x18\x03 \x01(\x05R\n" +
-	"pageOffset\"W\n" +
+	"pageOffset\x12\x16\n" +
+	"\x06cursor\x18\x04 \x01(\tR\x06cursor\"x\n" +
 	"\x18GetConversationsResponse\x12;\n" +
-	"\rconversations\x18\x01 \x03(\v2\x15.message.ConversationR\rconversations\"\x94\x01\n" +
+	"\rconversations\x18\x01 \x03(\v2\x15.message.ConversationR\rconversations\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"\x94\x01\n" +
 	"\x12GetMessagesRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12'\n" +
 	"\x0fconversation_id\x18\x02 \x01(\tR\x0econversationId\x12\x1b\n" +
@@ -1487,14 +3037,23 @@ const file_message_proto_rawDesc = "" +
 	"\vpage_offset\x18\x04 \x01(\x05R\n" +
 	"pageOffset\"C\n" +
 	"\x13GetMessagesResponse\x12,\n" +
-	"\bmessages\x18\x01 \x03(\v2\x10.message.MessageR\bmessages\"\xb0\x01\n" +
+	"\bmessages\x18\x01 \x03(\v2\x10.message.MessageR\bmessages\"\xbe\x03\n" +
 	"\x12SendMessageRequest\x12\x1b\n" +
 	"\tsender_id\x18\x01 \x01(\x03R\bsenderId\x12'\n" +
 	"\x0fconversation_id\x18\x02 \x01(\tR\x0econversationId\x12\x18\n" +
 	"\acontent\x18\x03 \x01(\tR\acontent\x12\x1b\n" +
 	"\tmedia_url\x18\x04 \x01(\tR\bmediaUrl\x12\x1d\n" +
 	"\n" +
-	"media_type\x18\x05 \x01(\tR\tmediaType\"A\n" +
+	"media_type\x18\x05 \x01(\tR\tmediaType\x12\x12\n" +
+	"\x04type\x18\x06 \x01(\tR\x04type\x124\n" +
+	"\vshared_post\x18\a \x01(\v2\x13.message.SharedPostR\n" +
+	"sharedPost\x124\n" +
+	"\vstory_reply\x18\b \x01(\v2\x13.message.StoryReplyR\n" +
+	"storyReply\x12.\n" +
+	"\aprofile\x18\t \x01(\v2\x14.message.ProfileCardR\aprofile\x12-\n" +
+	"\blocation\x18\n" +
+	" \x01(\v2\x11.message.LocationR\blocation\x12-\n" +
+	"\x13reply_to_message_id\x18\v \x01(\tR\x10replyToMessageId\"A\n" +
 	"\x13SendMessageResponse\x12*\n" +
 	"\amessage\x18\x01 \x01(\v2\x10.message.MessageR\amessage\"\xaa\x01\n" +
 	"\x19CreateConversationRequest\x12\x1d\n" +
@@ -1551,7 +3110,59 @@ const file_message_proto_rawDesc = "" +
 	"\x0fconversation_id\x18\x02 \x01(\tR\x0econversationId\x12\x14\n" +
 	"\x05query\x18\x03 \x01(\tR\x05query\"F\n" +
 	"\x16SearchMessagesResponse\x12,\n" +
-	"\bmessages\x18\x01 \x03(\v2\x10.message.MessageR\bmessages2\xf8\a\n" +
+	"\bmessages\x18\x01 \x03(\v2\x10.message.MessageR\bmessages\"~\n" +
+	"\x1bMarkConversationReadRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12'\n" +
+	"\x0fconversation_id\x18\x02 \x01(\tR\x0econversationId\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x03 \x01(\tR\tmessageId\"r\n" +
+	"\x1dGetDirectMessageCountsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x19\n" +
+	"\bpeer_ids\x18\x02 \x03(\x03R\apeerIds\x12\x1d\n" +
+	"\n" +
+	"since_days\x18\x03 \x01(\x05R\tsinceDays\"\xa8\x01\n" +
+	"\x1eGetDirectMessageCountsResponse\x12K\n" +
+	"\x06counts\x18\x01 \x03(\v23.message.GetDirectMessageCountsResponse.CountsEntryR\x06counts\x1a9\n" +
+	"\vCountsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x03R\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"f\n" +
+	"\"GetConversationParticipantsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12'\n" +
+	"\x0fconversation_id\x18\x02 \x01(\tR\x0econversationId\"i\n" +
+	"#GetConversationParticipantsResponse\x12'\n" +
+	"\x0fparticipant_ids\x18\x01 \x03(\x03R\x0eparticipantIds\x12\x19\n" +
+	"\bis_group\x18\x02 \x01(\bR\aisGroup\"L\n" +
+	"\x12GetPresenceRequest\x12\x1b\n" +
+	"\tviewer_id\x18\x01 \x01(\x03R\bviewerId\x12\x19\n" +
+	"\buser_ids\x18\x02 \x03(\x03R\auserIds\"]\n" +
+	"\bPresence\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x16\n" +
+	"\x06online\x18\x02 \x01(\bR\x06online\x12 \n" +
+	"\flast_seen_at\x18\x03 \x01(\tR\n" +
+	"lastSeenAt\"F\n" +
+	"\x13GetPresenceResponse\x12/\n" +
+	"\tpresences\x18\x01 \x03(\v2\x11.message.PresenceR\tpresences\"e\n" +
+	"\x15ReactToMessageRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x02 \x01(\tR\tmessageId\x12\x14\n" +
+	"\x05emoji\x18\x03 \x01(\tR\x05emoji\"O\n" +
+	"\x15RemoveReactionRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x02 \x01(\tR\tmessageId\"\x92\x01\n" +
+	"\x10MessageReactions\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x01 \x01(\tR\tmessageId\x12'\n" +
+	"\x0fconversation_id\x18\x02 \x01(\tR\x0econversationId\x126\n" +
+	"\treactions\x18\x03 \x03(\v2\x18.message.ReactionSummaryR\treactions\"z\n" +
+	"\x15ForwardMessageRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x02 \x01(\tR\tmessageId\x12)\n" +
+	"\x10conversation_ids\x18\x03 \x03(\tR\x0fconversationIds\"F\n" +
+	"\x16ForwardMessageResponse\x12,\n" +
+	"\bmessages\x18\x01 \x03(\v2\x10.message.MessageR\bmessages2\xe6\f\n" +
 	"\x0eMessageService\x12W\n" +
 	"\x10GetConversations\x12 .message.GetConversationsRequest\x1a!.message.GetConversationsResponse\x12H\n" +
 	"\vGetMessages\x12\x1b.message.GetMessagesRequest\x1a\x1c.message.GetMessagesResponse\x12H\n" +
@@ -1565,7 +3176,14 @@ const file_message_proto_rawDesc = "" +
 	"\x0fUpdateGroupInfo\x12\x1f.message.UpdateGroupInfoRequest\x1a .message.UpdateGroupInfoResponse\x12E\n" +
 	"\n" +
 	"LeaveGroup\x12\x1a.message.LeaveGroupRequest\x1a\x1b.message.LeaveGroupResponse\x12Q\n" +
-	"\x0eSearchMessages\x12\x1e.message.SearchMessagesRequest\x1a\x1f.message.SearchMessagesResponseB/Z-github.com/hoshibmatchi/message-service/protob\x06proto3"
+	"\x0eSearchMessages\x12\x1e.message.SearchMessagesRequest\x1a\x1f.message.SearchMessagesResponse\x12P\n" +
+	"\x14MarkConversationRead\x12$.message.MarkConversationReadRequest\x1a\x12.message.ReadState\x12i\n" +
+	"\x16GetDirectMessageCounts\x12&.message.GetDirectMessageCountsRequest\x1a'.message.GetDirectMessageCountsResponse\x12x\n" +
+	"\x1bGetConversationParticipants\x12+.message.GetConversationParticipantsRequest\x1a,.message.GetConversationParticipantsResponse\x12H\n" +
+	"\vGetPresence\x12\x1b.message.GetPresenceRequest\x1a\x1c.message.GetPresenceResponse\x12K\n" +
+	"\x0eReactToMessage\x12\x1e.message.ReactToMessageRequest\x1a\x19.message.MessageReactions\x12K\n" +
+	"\x0eRemoveReaction\x12\x1e.message.RemoveReactionRequest\x1a\x19.message.MessageReactions\x12Q\n" +
+	"\x0eForwardMessage\x12\x1e.message.ForwardMessageRequest\x1a\x1f.message.ForwardMessageResponseB/Z-github.com/hoshibmatchi/message-service/protob\x06proto3"
 
 var (
 	file_message_proto_rawDescOnce sync.Once
@@ -1579,71 +3197,123 @@ func file_message_proto_rawDescGZIP() []byte {
 	return file_message_proto_rawDescData
 }
 
-var file_message_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
+var file_message_proto_msgTypes = make([]protoimpl.MessageInfo, 47)
 var file_message_proto_goTypes = []any{
-	(*Conversation)(nil),               // 0: message.Conversation
-	(*Message)(nil),                    // 1: message.Message
-	(*GetConversationsRequest)(nil),    // 2: message.GetConversationsRequest
-	(*GetConversationsResponse)(nil),   // 3: message.GetConversationsResponse
-	(*GetMessagesRequest)(nil),         // 4: message.GetMessagesRequest
-	(*GetMessagesResponse)(nil),        // 5: message.GetMessagesResponse
-	(*SendMessageRequest)(nil),         // 6: message.SendMessageRequest
-	(*SendMessageResponse)(nil),        // 7: message.SendMessageResponse
-	(*CreateConversationRequest)(nil),  // 8: message.CreateConversationRequest
-	(*UnsendMessageRequest)(nil),       // 9: message.UnsendMessageRequest
-	(*UnsendMessageResponse)(nil),      // 10: message.UnsendMessageResponse
-	(*DeleteConversationRequest)(nil),  // 11: message.DeleteConversationRequest
-	(*DeleteConversationResponse)(nil), // 12: message.DeleteConversationResponse
-	(*GetVideoCallTokenRequest)(nil),   // 13: message.GetVideoCallTokenRequest
-	(*GetVideoCallTokenResponse)(nil),  // 14: message.GetVideoCallTokenResponse
-	(*AddParticipantRequest)(nil),      // 15: message.AddParticipantRequest
-	(*AddParticipantResponse)(nil),     // 16: message.AddParticipantResponse
-	(*RemoveParticipantRequest)(nil),   // 17: message.RemoveParticipantRequest
-	(*RemoveParticipantResponse)(nil),  // 18: message.RemoveParticipantResponse
-	(*UpdateGroupInfoRequest)(nil),     // 19: message.UpdateGroupInfoRequest
-	(*UpdateGroupInfoResponse)(nil),    // 20: message.UpdateGroupInfoResponse
-	(*LeaveGroupRequest)(nil),          // 21: message.LeaveGroupRequest
-	(*LeaveGroupResponse)(nil),         // 22: message.LeaveGroupResponse
-	(*SearchMessagesRequest)(nil),      // 23: message.SearchMessagesRequest
-	(*SearchMessagesResponse)(nil),     // 24: message.SearchMessagesResponse
-	(*proto.GetUserDataResponse)(nil),  // 25: user.GetUserDataResponse
+	(*Conversation)(nil),                        // 0: message.Conversation
+	(*ReadState)(nil),                           // 1: message.ReadState
+	(*Message)(nil),                             // 2: message.Message
+	(*ReplyPreview)(nil),                        // 3: message.ReplyPreview
+	(*ReactionSummary)(nil),                     // 4: message.ReactionSummary
+	(*SharedPost)(nil),                          // 5: message.SharedPost
+	(*StoryReply)(nil),                          // 6: message.StoryReply
+	(*ProfileCard)(nil),                         // 7: message.ProfileCard
+	(*Location)(nil),                            // 8: message.Location
+	(*SystemEvent)(nil),                         // 9: message.SystemEvent
+	(*GetConversationsRequest)(nil),             // 10: message.GetConversationsRequest
+	(*GetConversationsResponse)(nil),            // 11: message.GetConversationsResponse
+	(*GetMessagesRequest)(nil),                  // 12: message.GetMessagesRequest
+	(*GetMessagesResponse)(nil),                 // 13: message.GetMessagesResponse
+	(*SendMessageRequest)(nil),                  // 14: message.SendMessageRequest
+	(*SendMessageResponse)(nil),                 // 15: message.SendMessageResponse
+	(*CreateConversationRequest)(nil),           // 16: message.CreateConversationRequest
+	(*UnsendMessageRequest)(nil),                // 17: message.UnsendMessageRequest
+	(*UnsendMessageResponse)(nil),               // 18: message.UnsendMessageResponse
+	(*DeleteConversationRequest)(nil),           // 19: message.DeleteConversationRequest
+	(*DeleteConversationResponse)(nil),          // 20: message.DeleteConversationResponse
+	(*GetVideoCallTokenRequest)(nil),            // 21: message.GetVideoCallTokenRequest
+	(*GetVideoCallTokenResponse)(nil),           // 22: message.GetVideoCallTokenResponse
+	(*AddParticipantRequest)(nil),               // 23: message.AddParticipantRequest
+	(*AddParticipantResponse)(nil),              // 24: message.AddParticipantResponse
+	(*RemoveParticipantRequest)(nil),            // 25: message.RemoveParticipantRequest
+	(*RemoveParticipantResponse)(nil),           // 26: message.RemoveParticipantResponse
+	(*UpdateGroupInfoRequest)(nil),              // 27: message.UpdateGroupInfoRequest
+	(*UpdateGroupInfoResponse)(nil),             // 28: message.UpdateGroupInfoResponse
+	(*LeaveGroupRequest)(nil),                   // 29: message.LeaveGroupRequest
+	(*LeaveGroupResponse)(nil),                  // 30: message.LeaveGroupResponse
+	(*SearchMessagesRequest)(nil),               // 31: message.SearchMessagesRequest
+	(*SearchMessagesResponse)(nil),              // 32: message.SearchMessagesResponse
+	(*MarkConversationReadRequest)(nil),         // 33: message.MarkConversationReadRequest
+	(*GetDirectMessageCountsRequest)(nil),       // 34: message.GetDirectMessageCountsRequest
+	(*GetDirectMessageCountsResponse)(nil),      // 35: message.GetDirectMessageCountsResponse
+	(*GetConversationParticipantsRequest)(nil),  // 36: message.GetConversationParticipantsRequest
+	(*GetConversationParticipantsResponse)(nil), // 37: message.GetConversationParticipantsResponse
+	(*GetPresenceRequest)(nil),                  // 38: message.GetPresenceRequest
+	(*Presence)(nil),                            // 39: message.Presence
+	(*GetPresenceResponse)(nil),                 // 40: message.GetPresenceResponse
+	(*ReactToMessageRequest)(nil),               // 41: message.ReactToMessageRequest
+	(*RemoveReactionRequest)(nil),               // 42: message.RemoveReactionRequest
+	(*MessageReactions)(nil),                    // 43: message.MessageReactions
+	(*ForwardMessageRequest)(nil),               // 44: message.ForwardMessageRequest
+	(*ForwardMessageResponse)(nil),              // 45: message.ForwardMessageResponse
+	nil,                                         // 46: message.GetDirectMessageCountsResponse.CountsEntry
+	(*proto.GetUserDataResponse)(nil),           // 47: user.GetUserDataResponse
 }
 var file_message_proto_depIdxs = []int32{
-	25, // 0: message.Conversation.participants:type_name -> user.GetUserDataResponse
-	1,  // 1: message.Conversation.last_message:type_name -> message.Message
-	0,  // 2: message.GetConversationsResponse.conversations:type_name -> message.Conversation
-	1,  // 3: message.GetMessagesResponse.messages:type_name -> message.Message
-	1,  // 4: message.SendMessageResponse.message:type_name -> message.Message
-	1,  // 5: message.SearchMessagesResponse.messages:type_name -> message.Message
-	2,  // 6: message.MessageService.GetConversations:input_type -> message.GetConversationsRequest
-	4,  // 7: message.MessageService.GetMessages:input_type -> message.GetMessagesRequest
-	6,  // 8: message.MessageService.SendMessage:input_type -> message.SendMessageRequest
-	8,  // 9: message.MessageService.CreateConversation:input_type -> message.CreateConversationRequest
-	9,  // 10: message.MessageService.UnsendMessage:input_type -> message.UnsendMessageRequest
-	11, // 11: message.MessageService.DeleteConversation:input_type -> message.DeleteConversationRequest
-	13, // 12: message.MessageService.GetVideoCallToken:input_type -> message.GetVideoCallTokenRequest
-	15, // 13: message.MessageService.AddParticipant:input_type -> message.AddParticipantRequest
-	17, // 14: message.MessageService.RemoveParticipant:input_type -> message.RemoveParticipantRequest
-	19, // 15: message.MessageService.UpdateGroupInfo:input_type -> message.UpdateGroupInfoRequest
-	21, // 16: message.MessageService.LeaveGroup:input_type -> message.LeaveGroupRequest
-	23, // 17: message.MessageService.SearchMessages:input_type -> message.SearchMessagesRequest
-	3,  // 18: message.MessageService.GetConversations:output_type -> message.GetConversationsResponse
-	5,  // 19: message.MessageService.GetMessages:output_type -> message.GetMessagesResponse
-	7,  // 20: message.MessageService.SendMessage:output_type -> message.SendMessageResponse
-	0,  // 21: message.MessageService.CreateConversation:output_type -> message.Conversation
-	10, // 22: message.MessageService.UnsendMessage:output_type -> message.UnsendMessageResponse
-	12, // 23: message.MessageService.DeleteConversation:output_type -> message.DeleteConversationResponse
-	14, // 24: message.MessageService.GetVideoCallToken:output_type -> message.GetVideoCallTokenResponse
-	16, // 25: message.MessageService.AddParticipant:output_type -> message.AddParticipantResponse
-	18, // 26: message.MessageService.RemoveParticipant:output_type -> message.RemoveParticipantResponse
-	20, // 27: message.MessageService.UpdateGroupInfo:output_type -> message.UpdateGroupInfoResponse
-	22, // 28: message.MessageService.LeaveGroup:output_type -> message.LeaveGroupResponse
-	24, // 29: message.MessageService.SearchMessages:output_type -> message.SearchMessagesResponse
-	18, // [18:30] is the sub-list for method output_type
-	6,  // [6:18] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+	47, // 0: message.Conversation.participants:type_name -> user.GetUserDataResponse
+	2,  // 1: message.Conversation.last_message:type_name -> message.Message
+	1,  // 2: message.Conversation.read_states:type_name -> message.ReadState
+	5,  // 3: message.Message.shared_post:type_name -> message.SharedPost
+	6,  // 4: message.Message.story_reply:type_name -> message.StoryReply
+	7,  // 5: message.Message.profile:type_name -> message.ProfileCard
+	8,  // 6: message.Message.location:type_name -> message.Location
+	9,  // 7: message.Message.system:type_name -> message.SystemEvent
+	3,  // 8: message.Message.reply_to:type_name -> message.ReplyPreview
+	4,  // 9: message.Message.reactions:type_name -> message.ReactionSummary
+	0,  // 10: message.GetConversationsResponse.conversations:type_name -> message.Conversation
+	2,  // 11: message.GetMessagesResponse.messages:type_name -> message.Message
+	5,  // 12: message.SendMessageRequest.shared_post:type_name -> message.SharedPost
+	6,  // 13: message.SendMessageRequest.story_reply:type_name -> message.StoryReply
+	7,  // 14: message.SendMessageRequest.profile:type_name -> message.ProfileCard
+	8,  // 15: message.SendMessageRequest.location:type_name -> message.Location
+	2,  // 16: message.SendMessageResponse.message:type_name -> message.Message
+	2,  // 17: message.SearchMessagesResponse.messages:type_name -> message.Message
+	46, // 18: message.GetDirectMessageCountsResponse.counts:type_name -> message.GetDirectMessageCountsResponse.CountsEntry
+	39, // 19: message.GetPresenceResponse.presences:type_name -> message.Presence
+	4,  // 20: message.MessageReactions.reactions:type_name -> message.ReactionSummary
+	2,  // 21: message.ForwardMessageResponse.messages:type_name -> message.Message
+	10, // 22: message.MessageService.GetConversations:input_type -> message.GetConversationsRequest
+	12, // 23: message.MessageService.GetMessages:input_type -> message.GetMessagesRequest
+	14, // 24: message.MessageService.SendMessage:input_type -> message.SendMessageRequest
+	16, // 25: message.MessageService.CreateConversation:input_type -> message.CreateConversationRequest
+	17, // 26: message.MessageService.UnsendMessage:input_type -> message.UnsendMessageRequest
+	19, // 27: message.MessageService.DeleteConversation:input_type -> message.DeleteConversationRequest
+	21, // 28: message.MessageService.GetVideoCallToken:input_type -> message.GetVideoCallTokenRequest
+	23, // 29: message.MessageService.AddParticipant:input_type -> message.AddParticipantRequest
+	25, // 30: message.MessageService.RemoveParticipant:input_type -> message.RemoveParticipantRequest
+	27, // 31: message.MessageService.UpdateGroupInfo:input_type -> message.UpdateGroupInfoRequest
+	29, // 32: message.MessageService.LeaveGroup:input_type -> message.LeaveGroupRequest
+	31, // 33: message.MessageService.SearchMessages:input_type -> message.SearchMessagesRequest
+	33, // 34: message.MessageService.MarkConversationRead:input_type -> message.MarkConversationReadRequest
+	34, // 35: message.MessageService.GetDirectMessageCounts:input_type -> message.GetDirectMessageCountsRequest
+	36, // 36: message.MessageService.GetConversationParticipants:input_type -> message.GetConversationParticipantsRequest
+	38, // 37: message.MessageService.GetPresence:input_type -> message.GetPresenceRequest
+	41, // 38: message.MessageService.ReactToMessage:input_type -> message.ReactToMessageRequest
+	42, // 39: message.MessageService.RemoveReaction:input_type -> message.RemoveReactionRequest
+	44, // 40: message.MessageService.ForwardMessage:input_type -> message.ForwardMessageRequest
+	11, // 41: message.MessageService.GetConversations:output_type -> message.GetConversationsResponse
+	13, // 42: message.MessageService.GetMessages:output_type -> message.GetMessagesResponse
+	15, // 43: message.MessageService.SendMessage:output_type -> message.SendMessageResponse
+	0,  // 44: message.MessageService.CreateConversation:output_type -> message.Conversation
+	18, // 45: message.MessageService.UnsendMessage:output_type -> message.UnsendMessageResponse
+	20, // 46: message.MessageService.DeleteConversation:output_type -> message.DeleteConversationResponse
+	22, // 47: message.MessageService.GetVideoCallToken:output_type -> message.GetVideoCallTokenResponse
+	24, // 48: message.MessageService.AddParticipant:output_type -> message.AddParticipantResponse
+	26, // 49: message.MessageService.RemoveParticipant:output_type -> message.RemoveParticipantResponse
+	28, // 50: message.MessageService.UpdateGroupInfo:output_type -> message.UpdateGroupInfoResponse
+	30, // 51: message.MessageService.LeaveGroup:output_type -> message.LeaveGroupResponse
+	32, // 52: message.MessageService.SearchMessages:output_type -> message.SearchMessagesResponse
+	1,  // 53: message.MessageService.MarkConversationRead:output_type -> message.ReadState
+	35, // 54: message.MessageService.GetDirectMessageCounts:output_type -> message.GetDirectMessageCountsResponse
+	37, // 55: message.MessageService.GetConversationParticipants:output_type -> message.GetConversationParticipantsResponse
+	40, // 56: message.MessageService.GetPresence:output_type -> message.GetPresenceResponse
+	43, // 57: message.MessageService.ReactToMessage:output_type -> message.MessageReactions
+	43, // 58: message.MessageService.RemoveReaction:output_type -> message.MessageReactions
+	45, // 59: message.MessageService.ForwardMessage:output_type -> message.ForwardMessageResponse
+	41, // [41:60] is the sub-list for method output_type
+	22, // [22:41] is the sub-list for method input_type
+	22, // [22:22] is the sub-list for extension type_name
+	22, // [22:22] is the sub-list for extension extendee
+	0,  // [0:22] is the sub-list for field type_name
 }
 
 func init() { file_message_proto_init() }
@@ -1657,7 +3327,7 @@ func file_message_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_message_proto_rawDesc), len(file_message_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   25,
+			NumMessages:   47,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,18 +19,25 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	MessageService_GetConversations_FullMethodName   = "/message.MessageService/GetConversations"
-	MessageService_GetMessages_FullMethodName        = "/message.MessageService/GetMessages"
-	MessageService_SendMessage_FullMethodName        = "/message.MessageService/SendMessage"
-	MessageService_CreateConversation_FullMethodName = "/message.MessageService/CreateConversation"
-	MessageService_UnsendMessage_FullMethodName      = "/message.MessageService/UnsendMessage"
-	MessageService_DeleteConversation_FullMethodName = "/message.MessageService/DeleteConversation"
-	MessageService_GetVideoCallToken_FullMethodName  = "/message.MessageService/GetVideoCallToken"
-	MessageService_AddParticipant_FullMethodName     = "/message.MessageService/AddParticipant"
-	MessageService_RemoveParticipant_FullMethodName  = "/message.MessageService/RemoveParticipant"
-	MessageService_UpdateGroupInfo_FullMethodName    = "/message.MessageService/UpdateGroupInfo"
-	MessageService_LeaveGroup_FullMethodName         = "/message.MessageService/LeaveGroup"
-	MessageService_SearchMessages_FullMethodName     = "/message.MessageService/SearchMessages"
+	MessageService_GetConversations_FullMethodName            = "/message.MessageService/GetConversations"
+	MessageService_GetMessages_FullMethodName                 = "/message.MessageService/GetMessages"
+	MessageService_SendMessage_FullMethodName                 = "/message.MessageService/SendMessage"
+	MessageService_CreateConversation_FullMethodName          = "/message.MessageService/CreateConversation"
+	MessageService_UnsendMessage_FullMethodName               = "/message.MessageService/UnsendMessage"
+	MessageService_DeleteConversation_FullMethodName          = "/message.MessageService/DeleteConversation"
+	MessageService_GetVideoCallToken_FullMethodName           = "/message.MessageService/GetVideoCallToken"
+	MessageService_AddParticipant_FullMethodName              = "/message.MessageService/AddParticipant"
+	MessageService_RemoveParticipant_FullMethodName           = "/message.MessageService/RemoveParticipant"
+	MessageService_UpdateGroupInfo_FullMethodName             = "/message.MessageService/UpdateGroupInfo"
+	MessageService_LeaveGroup_FullMethodName                  = "/message.MessageService/LeaveGroup"
+	MessageService_SearchMessages_FullMethodName              = "/message.MessageService/SearchMessages"
+	MessageService_MarkConversationRead_FullMethodName        = "/message.MessageService/MarkConversationRead"
+	MessageService_GetDirectMessageCounts_FullMethodName      = "/message.MessageService/GetDirectMessageCounts"
+	MessageService_GetConversationParticipants_FullMethodName = "/message.MessageService/GetConversationParticipants"
+	MessageService_GetPresence_FullMethodName                 = "/message.MessageService/GetPresence"
+	MessageService_ReactToMessage_FullMethodName              = "/message.MessageService/ReactToMessage"
+	MessageService_RemoveReaction_FullMethodName              = "/message.MessageService/RemoveReaction"
+	MessageService_ForwardMessage_FullMethodName              = "/message.MessageService/ForwardMessage"
 )
 
 // MessageServiceClient is the client API for MessageService service.
@@ -56,6 +63,19 @@ type MessageServiceClient interface {
 	LeaveGroup(ctx context.Context, in *LeaveGroupRequest, opts ...grpc.CallOption) (*LeaveGroupResponse, error)
 	// Search messages in a conversation
 	SearchMessages(ctx context.Context, in *SearchMessagesRequest, opts ...grpc.CallOption) (*SearchMessagesResponse, error)
+	// Moves the user's read pointer forward and tells the other participants
+	MarkConversationRead(ctx context.Context, in *MarkConversationReadRequest, opts ...grpc.CallOption) (*ReadState, error)
+	// Internal: recent 1:1 message volume between a user and each peer (feed ranking)
+	GetDirectMessageCounts(ctx context.Context, in *GetDirectMessageCountsRequest, opts ...grpc.CallOption) (*GetDirectMessageCountsResponse, error)
+	// Internal: who else is in a conversation (post-service checks post privacy for each of them)
+	GetConversationParticipants(ctx context.Context, in *GetConversationParticipantsRequest, opts ...grpc.CallOption) (*GetConversationParticipantsResponse, error)
+	// Whether users are online and when they were last active, for a batch of users the viewer shares a conversation with
+	GetPresence(ctx context.Context, in *GetPresenceRequest, opts ...grpc.CallOption) (*GetPresenceResponse, error)
+	// Reactions: one emoji per user per message; reacting again replaces it
+	ReactToMessage(ctx context.Context, in *ReactToMessageRequest, opts ...grpc.CallOption) (*MessageReactions, error)
+	RemoveReaction(ctx context.Context, in *RemoveReactionRequest, opts ...grpc.CallOption) (*MessageReactions, error)
+	// Copies a message into other conversations the user is in
+	ForwardMessage(ctx context.Context, in *ForwardMessageRequest, opts ...grpc.CallOption) (*ForwardMessageResponse, error)
 }
 
 type messageServiceClient struct {
@@ -186,6 +206,76 @@ func (c *messageServiceClient) SearchMessages(ctx context.Context, in *SearchMes
 	return out, nil
 }
 
+func (c *messageServiceClient) MarkConversationRead(ctx context.Context, in *MarkConversationReadRequest, opts ...grpc.CallOption) (*ReadState, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReadState)
+	err := c.cc.Invoke(ctx, MessageService_MarkConversationRead_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageServiceClient) GetDirectMessageCounts(ctx context.Context, in *GetDirectMessageCountsRequest, opts ...grpc.CallOption) (*GetDirectMessageCountsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDirectMessageCountsResponse)
+	err := c.cc.Invoke(ctx, MessageService_GetDirectMessageCounts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageServiceClient) GetConversationParticipants(ctx context.Context, in *GetConversationParticipantsRequest, opts ...grpc.CallOption) (*GetConversationParticipantsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetConversationParticipantsResponse)
+	err := c.cc.Invoke(ctx, MessageService_GetConversationParticipants_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageServiceClient) GetPresence(ctx context.Context, in *GetPresenceRequest, opts ...grpc.CallOption) (*GetPresenceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPresenceResponse)
+	err := c.cc.Invoke(ctx, MessageService_GetPresence_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageServiceClient) ReactToMessage(ctx context.Context, in *ReactToMessageRequest, opts ...grpc.CallOption) (*MessageReactions, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MessageReactions)
+	err := c.cc.Invoke(ctx, MessageService_ReactToMessage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageServiceClient) RemoveReaction(ctx context.Context, in *RemoveReactionRequest, opts ...grpc.CallOption) (*MessageReactions, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MessageReactions)
+	err := c.cc.Invoke(ctx, MessageService_RemoveReaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageServiceClient) ForwardMessage(ctx context.Context, in *ForwardMessageRequest, opts ...grpc.CallOption) (*ForwardMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ForwardMessageResponse)
+	err := c.cc.Invoke(ctx, MessageService_ForwardMessage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MessageServiceServer is the server API for MessageService service.
 // All implementations must embed UnimplementedMessageServiceServer
 // for forward compatibility.
@@ -209,6 +299,19 @@ type MessageServiceServer interface {
 	LeaveGroup(context.Context, *LeaveGroupRequest) (*LeaveGroupResponse, error)
 	// Search messages in a conversation
 	SearchMessages(context.Context, *SearchMessagesRequest) (*SearchMessagesResponse, error)
+	// Moves the user's read pointer forward and tells the other participants
+	MarkConversationRead(context.Context, *MarkConversationReadRequest) (*ReadState, error)
+	// Internal: recent 1:1 message volume between a user and each peer (feed ranking)
+	GetDirectMessageCounts(context.Context, *GetDirectMessageCountsRequest) (*GetDirectMessageCountsResponse, error)
+	// Internal: who else is in a conversation (post-service checks post privacy for each of them)
+	GetConversationParticipants(context.Context, *GetConversationParticipantsRequest) (*GetConversationParticipantsResponse, error)
+	// Whether users are online and when they were last active, for a batch of users the viewer shares a conversation with
+	GetPresence(context.Context, *GetPresenceRequest) (*GetPresenceResponse, error)
+	// Reactions: one emoji per user per message; reacting again replaces it
+	ReactToMessage(context.Context, *ReactToMessageRequest) (*MessageReactions, error)
+	RemoveReaction(context.Context, *RemoveReactionRequest) (*MessageReactions, error)
+	// Copies a message into other conversations the user is in
+	ForwardMessage(context.Context, *ForwardMessageRequest) (*ForwardMessageResponse, error)
 	mustEmbedUnimplementedMessageServiceServer()
 }
 
@@ -255,6 +358,27 @@ func (UnimplementedMessageServiceServer) LeaveGroup(context.Context, *LeaveGroup
 func (UnimplementedMessageServiceServer) SearchMessages(context.Context, *SearchMessagesRequest) (*SearchMessagesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SearchMessages not implemented")
 }
+func (UnimplementedMessageServiceServer) MarkConversationRead(context.Context, *MarkConversationReadRequest) (*ReadState, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkConversationRead not implemented")
+}
+func (UnimplementedMessageServiceServer) GetDirectMessageCounts(context.Context, *GetDirectMessageCountsRequest) (*GetDirectMessageCountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDirectMessageCounts not implemented")
+}
+func (UnimplementedMessageServiceServer) GetConversationParticipants(context.Context, *GetConversationParticipantsRequest) (*GetConversationParticipantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConversationParticipants not implemented")
+}
+func (UnimplementedMessageServiceServer) GetPresence(context.Context, *GetPresenceRequest) (*GetPresenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPresence not implemented")
+}
+func (UnimplementedMessageServiceServer) ReactToMessage(context.Context, *ReactToMessageRequest) (*MessageReactions, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReactToMessage not implemented")
+}
+func (UnimplementedMessageServiceServer) RemoveReaction(context.Context, *RemoveReactionRequest) (*MessageReactions, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveReaction not implemented")
+}
+func (UnimplementedMessageServiceServer) ForwardMessage(context.Context, *ForwardMessageRequest) (*ForwardMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForwardMessage not implemented")
+}
 func (UnimplementedMessageServiceServer) mustEmbedUnimplementedMessageServiceServer() {}
 func (UnimplementedMessageServiceServer) testEmbeddedByValue()                        {}
 
@@ -492,6 +616,132 @@ func _MessageService_SearchMessages_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MessageService_MarkConversationRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkConversationReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServiceServer).MarkConversationRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessageService_MarkConversationRead_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServiceServer).MarkConversationRead(ctx, req.(*MarkConversationReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessageService_GetDirectMessageCounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDirectMessageCountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServiceServer).GetDirectMessageCounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessageService_GetDirectMessageCounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServiceServer).GetDirectMessageCounts(ctx, req.(*GetDirectMessageCountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessageService_GetConversationParticipants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConversationParticipantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServiceServer).GetConversationParticipants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessageService_GetConversationParticipants_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServiceServer).GetConversationParticipants(ctx, req.(*GetConversationParticipantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessageService_GetPresence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPresenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServiceServer).GetPresence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessageService_GetPresence_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServiceServer).GetPresence(ctx, req.(*GetPresenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessageService_ReactToMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReactToMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServiceServer).ReactToMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessageService_ReactToMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServiceServer).ReactToMessage(ctx, req.(*ReactToMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessageService_RemoveReaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveReactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServiceServer).RemoveReaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessageService_RemoveReaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServiceServer).RemoveReaction(ctx, req.(*RemoveReactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessageService_ForwardMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForwardMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServiceServer).ForwardMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessageService_ForwardMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServiceServer).ForwardMessage(ctx, req.(*ForwardMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // MessageService_ServiceDesc is the grpc.ServiceDesc for MessageService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -547,6 +797,34 @@ var MessageService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SearchMessages",
 			Handler:    _MessageService_SearchMessages_Handler,
 		},
+		{
+			MethodName: "MarkConversationRead",
+			Handler:    _MessageService_MarkConversationRead_Handler,
+		},
+		{
+			MethodName: "GetDirectMessageCounts",
+			Handler:    _MessageService_GetDirectMessageCounts_Handler,
+		},
+		{
+			MethodName: "GetConversationParticipants",
+			Handler:    _MessageService_GetConversationParticipants_Handler,
+		},
+		{
+			MethodName: "GetPresence",
+			Handler:    _MessageService_GetPresence_Handler,
+		},
+		{
+			MethodName: "ReactToMessage",
+			Handler:    _MessageService_ReactToMessage_Handler,
+		},
+		{
+			MethodName: "RemoveReaction",
+			Handler:    _MessageService_RemoveReaction_Handler,
+		},
+		{
+			MethodName: "ForwardMessage",
+			Handler:    _MessageService_ForwardMessage_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "message.proto",
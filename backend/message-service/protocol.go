@@ -0,0 +1,300 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/hoshibmatchi/message-service/proto"
+	userPb "github.com/hoshibmatchi/user-service/proto"
+)
+
+// WebSocket protocol, version 1.
+//
+// Clients send one JSON envelope per frame:
+//
+//	{"v": 1, "type": "<type>", "id": "<optional, echoed in the reply>", ...}
+//
+// Clients from before versioning omit "v"; it is read as 1. Any other version
+// is rejected with an error frame.
+//
+// Client -> server
+//
+//	typing_start  {"conversation_id"}                  Typing in the conversation. Repeat every few
+//	                                                    seconds while typing; at most one per
+//	                                                    typingThrottle is forwarded.
+//	typing_stop   {"conversation_id"}                  Stopped typing (sent, cleared the box, left)
+//	delivered     {"conversation_id", "message_id"}    A pushed message reached this device
+//	read          {"conversation_id", "message_id"?}   Read up to message_id, or the latest message
+//	ping          {}                                    Keepalive, answered with pong
+//	subscribe     {"conversation_ids": [...]}           Also receive these conversations, e.g. one
+//	                                                    joined after connecting. Conversations the
+//	                                                    user isn't in are skipped.
+//
+// Server -> client
+//
+// Replies go only to the connection that asked:
+//
+//	pong          {"v", "id"?}
+//	subscribed    {"v", "id"?, "conversation_ids"}      The conversations now being received
+//	error         {"v", "id"?, "error"}                 The frame was rejected
+//
+// Every connection of a user is told when they join or leave a conversation
+// (created, added, removed, left); the server updates its subscriptions itself:
+//
+//	conversation_joined  {"v", "conversation_ids"}
+//	conversation_left    {"v", "conversation_ids"}
+//
+// Everything else is published on the conversation's Redis chat:<id> channel
+// and pushed to every subscribed connection:
+//
+//	(message)     A chat message: the pb.Message JSON, with its "id" and "sender_id".
+//	              Replies carry "reply_to" and forwarded copies "forwarded".
+//	typing        {"v", "conversation_id", "user_id", "username", "typing", "expires_in_ms"}
+//	              Someone else started or stopped typing. Clear the indicator after
+//	              expires_in_ms without another typing event.
+//	read_receipt  {"conversation_id", "user_id", "last_delivered_message_id", "last_read_message_id"}
+//	presence      {"v", "conversation_id", "user_id", "online", "last_seen_at"?}
+//	              Someone came online on their first connection or went offline when
+//	              their last one closed. Not sent for users hiding their activity
+//	              status; GetPresence has the current state (see presence.go).
+//	reaction      {"v", "conversation_id", "message_id", "user_id", "emoji", "reactions"}
+//	              user_id reacted with emoji, or removed theirs if it is empty.
+//	              reactions replaces everything the message had.
+//	participant_added, participant_removed, participant_left, group_updated
+//	              Group changes; a system message with the same news follows as a chat message
+
+const (
+	protocolVersion    = 1
+	maxFrameSize       = 4096
+	maxSubscribeIDs    = 100
+	typingThrottle     = 3 * time.Second // Per connection and conversation
+	typingIndicatorTTL = 6 * time.Second // How long clients show an indicator without a refresh
+)
+
+// Frame types
+const (
+	frameTypingStart = "typing_start"
+	frameTypingStop  = "typing_stop"
+	frameDelivered   = "delivered"
+	frameRead        = "read"
+	framePing        = "ping"
+	frameSubscribe   = "subscribe"
+
+	framePong       = "pong"
+	frameSubscribed = "subscribed"
+	frameError      = "error"
+	eventTyping     = "typing"
+)
+
+// clientFrame is an envelope sent by a client
+type clientFrame struct {
+	V               int      `json:"v"`
+	Type            string   `json:"type"`
+	ID              string   `json:"id"`
+	ConversationID  string   `json:"conversation_id"`
+	MessageID       string   `json:"message_id"`
+	ConversationIDs []string `json:"conversation_ids"`
+}
+
+// replyFrame is sent to a single connection
+type replyFrame struct {
+	V               int      `json:"v"`
+	Type            string   `json:"type"`
+	ID              string   `json:"id,omitempty"`
+	Error           string   `json:"error,omitempty"`
+	ConversationIDs []string `json:"conversation_ids,omitempty"`
+}
+
+// typingEvent is fanned out to the rest of the conversation
+type typingEvent struct {
+	V              int    `json:"v"`
+	Type           string `json:"type"`
+	ConversationID string `json:"conversation_id"`
+	UserID         int64  `json:"user_id"`
+	Username       string `json:"username"`
+	Typing         bool   `json:"typing"`
+	ExpiresInMs    int64  `json:"expires_in_ms"`
+}
+
+// realtimeHeader is what the Redis listener routes chat:* payloads by.
+// Chat messages and events all carry conversation_id.
+type realtimeHeader struct {
+	Type           string `json:"type"`
+	ConversationID string `json:"conversation_id"`
+	UserID         int64  `json:"user_id"`
+}
+
+// reply queues a frame for this connection only
+func (c *Client) reply(frame replyFrame) {
+	frame.V = protocolVersion
+	body, _ := json.Marshal(frame)
+	if !c.deliver(body) {
+		log.Printf("Dropped %s reply to client %d", frame.Type, c.userID)
+	}
+}
+
+// handleClientFrame processes one frame read from a client. Problems are
+// answered with an error frame; the connection stays open.
+func (s *server) handleClientFrame(c *Client, data []byte) {
+	var frame clientFrame
+	if err := json.Unmarshal(data, &frame); err != nil {
+		c.reply(replyFrame{Type: frameError, Error: "Invalid frame"})
+		return
+	}
+	if frame.V == 0 {
+		frame.V = protocolVersion
+	}
+	if frame.V != protocolVersion {
+		c.reply(replyFrame{Type: frameError, ID: frame.ID, Error: fmt.Sprintf("Unsupported protocol version %d", frame.V)})
+		return
+	}
+
+	ctx := context.Background()
+	var err error
+	switch frame.Type {
+	case framePing:
+		c.reply(replyFrame{Type: framePong, ID: frame.ID})
+	case frameSubscribe:
+		subscribed, subErr := s.subscribeClient(c, frame.ConversationIDs)
+		if err = subErr; err == nil {
+			c.reply(replyFrame{Type: frameSubscribed, ID: frame.ID, ConversationIDs: subscribed})
+		}
+	case frameTypingStart, frameTypingStop:
+		err = s.handleTyping(ctx, c, frame.ConversationID, frame.Type == frameTypingStart)
+	case frameDelivered:
+		if !c.isSubscribed(frame.ConversationID) {
+			err = status.Error(codes.PermissionDenied, "Not subscribed to this conversation")
+		} else {
+			err = s.markDelivered(ctx, c.userID, frame.ConversationID, frame.MessageID)
+		}
+	case frameRead:
+		_, err = s.MarkConversationRead(ctx, &pb.MarkConversationReadRequest{
+			UserId:         c.userID,
+			ConversationId: frame.ConversationID,
+			MessageId:      frame.MessageID,
+		})
+	default:
+		err = status.Error(codes.InvalidArgument, "Unknown frame type")
+	}
+
+	if err != nil {
+		c.reply(replyFrame{Type: frameError, ID: frame.ID, Error: status.Convert(err).Message()})
+	}
+}
+
+// subscribeClient adds the conversations the user is actually in to the
+// connection and returns all it now receives from the requested ones
+func (s *server) subscribeClient(c *Client, convoIDs []string) ([]string, error) {
+	if len(convoIDs) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "conversation_ids is required")
+	}
+	if len(convoIDs) > maxSubscribeIDs {
+		return nil, status.Errorf(codes.InvalidArgument, "Too many conversations (max %d)", maxSubscribeIDs)
+	}
+
+	ids := make([]uint, 0, len(convoIDs))
+	for _, id := range convoIDs {
+		if parsed, _ := strconv.ParseUint(id, 10, 64); parsed != 0 {
+			ids = append(ids, uint(parsed))
+		}
+	}
+	var memberOf []uint
+	if err := s.db.Model(&Participant{}).Where("user_id = ? AND conversation_id IN ?", c.userID, ids).Pluck("conversation_id", &memberOf).Error; err != nil {
+		log.Printf("Failed to check subscriptions for user %d: %v", c.userID, err)
+		return nil, status.Error(codes.Internal, "Failed to subscribe")
+	}
+
+	subscribed := make([]string, 0, len(memberOf))
+	c.mu.Lock()
+	for _, id := range memberOf {
+		key := strconv.FormatUint(uint64(id), 10)
+		c.convoIDs[key] = true
+		subscribed = append(subscribed, key)
+	}
+	c.mu.Unlock()
+	return subscribed, nil
+}
+
+// handleTyping forwards a typing change unless it is throttled or redundant
+func (s *server) handleTyping(ctx context.Context, c *Client, convoID string, typing bool) error {
+	if !c.isSubscribed(convoID) {
+		return status.Error(codes.PermissionDenied, "Not subscribed to this conversation")
+	}
+
+	now := time.Now()
+	c.mu.Lock()
+	last, active := c.typing[convoID]
+	forward := false
+	if typing && (!active || now.Sub(last) >= typingThrottle) {
+		c.typing[convoID] = now
+		forward = true
+	} else if !typing && active {
+		delete(c.typing, convoID)
+		forward = true
+	}
+	c.mu.Unlock()
+
+	if forward {
+		s.publishTyping(ctx, c, convoID, typing)
+	}
+	return nil
+}
+
+// stopTyping clears every indicator a closing connection left on
+func (s *server) stopTyping(ctx context.Context, c *Client) {
+	c.mu.Lock()
+	convoIDs := make([]string, 0, len(c.typing))
+	for convoID := range c.typing {
+		convoIDs = append(convoIDs, convoID)
+	}
+	c.typing = make(map[string]time.Time)
+	c.mu.Unlock()
+
+	for _, convoID := range convoIDs {
+		s.publishTyping(ctx, c, convoID, false)
+	}
+}
+
+func (s *server) publishTyping(ctx context.Context, c *Client, convoID string, typing bool) {
+	event := typingEvent{
+		V:              protocolVersion,
+		Type:           eventTyping,
+		ConversationID: convoID,
+		UserID:         c.userID,
+		Username:       s.clientUsername(ctx, c),
+		Typing:         typing,
+		ExpiresInMs:    typingIndicatorTTL.Milliseconds(),
+	}
+	msgBody, _ := json.Marshal(event)
+	channelName := fmt.Sprintf("chat:%s", convoID)
+	if err := s.rdb.Publish(ctx, channelName, msgBody).Err(); err != nil {
+		log.Printf("Failed to publish typing event: %v", err)
+	}
+}
+
+// clientUsername looks the connection's username up once
+func (s *server) clientUsername(ctx context.Context, c *Client) string {
+	c.mu.Lock()
+	username := c.username
+	c.mu.Unlock()
+	if username != "" {
+		return username
+	}
+
+	userData, err := s.userClient.GetUserData(ctx, &userPb.GetUserDataRequest{UserId: c.userID})
+	if err != nil {
+		log.Printf("Failed to get user data for user %d: %v", c.userID, err)
+		return ""
+	}
+	c.mu.Lock()
+	c.username = userData.Username
+	c.mu.Unlock()
+	return userData.Username
+}
@@ -0,0 +1,222 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	pb "github.com/hoshibmatchi/message-service/proto"
+)
+
+// Reactions. Each participant can put one emoji on a message; reacting again
+// replaces it. Every change is pushed to the conversation with the message's
+// full reaction summary, so clients just swap theirs out.
+
+const (
+	eventReaction    = "reaction"
+	maxReactionBytes = 32 // Room for skin tones and ZWJ sequences
+	maxReactionRunes = 8
+)
+
+// reactionEvent is published on the conversation's channel after a change
+type reactionEvent struct {
+	V              int                   `json:"v"`
+	Type           string                `json:"type"`
+	ConversationID string                `json:"conversation_id"`
+	MessageID      string                `json:"message_id"`
+	UserID         int64                 `json:"user_id"`
+	Emoji          string                `json:"emoji"` // Empty when the reaction was removed
+	Reactions      []*pb.ReactionSummary `json:"reactions"`
+}
+
+// validReaction accepts a single emoji. It can't tell one emoji from a few,
+// but it keeps out text.
+func validReaction(emoji string) bool {
+	if emoji == "" || len(emoji) > maxReactionBytes || utf8.RuneCountInString(emoji) > maxReactionRunes || !utf8.ValidString(emoji) {
+		return false
+	}
+	for _, r := range emoji {
+		if r < utf8.RuneSelf || unicode.IsLetter(r) || unicode.IsNumber(r) || unicode.IsSpace(r) {
+			return false
+		}
+	}
+	return true
+}
+
+// reactableMessage loads a message the user can react to
+func (s *server) reactableMessage(userID int64, messageID string) (*Message, error) {
+	msgID, _ := strconv.ParseUint(messageID, 10, 64)
+	if msgID == 0 {
+		return nil, status.Error(codes.InvalidArgument, "Invalid message ID format")
+	}
+
+	var msg Message
+	if err := s.db.First(&msg, msgID).Error; err == gorm.ErrRecordNotFound {
+		return nil, status.Error(codes.NotFound, "Message not found")
+	} else if err != nil {
+		log.Printf("Failed to get message %d: %v", msgID, err)
+		return nil, status.Error(codes.Internal, "Failed to get message")
+	}
+
+	var participantCount int64
+	s.db.Model(&Participant{}).Where("conversation_id = ? AND user_id = ?", msg.ConversationID, userID).Count(&participantCount)
+	if participantCount == 0 {
+		return nil, status.Error(codes.PermissionDenied, "User is not a participant of this conversation")
+	}
+	if msg.Type == messageTypeSystem {
+		return nil, status.Error(codes.InvalidArgument, "System messages can't be reacted to")
+	}
+	return &msg, nil
+}
+
+// reactionSummaries groups the reactions on each message by emoji, most used
+// first; ties keep the order the emoji were first used in
+func (s *server) reactionSummaries(msgIDs []uint) (map[uint][]*pb.ReactionSummary, error) {
+	summaries := make(map[uint][]*pb.ReactionSummary, len(msgIDs))
+	if len(msgIDs) == 0 {
+		return summaries, nil
+	}
+	var reactions []MessageReaction
+	if err := s.db.Where("message_id IN ?", msgIDs).Order("created_at ASC").Find(&reactions).Error; err != nil {
+		return nil, err
+	}
+
+	byEmoji := make(map[uint]map[string]*pb.ReactionSummary)
+	for _, reaction := range reactions {
+		if byEmoji[reaction.MessageID] == nil {
+			byEmoji[reaction.MessageID] = make(map[string]*pb.ReactionSummary)
+		}
+		summary := byEmoji[reaction.MessageID][reaction.Emoji]
+		if summary == nil {
+			summary = &pb.ReactionSummary{Emoji: reaction.Emoji}
+			byEmoji[reaction.MessageID][reaction.Emoji] = summary
+			summaries[reaction.MessageID] = append(summaries[reaction.MessageID], summary)
+		}
+		summary.Count++
+		summary.UserIds = append(summary.UserIds, reaction.UserID)
+	}
+	for _, list := range summaries {
+		sort.SliceStable(list, func(i, j int) bool { return list[i].Count > list[j].Count })
+	}
+	return summaries, nil
+}
+
+// attachReactions fills in the reactions of converted messages
+func (s *server) attachReactions(messages []*pb.Message) {
+	ids := make([]uint, 0, len(messages))
+	for _, msg := range messages {
+		if id, _ := strconv.ParseUint(msg.Id, 10, 64); id != 0 {
+			ids = append(ids, uint(id))
+		}
+	}
+	summaries, err := s.reactionSummaries(ids)
+	if err != nil {
+		log.Printf("Failed to load reactions for %d messages: %v", len(ids), err)
+		return
+	}
+	for _, msg := range messages {
+		id, _ := strconv.ParseUint(msg.Id, 10, 64)
+		msg.Reactions = summaries[uint(id)]
+	}
+}
+
+func (s *server) messageReactions(msg *Message) (*pb.MessageReactions, error) {
+	summaries, err := s.reactionSummaries([]uint{msg.ID})
+	if err != nil {
+		log.Printf("Failed to load reactions of message %d: %v", msg.ID, err)
+		return nil, status.Error(codes.Internal, "Failed to load reactions")
+	}
+	return &pb.MessageReactions{
+		MessageId:      strconv.FormatUint(uint64(msg.ID), 10),
+		ConversationId: strconv.FormatUint(uint64(msg.ConversationID), 10),
+		Reactions:      summaries[msg.ID],
+	}, nil
+}
+
+// publishReactions tells the conversation that userID changed their reaction
+func (s *server) publishReactions(ctx context.Context, reactions *pb.MessageReactions, userID int64, emoji string) {
+	event := reactionEvent{
+		V:              protocolVersion,
+		Type:           eventReaction,
+		ConversationID: reactions.ConversationId,
+		MessageID:      reactions.MessageId,
+		UserID:         userID,
+		Emoji:          emoji,
+		Reactions:      reactions.Reactions,
+	}
+	msgBody, _ := json.Marshal(event)
+	channelName := fmt.Sprintf("chat:%s", reactions.ConversationId)
+	if err := s.rdb.Publish(ctx, channelName, msgBody).Err(); err != nil {
+		log.Printf("Failed to publish reaction event: %v", err)
+	}
+}
+
+// --- GRPC: ReactToMessage ---
+func (s *server) ReactToMessage(ctx context.Context, req *pb.ReactToMessageRequest) (*pb.MessageReactions, error) {
+	emoji := strings.TrimSpace(req.Emoji)
+	if !validReaction(emoji) {
+		return nil, status.Error(codes.InvalidArgument, "Reaction must be a single emoji")
+	}
+	msg, err := s.reactableMessage(req.UserId, req.MessageId)
+	if err != nil {
+		return nil, err
+	}
+
+	reaction := MessageReaction{
+		MessageID: msg.ID,
+		UserID:    req.UserId,
+		Emoji:     emoji,
+		CreatedAt: time.Now(),
+	}
+	err = s.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "message_id"}, {Name: "user_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"emoji", "created_at"}),
+	}).Create(&reaction).Error
+	if err != nil {
+		log.Printf("Failed to save reaction of user %d on message %d: %v", req.UserId, msg.ID, err)
+		return nil, status.Error(codes.Internal, "Failed to react to message")
+	}
+
+	reactions, err := s.messageReactions(msg)
+	if err != nil {
+		return nil, err
+	}
+	s.publishReactions(ctx, reactions, req.UserId, emoji)
+	return reactions, nil
+}
+
+// --- GRPC: RemoveReaction ---
+func (s *server) RemoveReaction(ctx context.Context, req *pb.RemoveReactionRequest) (*pb.MessageReactions, error) {
+	msg, err := s.reactableMessage(req.UserId, req.MessageId)
+	if err != nil {
+		return nil, err
+	}
+
+	result := s.db.Where("message_id = ? AND user_id = ?", msg.ID, req.UserId).Delete(&MessageReaction{})
+	if result.Error != nil {
+		log.Printf("Failed to remove reaction of user %d on message %d: %v", req.UserId, msg.ID, result.Error)
+		return nil, status.Error(codes.Internal, "Failed to remove reaction")
+	}
+
+	reactions, err := s.messageReactions(msg)
+	if err != nil {
+		return nil, err
+	}
+	// Nothing to tell anyone if there was no reaction
+	if result.RowsAffected > 0 {
+		s.publishReactions(ctx, reactions, req.UserId, "")
+	}
+	return reactions, nil
+}
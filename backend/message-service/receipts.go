@@ -0,0 +1,181 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strconv"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+
+	pb "github.com/hoshibmatchi/message-service/proto"
+)
+
+// Delivery and read receipts. Every participant has two pointers into the
+// conversation: the newest message that reached one of their devices and the
+// newest one they have seen. Clients derive sent/delivered/seen from them.
+
+const eventReadReceipt = "read_receipt"
+
+// advanceReadState moves a participant's pointers forward, never back. Reading
+// a message implies it was delivered. Other participants are told when
+// anything moved and broadcast is set.
+func (s *server) advanceReadState(ctx context.Context, convoID uint, userID int64, deliveredID, readID uint, broadcast bool) (*Participant, error) {
+	if readID > deliveredID {
+		deliveredID = readID
+	}
+	result := s.db.Model(&Participant{}).
+		Where("conversation_id = ? AND user_id = ?", convoID, userID).
+		Where("(last_delivered_message_id < ? OR last_read_message_id < ?)", deliveredID, readID).
+		Updates(map[string]interface{}{
+			// CASE rather than GREATEST, which SQLite lacks
+			"last_delivered_message_id": gorm.Expr("CASE WHEN last_delivered_message_id < ? THEN ? ELSE last_delivered_message_id END", deliveredID, deliveredID),
+			"last_read_message_id":      gorm.Expr("CASE WHEN last_read_message_id < ? THEN ? ELSE last_read_message_id END", readID, readID),
+		})
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	var participant Participant
+	if err := s.db.Where("conversation_id = ? AND user_id = ?", convoID, userID).First(&participant).Error; err != nil {
+		return nil, err
+	}
+	if broadcast && result.RowsAffected > 0 {
+		s.publishReadState(ctx, &participant)
+	}
+	return &participant, nil
+}
+
+// backfillReadState counts every message already in each conversation as
+// delivered to and read by its participants
+func backfillReadState(db *gorm.DB) error {
+	return db.Exec(`UPDATE participants SET
+		last_delivered_message_id = latest.id,
+		last_read_message_id = latest.id
+		FROM (SELECT conversation_id, MAX(id) AS id FROM messages GROUP BY conversation_id) AS latest
+		WHERE participants.conversation_id = latest.conversation_id`).Error
+}
+
+// publishReadState sends a read_receipt event to the conversation's channel
+func (s *server) publishReadState(ctx context.Context, participant *Participant) {
+	event := map[string]interface{}{
+		"type":                      eventReadReceipt,
+		"conversation_id":           strconv.FormatUint(uint64(participant.ConversationID), 10),
+		"user_id":                   participant.UserID,
+		"last_delivered_message_id": strconv.FormatUint(uint64(participant.LastDeliveredMessageID), 10),
+		"last_read_message_id":      strconv.FormatUint(uint64(participant.LastReadMessageID), 10),
+	}
+	msgBody, _ := json.Marshal(event)
+	channelName := fmt.Sprintf("chat:%d", participant.ConversationID)
+	if err := s.rdb.Publish(ctx, channelName, msgBody).Err(); err != nil {
+		log.Printf("Failed to publish read_receipt event: %v", err)
+	}
+}
+
+func readStateToGrpc(participant *Participant) *pb.ReadState {
+	return &pb.ReadState{
+		UserId:                 participant.UserID,
+		LastDeliveredMessageId: strconv.FormatUint(uint64(participant.LastDeliveredMessageID), 10),
+		LastReadMessageId:      strconv.FormatUint(uint64(participant.LastReadMessageID), 10),
+	}
+}
+
+// otherReadStates returns, per conversation, the pointers of everyone but the viewer
+func (s *server) otherReadStates(convoIDs []uint, viewerID int64) (map[uint][]*pb.ReadState, error) {
+	var participants []Participant
+	if err := s.db.Where("conversation_id IN ? AND user_id <> ?", convoIDs, viewerID).Find(&participants).Error; err != nil {
+		return nil, err
+	}
+	states := make(map[uint][]*pb.ReadState, len(convoIDs))
+	for i := range participants {
+		states[participants[i].ConversationID] = append(states[participants[i].ConversationID], readStateToGrpc(&participants[i]))
+	}
+	return states, nil
+}
+
+// unreadCounts counts, per conversation, messages from others after the
+// user's read pointer. System messages don't count.
+func (s *server) unreadCounts(convoIDs []uint, userID int64) (map[uint]int32, error) {
+	var rows []struct {
+		ConversationID uint
+		Count          int32
+	}
+	err := s.db.Table("participants").
+		Select("participants.conversation_id, COUNT(messages.id) AS count").
+		Joins("JOIN messages ON messages.conversation_id = participants.conversation_id AND messages.id > participants.last_read_message_id AND messages.deleted_at IS NULL").
+		Where("participants.user_id = ? AND participants.conversation_id IN ?", userID, convoIDs).
+		Where("messages.sender_id <> ? AND (messages.type IS NULL OR messages.type <> ?)", userID, messageTypeSystem).
+		Group("participants.conversation_id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	counts := make(map[uint]int32, len(rows))
+	for _, row := range rows {
+		counts[row.ConversationID] = row.Count
+	}
+	return counts, nil
+}
+
+// markDelivered records a client's acknowledgement that a message reached it
+func (s *server) markDelivered(ctx context.Context, userID int64, conversationID, messageID string) error {
+	convoID, _ := strconv.ParseUint(conversationID, 10, 64)
+	msgID, _ := strconv.ParseUint(messageID, 10, 64)
+	if convoID == 0 || msgID == 0 {
+		return status.Error(codes.InvalidArgument, "Invalid conversation or message ID")
+	}
+
+	var messageCount int64
+	s.db.Model(&Message{}).Where("id = ? AND conversation_id = ?", msgID, convoID).Count(&messageCount)
+	if messageCount == 0 {
+		return status.Error(codes.NotFound, "Message not found in this conversation")
+	}
+	if _, err := s.advanceReadState(ctx, uint(convoID), userID, uint(msgID), 0, true); err != nil {
+		log.Printf("Failed to record delivery of message %d for user %d: %v", msgID, userID, err)
+		return status.Error(codes.Internal, "Failed to record delivery")
+	}
+	return nil
+}
+
+// --- GRPC: MarkConversationRead ---
+func (s *server) MarkConversationRead(ctx context.Context, req *pb.MarkConversationReadRequest) (*pb.ReadState, error) {
+	convoID, _ := strconv.ParseUint(req.ConversationId, 10, 64)
+	if convoID == 0 {
+		return nil, status.Error(codes.InvalidArgument, "Invalid conversation ID format")
+	}
+
+	var participantCount int64
+	s.db.Model(&Participant{}).Where("conversation_id = ? AND user_id = ?", convoID, req.UserId).Count(&participantCount)
+	if participantCount == 0 {
+		return nil, status.Error(codes.PermissionDenied, "User is not a participant of this conversation")
+	}
+
+	// Read up to the given message, or the latest one
+	var msg Message
+	query := s.db.Select("id").Where("conversation_id = ?", convoID)
+	if req.MessageId != "" {
+		msgID, _ := strconv.ParseUint(req.MessageId, 10, 64)
+		if msgID == 0 {
+			return nil, status.Error(codes.InvalidArgument, "Invalid message ID format")
+		}
+		query = query.Where("id = ?", msgID)
+	}
+	err := query.Order("id DESC").First(&msg).Error
+	if err == gorm.ErrRecordNotFound && req.MessageId != "" {
+		return nil, status.Error(codes.NotFound, "Message not found in this conversation")
+	} else if err != nil && err != gorm.ErrRecordNotFound {
+		log.Printf("Failed to find message to mark read in convo %d: %v", convoID, err)
+		return nil, status.Error(codes.Internal, "Failed to mark conversation read")
+	}
+
+	// msg.ID is 0 for an empty conversation, which leaves the pointers alone
+	participant, err := s.advanceReadState(ctx, uint(convoID), req.UserId, msg.ID, msg.ID, true)
+	if err != nil {
+		log.Printf("Failed to mark convo %d read for user %d: %v", convoID, req.UserId, err)
+		return nil, status.Error(codes.Internal, "Failed to mark conversation read")
+	}
+	return readStateToGrpc(participant), nil
+}
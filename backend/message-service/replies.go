@@ -0,0 +1,144 @@
+package main
+
+import (
+	"context"
+	"log"
+	"strconv"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/hoshibmatchi/message-service/proto"
+	userPb "github.com/hoshibmatchi/user-service/proto"
+)
+
+// Replies. A message can answer an earlier one in the same conversation; it
+// is shown with a quote of the original, read fresh each time so an unsent
+// original shows as such.
+
+const replySnippetLength = 100 // Runes
+
+// replyTarget checks that a message can be replied to in the conversation
+func (s *server) replyTarget(convoID uint, messageID string) (uint, error) {
+	replyToID, _ := strconv.ParseUint(messageID, 10, 64)
+	if replyToID == 0 {
+		return 0, status.Error(codes.InvalidArgument, "Invalid reply_to_message_id format")
+	}
+
+	var count int64
+	s.db.Model(&Message{}).
+		Where("id = ? AND conversation_id = ?", replyToID, convoID).
+		Where("(type IS NULL OR type <> ?)", messageTypeSystem).
+		Count(&count)
+	if count == 0 {
+		return 0, status.Error(codes.NotFound, "The message being replied to is not in this conversation")
+	}
+	return uint(replyToID), nil
+}
+
+// attachReplyPreviews quotes the messages that converted replies answer,
+// loading the originals and their senders once for the whole page
+func (s *server) attachReplyPreviews(ctx context.Context, messages []*pb.Message) {
+	ids := make([]uint, 0, len(messages))
+	for _, msg := range messages {
+		if msg.ReplyTo == nil {
+			continue
+		}
+		if id, _ := strconv.ParseUint(msg.ReplyTo.MessageId, 10, 64); id != 0 {
+			ids = append(ids, uint(id))
+		}
+	}
+	if len(ids) == 0 {
+		return
+	}
+
+	// Unscoped, so an unsent original is told apart from a lookup failure
+	var originals []Message
+	if err := s.db.Unscoped().Where("id IN ?", ids).Find(&originals).Error; err != nil {
+		log.Printf("Failed to get %d replied-to messages: %v", len(ids), err)
+		return
+	}
+	byID := make(map[uint]*Message, len(originals))
+	senderIDs := make([]int64, 0, len(originals))
+	seen := make(map[int64]bool)
+	for i := range originals {
+		byID[originals[i].ID] = &originals[i]
+		if senderID := originals[i].SenderID; !originals[i].DeletedAt.Valid && !seen[senderID] {
+			seen[senderID] = true
+			senderIDs = append(senderIDs, senderID)
+		}
+	}
+	usernames := s.usernamesOf(ctx, senderIDs)
+
+	for _, msg := range messages {
+		if msg.ReplyTo == nil {
+			continue
+		}
+		id, _ := strconv.ParseUint(msg.ReplyTo.MessageId, 10, 64)
+		original := byID[uint(id)]
+		if original == nil || original.DeletedAt.Valid {
+			msg.ReplyTo.Unsent = true
+			continue
+		}
+		msg.ReplyTo.SenderId = strconv.FormatInt(original.SenderID, 10)
+		msg.ReplyTo.SenderUsername = usernames[original.SenderID]
+		if msg.ReplyTo.SenderUsername == "" {
+			msg.ReplyTo.SenderUsername = "Unknown"
+		}
+		msg.ReplyTo.Type = original.Type
+		if msg.ReplyTo.Type == "" {
+			msg.ReplyTo.Type = messageTypeText
+		}
+		msg.ReplyTo.Snippet = replySnippet(original)
+	}
+}
+
+// usernamesOf looks up the usernames of several users in one call
+func (s *server) usernamesOf(ctx context.Context, userIDs []int64) map[int64]string {
+	usernames := make(map[int64]string, len(userIDs))
+	if len(userIDs) == 0 {
+		return usernames
+	}
+	res, err := s.userClient.GetUserSummaries(ctx, &userPb.GetUserSummariesRequest{UserIds: userIDs})
+	if err != nil {
+		log.Printf("Failed to get usernames of %d users: %v", len(userIDs), err)
+		return usernames
+	}
+	for _, summary := range res.Users {
+		usernames[summary.User.UserId] = summary.User.Username
+	}
+	return usernames
+}
+
+// replySnippet is the start of a message's text, or what kind of thing it was
+func replySnippet(msg *Message) string {
+	if msg.Content != "" {
+		return truncateRunes(msg.Content, replySnippetLength)
+	}
+	switch msg.Type {
+	case messageTypeMedia:
+		switch msg.MediaType {
+		case "video":
+			return "Video"
+		case "gif":
+			return "GIF"
+		}
+		return "Photo"
+	case messageTypeSharedPost:
+		return "Post"
+	case messageTypeProfile:
+		return "Profile"
+	case messageTypeLocation:
+		return "Location"
+	}
+	return ""
+}
+
+// truncateRunes cuts s to at most n runes, marking the cut with an ellipsis
+func truncateRunes(s string, n int) string {
+	runes := []rune(s)
+	if len(runes) <= n {
+		return s
+	}
+	return string(runes[:n-1]) + "…"
+}
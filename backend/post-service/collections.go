@@ -0,0 +1,716 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"log"
+	"strconv"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+
+	pb "github.com/hoshibmatchi/post-service/proto"
+)
+
+// Roles in a collection. The owner is Collection.UserID; everyone else is a
+// CollectionMember. Anyone who can open a "link" or "public" collection
+// without being a member is a viewer.
+const (
+	collectionRoleOwner  = "owner"
+	collectionRoleEditor = "editor"
+	collectionRoleViewer = "viewer"
+
+	collectionPrivate = "private" // Owner and members only
+	collectionLink    = "link"    // Also anyone with the share link
+	collectionPublic  = "public"  // Anyone
+
+	memberPending  = "pending"
+	memberAccepted = "accepted"
+
+	collectionCoverCount = 4   // Cover images shown per collection
+	maxSavedPostBatch    = 100 // Posts per reorder or bulk move
+)
+
+// savedPostOrder is a collection's order, top first. Posts saved before manual
+// ordering all share position 0 and fall back to newest first.
+const savedPostOrder = "saved_posts.position DESC, saved_posts.created_at DESC, saved_posts.post_id DESC"
+
+// CollectionMember gives a user access to someone else's collection
+type CollectionMember struct {
+	CollectionID uint   `gorm:"primaryKey"`
+	UserID       int64  `gorm:"primaryKey;index"`
+	Role         string `gorm:"type:varchar(10)"` // editor or viewer
+	Status       string `gorm:"type:varchar(10)"` // pending until the invitee accepts
+	InvitedBy    int64
+	CreatedAt    time.Time
+	AcceptedAt   *time.Time
+}
+
+func (m *CollectionMember) toProto() *pb.CollectionMember {
+	return &pb.CollectionMember{
+		CollectionId: strconv.FormatUint(uint64(m.CollectionID), 10),
+		UserId:       m.UserID,
+		Role:         m.Role,
+		Status:       m.Status,
+		InvitedBy:    m.InvitedBy,
+		CreatedAt:    m.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+// newShareToken returns a random token for a collection share link
+func newShareToken() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// collectionRole returns what the user may do with a collection, or "" if they
+// can't see it at all. shareToken is the token from a share link, if any.
+func (s *server) collectionRole(collection *Collection, userID int64, shareToken string) (string, error) {
+	if collection.UserID == userID {
+		return collectionRoleOwner, nil
+	}
+
+	var member CollectionMember
+	err := s.db.Where("collection_id = ? AND user_id = ? AND status = ?", collection.ID, userID, memberAccepted).First(&member).Error
+	if err == nil {
+		return member.Role, nil
+	}
+	if err != gorm.ErrRecordNotFound {
+		return "", err
+	}
+
+	switch collection.Privacy {
+	case collectionPublic:
+		return collectionRoleViewer, nil
+	case collectionLink:
+		if shareToken != "" && collection.ShareToken != nil &&
+			subtle.ConstantTimeCompare([]byte(shareToken), []byte(*collection.ShareToken)) == 1 {
+			return collectionRoleViewer, nil
+		}
+	}
+	return "", nil
+}
+
+// authorizeCollection loads a collection the user can view, or edit when canEdit is set
+func (s *server) authorizeCollection(collectionID, userID int64, shareToken string, canEdit bool) (*Collection, string, error) {
+	var collection Collection
+	if err := s.db.First(&collection, collectionID).Error; err == gorm.ErrRecordNotFound {
+		return nil, "", status.Error(codes.NotFound, "Collection not found")
+	} else if err != nil {
+		return nil, "", status.Error(codes.Internal, "Failed to retrieve collection")
+	}
+
+	role, err := s.collectionRole(&collection, userID, shareToken)
+	if err != nil {
+		return nil, "", status.Error(codes.Internal, "Failed to check collection access")
+	}
+	if role == "" {
+		return nil, "", status.Error(codes.PermissionDenied, "You don't have access to this collection")
+	}
+	if canEdit && role != collectionRoleOwner && role != collectionRoleEditor {
+		return nil, "", status.Error(codes.PermissionDenied, "You can't edit this collection")
+	}
+	return &collection, role, nil
+}
+
+// ownedCollection loads a collection for an owner-only change. The default
+// collection is private to its owner and can't be shared.
+func (s *server) ownedCollection(collectionID, userID int64) (*Collection, error) {
+	var collection Collection
+	if err := s.db.First(&collection, collectionID).Error; err == gorm.ErrRecordNotFound {
+		return nil, status.Error(codes.NotFound, "Collection not found")
+	} else if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to retrieve collection")
+	}
+	if collection.UserID != userID {
+		return nil, status.Error(codes.PermissionDenied, "You do not own this collection")
+	}
+	if collection.IsDefault {
+		return nil, status.Error(codes.PermissionDenied, "Cannot share default collection")
+	}
+	return &collection, nil
+}
+
+// --- GRPC: GetCollection ---
+func (s *server) GetCollection(ctx context.Context, req *pb.GetCollectionRequest) (*pb.Collection, error) {
+	collection, role, err := s.authorizeCollection(req.CollectionId, req.UserId, req.ShareToken, false)
+	if err != nil {
+		return nil, err
+	}
+	grpcCollection := s.gormToGrpcCollection(collection, role)
+	s.attachCollectionCovers(ctx, []Collection{*collection}, []*pb.Collection{grpcCollection}, req.UserId)
+	return grpcCollection, nil
+}
+
+// --- GRPC: SetCollectionPrivacy ---
+func (s *server) SetCollectionPrivacy(ctx context.Context, req *pb.SetCollectionPrivacyRequest) (*pb.Collection, error) {
+	if req.Privacy != collectionPrivate && req.Privacy != collectionLink && req.Privacy != collectionPublic {
+		return nil, status.Error(codes.InvalidArgument, "Privacy must be private, link or public")
+	}
+	collection, err := s.ownedCollection(req.CollectionId, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	collection.Privacy = req.Privacy
+	// The token survives switching back to private, so re-sharing keeps the old link unless reset
+	if req.Privacy != collectionPrivate && (collection.ShareToken == nil || req.ResetShareLink) {
+		token, err := newShareToken()
+		if err != nil {
+			return nil, status.Error(codes.Internal, "Failed to create share link")
+		}
+		collection.ShareToken = &token
+	}
+	if err := s.db.Model(collection).Updates(map[string]interface{}{
+		"privacy":     collection.Privacy,
+		"share_token": collection.ShareToken,
+	}).Error; err != nil {
+		return nil, status.Error(codes.Internal, "Failed to update collection")
+	}
+
+	return s.gormToGrpcCollection(collection, collectionRoleOwner), nil
+}
+
+// --- GRPC: InviteCollectionMember ---
+func (s *server) InviteCollectionMember(ctx context.Context, req *pb.InviteCollectionMemberRequest) (*pb.CollectionMember, error) {
+	if req.Role != collectionRoleEditor && req.Role != collectionRoleViewer {
+		return nil, status.Error(codes.InvalidArgument, "Role must be editor or viewer")
+	}
+	if req.InviteeId == req.UserId {
+		return nil, status.Error(codes.InvalidArgument, "You already own this collection")
+	}
+	collection, err := s.ownedCollection(req.CollectionId, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	rel, err := s.newRelationshipCache(req.UserId).get(ctx, req.InviteeId)
+	if err != nil {
+		log.Printf("Failed to check relationship between %d and %d: %v", req.UserId, req.InviteeId, err)
+		return nil, status.Error(codes.Internal, "Failed to check user")
+	}
+	if !rel.Exists {
+		return nil, status.Error(codes.NotFound, "User not found")
+	}
+	if rel.Blocked {
+		return nil, status.Error(codes.PermissionDenied, "You can't invite this user")
+	}
+
+	var member CollectionMember
+	err = s.db.Where("collection_id = ? AND user_id = ?", collection.ID, req.InviteeId).First(&member).Error
+	if err == nil {
+		// Already invited or a member: only the role changes
+		if err := s.db.Model(&member).Update("role", req.Role).Error; err != nil {
+			return nil, status.Error(codes.Internal, "Failed to update member")
+		}
+		return member.toProto(), nil
+	}
+	if err != gorm.ErrRecordNotFound {
+		return nil, status.Error(codes.Internal, "Failed to retrieve member")
+	}
+
+	member = CollectionMember{
+		CollectionID: collection.ID,
+		UserID:       req.InviteeId,
+		Role:         req.Role,
+		Status:       memberPending,
+		InvitedBy:    req.UserId,
+	}
+	if err := s.db.Create(&member).Error; err != nil {
+		log.Printf("Failed to invite user %d to collection %d: %v", req.InviteeId, collection.ID, err)
+		return nil, status.Error(codes.Internal, "Failed to invite member")
+	}
+
+	msgBody, _ := json.Marshal(map[string]interface{}{
+		"type":      "collection.invited",
+		"actor_id":  req.UserId,
+		"user_id":   req.InviteeId,
+		"entity_id": collection.ID,
+	})
+	s.publishToQueue(ctx, "notification_queue", msgBody)
+
+	return member.toProto(), nil
+}
+
+// --- GRPC: RespondToCollectionInvite ---
+func (s *server) RespondToCollectionInvite(ctx context.Context, req *pb.RespondToCollectionInviteRequest) (*pb.RespondToCollectionInviteResponse, error) {
+	var member CollectionMember
+	if err := s.db.Where("collection_id = ? AND user_id = ? AND status = ?", req.CollectionId, req.UserId, memberPending).
+		First(&member).Error; err == gorm.ErrRecordNotFound {
+		return nil, status.Error(codes.NotFound, "Invite not found")
+	} else if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to retrieve invite")
+	}
+
+	if !req.Accept {
+		if err := s.db.Delete(&member).Error; err != nil {
+			return nil, status.Error(codes.Internal, "Failed to decline invite")
+		}
+		return &pb.RespondToCollectionInviteResponse{Message: "Invite declined"}, nil
+	}
+
+	now := time.Now()
+	if err := s.db.Model(&member).Updates(map[string]interface{}{"status": memberAccepted, "accepted_at": now}).Error; err != nil {
+		return nil, status.Error(codes.Internal, "Failed to accept invite")
+	}
+	return &pb.RespondToCollectionInviteResponse{Message: "Invite accepted"}, nil
+}
+
+// --- GRPC: GetCollectionInvites ---
+func (s *server) GetCollectionInvites(ctx context.Context, req *pb.GetCollectionInvitesRequest) (*pb.GetCollectionInvitesResponse, error) {
+	var invites []CollectionMember
+	if err := s.db.Where("user_id = ? AND status = ?", req.UserId, memberPending).
+		Order("created_at DESC").Find(&invites).Error; err != nil {
+		return nil, status.Error(codes.Internal, "Failed to retrieve invites")
+	}
+	if len(invites) == 0 {
+		return &pb.GetCollectionInvitesResponse{Invites: []*pb.CollectionInvite{}}, nil
+	}
+
+	ids := make([]uint, len(invites))
+	for i := range invites {
+		ids[i] = invites[i].CollectionID
+	}
+	var collections []Collection
+	if err := s.db.Where("id IN ?", ids).Find(&collections).Error; err != nil {
+		return nil, status.Error(codes.Internal, "Failed to retrieve collections")
+	}
+	byID := make(map[uint]*Collection, len(collections))
+	for i := range collections {
+		byID[collections[i].ID] = &collections[i]
+	}
+
+	response := &pb.GetCollectionInvitesResponse{Invites: make([]*pb.CollectionInvite, 0, len(invites))}
+	for _, invite := range invites {
+		collection, ok := byID[invite.CollectionID]
+		if !ok {
+			continue
+		}
+		response.Invites = append(response.Invites, &pb.CollectionInvite{
+			Collection: s.gormToGrpcCollection(collection, ""),
+			Role:       invite.Role,
+			InvitedBy:  invite.InvitedBy,
+			CreatedAt:  invite.CreatedAt.Format(time.RFC3339),
+		})
+	}
+	return response, nil
+}
+
+// --- GRPC: GetCollectionMembers ---
+// Members can see each other; pending invites are only shown to the owner
+func (s *server) GetCollectionMembers(ctx context.Context, req *pb.GetCollectionMembersRequest) (*pb.GetCollectionMembersResponse, error) {
+	var collection Collection
+	if err := s.db.First(&collection, req.CollectionId).Error; err == gorm.ErrRecordNotFound {
+		return nil, status.Error(codes.NotFound, "Collection not found")
+	} else if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to retrieve collection")
+	}
+
+	isOwner := collection.UserID == req.UserId
+	if !isOwner {
+		var count int64
+		if err := s.db.Model(&CollectionMember{}).
+			Where("collection_id = ? AND user_id = ? AND status = ?", collection.ID, req.UserId, memberAccepted).
+			Count(&count).Error; err != nil {
+			return nil, status.Error(codes.Internal, "Failed to check collection access")
+		}
+		if count == 0 {
+			return nil, status.Error(codes.PermissionDenied, "Only members can see who else is in this collection")
+		}
+	}
+
+	query := s.db.Where("collection_id = ?", collection.ID)
+	if !isOwner {
+		query = query.Where("status = ?", memberAccepted)
+	}
+	var members []CollectionMember
+	if err := query.Order("created_at ASC").Find(&members).Error; err != nil {
+		return nil, status.Error(codes.Internal, "Failed to retrieve members")
+	}
+
+	owner := CollectionMember{
+		CollectionID: collection.ID,
+		UserID:       collection.UserID,
+		Role:         collectionRoleOwner,
+		Status:       memberAccepted,
+		CreatedAt:    collection.CreatedAt,
+	}
+	response := &pb.GetCollectionMembersResponse{Members: []*pb.CollectionMember{owner.toProto()}}
+	for i := range members {
+		response.Members = append(response.Members, members[i].toProto())
+	}
+	return response, nil
+}
+
+// --- GRPC: RemoveCollectionMember ---
+func (s *server) RemoveCollectionMember(ctx context.Context, req *pb.RemoveCollectionMemberRequest) (*pb.RemoveCollectionMemberResponse, error) {
+	var collection Collection
+	if err := s.db.First(&collection, req.CollectionId).Error; err == gorm.ErrRecordNotFound {
+		return nil, status.Error(codes.NotFound, "Collection not found")
+	} else if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to retrieve collection")
+	}
+	if req.UserId != collection.UserID && req.UserId != req.MemberId {
+		return nil, status.Error(codes.PermissionDenied, "Only the owner can remove other members")
+	}
+	if req.MemberId == collection.UserID {
+		return nil, status.Error(codes.InvalidArgument, "The owner can't leave their own collection")
+	}
+
+	result := s.db.Where("collection_id = ? AND user_id = ?", collection.ID, req.MemberId).Delete(&CollectionMember{})
+	if result.Error != nil {
+		return nil, status.Error(codes.Internal, "Failed to remove member")
+	}
+	if result.RowsAffected == 0 {
+		return nil, status.Error(codes.NotFound, "Member not found")
+	}
+
+	message := "Member removed"
+	if req.UserId == req.MemberId {
+		message = "You left the collection"
+	}
+	return &pb.RemoveCollectionMemberResponse{Message: message}, nil
+}
+
+// nextSavedPostPosition returns the position that puts a new save on top of a collection
+func (s *server) nextSavedPostPosition(db *gorm.DB, collectionID uint) int64 {
+	var top int64
+	if err := db.Model(&SavedPost{}).Where("collection_id = ?", collectionID).
+		Select("COALESCE(MAX(position), 0)").Scan(&top).Error; err != nil {
+		log.Printf("Failed to read top position of collection %d: %v", collectionID, err)
+	}
+	return top + 1
+}
+
+// recordFirstSave counts a save in the post's insights the first time it lands
+// in any of the owner's collections
+func (s *server) recordFirstSave(postID, ownerID int64) {
+	var savedBy int64
+	s.db.Table("saved_posts").
+		Joins("JOIN collections ON collections.id = saved_posts.collection_id").
+		Where("saved_posts.post_id = ? AND collections.user_id = ?", postID, ownerID).
+		Count(&savedBy)
+	if savedBy == 1 {
+		s.recordPostMetric(postID, metricSave)
+	}
+}
+
+// attachCollectionCovers fills in post counts and cover images. Covers skip
+// archived posts and posts the viewer isn't allowed to see.
+func (s *server) attachCollectionCovers(ctx context.Context, collections []Collection, out []*pb.Collection, viewerID int64) {
+	if len(collections) == 0 {
+		return
+	}
+	ids := make([]uint, len(collections))
+	var chosenIDs []uint
+	for i := range collections {
+		ids[i] = collections[i].ID
+		if collections[i].CoverPostID != nil {
+			chosenIDs = append(chosenIDs, *collections[i].CoverPostID)
+		}
+	}
+
+	var counts []struct {
+		CollectionID uint
+		Count        int64
+	}
+	if err := s.db.Model(&SavedPost{}).
+		Select("saved_posts.collection_id, COUNT(*) AS count").
+		Joins("JOIN posts ON posts.id = saved_posts.post_id").
+		Where("saved_posts.collection_id IN ? AND posts.archived_at IS NULL AND posts.deleted_at IS NULL", ids).
+		Group("saved_posts.collection_id").
+		Scan(&counts).Error; err != nil {
+		log.Printf("Failed to count collection posts: %v", err)
+	}
+	countByID := make(map[uint]int64, len(counts))
+	for _, c := range counts {
+		countByID[c.CollectionID] = c.Count
+	}
+
+	// A few spare candidates per collection, in case some are hidden from the viewer,
+	// ranked within each collection in one query
+	var ranked []struct {
+		CollectionID uint
+		PostID       uint
+	}
+	rankedPosts := s.db.Model(&SavedPost{}).
+		Select("saved_posts.collection_id, saved_posts.post_id, ROW_NUMBER() OVER (PARTITION BY saved_posts.collection_id ORDER BY "+savedPostOrder+") AS row_num").
+		Joins("JOIN posts ON posts.id = saved_posts.post_id").
+		Where("saved_posts.collection_id IN ? AND posts.archived_at IS NULL AND posts.deleted_at IS NULL", ids)
+	if err := s.db.Table("(?) AS ranked", rankedPosts).
+		Select("collection_id, post_id").
+		Where("row_num <= ?", collectionCoverCount*2).
+		Order("collection_id, row_num").
+		Scan(&ranked).Error; err != nil {
+		log.Printf("Failed to load collection covers: %v", err)
+	}
+
+	// The chosen covers and the candidates are loaded together
+	postIDs := chosenIDs
+	for _, r := range ranked {
+		postIDs = append(postIDs, r.PostID)
+	}
+	postByID := make(map[uint]Post, len(postIDs))
+	if len(postIDs) > 0 {
+		var posts []Post
+		if err := s.db.Scopes(notArchived).Where("id IN ?", postIDs).Find(&posts).Error; err != nil {
+			log.Printf("Failed to load collection cover posts: %v", err)
+		}
+		for _, post := range posts {
+			postByID[post.ID] = post
+		}
+	}
+	rankedByCollection := make(map[uint][]uint, len(collections))
+	for _, r := range ranked {
+		rankedByCollection[r.CollectionID] = append(rankedByCollection[r.CollectionID], r.PostID)
+	}
+
+	candidates := make([][]Post, len(collections))
+	var authorIDs []int64
+	for i := range collections {
+		if collections[i].CoverPostID != nil {
+			if post, ok := postByID[*collections[i].CoverPostID]; ok {
+				candidates[i] = append(candidates[i], post)
+			}
+		}
+		for _, postID := range rankedByCollection[collections[i].ID] {
+			post, ok := postByID[postID]
+			if ok && (collections[i].CoverPostID == nil || postID != *collections[i].CoverPostID) {
+				candidates[i] = append(candidates[i], post)
+			}
+		}
+		for _, post := range candidates[i] {
+			authorIDs = append(authorIDs, post.AuthorID)
+		}
+	}
+
+	rels := s.newRelationshipCache(viewerID)
+	if err := rels.prefetch(ctx, authorIDs); err != nil {
+		log.Printf("Failed to load relationships for user %d: %v", viewerID, err)
+		candidates = make([][]Post, len(collections))
+	}
+	for i := range collections {
+		out[i].PostCount = countByID[collections[i].ID]
+		for _, post := range candidates[i] {
+			if len(out[i].CoverImageUrls) == collectionCoverCount {
+				break
+			}
+			if !rels.canView(ctx, post.AuthorID) {
+				continue
+			}
+			if url := coverImageURL(&post); url != "" {
+				out[i].CoverImageUrls = append(out[i].CoverImageUrls, url)
+			}
+		}
+	}
+}
+
+// coverImageURL prefers a post's thumbnail (set for videos) over its first media item
+func coverImageURL(post *Post) string {
+	if post.ThumbnailURL != "" {
+		return post.ThumbnailURL
+	}
+	if len(post.MediaURLs) > 0 {
+		return post.MediaURLs[0]
+	}
+	return ""
+}
+
+// uniquePostIDs validates a batch of post IDs, keeping the first of any repeats
+func uniquePostIDs(postIDs []int64) ([]uint, error) {
+	if len(postIDs) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "post_ids is required")
+	}
+	if len(postIDs) > maxSavedPostBatch {
+		return nil, status.Errorf(codes.InvalidArgument, "At most %d posts at a time", maxSavedPostBatch)
+	}
+	seen := make(map[int64]bool, len(postIDs))
+	ids := make([]uint, 0, len(postIDs))
+	for _, id := range postIDs {
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, uint(id))
+		}
+	}
+	return ids, nil
+}
+
+// --- GRPC: SetCollectionCover ---
+// Owners and editors can pick the cover
+func (s *server) SetCollectionCover(ctx context.Context, req *pb.SetCollectionCoverRequest) (*pb.Collection, error) {
+	collection, role, err := s.authorizeCollection(req.CollectionId, req.UserId, "", true)
+	if err != nil {
+		return nil, err
+	}
+
+	var cover *uint
+	if req.PostId != 0 {
+		var count int64
+		s.db.Model(&SavedPost{}).Where("collection_id = ? AND post_id = ?", collection.ID, req.PostId).Count(&count)
+		if count == 0 {
+			return nil, status.Error(codes.InvalidArgument, "The cover must be a post in this collection")
+		}
+		postID := uint(req.PostId)
+		cover = &postID
+	}
+	if err := s.db.Model(collection).Update("cover_post_id", cover).Error; err != nil {
+		return nil, status.Error(codes.Internal, "Failed to update collection")
+	}
+	collection.CoverPostID = cover
+
+	grpcCollection := s.gormToGrpcCollection(collection, role)
+	s.attachCollectionCovers(ctx, []Collection{*collection}, []*pb.Collection{grpcCollection}, req.UserId)
+	return grpcCollection, nil
+}
+
+// --- GRPC: ReorderCollectionPosts ---
+func (s *server) ReorderCollectionPosts(ctx context.Context, req *pb.ReorderCollectionPostsRequest) (*pb.ReorderCollectionPostsResponse, error) {
+	postIDs, err := uniquePostIDs(req.PostIds)
+	if err != nil {
+		return nil, err
+	}
+	if len(postIDs) != len(req.PostIds) {
+		return nil, status.Error(codes.InvalidArgument, "post_ids must not repeat")
+	}
+	collection, _, err := s.authorizeCollection(req.CollectionId, req.UserId, "", true)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		// 1. Give every post its own position first, so swapping positions actually moves posts
+		var distinct, total int64
+		tx.Model(&SavedPost{}).Where("collection_id = ?", collection.ID).Count(&total)
+		tx.Model(&SavedPost{}).Where("collection_id = ?", collection.ID).Distinct("position").Count(&distinct)
+		if distinct != total {
+			var all []SavedPost
+			if err := tx.Where("collection_id = ?", collection.ID).Order(savedPostOrder).Find(&all).Error; err != nil {
+				return err
+			}
+			for i, saved := range all {
+				if err := tx.Model(&SavedPost{}).
+					Where("collection_id = ? AND post_id = ?", collection.ID, saved.PostID).
+					Update("position", int64(len(all)-i)).Error; err != nil {
+					return err
+				}
+			}
+		}
+
+		// 2. Hand the posts' current positions back out in the requested order
+		var positions []int64
+		if err := tx.Model(&SavedPost{}).
+			Where("collection_id = ? AND post_id IN ?", collection.ID, postIDs).
+			Order("position DESC").
+			Pluck("position", &positions).Error; err != nil {
+			return err
+		}
+		if len(positions) != len(postIDs) {
+			return gorm.ErrRecordNotFound
+		}
+		for i, postID := range postIDs {
+			if err := tx.Model(&SavedPost{}).
+				Where("collection_id = ? AND post_id = ?", collection.ID, postID).
+				Update("position", positions[i]).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err == gorm.ErrRecordNotFound {
+		return nil, status.Error(codes.InvalidArgument, "All posts must be in this collection")
+	} else if err != nil {
+		log.Printf("Failed to reorder collection %d: %v", collection.ID, err)
+		return nil, status.Error(codes.Internal, "Failed to reorder collection")
+	}
+
+	return &pb.ReorderCollectionPostsResponse{Message: "Collection reordered"}, nil
+}
+
+// --- GRPC: MoveSavedPosts ---
+// Moves or copies posts between collections in one transaction: either every
+// post lands in the target collection or nothing changes.
+func (s *server) MoveSavedPosts(ctx context.Context, req *pb.MoveSavedPostsRequest) (*pb.MoveSavedPostsResponse, error) {
+	if req.FromCollectionId == req.ToCollectionId {
+		return nil, status.Error(codes.InvalidArgument, "Source and target collections must differ")
+	}
+	postIDs, err := uniquePostIDs(req.PostIds)
+	if err != nil {
+		return nil, err
+	}
+	// Copying only reads the source; moving takes posts out of it
+	from, _, err := s.authorizeCollection(req.FromCollectionId, req.UserId, "", !req.Copy)
+	if err != nil {
+		return nil, err
+	}
+	to, _, err := s.authorizeCollection(req.ToCollectionId, req.UserId, "", true)
+	if err != nil {
+		return nil, err
+	}
+
+	var added []uint
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		// 1. Every post must be in the source collection
+		var inSource int64
+		if err := tx.Model(&SavedPost{}).Where("collection_id = ? AND post_id IN ?", from.ID, postIDs).Count(&inSource).Error; err != nil {
+			return err
+		}
+		if int(inSource) != len(postIDs) {
+			return gorm.ErrRecordNotFound
+		}
+
+		// 2. Add the ones the target doesn't have yet, on top and in the given order
+		var existing []uint
+		if err := tx.Model(&SavedPost{}).Where("collection_id = ? AND post_id IN ?", to.ID, postIDs).Pluck("post_id", &existing).Error; err != nil {
+			return err
+		}
+		inTarget := make(map[uint]bool, len(existing))
+		for _, id := range existing {
+			inTarget[id] = true
+		}
+		for _, id := range postIDs {
+			if !inTarget[id] {
+				added = append(added, id)
+			}
+		}
+		top := s.nextSavedPostPosition(tx, to.ID)
+		for i, id := range added {
+			saved := SavedPost{CollectionID: to.ID, PostID: id, Position: top + int64(len(added)-1-i)}
+			if err := tx.Create(&saved).Error; err != nil {
+				return err
+			}
+		}
+
+		// 3. Take them out of the source when moving
+		if !req.Copy {
+			if err := tx.Where("collection_id = ? AND post_id IN ?", from.ID, postIDs).Delete(&SavedPost{}).Error; err != nil {
+				return err
+			}
+			if err := tx.Model(&Collection{}).Where("id = ? AND cover_post_id IN ?", from.ID, postIDs).Update("cover_post_id", nil).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err == gorm.ErrRecordNotFound {
+		return nil, status.Error(codes.InvalidArgument, "All posts must be in the source collection")
+	} else if err != nil {
+		log.Printf("Failed to move posts from collection %d to %d: %v", from.ID, to.ID, err)
+		return nil, status.Error(codes.Internal, "Failed to move posts")
+	}
+
+	if from.UserID != to.UserID {
+		for _, id := range added {
+			s.recordFirstSave(int64(id), to.UserID)
+		}
+	}
+
+	return &pb.MoveSavedPostsResponse{Moved: int32(len(added)), Skipped: int32(len(postIDs) - len(added))}, nil
+}
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+)
+
+// pageCursor is the decoded form of the opaque cursor tokens we hand to clients.
+// CreatedAt + ID identify the last item of a page; Score is only used by
+// orderings that don't sort by time (e.g. comments sorted by likes). Rewatch
+// marks reels feed pages served from reels the viewer has already watched.
+type pageCursor struct {
+	CreatedAt time.Time `json:"t"`
+	ID        uint      `json:"id"`
+	Score     int64     `json:"s,omitempty"`
+	Rewatch   bool      `json:"rw,omitempty"`
+}
+
+// encodeCursor turns a cursor into an opaque, URL-safe token
+func encodeCursor(c pageCursor) string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// decodeCursor parses a token from encodeCursor. An empty token means "first page".
+func decodeCursor(token string) (*pageCursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "Invalid cursor")
+	}
+	var c pageCursor
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "Invalid cursor")
+	}
+	return &c, nil
+}
+
+// normalizePageSize applies the default and upper bound to a requested page size
+func normalizePageSize(size int32) int {
+	if size <= 0 {
+		return defaultPageSize
+	}
+	if size > maxPageSize {
+		return maxPageSize
+	}
+	return int(size)
+}
+
+// newestFirst orders a post query by (created_at, id) descending and starts it
+// after the cursor. It fetches one extra row so nextPostCursor can tell whether
+// another page exists.
+func newestFirst(cursor *pageCursor, pageSize int) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if cursor != nil {
+			db = db.Where("posts.created_at < ? OR (posts.created_at = ? AND posts.id < ?)", cursor.CreatedAt, cursor.CreatedAt, cursor.ID)
+		}
+		return db.Order("posts.created_at DESC, posts.id DESC").Limit(pageSize + 1)
+	}
+}
+
+// nextPostCursor trims a newestFirst result to the page size and returns the
+// token for the following page, or "" on the last page
+func nextPostCursor(posts []Post, pageSize int) ([]Post, string) {
+	if len(posts) <= pageSize {
+		return posts, ""
+	}
+	posts = posts[:pageSize]
+	last := posts[len(posts)-1]
+	return posts, encodeCursor(pageCursor{CreatedAt: last.CreatedAt, ID: last.ID})
+}
@@ -0,0 +1,189 @@
+package main
+
+import (
+	"context"
+	"log"
+	"unicode/utf8"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+
+	messagePb "github.com/hoshibmatchi/message-service/proto"
+	pb "github.com/hoshibmatchi/post-service/proto"
+)
+
+const (
+	maxDirectSendTargets   = 20  // Recipients plus conversations per send
+	sharedPostCaptionRunes = 100 // Caption length in the DM preview
+	maxDirectNoteLength    = 1000
+)
+
+// sharedPostPreview snapshots what a DM preview card needs
+func sharedPostPreview(post *Post) *messagePb.SharedPost {
+	caption := post.Caption
+	if utf8.RuneCountInString(caption) > sharedPostCaptionRunes {
+		caption = string([]rune(caption)[:sharedPostCaptionRunes]) + "…"
+	}
+	return &messagePb.SharedPost{
+		PostId:           int64(post.ID),
+		AuthorId:         post.AuthorID,
+		AuthorUsername:   post.AuthorUsername,
+		AuthorProfileUrl: post.AuthorProfileURL,
+		ThumbnailUrl:     coverImageURL(post),
+		Caption:          caption,
+		IsReel:           post.IsReel,
+	}
+}
+
+// everyoneCanView reports whether every one of the users may see the author's posts
+func (s *server) everyoneCanView(ctx context.Context, userIDs []int64, authorID int64) bool {
+	for _, id := range userIDs {
+		if !s.newRelationshipCache(id).canView(ctx, authorID) {
+			return false
+		}
+	}
+	return true
+}
+
+// sendSharedPost sends the post into one conversation, with the optional note as its text
+func (s *server) sendSharedPost(ctx context.Context, senderID int64, conversationID string, preview *messagePb.SharedPost, note string) error {
+	_, err := s.messageClient.SendMessage(ctx, &messagePb.SendMessageRequest{
+		SenderId:       senderID,
+		ConversationId: conversationID,
+		Content:        note,
+		Type:           "shared_post",
+		SharedPost:     preview,
+	})
+	return err
+}
+
+// --- GRPC: SendPostToDirect ---
+// Sends a post into DMs. Each recipient and conversation succeeds or fails on
+// its own; the post only goes where everyone on the other end can see it.
+func (s *server) SendPostToDirect(ctx context.Context, req *pb.SendPostToDirectRequest) (*pb.SendPostToDirectResponse, error) {
+	targets := len(req.RecipientIds) + len(req.ConversationIds)
+	if targets == 0 {
+		return nil, status.Error(codes.InvalidArgument, "Choose at least one recipient or conversation")
+	}
+	if targets > maxDirectSendTargets {
+		return nil, status.Errorf(codes.InvalidArgument, "You can send to at most %d people or conversations at once", maxDirectSendTargets)
+	}
+	if utf8.RuneCountInString(req.Message) > maxDirectNoteLength {
+		return nil, status.Errorf(codes.InvalidArgument, "Message cannot exceed %d characters", maxDirectNoteLength)
+	}
+
+	var post Post
+	if err := s.db.Scopes(notArchived).First(&post, req.PostId).Error; err == gorm.ErrRecordNotFound {
+		return nil, status.Error(codes.NotFound, "Post not found")
+	} else if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to fetch post")
+	}
+	senderRels := s.newRelationshipCache(req.UserId)
+	if !senderRels.canView(ctx, post.AuthorID) {
+		return nil, status.Error(codes.PermissionDenied, "You don't have permission to view this post")
+	}
+	preview := sharedPostPreview(&post)
+
+	var results []*pb.DirectSendResult
+	sent := 0
+	seenRecipients := make(map[int64]bool, len(req.RecipientIds))
+	seenConversations := make(map[string]bool, targets)
+
+	// 1. People: reuse or start the 1:1 conversation with each of them
+	for _, recipientID := range req.RecipientIds {
+		if seenRecipients[recipientID] {
+			continue
+		}
+		seenRecipients[recipientID] = true
+		result := &pb.DirectSendResult{RecipientId: recipientID}
+		results = append(results, result)
+
+		if recipientID == req.UserId {
+			result.Error = "You can't send a post to yourself"
+			continue
+		}
+		rel, err := senderRels.get(ctx, recipientID)
+		if err != nil {
+			log.Printf("Failed to check relationship between %d and %d: %v", req.UserId, recipientID, err)
+			result.Error = "Failed to check recipient"
+			continue
+		}
+		if !rel.Exists {
+			result.Error = "User not found"
+			continue
+		}
+		if rel.Blocked {
+			result.Error = "You can't message this user"
+			continue
+		}
+		if !s.everyoneCanView(ctx, []int64{recipientID}, post.AuthorID) {
+			result.Error = "This post isn't visible to them"
+			continue
+		}
+
+		convo, err := s.messageClient.CreateConversation(ctx, &messagePb.CreateConversationRequest{
+			CreatorId:      req.UserId,
+			ParticipantIds: []int64{recipientID},
+		})
+		if err != nil {
+			log.Printf("Failed to open conversation between %d and %d: %v", req.UserId, recipientID, err)
+			result.Error = "Failed to start conversation"
+			continue
+		}
+		seenConversations[convo.Id] = true // Also listed under conversation_ids: send it once
+		if err := s.sendSharedPost(ctx, req.UserId, convo.Id, preview, req.Message); err != nil {
+			log.Printf("Failed to send post %d to conversation %s: %v", req.PostId, convo.Id, err)
+			result.Error = "Failed to send"
+			continue
+		}
+		result.ConversationId = convo.Id
+		result.Sent = true
+		sent++
+	}
+
+	// 2. Existing conversations: every participant must be able to see the post
+	for _, conversationID := range req.ConversationIds {
+		if seenConversations[conversationID] {
+			continue
+		}
+		seenConversations[conversationID] = true
+		result := &pb.DirectSendResult{ConversationId: conversationID}
+		results = append(results, result)
+
+		participants, err := s.messageClient.GetConversationParticipants(ctx, &messagePb.GetConversationParticipantsRequest{
+			UserId:         req.UserId,
+			ConversationId: conversationID,
+		})
+		if err != nil {
+			if st, ok := status.FromError(err); ok && st.Code() != codes.Internal && st.Code() != codes.Unknown {
+				result.Error = st.Message()
+			} else {
+				log.Printf("Failed to get participants of conversation %s: %v", conversationID, err)
+				result.Error = "Failed to check conversation"
+			}
+			continue
+		}
+		if !s.everyoneCanView(ctx, participants.ParticipantIds, post.AuthorID) {
+			result.Error = "Not everyone in this conversation can see this post"
+			continue
+		}
+		if err := s.sendSharedPost(ctx, req.UserId, conversationID, preview, req.Message); err != nil {
+			log.Printf("Failed to send post %d to conversation %s: %v", req.PostId, conversationID, err)
+			result.Error = "Failed to send"
+			continue
+		}
+		result.Sent = true
+		sent++
+	}
+
+	// 3. One send counts as one share, however many chats it went to
+	if sent > 0 {
+		if err := s.db.Model(&Post{}).Where("id = ?", post.ID).Update("share_count", gorm.Expr("share_count + 1")).Error; err != nil {
+			log.Printf("Failed to bump share count of post %d: %v", post.ID, err)
+		}
+		s.recordPostMetric(req.PostId, metricShare)
+	}
+
+	return &pb.SendPostToDirectResponse{Results: results}, nil
+}
@@ -0,0 +1,248 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+	"strconv"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	hashtagPb "github.com/hoshibmatchi/hashtag-service/proto"
+	pb "github.com/hoshibmatchi/post-service/proto"
+	userPb "github.com/hoshibmatchi/user-service/proto"
+)
+
+// The explore feed is built from three candidate sources: posts sharing hashtags
+// with posts the viewer engaged with, posts liked by accounts they follow, and
+// globally trending posts. Served posts are remembered in Redis so a refresh
+// shows something new.
+const (
+	exploreWindowDays  = 14  // Candidates and seed engagement no older than this
+	exploreSourceLimit = 200 // Max candidates per source
+	exploreSeedLimit   = 100 // Max engaged posts used to find related hashtags
+	exploreSeenTTL     = 24 * time.Hour
+	exploreHalfLife    = 48 * time.Hour
+)
+
+func exploreSeenKey(userID int64) string {
+	return fmt.Sprintf("explore:seen:%d", userID)
+}
+
+// exploreRanker scores explore candidates by how they were found, their overall
+// engagement and a slower recency decay than the home feed
+type exploreRanker struct{}
+
+func (exploreRanker) Score(post *Post, signals *rankSignals) float64 {
+	age := signals.Now.Sub(post.CreatedAt)
+	if age < 0 {
+		age = 0
+	}
+	engagement := float64(post.LikeCount) + 2*float64(post.CommentCount)
+	relevance := 1 +
+		3*float64(signals.HashtagMatches[post.ID]) +
+		2*float64(signals.FolloweeLikes[post.ID]) +
+		math.Log1p(engagement)
+	return relevance * math.Exp2(-age.Hours()/exploreHalfLife.Hours())
+}
+
+// --- Implement GetExploreFeed ---
+func (s *server) GetExploreFeed(ctx context.Context, req *pb.GetHomeFeedRequest) (*pb.GetHomeFeedResponse, error) {
+	log.Printf("GetExploreFeed request received for user %d", req.UserId)
+
+	cursor, err := decodeCursor(req.Cursor)
+	if err != nil {
+		return nil, err
+	}
+	pageSize := normalizePageSize(req.PageSize)
+	now := time.Now()
+	if cursor != nil {
+		now = cursor.CreatedAt // Score later pages against the same clock
+	}
+
+	candidates, signals, err := s.exploreCandidates(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	signals.Now = now
+
+	// --- Drop posts already served to this viewer ---
+	seenKey := exploreSeenKey(req.UserId)
+	seen, err := s.rdb.SMembers(ctx, seenKey).Result()
+	if err != nil {
+		log.Printf("Failed to read explore seen set for user %d: %v", req.UserId, err)
+	}
+	unseen := filterSeenPosts(candidates, seen)
+	if len(unseen) == 0 && cursor == nil && len(candidates) > 0 {
+		// Everything has been seen; start over rather than show an empty page
+		s.rdb.Del(ctx, seenKey)
+		unseen = candidates
+	}
+
+	posts, next := rankPage(exploreRanker{}, unseen, signals, cursor, pageSize)
+
+	if len(posts) > 0 {
+		served := make([]interface{}, len(posts))
+		for i := range posts {
+			served[i] = strconv.FormatUint(uint64(posts[i].ID), 10)
+		}
+		pipe := s.rdb.Pipeline()
+		pipe.SAdd(ctx, seenKey, served...)
+		pipe.Expire(ctx, seenKey, exploreSeenTTL)
+		if _, err := pipe.Exec(ctx); err != nil {
+			log.Printf("Failed to record explore seen posts for user %d: %v", req.UserId, err)
+		}
+	}
+
+	grpcPosts := s.enrichPosts(ctx, posts, req.UserId)
+
+	response := &pb.GetHomeFeedResponse{Posts: grpcPosts}
+	if next != nil {
+		response.NextCursor = encodeCursor(*next)
+	}
+	return response, nil
+}
+
+// exploreCandidates gathers explore posts the viewer is allowed to see, with the
+// per-post signals the ranker needs. Private and blocked authors are removed here,
+// before ranking and paging, so pages come back full.
+func (s *server) exploreCandidates(ctx context.Context, viewerID int64) ([]Post, *rankSignals, error) {
+	since := time.Now().AddDate(0, 0, -exploreWindowDays)
+	signals := &rankSignals{
+		HashtagMatches: make(map[uint]int32),
+		FolloweeLikes:  make(map[uint]int64),
+	}
+	candidateIDs := make(map[int64]bool)
+
+	// --- Source 1: hashtags on posts the viewer liked or commented on ---
+	var seedIDs []int64
+	s.db.Model(&PostLike{}).
+		Where("user_id = ? AND created_at > ?", viewerID, since).
+		Order("created_at DESC").Limit(exploreSeedLimit).
+		Pluck("post_id", &seedIDs)
+	var commentedIDs []int64
+	s.db.Model(&Comment{}).
+		Where("user_id = ? AND created_at > ?", viewerID, since).
+		Order("created_at DESC").Limit(exploreSeedLimit).
+		Pluck("post_id", &commentedIDs)
+	seedIDs = append(seedIDs, commentedIDs...)
+
+	if len(seedIDs) > 0 {
+		related, err := s.hashtagClient.GetRelatedPosts(ctx, &hashtagPb.GetRelatedPostsRequest{
+			PostIds:   seedIDs,
+			SinceDays: exploreWindowDays,
+			Limit:     exploreSourceLimit,
+		})
+		if err != nil {
+			// Fall back to the other sources
+			log.Printf("Failed to get related posts for user %d: %v", viewerID, err)
+		} else {
+			for _, rp := range related.Posts {
+				signals.HashtagMatches[uint(rp.PostId)] = rp.SharedHashtags
+				candidateIDs[rp.PostId] = true
+			}
+		}
+	}
+
+	// --- Source 2: posts liked by accounts the viewer follows ---
+	followingRes, err := s.userClient.GetFollowingList(ctx, &userPb.GetFollowingListRequest{UserId: viewerID})
+	if err != nil {
+		log.Printf("Failed to get following list from user-service: %v", err)
+		return nil, nil, status.Error(codes.Internal, "Failed to retrieve explore feed")
+	}
+	var followeeIDs []int64
+	for _, id := range followingRes.FollowingUserIds {
+		if id != viewerID {
+			followeeIDs = append(followeeIDs, id)
+		}
+	}
+	if len(followeeIDs) > 0 {
+		var rows []struct {
+			PostID int64
+			Likes  int64
+		}
+		if err := s.db.Model(&PostLike{}).
+			Select("post_id, COUNT(*) AS likes").
+			Where("user_id IN ? AND created_at > ?", followeeIDs, since).
+			Group("post_id").
+			Order("likes DESC").
+			Limit(exploreSourceLimit).
+			Scan(&rows).Error; err != nil {
+			return nil, nil, status.Error(codes.Internal, "Failed to retrieve explore feed")
+		}
+		for _, row := range rows {
+			signals.FolloweeLikes[uint(row.PostID)] = row.Likes
+			candidateIDs[row.PostID] = true
+		}
+	}
+
+	// --- Source 3: globally trending posts ---
+	var trendingIDs []int64
+	if err := s.db.Model(&Post{}).Scopes(notArchived).
+		Where("is_reel = ? AND created_at > ?", false, since).
+		Order("like_count + 2 * comment_count DESC").
+		Limit(exploreSourceLimit).
+		Pluck("id", &trendingIDs).Error; err != nil {
+		return nil, nil, status.Error(codes.Internal, "Failed to retrieve explore feed")
+	}
+	for _, id := range trendingIDs {
+		candidateIDs[id] = true
+	}
+
+	if len(candidateIDs) == 0 {
+		return nil, signals, nil
+	}
+
+	// --- Load candidates, skipping the viewer's own and already-liked posts ---
+	ids := make([]int64, 0, len(candidateIDs))
+	for id := range candidateIDs {
+		ids = append(ids, id)
+	}
+	var posts []Post
+	if err := s.db.Scopes(notArchived, excludeNotInterested(s.db, viewerID)).
+		Where("id IN ? AND is_reel = ? AND author_id != ?", ids, false, viewerID).
+		Where("id NOT IN (?)", s.db.Model(&PostLike{}).Select("post_id").Where("user_id = ?", viewerID)).
+		Find(&posts).Error; err != nil {
+		return nil, nil, status.Error(codes.Internal, "Failed to retrieve posts")
+	}
+
+	// --- Privacy: drop blocked authors and private accounts the viewer doesn't follow ---
+	authorIDs := make([]int64, len(posts))
+	for i := range posts {
+		authorIDs[i] = posts[i].AuthorID
+	}
+	rels := s.newRelationshipCache(viewerID)
+	if err := rels.prefetch(ctx, authorIDs); err != nil {
+		log.Printf("Failed to check author visibility for user %d: %v", viewerID, err)
+		return nil, nil, status.Error(codes.Internal, "Failed to retrieve explore feed")
+	}
+	filtered := posts[:0]
+	for _, post := range posts {
+		if rels.canView(ctx, post.AuthorID) {
+			filtered = append(filtered, post)
+		}
+	}
+
+	return s.filterNotInterestedHashtags(filtered, viewerID), signals, nil
+}
+
+// filterSeenPosts drops posts whose IDs are in seen
+func filterSeenPosts(posts []Post, seen []string) []Post {
+	if len(seen) == 0 {
+		return posts
+	}
+	seenSet := make(map[string]bool, len(seen))
+	for _, id := range seen {
+		seenSet[id] = true
+	}
+	unseen := make([]Post, 0, len(posts))
+	for _, post := range posts {
+		if !seenSet[strconv.FormatUint(uint64(post.ID), 10)] {
+			unseen = append(unseen, post)
+		}
+	}
+	return unseen
+}
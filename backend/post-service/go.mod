@@ -4,6 +4,8 @@ go 1.25.3
 
 require (
 	github.com/go-redis/redis/v8 v8.11.5
+	github.com/hoshibmatchi/hashtag-service v0.0.0
+	github.com/hoshibmatchi/message-service v0.0.0-00010101000000-000000000000
 	github.com/hoshibmatchi/user-service v0.0.0
 	github.com/lib/pq v1.10.9
 	github.com/minio/minio-go/v7 v7.0.73
@@ -11,6 +13,7 @@ require (
 	google.golang.org/grpc v1.76.0
 	google.golang.org/protobuf v1.36.10
 	gorm.io/driver/postgres v1.6.0
+	gorm.io/driver/sqlite v1.6.0
 	gorm.io/gorm v1.31.1
 )
 
@@ -38,7 +41,12 @@ require (
 	golang.org/x/sys v0.38.0 // indirect
 	golang.org/x/text v0.30.0 // indirect
 	google.golang.org/genproto/googleapis/rpc v0.0.0-20250804133106-a7a43d27e69b // indirect
-	gorm.io/driver/sqlite v1.6.0 // indirect
 )
 
 replace github.com/hoshibmatchi/user-service => ../user-service
+
+replace github.com/hoshibmatchi/message-service => ../message-service
+
+replace github.com/hoshibmatchi/hashtag-service => ../hashtag-service
+
+replace github.com/hoshibmatchi/story-service => ../story-service
@@ -0,0 +1,398 @@
+package main
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	pb "github.com/hoshibmatchi/post-service/proto"
+	userPb "github.com/hoshibmatchi/user-service/proto"
+)
+
+// Creator insights are kept as per-day counters so the author can see how a
+// post did over time. Likes, saves and shares are counted as they happen;
+// impressions and profile visits are reported by clients.
+const (
+	metricImpression   = "impression"
+	metricReach        = "reach" // First time an account saw the post
+	metricProfileVisit = "profile_visit"
+	metricLike         = "like"
+	metricSave         = "save"
+	metricShare        = "share"
+
+	defaultInsightDays = 30
+	maxInsightDays     = 90
+	maxImpressionBatch = 100
+	topPostLimit       = 5
+)
+
+// Where a post can be seen. Impressions are broken down by these.
+var impressionSurfaces = map[string]bool{
+	"home":       true,
+	"explore":    true,
+	"reels":      true,
+	"profile":    true,
+	"hashtag":    true,
+	"collection": true,
+}
+
+// PostViewer records the first time an account saw a post, for reach
+type PostViewer struct {
+	PostID      int64 `gorm:"primaryKey"`
+	UserID      int64 `gorm:"primaryKey"`
+	FirstSeenAt time.Time
+}
+
+// PostDailyMetric counts one metric for one post on one day. Surface is only
+// set for impressions.
+type PostDailyMetric struct {
+	PostID  int64  `gorm:"primaryKey"`
+	Day     string `gorm:"primaryKey;type:varchar(10)"` // YYYY-MM-DD in UTC
+	Metric  string `gorm:"primaryKey;type:varchar(20)"`
+	Surface string `gorm:"primaryKey;type:varchar(20)"`
+	Count   int64
+}
+
+// insightDay is the UTC day bucket a moment falls in
+func insightDay(t time.Time) string {
+	return t.UTC().Format("2006-01-02")
+}
+
+// normalizeInsightDays applies the default and upper bound to a requested period
+func normalizeInsightDays(days int32) int {
+	if days <= 0 {
+		return defaultInsightDays
+	}
+	if days > maxInsightDays {
+		return maxInsightDays
+	}
+	return int(days)
+}
+
+// addPostMetric adds n to today's count of a metric
+func addPostMetric(db *gorm.DB, postID int64, metric, surface string, n int64) error {
+	row := PostDailyMetric{PostID: postID, Day: insightDay(time.Now()), Metric: metric, Surface: surface, Count: n}
+	return db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "post_id"}, {Name: "day"}, {Name: "metric"}, {Name: "surface"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{"count": gorm.Expr("post_daily_metrics.count + ?", n)}),
+	}).Create(&row).Error
+}
+
+// recordPostMetric counts one like, save or share. Insights are best effort, so
+// a failure is logged rather than failing the action itself.
+func (s *server) recordPostMetric(postID int64, metric string) {
+	if err := addPostMetric(s.db, postID, metric, "", 1); err != nil {
+		log.Printf("Failed to record %s for post %d: %v", metric, postID, err)
+	}
+}
+
+// --- GRPC: RecordImpressions ---
+// Own posts and posts the viewer can't see are skipped
+func (s *server) RecordImpressions(ctx context.Context, req *pb.RecordImpressionsRequest) (*pb.RecordImpressionsResponse, error) {
+	if !impressionSurfaces[req.Surface] {
+		return nil, status.Error(codes.InvalidArgument, "Unknown surface")
+	}
+	if len(req.PostIds) > maxImpressionBatch {
+		return nil, status.Error(codes.InvalidArgument, "Cannot record more than 100 impressions at once")
+	}
+	if len(req.PostIds) == 0 {
+		return &pb.RecordImpressionsResponse{}, nil
+	}
+
+	var posts []Post
+	if err := s.db.Scopes(notArchived).Select("id", "author_id").
+		Where("id IN ? AND author_id != ?", req.PostIds, req.UserId).
+		Find(&posts).Error; err != nil {
+		return nil, status.Error(codes.Internal, "Failed to retrieve posts")
+	}
+	posts, err := s.filterPostsByPrivacy(ctx, posts, req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to record impressions")
+	}
+	if len(posts) == 0 {
+		return &pb.RecordImpressionsResponse{}, nil
+	}
+
+	now := time.Now()
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		for _, post := range posts {
+			postID := int64(post.ID)
+			if err := addPostMetric(tx, postID, metricImpression, req.Surface, 1); err != nil {
+				return err
+			}
+			result := tx.Clauses(clause.OnConflict{DoNothing: true}).
+				Create(&PostViewer{PostID: postID, UserID: req.UserId, FirstSeenAt: now})
+			if result.Error != nil {
+				return result.Error
+			}
+			if result.RowsAffected == 1 {
+				if err := addPostMetric(tx, postID, metricReach, "", 1); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		log.Printf("Failed to record impressions for user %d: %v", req.UserId, err)
+		return nil, status.Error(codes.Internal, "Failed to record impressions")
+	}
+
+	return &pb.RecordImpressionsResponse{Recorded: int32(len(posts))}, nil
+}
+
+// --- GRPC: RecordProfileVisit ---
+func (s *server) RecordProfileVisit(ctx context.Context, req *pb.RecordProfileVisitRequest) (*pb.RecordProfileVisitResponse, error) {
+	var post Post
+	if err := s.db.Scopes(notArchived).Select("id", "author_id").First(&post, req.PostId).Error; err == gorm.ErrRecordNotFound {
+		return nil, status.Error(codes.NotFound, "Post not found")
+	} else if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to retrieve post")
+	}
+	if post.AuthorID == req.UserId {
+		// Authors opening their own profile aren't visits
+		return &pb.RecordProfileVisitResponse{Message: "Profile visit recorded"}, nil
+	}
+	if !s.canViewPost(ctx, &post, req.UserId) {
+		return nil, status.Error(codes.PermissionDenied, "You don't have permission to view this post")
+	}
+
+	if err := addPostMetric(s.db, req.PostId, metricProfileVisit, "", 1); err != nil {
+		log.Printf("Failed to record profile visit from post %d: %v", req.PostId, err)
+		return nil, status.Error(codes.Internal, "Failed to record profile visit")
+	}
+	return &pb.RecordProfileVisitResponse{Message: "Profile visit recorded"}, nil
+}
+
+// --- GRPC: GetPostInsights ---
+func (s *server) GetPostInsights(ctx context.Context, req *pb.GetPostInsightsRequest) (*pb.PostInsights, error) {
+	var post Post
+	if err := s.db.First(&post, req.PostId).Error; err == gorm.ErrRecordNotFound {
+		return nil, status.Error(codes.NotFound, "Post not found")
+	} else if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to retrieve post")
+	}
+	if post.AuthorID != req.UserId {
+		return nil, status.Error(codes.PermissionDenied, "Only the author can view post insights")
+	}
+
+	insights := &pb.PostInsights{
+		PostId:               req.PostId,
+		Likes:                post.LikeCount,
+		Comments:             post.CommentCount,
+		Shares:               post.ShareCount,
+		ImpressionsBySurface: map[string]int64{},
+	}
+
+	var totals []struct {
+		Metric  string
+		Surface string
+		Total   int64
+	}
+	if err := s.db.Model(&PostDailyMetric{}).
+		Select("metric, surface, SUM(count) AS total").
+		Where("post_id = ? AND metric IN ?", req.PostId, []string{metricImpression, metricProfileVisit}).
+		Group("metric, surface").
+		Scan(&totals).Error; err != nil {
+		return nil, status.Error(codes.Internal, "Failed to retrieve insights")
+	}
+	for _, total := range totals {
+		if total.Metric == metricImpression {
+			insights.Impressions += total.Total
+			insights.ImpressionsBySurface[total.Surface] += total.Total
+		} else {
+			insights.ProfileVisits += total.Total
+		}
+	}
+
+	if err := s.db.Model(&PostViewer{}).Where("post_id = ?", req.PostId).Count(&insights.Reach).Error; err != nil {
+		return nil, status.Error(codes.Internal, "Failed to retrieve insights")
+	}
+	// Saving into several collections is still one save
+	if err := s.db.Table("saved_posts").
+		Joins("JOIN collections ON collections.id = saved_posts.collection_id").
+		Where("saved_posts.post_id = ?", req.PostId).
+		Distinct("collections.user_id").
+		Count(&insights.Saves).Error; err != nil {
+		return nil, status.Error(codes.Internal, "Failed to retrieve insights")
+	}
+
+	days, err := s.dailyInsights(normalizeInsightDays(req.Days), func(query *gorm.DB) *gorm.DB {
+		return query.Where("post_id = ?", req.PostId)
+	})
+	if err != nil {
+		return nil, err
+	}
+	insights.Days = days
+	return insights, nil
+}
+
+// --- GRPC: GetAccountInsights ---
+func (s *server) GetAccountInsights(ctx context.Context, req *pb.GetAccountInsightsRequest) (*pb.AccountInsights, error) {
+	period := normalizeInsightDays(req.Days)
+	since := time.Now().UTC().AddDate(0, 0, -(period - 1))
+	authorPosts := func(query *gorm.DB) *gorm.DB {
+		return query.Where("post_id IN (?)", s.db.Model(&Post{}).Select("id").Where("author_id = ?", req.UserId))
+	}
+
+	days, err := s.dailyInsights(period, authorPosts)
+	if err != nil {
+		return nil, err
+	}
+	insights := &pb.AccountInsights{Days: days}
+	for _, day := range days {
+		insights.Impressions += day.Impressions
+		insights.ProfileVisits += day.ProfileVisits
+		insights.Likes += day.Likes
+		insights.Saves += day.Saves
+		insights.Shares += day.Shares
+	}
+
+	// Summing daily reach would count an account once per post it saw
+	if err := s.db.Model(&PostViewer{}).Scopes(authorPosts).
+		Where("first_seen_at >= ?", since.Truncate(24*time.Hour)).
+		Distinct("user_id").
+		Count(&insights.Reach).Error; err != nil {
+		return nil, status.Error(codes.Internal, "Failed to retrieve insights")
+	}
+
+	// --- Follower growth from user-service ---
+	growth, err := s.userClient.GetFollowerGrowth(ctx, &userPb.GetFollowerGrowthRequest{UserId: req.UserId, Since: insightDay(since)})
+	if err != nil {
+		log.Printf("Failed to get follower growth for user %d: %v", req.UserId, err)
+		return nil, status.Error(codes.Internal, "Failed to retrieve follower growth")
+	}
+	insights.FollowerCount = growth.FollowerCount
+	byDate := make(map[string]*pb.InsightDay, len(days))
+	for _, day := range days {
+		byDate[day.Date] = day
+	}
+	for _, gained := range growth.Days {
+		if day, ok := byDate[gained.Date]; ok {
+			day.FollowersGained += gained.Count
+			insights.FollowersGained += gained.Count
+		}
+	}
+
+	topPosts, err := s.topPosts(ctx, req.UserId, insightDay(since), authorPosts)
+	if err != nil {
+		return nil, err
+	}
+	insights.TopPosts = topPosts
+	return insights, nil
+}
+
+// dailyInsights sums the metrics of the posts selected by scope into one entry
+// per day for the last `period` days, oldest first
+func (s *server) dailyInsights(period int, scope func(*gorm.DB) *gorm.DB) ([]*pb.InsightDay, error) {
+	start := time.Now().UTC().AddDate(0, 0, -(period - 1))
+
+	days := make([]*pb.InsightDay, period)
+	byDate := make(map[string]*pb.InsightDay, period)
+	for i := range days {
+		days[i] = &pb.InsightDay{Date: insightDay(start.AddDate(0, 0, i))}
+		byDate[days[i].Date] = days[i]
+	}
+
+	var rows []struct {
+		Day    string
+		Metric string
+		Total  int64
+	}
+	if err := s.db.Model(&PostDailyMetric{}).Scopes(scope).
+		Select("day, metric, SUM(count) AS total").
+		Where("day >= ?", insightDay(start)).
+		Group("day, metric").
+		Scan(&rows).Error; err != nil {
+		return nil, status.Error(codes.Internal, "Failed to retrieve insights")
+	}
+
+	for _, row := range rows {
+		day, ok := byDate[row.Day]
+		if !ok {
+			continue
+		}
+		switch row.Metric {
+		case metricImpression:
+			day.Impressions += row.Total
+		case metricReach:
+			day.Reach += row.Total
+		case metricProfileVisit:
+			day.ProfileVisits += row.Total
+		case metricLike:
+			day.Likes += row.Total
+		case metricSave:
+			day.Saves += row.Total
+		case metricShare:
+			day.Shares += row.Total
+		}
+	}
+	return days, nil
+}
+
+// topPosts returns the author's most seen posts since the given day, with
+// their likes, saves and shares over the same period
+func (s *server) topPosts(ctx context.Context, authorID int64, since string, scope func(*gorm.DB) *gorm.DB) ([]*pb.TopPost, error) {
+	var ranked []struct {
+		PostID int64
+		Total  int64
+	}
+	if err := s.db.Model(&PostDailyMetric{}).Scopes(scope).
+		Select("post_id, SUM(count) AS total").
+		Where("metric = ? AND day >= ?", metricImpression, since).
+		Group("post_id").
+		Order("total DESC, post_id DESC").
+		Limit(topPostLimit).
+		Scan(&ranked).Error; err != nil {
+		return nil, status.Error(codes.Internal, "Failed to retrieve top posts")
+	}
+	if len(ranked) == 0 {
+		return []*pb.TopPost{}, nil
+	}
+
+	ids := make([]int64, len(ranked))
+	for i, row := range ranked {
+		ids[i] = row.PostID
+	}
+
+	var engagement []struct {
+		PostID int64
+		Total  int64
+	}
+	if err := s.db.Model(&PostDailyMetric{}).
+		Select("post_id, SUM(count) AS total").
+		Where("post_id IN ? AND metric IN ? AND day >= ?", ids, []string{metricLike, metricSave, metricShare}, since).
+		Group("post_id").
+		Scan(&engagement).Error; err != nil {
+		return nil, status.Error(codes.Internal, "Failed to retrieve top posts")
+	}
+	engagementByPost := make(map[int64]int64, len(engagement))
+	for _, row := range engagement {
+		engagementByPost[row.PostID] = row.Total
+	}
+
+	var posts []Post
+	if err := s.db.Where("id IN ?", ids).Find(&posts).Error; err != nil {
+		return nil, status.Error(codes.Internal, "Failed to retrieve top posts")
+	}
+	protos := s.enrichPosts(ctx, posts, authorID)
+	protoByID := make(map[int64]*pb.Post, len(posts))
+	for i := range posts {
+		protoByID[int64(posts[i].ID)] = protos[i]
+	}
+
+	top := make([]*pb.TopPost, 0, len(ranked))
+	for _, row := range ranked {
+		post, ok := protoByID[row.PostID] // Deleted since
+		if !ok {
+			continue
+		}
+		top = append(top, &pb.TopPost{Post: post, Impressions: row.Total, Engagement: engagementByPost[row.PostID]})
+	}
+	return top, nil
+}
@@ -21,7 +21,10 @@ import (
 	"google.golang.org/grpc/status"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
+	hashtagPb "github.com/hoshibmatchi/hashtag-service/proto"
+	messagePb "github.com/hoshibmatchi/message-service/proto"
 	"github.com/hoshibmatchi/post-service/logger"
 	pb "github.com/hoshibmatchi/post-service/proto"
 	userPb "github.com/hoshibmatchi/user-service/proto"
@@ -41,11 +44,14 @@ type Post struct {
 	Caption          string
 	MediaURLs        pq.StringArray `gorm:"type:text[]"`
 	IsReel           bool           `gorm:"default:false"`
-	CommentsDisabled bool           `gorm:"default:false"`
+	CommentsDisabled bool           `gorm:"default:false"` // Kept in sync with CommentAudience == "off"
+	CommentAudience  string         `gorm:"type:varchar(20);default:'everyone'"`
 	ThumbnailURL     string         `gorm:"type:varchar(255)"`
 	LikeCount        int64          `gorm:"default:0"`
 	CommentCount     int64          `gorm:"default:0"`
 	ShareCount       int64          `gorm:"default:0"`
+	HideLikeCount    bool           `gorm:"default:false"` // Only the author sees the like count
+	ArchivedAt       *time.Time     `gorm:"index"`         // Hidden from profile and feeds, engagement kept
 
 	Location        string
 	CollaboratorIDs pq.Int64Array `gorm:"type:bigint[]"`
@@ -56,6 +62,14 @@ type Post struct {
 	AuthorIsVerified bool
 }
 
+// Who may comment on a post
+const (
+	commentAudienceEveryone  = "everyone"
+	commentAudienceFollowing = "following" // People the author follows
+	commentAudienceFollowers = "followers" // People who follow the author
+	commentAudienceOff       = "off"
+)
+
 // SharedPost tracks when a user shares a post
 type SharedPost struct {
 	gorm.Model
@@ -80,7 +94,14 @@ type Comment struct {
 	Content string // This can be text or a GIF URL
 
 	// For nested replies
-	ParentCommentID uint // GORM's Model.ID is uint
+	ParentCommentID uint  `gorm:"index"` // GORM's Model.ID is uint
+	LikeCount       int64 `gorm:"default:0"`
+
+	// Creator moderation
+	EditedAt     *time.Time
+	PinnedAt     *time.Time `gorm:"index"` // Non-nil while pinned by the post author
+	IsHidden     bool       `gorm:"default:false"`
+	HiddenReason string     `gorm:"type:varchar(20)"` // hiddenByAuthor; keyword matches are filtered when read, not stored
 
 	// Denormalized data from user-service
 	AuthorUsername   string
@@ -88,6 +109,16 @@ type Comment struct {
 	AuthorIsVerified bool
 }
 
+const (
+	hiddenByAuthor = "author" // Post author hid it with HideComment
+
+	maxPinnedComments = 3
+)
+
+// trashRetention is how long a deleted post stays in Recently Deleted before
+// worker-service purges it (keep in sync with worker-service)
+const trashRetention = 30 * 24 * time.Hour
+
 // CommentLike defines the GORM model for a like on a comment
 type CommentLike struct {
 	// Composite primary key (user_id, comment_id)
@@ -98,19 +129,25 @@ type CommentLike struct {
 
 type server struct {
 	pb.UnimplementedPostServiceServer
-	db          *gorm.DB
-	userClient  userPb.UserServiceClient
-	amqpCh      *amqp.Channel
-	minioClient *minio.Client
-	rdb         *redis.Client
+	db            *gorm.DB
+	userClient    userPb.UserServiceClient
+	messageClient messagePb.MessageServiceClient
+	hashtagClient hashtagPb.HashtagServiceClient
+	amqpCh        *amqp.Channel
+	minioClient   *minio.Client
+	rdb           *redis.Client
+	ranker        Ranker // Orders the home feed when sort=ranked
 }
 
 // Collection defines a user's named collection of posts
 type Collection struct {
 	gorm.Model
-	UserID    int64  `gorm:"index"`
-	Name      string `gorm:"type:varchar(100)"`
-	IsDefault bool   `gorm:"default:false"`
+	UserID      int64   `gorm:"index"` // The owner
+	Name        string  `gorm:"type:varchar(100)"`
+	IsDefault   bool    `gorm:"default:false"`
+	Privacy     string  `gorm:"type:varchar(10);default:'private'"`
+	ShareToken  *string `gorm:"type:varchar(64);uniqueIndex"` // Set once the collection has been shared by link
+	CoverPostID *uint   // Chosen cover; nil while the cover follows the collection's order
 }
 
 type PostCollaborator struct {
@@ -122,8 +159,9 @@ type PostCollaborator struct {
 // between collections and posts
 type SavedPost struct {
 	// Composite primary key
-	CollectionID uint `gorm:"primaryKey"`
-	PostID       uint `gorm:"primaryKey"`
+	CollectionID uint  `gorm:"primaryKey"`
+	PostID       uint  `gorm:"primaryKey"`
+	Position     int64 `gorm:"default:0"` // Higher is nearer the top
 	CreatedAt    time.Time
 }
 
@@ -157,14 +195,32 @@ func main() {
 	appLogger.Info("Successfully connected to PostgreSQL database")
 
 	appLogger.Info("Running database migrations...")
+	// Posts got a comment_audience; carry over comments_disabled the first time the column is added
+	backfillCommentAudience := !db.Migrator().HasColumn(&Post{}, "comment_audience")
 	db.AutoMigrate(&Post{})
+	if backfillCommentAudience {
+		db.Exec("UPDATE posts SET comment_audience = 'off' WHERE comments_disabled = true")
+	}
 	db.AutoMigrate(&PostLike{})
+	// Comments got a denormalized like_count; backfill it the first time the column is added
+	backfillCommentLikes := !db.Migrator().HasColumn(&Comment{}, "like_count")
 	db.AutoMigrate(&Comment{})
+	if backfillCommentLikes {
+		db.Exec("UPDATE comments SET like_count = (SELECT COUNT(*) FROM comment_likes WHERE comment_likes.comment_id = comments.id)")
+	}
+	// Keyword filter matches used to be stored as hidden; they are filtered when read now
+	db.Exec("UPDATE comments SET is_hidden = false, hidden_reason = '' WHERE hidden_reason = 'keyword'")
 	db.AutoMigrate(&CommentLike{})
 	db.AutoMigrate(&Collection{})
+	db.AutoMigrate(&CollectionMember{})
 	db.AutoMigrate(&SavedPost{})
 	db.AutoMigrate(&PostCollaborator{})
 	db.AutoMigrate(&SharedPost{})
+	db.AutoMigrate(&ReelWatch{})
+	db.AutoMigrate(&ReelStat{})
+	db.AutoMigrate(&NotInterested{})
+	db.AutoMigrate(&PostViewer{})
+	db.AutoMigrate(&PostDailyMetric{})
 	appLogger.Info("Database migrations completed")
 
 	// --- Step 2: Connect to User Service (gRPC Client) ---
@@ -178,6 +234,24 @@ func main() {
 	appLogger.Info("Successfully connected to user-service")
 	log.Println("Successfully connected to user-service")
 
+	// --- Step 2.5: Connect to Message Service (gRPC Client) ---
+	messageConn, err := grpc.Dial("message-service:9003", grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		appLogger.Fatal("Failed to connect to message-service: %v", err)
+	}
+	defer messageConn.Close()
+	messageClient := messagePb.NewMessageServiceClient(messageConn)
+	log.Println("Successfully connected to message-service")
+
+	// --- Step 2.6: Connect to Hashtag Service (gRPC Client) ---
+	hashtagConn, err := grpc.Dial("hashtag-service:9007", grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		appLogger.Fatal("Failed to connect to hashtag-service: %v", err)
+	}
+	defer hashtagConn.Close()
+	hashtagClient := hashtagPb.NewHashtagServiceClient(hashtagConn)
+	log.Println("Successfully connected to hashtag-service")
+
 	// --- Step 3: Connect to RabbitMQ (with retries) ---
 	var amqpConn *amqp.Connection
 	maxRetries := 10
@@ -248,6 +322,19 @@ func main() {
 	}
 	log.Println("RabbitMQ hashtag_queue declared")
 
+	_, err = amqpCh.QueueDeclare(
+		"feed_fanout_queue",
+		true,  // durable
+		false, // delete when unused
+		false, // exclusive
+		false, // no-wait
+		nil,   // arguments
+	)
+	if err != nil {
+		log.Fatalf("Failed to declare feed_fanout_queue: %v", err)
+	}
+	log.Println("RabbitMQ feed_fanout_queue declared")
+
 	// --- ADDED: Connect to MinIO ---
 	// Get MinIO credentials from environment
 	endpoint := os.Getenv("MINIO_ENDPOINT")
@@ -295,11 +382,14 @@ func main() {
 	// --- THIS IS THE FIX ---
 	// We must pass the amqpCh to the server struct
 	pb.RegisterPostServiceServer(s, &server{
-		db:          db,
-		userClient:  userClient,
-		amqpCh:      amqpCh,
-		minioClient: minioClient,
-		rdb:         rdb,
+		db:            db,
+		userClient:    userClient,
+		messageClient: messageClient,
+		hashtagClient: hashtagClient,
+		amqpCh:        amqpCh,
+		minioClient:   minioClient,
+		rdb:           rdb,
+		ranker:        newDefaultRanker(),
 	})
 	// --- END FIX ---
 
@@ -310,6 +400,9 @@ func main() {
 }
 
 func (s *server) publishToQueue(ctx context.Context, queueName string, body []byte) error {
+	if s.amqpCh == nil {
+		return fmt.Errorf("not connected to RabbitMQ, dropping message for %s", queueName)
+	}
 	return s.amqpCh.PublishWithContext(
 		ctx,
 		"",        // exchange (default)
@@ -324,67 +417,43 @@ func (s *server) publishToQueue(ctx context.Context, queueName string, body []by
 	)
 }
 
-// canViewPost checks if a user can view a post based on privacy settings
-func (s *server) canViewPost(ctx context.Context, post *Post, viewerID int64) bool {
-	// User can always view their own posts
-	if post.AuthorID == viewerID {
-		return true
-	}
-
-	// Check if author has blocked the viewer or viewer has blocked the author
-	blockCheckAuthorToViewer, err := s.userClient.IsBlocked(ctx, &userPb.IsBlockedRequest{
-		BlockerId: post.AuthorID,
-		BlockedId: viewerID,
-	})
-	if err == nil && blockCheckAuthorToViewer.IsBlocked {
-		return false // Author blocked the viewer
-	}
-
-	blockCheckViewerToAuthor, err := s.userClient.IsBlocked(ctx, &userPb.IsBlockedRequest{
-		BlockerId: viewerID,
-		BlockedId: post.AuthorID,
+// publishFeedEvent asks worker-service to update home timelines for a post
+func (s *server) publishFeedEvent(ctx context.Context, eventType string, postID uint) {
+	msgBody, _ := json.Marshal(map[string]interface{}{
+		"type":    eventType,
+		"post_id": postID,
 	})
-	if err == nil && blockCheckViewerToAuthor.IsBlocked {
-		return false // Viewer blocked the author
+	if err := s.publishToQueue(ctx, "feed_fanout_queue", msgBody); err != nil {
+		log.Printf("Failed to publish %s for post %d: %v", eventType, postID, err)
 	}
+}
 
-	// Get the author's profile to check privacy settings
-	profileResp, err := s.userClient.GetUserProfile(ctx, &userPb.GetUserProfileRequest{
-		Username:   post.AuthorUsername,
-		SelfUserId: viewerID,
-	})
-	if err != nil {
-		log.Printf("Failed to get author profile: %v", err)
-		return false
-	}
+// canViewPost checks if a user can view a post based on privacy settings
+func (s *server) canViewPost(ctx context.Context, post *Post, viewerID int64) bool {
+	return s.newRelationshipCache(viewerID).canView(ctx, post.AuthorID)
+}
 
-	// If account is public, anyone can view
-	if !profileResp.IsPrivate {
-		return true
+// filterPostsByPrivacy filters a slice of posts based on privacy settings,
+// looking up all of their authors in one batch. It fails rather than guess when
+// the relationships can't be loaded.
+func (s *server) filterPostsByPrivacy(ctx context.Context, posts []Post, viewerID int64) ([]Post, error) {
+	rels := s.newRelationshipCache(viewerID)
+	authorIDs := make([]int64, len(posts))
+	for i := range posts {
+		authorIDs[i] = posts[i].AuthorID
 	}
-
-	// If account is private, check if viewer is following
-	followResp, err := s.userClient.IsFollowing(ctx, &userPb.IsFollowingRequest{
-		FollowerId:  viewerID,
-		FollowingId: post.AuthorID,
-	})
-	if err != nil {
-		log.Printf("Failed to check follow status: %v", err)
-		return false
+	if err := rels.prefetch(ctx, authorIDs); err != nil {
+		log.Printf("Failed to load relationships for user %d: %v", viewerID, err)
+		return nil, err
 	}
 
-	return followResp.IsFollowing
-}
-
-// filterPostsByPrivacy filters a slice of posts based on privacy settings
-func (s *server) filterPostsByPrivacy(ctx context.Context, posts []Post, viewerID int64) []Post {
 	var visiblePosts []Post
 	for _, post := range posts {
-		if s.canViewPost(ctx, &post, viewerID) {
+		if rels.canView(ctx, post.AuthorID) {
 			visiblePosts = append(visiblePosts, post)
 		}
 	}
-	return visiblePosts
+	return visiblePosts, nil
 }
 
 // --- Implement CreatePost ---
@@ -406,13 +475,30 @@ func (s *server) CreatePost(ctx context.Context, req *pb.CreatePostRequest) (*pb
 		return nil, status.Error(codes.Internal, "Failed to retrieve author details")
 	}
 
+	// Explicit audience wins, then the legacy flag, then the author's default
+	audience := req.CommentAudience
+	if audience == "" && req.CommentsDisabled {
+		audience = commentAudienceOff
+	}
+	if audience == "" {
+		audience = userData.DefaultCommentAudience
+	}
+	if audience == "" {
+		audience = commentAudienceEveryone
+	}
+	if !isValidCommentAudience(audience) {
+		return nil, status.Error(codes.InvalidArgument, "Comment audience must be one of everyone, following, followers or off")
+	}
+
 	// --- Step 2: Create the Post in our DB ---
 	newPost := Post{
 		AuthorID:         req.AuthorId,
 		Caption:          req.Caption,
 		MediaURLs:        req.MediaUrls,
 		IsReel:           req.IsReel,
-		CommentsDisabled: req.CommentsDisabled,
+		CommentsDisabled: audience == commentAudienceOff,
+		CommentAudience:  audience,
+		HideLikeCount:    req.HideLikeCount,
 		ThumbnailURL:     req.ThumbnailUrl,
 		// Add denormalized data
 		AuthorUsername:   userData.Username,
@@ -541,17 +627,8 @@ func (s *server) CreatePost(ctx context.Context, req *pb.CreatePostRequest) (*pb
 		}
 	}
 
-	// --- Clear feed caches since new post should appear in feeds ---
-	feedPattern := "feed:*"
-	iter := s.rdb.Scan(ctx, 0, feedPattern, 0).Iterator()
-	for iter.Next(ctx) {
-		if err := s.rdb.Del(ctx, iter.Val()).Err(); err != nil {
-			log.Printf("Failed to delete cache key %s: %v", iter.Val(), err)
-		}
-	}
-	if err := iter.Err(); err != nil {
-		log.Printf("Error scanning feed cache keys: %v", err)
-	}
+	// --- Push the post into followers' home timelines ---
+	s.publishFeedEvent(ctx, "post.created", newPost.ID)
 
 	// --- Step 3: Return the created post ---
 	return &pb.CreatePostResponse{
@@ -559,6 +636,12 @@ func (s *server) CreatePost(ctx context.Context, req *pb.CreatePostRequest) (*pb
 	}, nil
 }
 
+// decrementCounter lowers a denormalized counter column without letting it go
+// negative if it has drifted; worker-service reconciles any drift
+func decrementCounter(column string) clause.Expr {
+	return gorm.Expr("CASE WHEN " + column + " > 0 THEN " + column + " - 1 ELSE 0 END")
+}
+
 // --- Implement LikePost ---
 func (s *server) LikePost(ctx context.Context, req *pb.LikePostRequest) (*pb.LikePostResponse, error) {
 	like := PostLike{
@@ -572,18 +655,26 @@ func (s *server) LikePost(ctx context.Context, req *pb.LikePostRequest) (*pb.Lik
 			return err
 		}
 		// 2. Increment the post's like_count
-		if err := tx.Model(&Post{}).Where("id = ?", req.PostId).Update("like_count", gorm.Expr("like_count + 1")).Error; err != nil {
-			return err
+		result := tx.Model(&Post{}).Where("id = ?", req.PostId).Update("like_count", gorm.Expr("like_count + 1"))
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound // Rolls the like back too
 		}
 		return nil
 	})
 
 	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, status.Error(codes.NotFound, "Post not found")
+		}
 		if strings.Contains(err.Error(), "unique constraint") || strings.Contains(err.Error(), "duplicate key") {
 			return nil, status.Error(codes.AlreadyExists, "Post already liked")
 		}
 		return nil, status.Error(codes.Internal, "Failed to like post")
 	}
+	s.recordPostMetric(req.PostId, metricLike)
 
 	// RabbitMQ Notifications
 	// Get Post Author ID
@@ -602,23 +693,12 @@ func (s *server) LikePost(ctx context.Context, req *pb.LikePostRequest) (*pb.Lik
 	}
 	// --- END ADD ---
 
-	// --- Clear cache for this post and feed caches ---
+	// --- Clear cache for this post ---
 	postCacheKey := fmt.Sprintf("post:%d", req.PostId)
 	if err := s.rdb.Del(ctx, postCacheKey).Err(); err != nil {
 		log.Printf("Failed to delete post cache key %s: %v", postCacheKey, err)
 	}
 
-	feedPattern := "feed:*"
-	iter := s.rdb.Scan(ctx, 0, feedPattern, 0).Iterator()
-	for iter.Next(ctx) {
-		if err := s.rdb.Del(ctx, iter.Val()).Err(); err != nil {
-			log.Printf("Failed to delete cache key %s: %v", iter.Val(), err)
-		}
-	}
-	if err := iter.Err(); err != nil {
-		log.Printf("Error scanning feed cache keys: %v", err)
-	}
-
 	return &pb.LikePostResponse{Message: "Post liked"}, nil
 }
 
@@ -639,7 +719,7 @@ func (s *server) UnlikePost(ctx context.Context, req *pb.LikePostRequest) (*pb.U
 			return status.Error(codes.NotFound, "Post was not liked")
 		}
 		// 2. Decrement the post's like_count
-		if err := tx.Model(&Post{}).Where("id = ?", req.PostId).Update("like_count", gorm.Expr("like_count - 1")).Error; err != nil {
+		if err := tx.Model(&Post{}).Where("id = ?", req.PostId).Update("like_count", decrementCounter("like_count")).Error; err != nil {
 			return err
 		}
 		return nil
@@ -649,24 +729,161 @@ func (s *server) UnlikePost(ctx context.Context, req *pb.LikePostRequest) (*pb.U
 		return nil, err
 	}
 
-	// --- Clear cache for this post and feed caches ---
+	// --- Clear cache for this post ---
 	postCacheKey := fmt.Sprintf("post:%d", req.PostId)
 	if err := s.rdb.Del(ctx, postCacheKey).Err(); err != nil {
 		log.Printf("Failed to delete post cache key %s: %v", postCacheKey, err)
 	}
 
-	feedPattern := "feed:*"
-	iter := s.rdb.Scan(ctx, 0, feedPattern, 0).Iterator()
-	for iter.Next(ctx) {
-		if err := s.rdb.Del(ctx, iter.Val()).Err(); err != nil {
-			log.Printf("Failed to delete cache key %s: %v", iter.Val(), err)
+	return &pb.UnlikePostResponse{Message: "Post unliked"}, nil
+}
+
+// --- GRPC: GetPostLikers ---
+func (s *server) GetPostLikers(ctx context.Context, req *pb.GetPostLikersRequest) (*pb.GetPostLikersResponse, error) {
+	cursor, err := decodeCursor(req.Cursor)
+	if err != nil {
+		return nil, err
+	}
+	pageSize := normalizePageSize(req.PageSize)
+
+	var post Post
+	if err := s.db.First(&post, req.PostId).Error; err == gorm.ErrRecordNotFound {
+		return nil, status.Error(codes.NotFound, "Post not found")
+	} else if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to retrieve post")
+	}
+	if !s.canViewPost(ctx, &post, req.ViewerId) {
+		return nil, status.Error(codes.PermissionDenied, "You don't have permission to view this post")
+	}
+
+	// Newest likes first; user_id breaks ties for the keyset
+	query := s.db.Where("post_id = ?", req.PostId)
+	if cursor != nil {
+		query = query.Where("created_at < ? OR (created_at = ? AND user_id < ?)", cursor.CreatedAt, cursor.CreatedAt, cursor.ID)
+	}
+	var likes []PostLike
+	if err := query.Order("created_at DESC, user_id DESC").Limit(pageSize + 1).Find(&likes).Error; err != nil {
+		return nil, status.Error(codes.Internal, "Failed to retrieve likes")
+	}
+
+	nextCursor := ""
+	if len(likes) > pageSize {
+		likes = likes[:pageSize]
+		last := likes[len(likes)-1]
+		nextCursor = encodeCursor(pageCursor{CreatedAt: last.CreatedAt, ID: uint(last.UserID)})
+	}
+
+	likers := []*pb.PostLiker{}
+	if len(likes) > 0 {
+		userIDs := make([]int64, len(likes))
+		for i, like := range likes {
+			userIDs[i] = like.UserID
+		}
+
+		// One call for profiles and follow state; blocked users come back filtered out
+		summaries, err := s.userClient.GetUserSummaries(ctx, &userPb.GetUserSummariesRequest{
+			UserIds:  userIDs,
+			ViewerId: req.ViewerId,
+		})
+		if err != nil {
+			log.Printf("Failed to get user summaries for post %d likers: %v", req.PostId, err)
+			return nil, status.Error(codes.Internal, "Failed to retrieve likers")
+		}
+		summaryByID := make(map[int64]*userPb.UserSummary, len(summaries.Users))
+		for _, summary := range summaries.Users {
+			summaryByID[summary.User.UserId] = summary
+		}
+
+		for _, like := range likes {
+			summary, ok := summaryByID[like.UserID]
+			if !ok {
+				continue
+			}
+			likers = append(likers, &pb.PostLiker{
+				UserId:            like.UserID,
+				Username:          summary.User.Username,
+				Name:              summary.User.Name,
+				ProfilePictureUrl: summary.User.ProfilePictureUrl,
+				IsVerified:        summary.User.IsVerified,
+				IsFollowing:       summary.IsFollowedByViewer,
+				FollowStatus:      summary.FollowStatus,
+				LikedAt:           like.CreatedAt.Format(time.RFC3339),
+			})
 		}
 	}
-	if err := iter.Err(); err != nil {
-		log.Printf("Error scanning feed cache keys: %v", err)
+
+	res := &pb.GetPostLikersResponse{
+		Likers:     likers,
+		NextCursor: nextCursor,
+	}
+	if post.HideLikeCount && req.ViewerId != post.AuthorID {
+		res.LikeCountHidden = true
+	} else {
+		s.db.Model(&PostLike{}).Where("post_id = ?", req.PostId).Count(&res.LikeCount)
 	}
 
-	return &pb.UnlikePostResponse{Message: "Post unliked"}, nil
+	return res, nil
+}
+
+// --- GRPC: SetHideLikeCount ---
+func (s *server) SetHideLikeCount(ctx context.Context, req *pb.SetHideLikeCountRequest) (*pb.SetHideLikeCountResponse, error) {
+	var post Post
+	if err := s.db.Select("id", "author_id").First(&post, req.PostId).Error; err == gorm.ErrRecordNotFound {
+		return nil, status.Error(codes.NotFound, "Post not found")
+	} else if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to retrieve post")
+	}
+	if post.AuthorID != req.UserId {
+		return nil, status.Error(codes.PermissionDenied, "Only the post author can change this setting")
+	}
+
+	if err := s.db.Model(&Post{}).Where("id = ?", req.PostId).Update("hide_like_count", req.Hidden).Error; err != nil {
+		return nil, status.Error(codes.Internal, "Failed to update post")
+	}
+
+	postCacheKey := fmt.Sprintf("post:%d", req.PostId)
+	if err := s.rdb.Del(ctx, postCacheKey).Err(); err != nil {
+		log.Printf("Failed to delete post cache key %s: %v", postCacheKey, err)
+	}
+
+	if req.Hidden {
+		return &pb.SetHideLikeCountResponse{Message: "Like count hidden"}, nil
+	}
+	return &pb.SetHideLikeCountResponse{Message: "Like count visible"}, nil
+}
+
+func isValidCommentAudience(audience string) bool {
+	switch audience {
+	case commentAudienceEveryone, commentAudienceFollowing, commentAudienceFollowers, commentAudienceOff:
+		return true
+	}
+	return false
+}
+
+// canComment checks the cache's viewer against the post's comment audience.
+// The author can always comment on their own post.
+func (s *server) canComment(ctx context.Context, rels *relationshipCache, post *Post) (bool, error) {
+	if rels.viewerID == post.AuthorID {
+		return true, nil
+	}
+	if post.CommentsDisabled {
+		return false, nil
+	}
+
+	switch post.CommentAudience {
+	case commentAudienceOff:
+		return false, nil
+	case commentAudienceFollowing, commentAudienceFollowers:
+		rel, err := rels.get(ctx, post.AuthorID)
+		if err != nil {
+			return false, err
+		}
+		if post.CommentAudience == commentAudienceFollowing {
+			return rel.FollowsViewer, nil // The author follows the commenter
+		}
+		return rel.ViewerFollows, nil // The commenter follows the author
+	}
+	return true, nil
 }
 
 // --- Implement CommentOnPost ---
@@ -679,6 +896,25 @@ func (s *server) CommentOnPost(ctx context.Context, req *pb.CommentOnPostRequest
 		return nil, status.Error(codes.InvalidArgument, "Comment must not exceed 500 characters")
 	}
 
+	var post Post
+	if err := s.db.First(&post, req.PostId).Error; err == gorm.ErrRecordNotFound {
+		return nil, status.Error(codes.NotFound, "Post not found")
+	} else if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to retrieve post")
+	}
+
+	allowed, err := s.canComment(ctx, s.newRelationshipCache(req.UserId), &post)
+	if err != nil {
+		log.Printf("Failed to check comment audience for post %d: %v", post.ID, err)
+		return nil, status.Error(codes.Internal, "Failed to check comment permissions")
+	}
+	if !allowed {
+		if post.CommentAudience == commentAudienceOff || post.CommentsDisabled {
+			return nil, status.Error(codes.PermissionDenied, "Comments are turned off for this post")
+		}
+		return nil, status.Error(codes.PermissionDenied, "You can't comment on this post")
+	}
+
 	// --- Step 1: Call User Service for Denormalization (like in CreatePost) ---
 	userData, err := s.userClient.GetUserData(ctx, &userPb.GetUserDataRequest{UserId: req.UserId})
 	if err != nil {
@@ -696,6 +932,9 @@ func (s *server) CommentOnPost(ctx context.Context, req *pb.CommentOnPostRequest
 		AuthorProfileURL: userData.ProfilePictureUrl,
 		AuthorIsVerified: userData.IsVerified,
 	}
+
+	// Comments matching the post author's keyword filter are only visible to the commenter
+	filtered := req.UserId != post.AuthorID && matchesCommentFilter(newComment.Content, s.getCommentFilter(ctx, post.AuthorID))
 	// Note: Do NOT set ID manually - let GORM auto-generate it
 
 	err = s.db.Transaction(func(tx *gorm.DB) error {
@@ -716,12 +955,8 @@ func (s *server) CommentOnPost(ctx context.Context, req *pb.CommentOnPostRequest
 	}
 
 	// Notification for comments
-	// Get Post Author ID
-	var post Post
-	s.db.First(&post, req.PostId)
-
-	// Don't notify if user comments on their own post
-	if post.AuthorID != req.UserId {
+	// Don't notify if user comments on their own post, or the comment was filtered
+	if post.AuthorID != req.UserId && !filtered {
 		msgBody, _ := json.Marshal(map[string]interface{}{
 			"type":      "post.commented",
 			"actor_id":  req.UserId,
@@ -731,23 +966,12 @@ func (s *server) CommentOnPost(ctx context.Context, req *pb.CommentOnPostRequest
 		s.publishToQueue(ctx, "notification_queue", msgBody)
 	}
 
-	// --- Clear cache for this post and feed caches ---
+	// --- Clear cache for this post ---
 	postCacheKey := fmt.Sprintf("post:%d", req.PostId)
 	if err := s.rdb.Del(ctx, postCacheKey).Err(); err != nil {
 		log.Printf("Failed to delete post cache key %s: %v", postCacheKey, err)
 	}
 
-	feedPattern := "feed:*"
-	iter := s.rdb.Scan(ctx, 0, feedPattern, 0).Iterator()
-	for iter.Next(ctx) {
-		if err := s.rdb.Del(ctx, iter.Val()).Err(); err != nil {
-			log.Printf("Failed to delete cache key %s: %v", iter.Val(), err)
-		}
-	}
-	if err := iter.Err(); err != nil {
-		log.Printf("Error scanning feed cache keys: %v", err)
-	}
-
 	// --- Step 3: Return the created comment ---
 	return &pb.CommentResponse{
 		Id:               strconv.FormatUint(uint64(newComment.ID), 10),
@@ -762,6 +986,41 @@ func (s *server) CommentOnPost(ctx context.Context, req *pb.CommentOnPostRequest
 		IsLiked:          false,
 		ReplyCount:       0,
 		AuthorIsVerified: newComment.AuthorIsVerified,
+		IsHidden:         filtered,
+	}, nil
+}
+
+// --- GRPC: UpdateCommentAudience ---
+func (s *server) UpdateCommentAudience(ctx context.Context, req *pb.UpdateCommentAudienceRequest) (*pb.UpdateCommentAudienceResponse, error) {
+	if !isValidCommentAudience(req.Audience) {
+		return nil, status.Error(codes.InvalidArgument, "Comment audience must be one of everyone, following, followers or off")
+	}
+
+	var post Post
+	if err := s.db.Select("id", "author_id").First(&post, req.PostId).Error; err == gorm.ErrRecordNotFound {
+		return nil, status.Error(codes.NotFound, "Post not found")
+	} else if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to retrieve post")
+	}
+	if post.AuthorID != req.UserId {
+		return nil, status.Error(codes.PermissionDenied, "Only the post author can change who can comment")
+	}
+
+	if err := s.db.Model(&Post{}).Where("id = ?", req.PostId).Updates(map[string]interface{}{
+		"comment_audience":  req.Audience,
+		"comments_disabled": req.Audience == commentAudienceOff,
+	}).Error; err != nil {
+		return nil, status.Error(codes.Internal, "Failed to update comment audience")
+	}
+
+	postCacheKey := fmt.Sprintf("post:%d", req.PostId)
+	if err := s.rdb.Del(ctx, postCacheKey).Err(); err != nil {
+		log.Printf("Failed to delete post cache key %s: %v", postCacheKey, err)
+	}
+
+	return &pb.UpdateCommentAudienceResponse{
+		Message:         "Comment audience updated",
+		CommentAudience: req.Audience,
 	}, nil
 }
 
@@ -793,7 +1052,7 @@ func (s *server) DeleteComment(ctx context.Context, req *pb.DeleteCommentRequest
 			return err
 		}
 		// 2. Decrement the post's comment_count
-		if err := tx.Model(&Post{}).Where("id = ?", comment.PostID).Update("comment_count", gorm.Expr("comment_count - 1")).Error; err != nil {
+		if err := tx.Model(&Post{}).Where("id = ?", comment.PostID).Update("comment_count", decrementCounter("comment_count")).Error; err != nil {
 			return err
 		}
 		return nil
@@ -822,14 +1081,20 @@ func (s *server) LikeComment(ctx context.Context, req *pb.LikeCommentRequest) (*
 		return &pb.LikeCommentResponse{Message: "Comment already liked"}, nil
 	}
 
-	// Create new like
+	// Create new like and bump the comment's like_count
 	like := CommentLike{
 		UserID:    req.UserId,
 		CommentID: req.CommentId,
 		CreatedAt: time.Now(),
 	}
 
-	if err := s.db.Create(&like).Error; err != nil {
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&like).Error; err != nil {
+			return err
+		}
+		return tx.Model(&Comment{}).Where("id = ?", req.CommentId).Update("like_count", gorm.Expr("like_count + 1")).Error
+	})
+	if err != nil {
 		log.Printf("Failed to like comment: %v", err)
 		return nil, status.Error(codes.Internal, "Failed to like comment")
 	}
@@ -839,15 +1104,26 @@ func (s *server) LikeComment(ctx context.Context, req *pb.LikeCommentRequest) (*
 
 // --- GRPC: UnlikeComment ---
 func (s *server) UnlikeComment(ctx context.Context, req *pb.LikeCommentRequest) (*pb.UnlikeCommentResponse, error) {
-	// Delete the like
-	result := s.db.Where("user_id = ? AND comment_id = ?", req.UserId, req.CommentId).Delete(&CommentLike{})
+	// Delete the like and drop the comment's like_count
+	var rowsAffected int64
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("user_id = ? AND comment_id = ?", req.UserId, req.CommentId).Delete(&CommentLike{})
+		if result.Error != nil {
+			return result.Error
+		}
+		rowsAffected = result.RowsAffected
+		if rowsAffected == 0 {
+			return nil
+		}
+		return tx.Model(&Comment{}).Where("id = ?", req.CommentId).Update("like_count", decrementCounter("like_count")).Error
+	})
 
-	if result.Error != nil {
-		log.Printf("Failed to unlike comment: %v", result.Error)
+	if err != nil {
+		log.Printf("Failed to unlike comment: %v", err)
 		return nil, status.Error(codes.Internal, "Failed to unlike comment")
 	}
 
-	if result.RowsAffected == 0 {
+	if rowsAffected == 0 {
 		return &pb.UnlikeCommentResponse{Message: "Comment was not liked"}, nil
 	}
 
@@ -855,268 +1131,504 @@ func (s *server) UnlikeComment(ctx context.Context, req *pb.LikeCommentRequest)
 }
 
 // --- GRPC: GetCommentsByPost ---
+// Returns a page of top-level comments; replies are fetched per thread with GetCommentReplies.
 func (s *server) GetCommentsByPost(ctx context.Context, req *pb.GetCommentsByPostRequest) (*pb.GetCommentsByPostResponse, error) {
-	// Get requesting user ID from context (if available)
-	var requestingUserID int64
-	if md, ok := metadata.FromIncomingContext(ctx); ok {
-		if userIDs := md.Get("user_id"); len(userIDs) > 0 {
-			requestingUserID, _ = strconv.ParseInt(userIDs[0], 10, 64)
+	// Prefer the viewer from the request, fall back to metadata for older callers
+	requestingUserID := req.ViewerId
+	if requestingUserID == 0 {
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if userIDs := md.Get("user_id"); len(userIDs) > 0 {
+				requestingUserID, _ = strconv.ParseInt(userIDs[0], 10, 64)
+			}
 		}
 	}
 
-	var comments []Comment
-
-	// Fetch ALL comments for the post (both top-level and replies)
-	err := s.db.Where("post_id = ?", req.PostId).
-		Order("created_at ASC").
-		Find(&comments).Error
+	var post Post
+	if err := s.db.Select("id", "author_id").First(&post, req.PostId).Error; err == gorm.ErrRecordNotFound {
+		return nil, status.Error(codes.NotFound, "Post not found")
+	} else if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to retrieve post")
+	}
 
+	cursor, err := decodeCursor(req.Cursor)
 	if err != nil {
-		log.Printf("Failed to fetch comments: %v", err)
-		return nil, status.Error(codes.Internal, "Failed to fetch comments")
+		return nil, err
 	}
+	pageSize := normalizePageSize(req.PageSize)
 
-	// Convert to proto response with additional fields
-	var commentResponses []*pb.CommentResponse
-	for _, comment := range comments {
-		// Count likes for this comment
-		var likeCount int64
-		s.db.Model(&CommentLike{}).Where("comment_id = ?", comment.ID).Count(&likeCount)
+	seeHidden := req.IncludeHidden && requestingUserID == post.AuthorID
+	keywords := s.getCommentFilter(ctx, post.AuthorID)
 
-		// Check if requesting user liked this comment
-		var isLiked bool
-		if requestingUserID > 0 {
-			var like CommentLike
-			err := s.db.Where("user_id = ? AND comment_id = ?", requestingUserID, comment.ID).First(&like).Error
-			isLiked = err == nil
+	// Pinned comments lead the first page and are left out of the paginated list
+	var pinned []Comment
+	if cursor == nil && req.PageOffset == 0 {
+		pinnedQuery := s.db.Where("post_id = ? AND parent_comment_id = 0 AND pinned_at IS NOT NULL", req.PostId).
+			Order("pinned_at ASC")
+		pinnedQuery = scopeCommentFilter(scopeHiddenComments(pinnedQuery, requestingUserID, seeHidden), keywords, requestingUserID, post.AuthorID, seeHidden)
+		if err := pinnedQuery.Find(&pinned).Error; err != nil {
+			log.Printf("Failed to fetch pinned comments: %v", err)
+			return nil, status.Error(codes.Internal, "Failed to fetch comments")
 		}
+	}
 
-		// Count replies for this comment
-		var replyCount int64
-		s.db.Model(&Comment{}).Where("parent_comment_id = ?", comment.ID).Count(&replyCount)
+	query := s.db.Where("post_id = ? AND parent_comment_id = 0 AND pinned_at IS NULL", req.PostId)
+	query = scopeHiddenComments(query, requestingUserID, seeHidden)
+	query = scopeCommentFilter(query, keywords, requestingUserID, post.AuthorID, seeHidden)
 
-		commentResponses = append(commentResponses, &pb.CommentResponse{
-			Id:               strconv.FormatUint(uint64(comment.ID), 10),
-			Content:          comment.Content,
-			AuthorUsername:   comment.AuthorUsername,
-			AuthorProfileUrl: comment.AuthorProfileURL,
-			CreatedAt:        comment.CreatedAt.Format(time.RFC3339),
-			PostId:           comment.PostID,
-			ParentCommentId:  int64(comment.ParentCommentID),
-			UserId:           comment.UserID,
-			LikeCount:        likeCount,
-			IsLiked:          isLiked,
-			ReplyCount:       replyCount,
-			AuthorIsVerified: comment.AuthorIsVerified,
-		})
+	switch req.Sort {
+	case "", "newest":
+		query = query.Order("created_at DESC").Order("id DESC")
+		if cursor != nil {
+			query = query.Where("created_at < ? OR (created_at = ? AND id < ?)", cursor.CreatedAt, cursor.CreatedAt, cursor.ID)
+		}
+	case "top":
+		query = query.Order("like_count DESC").Order("id DESC")
+		if cursor != nil {
+			query = query.Where("like_count < ? OR (like_count = ? AND id < ?)", cursor.Score, cursor.Score, cursor.ID)
+		}
+	default:
+		return nil, status.Error(codes.InvalidArgument, "sort must be 'newest' or 'top'")
+	}
+
+	if cursor == nil && req.PageOffset > 0 {
+		query = query.Offset(int(req.PageOffset))
 	}
 
+	// Fetch one extra row to know whether there is a next page
+	var comments []Comment
+	if err := query.Limit(pageSize + 1).Find(&comments).Error; err != nil {
+		log.Printf("Failed to fetch comments: %v", err)
+		return nil, status.Error(codes.Internal, "Failed to fetch comments")
+	}
+
+	var nextCursor string
+	if len(comments) > pageSize {
+		comments = comments[:pageSize]
+		last := comments[len(comments)-1]
+		nextCursor = encodeCursor(pageCursor{CreatedAt: last.CreatedAt, ID: last.ID, Score: last.LikeCount})
+	}
+
+	comments = append(pinned, comments...)
+	flagFilteredComments(comments, keywords, post.AuthorID)
+
 	return &pb.GetCommentsByPostResponse{
-		Comments: commentResponses,
+		Comments:   s.buildCommentResponses(comments, requestingUserID),
+		NextCursor: nextCursor,
 	}, nil
 }
 
-// --- GPRC: GetHomeFeed ---
-func (s *server) GetHomeFeed(ctx context.Context, req *pb.GetHomeFeedRequest) (*pb.GetHomeFeedResponse, error) {
-	log.Printf("GetHomeFeed request received for user %d", req.UserId)
-
-	// // Try cache first
-	cacheKey := strconv.FormatInt(req.UserId, 10) + ":" + strconv.FormatInt(int64(req.PageSize), 10) + ":" + strconv.FormatInt(int64(req.PageOffset), 10)
-	cacheKey = "feed:home:" + cacheKey
-	cachedData, err := s.rdb.Get(ctx, cacheKey).Result()
-
-	if err == nil {
-		// Cache hit
-		var response pb.GetHomeFeedResponse
-		if json.Unmarshal([]byte(cachedData), &response) == nil {
-			log.Printf("Cache hit for home feed user %d", req.UserId)
-			return &response, nil
-		}
+// --- GRPC: GetCommentReplies ---
+// Returns a page of replies to a single comment, oldest first so threads read top to bottom.
+func (s *server) GetCommentReplies(ctx context.Context, req *pb.GetCommentRepliesRequest) (*pb.GetCommentsByPostResponse, error) {
+	var parent Comment
+	if err := s.db.First(&parent, req.CommentId).Error; err == gorm.ErrRecordNotFound {
+		return nil, status.Error(codes.NotFound, "Comment not found")
+	} else if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to fetch comment")
 	}
 
-	// --- Step 1: Get user's following list ---
-	followingRes, err := s.userClient.GetFollowingList(ctx, &userPb.GetFollowingListRequest{UserId: req.UserId})
+	cursor, err := decodeCursor(req.Cursor)
 	if err != nil {
-		log.Printf("Failed to get following list from user-service: %v", err)
-		return nil, status.Error(codes.Internal, "Failed to retrieve user feed")
+		return nil, err
 	}
-	followingIDs := followingRes.FollowingUserIds
-
-	// --- Step 2: Get posts where user is a collaborator ---
-	var collaboratorPostIDs []int64
-	// We *don't* want to re-show the user's *own* posts, so we filter them out
-	s.db.Model(&PostCollaborator{}).
-		Where("user_id = ? AND user_id NOT IN (SELECT author_id FROM posts WHERE id = post_collaborators.post_id)", req.UserId).
-		Pluck("post_id", &collaboratorPostIDs)
+	pageSize := normalizePageSize(req.PageSize)
 
-	// --- Step 3: Query our DB for posts ---
-	var posts []Post
-	query := s.db.Order("created_at DESC").
-		Limit(int(req.PageSize)).
-		Offset(int(req.PageOffset))
-
-	// Build the complex WHERE clause:
-	// (author_id IN [followingIDs]) OR (id IN [collaboratorPostIDs])
-	if len(followingIDs) > 0 && len(collaboratorPostIDs) > 0 {
-		query = query.Where("author_id IN ? OR id IN ?", followingIDs, collaboratorPostIDs)
-	} else if len(followingIDs) > 0 {
-		query = query.Where("author_id IN ?", followingIDs)
-	} else if len(collaboratorPostIDs) > 0 {
-		query = query.Where("id IN ?", collaboratorPostIDs)
-	} else {
-		// Not following anyone and not a collaborator on any posts
-		return &pb.GetHomeFeedResponse{Posts: []*pb.Post{}}, nil
+	var post Post
+	if err := s.db.Select("id", "author_id").First(&post, parent.PostID).Error; err != nil {
+		return nil, status.Error(codes.Internal, "Failed to retrieve post")
 	}
 
-	if err := query.Find(&posts).Error; err != nil {
-		return nil, status.Error(codes.Internal, "Failed to retrieve posts")
+	query := s.db.Where("parent_comment_id = ?", parent.ID).
+		Order("created_at ASC").
+		Order("id ASC")
+	keywords := s.getCommentFilter(ctx, post.AuthorID)
+	query = scopeHiddenComments(query, req.ViewerId, false)
+	query = scopeCommentFilter(query, keywords, req.ViewerId, post.AuthorID, false)
+	if cursor != nil {
+		query = query.Where("created_at > ? OR (created_at = ? AND id > ?)", cursor.CreatedAt, cursor.CreatedAt, cursor.ID)
 	}
 
-	// --- Step 4: Filter posts by privacy settings ---
-	posts = s.filterPostsByPrivacy(ctx, posts, req.UserId)
-
-	// --- Step 5: Convert GORM models to gRPC responses ---
-	var grpcPosts []*pb.Post
-	for _, post := range posts {
-		grpcPosts = append(grpcPosts, s.enrichPostProto(ctx, &post, req.UserId))
+	var replies []Comment
+	if err := query.Limit(pageSize + 1).Find(&replies).Error; err != nil {
+		log.Printf("Failed to fetch replies for comment %d: %v", req.CommentId, err)
+		return nil, status.Error(codes.Internal, "Failed to fetch replies")
 	}
 
-	response := &pb.GetHomeFeedResponse{Posts: grpcPosts}
-
-	// Cache with 5 minute TTL
-	if responseJSON, err := json.Marshal(response); err == nil {
-		s.rdb.Set(ctx, cacheKey, responseJSON, 2*time.Minute)
+	var nextCursor string
+	if len(replies) > pageSize {
+		replies = replies[:pageSize]
+		last := replies[len(replies)-1]
+		nextCursor = encodeCursor(pageCursor{CreatedAt: last.CreatedAt, ID: last.ID})
 	}
 
-	return response, nil
+	flagFilteredComments(replies, keywords, post.AuthorID)
+
+	return &pb.GetCommentsByPostResponse{
+		Comments:   s.buildCommentResponses(replies, req.ViewerId),
+		NextCursor: nextCursor,
+	}, nil
 }
 
-// --- NEW: GetUserTaggedPosts ---
-func (s *server) GetUserTaggedPosts(ctx context.Context, req *pb.GetUserContentRequest) (*pb.GetHomeFeedResponse, error) {
-	var postIDs []int64
+// buildCommentResponses converts a page of comments to protos, loading reply
+// counts and the viewer's likes with one query each instead of per comment.
+func (s *server) buildCommentResponses(comments []Comment, viewerID int64) []*pb.CommentResponse {
+	responses := make([]*pb.CommentResponse, 0, len(comments))
+	if len(comments) == 0 {
+		return responses
+	}
+
+	commentIDs := make([]uint, len(comments))
+	for i, comment := range comments {
+		commentIDs[i] = comment.ID
+	}
+
+	// Reply counts for the whole page
+	var replyRows []struct {
+		ParentCommentID uint
+		Count           int64
+	}
+	s.db.Model(&Comment{}).
+		Select("parent_comment_id, COUNT(*) AS count").
+		Where("parent_comment_id IN ?", commentIDs).
+		Group("parent_comment_id").
+		Scan(&replyRows)
+	replyCounts := make(map[uint]int64, len(replyRows))
+	for _, row := range replyRows {
+		replyCounts[row.ParentCommentID] = row.Count
+	}
+
+	// Which of these the viewer has liked
+	likedByViewer := make(map[int64]bool)
+	if viewerID > 0 {
+		var likedIDs []int64
+		s.db.Model(&CommentLike{}).
+			Where("user_id = ? AND comment_id IN ?", viewerID, commentIDs).
+			Pluck("comment_id", &likedIDs)
+		for _, id := range likedIDs {
+			likedByViewer[id] = true
+		}
+	}
 
-	// Find all posts where this user is a collaborator
-	if err := s.db.Model(&PostCollaborator{}).
-		Where("user_id = ?", req.UserId).
-		Pluck("post_id", &postIDs).Error; err != nil {
-		return nil, status.Error(codes.Internal, "Failed to fetch tagged posts")
+	for _, comment := range comments {
+		responses = append(responses, &pb.CommentResponse{
+			Id:               strconv.FormatUint(uint64(comment.ID), 10),
+			Content:          comment.Content,
+			AuthorUsername:   comment.AuthorUsername,
+			AuthorProfileUrl: comment.AuthorProfileURL,
+			CreatedAt:        comment.CreatedAt.Format(time.RFC3339),
+			PostId:           comment.PostID,
+			ParentCommentId:  int64(comment.ParentCommentID),
+			UserId:           comment.UserID,
+			LikeCount:        comment.LikeCount,
+			IsLiked:          likedByViewer[int64(comment.ID)],
+			ReplyCount:       replyCounts[comment.ID],
+			AuthorIsVerified: comment.AuthorIsVerified,
+			IsEdited:         comment.EditedAt != nil,
+			IsPinned:         comment.PinnedAt != nil,
+			IsHidden:         comment.IsHidden,
+		})
 	}
+	return responses
+}
 
-	if len(postIDs) == 0 {
-		return &pb.GetHomeFeedResponse{Posts: []*pb.Post{}}, nil
+// scopeHiddenComments restricts a comment query to what the viewer may see:
+// hidden comments are only returned to their own commenter, unless the post
+// author is explicitly reviewing them.
+func scopeHiddenComments(query *gorm.DB, viewerID int64, seeHidden bool) *gorm.DB {
+	if seeHidden {
+		return query
 	}
+	return query.Where("is_hidden = ? OR user_id = ?", false, viewerID)
+}
 
-	var posts []Post
-	if err := s.db.Where("? = ANY(collaborator_ids) AND author_id != ?", req.UserId, req.UserId).
-		Order("created_at DESC").
-		Limit(int(req.PageSize)).
-		Offset(int(req.PageOffset)).
-		Find(&posts).Error; err != nil {
-		return nil, status.Error(codes.Internal, "Failed to fetch tagged posts")
+// scopeCommentFilter applies the post author's keyword filter in the query, so
+// keywords added after a comment was written still apply and pages stay full.
+// Matching comments are left out for everyone except the commenter (and the
+// author reviewing hidden comments).
+func scopeCommentFilter(query *gorm.DB, keywords []string, viewerID, postAuthorID int64, seeHidden bool) *gorm.DB {
+	if seeHidden {
+		return query
+	}
+	var matches []string
+	args := []interface{}{postAuthorID, viewerID}
+	for _, keyword := range keywords {
+		if keyword != "" {
+			matches = append(matches, `LOWER(content) LIKE ? ESCAPE '\'`)
+			args = append(args, "%"+escapeLike(keyword)+"%")
+		}
 	}
+	if len(matches) == 0 {
+		return query
+	}
+	return query.Where("user_id IN (?, ?) OR NOT ("+strings.Join(matches, " OR ")+")", args...)
+}
 
-	// Filter privacy & Enrich
-	posts = s.filterPostsByPrivacy(ctx, posts, req.RequesterId)
-	var grpcPosts []*pb.Post
-	for _, post := range posts {
-		grpcPosts = append(grpcPosts, s.enrichPostProto(ctx, &post, req.RequesterId))
+// flagFilteredComments marks the comments matching the keyword filter that the
+// viewer still gets to see, so they are shown as hidden
+func flagFilteredComments(comments []Comment, keywords []string, postAuthorID int64) {
+	for i := range comments {
+		if comments[i].UserID != postAuthorID && matchesCommentFilter(comments[i].Content, keywords) {
+			comments[i].IsHidden = true
+		}
+	}
+}
+
+// escapeLike escapes LIKE wildcards so a keyword matches literally
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
+}
+
+// matchesCommentFilter reports whether content contains any of the (lowercased) keywords
+func matchesCommentFilter(content string, keywords []string) bool {
+	if len(keywords) == 0 {
+		return false
 	}
+	content = strings.ToLower(content)
+	for _, keyword := range keywords {
+		if keyword != "" && strings.Contains(content, keyword) {
+			return true
+		}
+	}
+	return false
+}
 
-	return &pb.GetHomeFeedResponse{Posts: grpcPosts}, nil
+// getCommentFilter fetches a post author's keyword filter from user-service.
+// Failures are logged and treated as "no filter" so comments keep working.
+func (s *server) getCommentFilter(ctx context.Context, authorID int64) []string {
+	if s.userClient == nil {
+		return nil
+	}
+	res, err := s.userClient.GetCommentFilterKeywords(ctx, &userPb.GetCommentFilterKeywordsRequest{UserId: authorID})
+	if err != nil {
+		log.Printf("Failed to get comment filter for user %d: %v", authorID, err)
+		return nil
+	}
+	return res.Keywords
 }
 
-// --- Implement GetExploreFeed ---
-func (s *server) GetExploreFeed(ctx context.Context, req *pb.GetHomeFeedRequest) (*pb.GetHomeFeedResponse, error) {
-	log.Println("GetExploreFeed request received")
+// --- GRPC: EditComment ---
+func (s *server) EditComment(ctx context.Context, req *pb.EditCommentRequest) (*pb.CommentResponse, error) {
+	if len(strings.TrimSpace(req.Content)) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "Comment content cannot be empty")
+	}
+	if len(req.Content) > 500 {
+		return nil, status.Error(codes.InvalidArgument, "Comment must not exceed 500 characters")
+	}
 
-	var posts []Post
-	// This feed gets ALL posts (not just from followed users)
-	// and filters out Reels
-	if err := s.db.Where("is_reel = ?", false).
-		Order("created_at DESC").
-		Limit(int(req.PageSize)).
-		Offset(int(req.PageOffset)).
-		Find(&posts).Error; err != nil {
-		return nil, status.Error(codes.Internal, "Failed to retrieve posts")
+	var comment Comment
+	if err := s.db.First(&comment, req.CommentId).Error; err == gorm.ErrRecordNotFound {
+		return nil, status.Error(codes.NotFound, "Comment not found")
+	} else if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to retrieve comment")
+	}
+	if comment.UserID != req.UserId {
+		return nil, status.Error(codes.PermissionDenied, "You can only edit your own comments")
 	}
 
-	// Filter by privacy settings
-	posts = s.filterPostsByPrivacy(ctx, posts, req.UserId)
+	var post Post
+	if err := s.db.Select("id", "author_id").First(&post, comment.PostID).Error; err != nil {
+		return nil, status.Error(codes.Internal, "Failed to retrieve post")
+	}
 
-	// Convert GORM models to gRPC responses
-	var grpcPosts []*pb.Post
-	for _, post := range posts {
-		grpcPosts = append(grpcPosts, s.enrichPostProto(ctx, &post, req.UserId))
+	now := time.Now()
+	comment.Content = req.Content
+	comment.EditedAt = &now
+
+	if err := s.db.Model(&comment).Select("content", "edited_at").Updates(&comment).Error; err != nil {
+		log.Printf("Failed to edit comment %d: %v", comment.ID, err)
+		return nil, status.Error(codes.Internal, "Failed to edit comment")
 	}
 
-	return &pb.GetHomeFeedResponse{Posts: grpcPosts}, nil
+	// The keyword filter is applied when comments are read; flag the new content for the commenter
+	edited := []Comment{comment}
+	flagFilteredComments(edited, s.getCommentFilter(ctx, post.AuthorID), post.AuthorID)
+	return s.buildCommentResponses(edited, req.UserId)[0], nil
 }
 
-// --- Implement GetReelsFeed ---
-func (s *server) GetReelsFeed(ctx context.Context, req *pb.GetHomeFeedRequest) (*pb.GetHomeFeedResponse, error) {
-	log.Println("GetReelsFeed request received")
+// --- GRPC: PinComment ---
+func (s *server) PinComment(ctx context.Context, req *pb.PinCommentRequest) (*pb.PinCommentResponse, error) {
+	comment, err := s.getCommentForPostAuthor(req.CommentId, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !req.Pinned {
+		if err := s.db.Model(comment).Update("pinned_at", nil).Error; err != nil {
+			return nil, status.Error(codes.Internal, "Failed to unpin comment")
+		}
+		return &pb.PinCommentResponse{Message: "Comment unpinned"}, nil
+	}
+
+	if comment.ParentCommentID != 0 {
+		return nil, status.Error(codes.InvalidArgument, "Only top-level comments can be pinned")
+	}
+	if comment.IsHidden {
+		return nil, status.Error(codes.FailedPrecondition, "Hidden comments cannot be pinned")
+	}
+	if comment.PinnedAt != nil {
+		return &pb.PinCommentResponse{Message: "Comment already pinned"}, nil
+	}
+
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		var pinnedCount int64
+		if err := tx.Model(&Comment{}).Where("post_id = ? AND pinned_at IS NOT NULL", comment.PostID).Count(&pinnedCount).Error; err != nil {
+			return err
+		}
+		if pinnedCount >= maxPinnedComments {
+			return status.Errorf(codes.FailedPrecondition, "You can pin up to %d comments per post", maxPinnedComments)
+		}
+		return tx.Model(comment).Update("pinned_at", time.Now()).Error
+	})
+	if err != nil {
+		if st, ok := status.FromError(err); ok && st.Code() == codes.FailedPrecondition {
+			return nil, st.Err()
+		}
+		log.Printf("Failed to pin comment %d: %v", comment.ID, err)
+		return nil, status.Error(codes.Internal, "Failed to pin comment")
+	}
+
+	return &pb.PinCommentResponse{Message: "Comment pinned"}, nil
+}
+
+// --- GRPC: HideComment ---
+func (s *server) HideComment(ctx context.Context, req *pb.HideCommentRequest) (*pb.HideCommentResponse, error) {
+	comment, err := s.getCommentForPostAuthor(req.CommentId, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	updates := map[string]interface{}{"is_hidden": false, "hidden_reason": ""}
+	message := "Comment unhidden"
+	if req.Hidden {
+		// Hiding also unpins
+		updates = map[string]interface{}{"is_hidden": true, "hidden_reason": hiddenByAuthor, "pinned_at": nil}
+		message = "Comment hidden"
+	}
+
+	if err := s.db.Model(comment).Updates(updates).Error; err != nil {
+		log.Printf("Failed to update hidden state of comment %d: %v", comment.ID, err)
+		return nil, status.Error(codes.Internal, "Failed to update comment")
+	}
+
+	return &pb.HideCommentResponse{Message: message}, nil
+}
+
+// getCommentForPostAuthor loads a comment and checks that userID authored the post it's on
+func (s *server) getCommentForPostAuthor(commentID, userID int64) (*Comment, error) {
+	var comment Comment
+	if err := s.db.First(&comment, commentID).Error; err == gorm.ErrRecordNotFound {
+		return nil, status.Error(codes.NotFound, "Comment not found")
+	} else if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to retrieve comment")
+	}
+
+	var post Post
+	if err := s.db.Select("id", "author_id").First(&post, comment.PostID).Error; err != nil {
+		return nil, status.Error(codes.Internal, "Failed to retrieve post")
+	}
+	if post.AuthorID != userID {
+		return nil, status.Error(codes.PermissionDenied, "Only the post author can moderate its comments")
+	}
+	return &comment, nil
+}
+
+// --- NEW: GetUserTaggedPosts ---
+func (s *server) GetUserTaggedPosts(ctx context.Context, req *pb.GetUserContentRequest) (*pb.GetHomeFeedResponse, error) {
+	cursor, err := decodeCursor(req.Cursor)
+	if err != nil {
+		return nil, err
+	}
+	pageSize := normalizePageSize(req.PageSize)
+
+	var postIDs []int64
+
+	// Find all posts where this user is a collaborator
+	if err := s.db.Model(&PostCollaborator{}).
+		Where("user_id = ?", req.UserId).
+		Pluck("post_id", &postIDs).Error; err != nil {
+		return nil, status.Error(codes.Internal, "Failed to fetch tagged posts")
+	}
+
+	if len(postIDs) == 0 {
+		return &pb.GetHomeFeedResponse{Posts: []*pb.Post{}}, nil
+	}
 
 	var posts []Post
-	// This feed gets ONLY posts that are Reels
-	if err := s.db.Where("is_reel = ?", true).
-		Order("created_at DESC").
-		Limit(int(req.PageSize)).
-		Offset(int(req.PageOffset)).
+	if err := s.db.Scopes(notArchived).
+		Where("? = ANY(collaborator_ids) AND author_id != ?", req.UserId, req.UserId).
+		Scopes(newestFirst(cursor, pageSize)).
 		Find(&posts).Error; err != nil {
-		return nil, status.Error(codes.Internal, "Failed to retrieve posts")
+		return nil, status.Error(codes.Internal, "Failed to fetch tagged posts")
 	}
+	posts, nextCursor := nextPostCursor(posts, pageSize)
 
-	// Filter by privacy settings
-	posts = s.filterPostsByPrivacy(ctx, posts, req.UserId)
-
-	// Convert GORM models to gRPC responses
-	var grpcPosts []*pb.Post
-	for _, post := range posts {
-		grpcPosts = append(grpcPosts, s.enrichPostProto(ctx, &post, req.UserId))
+	// Filter privacy & Enrich
+	posts, err = s.filterPostsByPrivacy(ctx, posts, req.RequesterId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to fetch tagged posts")
 	}
+	grpcPosts := s.enrichPosts(ctx, posts, req.RequesterId)
 
-	return &pb.GetHomeFeedResponse{Posts: grpcPosts}, nil
+	return &pb.GetHomeFeedResponse{Posts: grpcPosts, NextCursor: nextCursor}, nil
 }
 
 // --- Implement GetUserPosts ---
 func (s *server) GetUserPosts(ctx context.Context, req *pb.GetUserContentRequest) (*pb.GetHomeFeedResponse, error) {
+	cursor, err := decodeCursor(req.Cursor)
+	if err != nil {
+		return nil, err
+	}
+	pageSize := normalizePageSize(req.PageSize)
+
 	var posts []Post
 
 	// Query for posts by author_id, filtering OUT reels
-	if err := s.db.Where("author_id = ? AND is_reel = ?", req.UserId, false).
-		Order("created_at DESC").
-		Limit(int(req.PageSize)).
-		Offset(int(req.PageOffset)).
+	if err := s.db.Scopes(notArchived, newestFirst(cursor, pageSize)).
+		Where("author_id = ? AND is_reel = ?", req.UserId, false).
 		Find(&posts).Error; err != nil {
 		return nil, status.Error(codes.Internal, "Failed to retrieve posts")
 	}
+	posts, nextCursor := nextPostCursor(posts, pageSize)
 
 	// Filter by privacy settings
-	posts = s.filterPostsByPrivacy(ctx, posts, req.RequesterId)
-
-	var grpcPosts []*pb.Post
-	for _, post := range posts {
-		// We pass req.RequesterId so we know if the viewer liked/saved them
-		grpcPosts = append(grpcPosts, s.enrichPostProto(ctx, &post, req.RequesterId))
+	posts, err = s.filterPostsByPrivacy(ctx, posts, req.RequesterId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to retrieve posts")
+	}
+	if len(posts) == 0 {
+		nextCursor = "" // Nothing to page through if the viewer can't see the profile
 	}
-	return &pb.GetHomeFeedResponse{Posts: grpcPosts}, nil
+
+	grpcPosts := s.enrichPosts(ctx, posts, req.RequesterId)
+	return &pb.GetHomeFeedResponse{Posts: grpcPosts, NextCursor: nextCursor}, nil
 }
 
 // --- Implement GetUserReels ---
 func (s *server) GetUserReels(ctx context.Context, req *pb.GetUserContentRequest) (*pb.GetHomeFeedResponse, error) {
+	cursor, err := decodeCursor(req.Cursor)
+	if err != nil {
+		return nil, err
+	}
+	pageSize := normalizePageSize(req.PageSize)
+
 	var posts []Post
 
 	// Query for posts by author_id, filtering FOR reels
-	if err := s.db.Where("author_id = ? AND is_reel = ?", req.UserId, true).
-		Order("created_at DESC").
-		Limit(int(req.PageSize)).
-		Offset(int(req.PageOffset)).
+	if err := s.db.Scopes(notArchived, newestFirst(cursor, pageSize)).
+		Where("author_id = ? AND is_reel = ?", req.UserId, true).
 		Find(&posts).Error; err != nil {
 		return nil, status.Error(codes.Internal, "Failed to retrieve reels")
 	}
+	posts, nextCursor := nextPostCursor(posts, pageSize)
 
 	// Filter by privacy settings
-	posts = s.filterPostsByPrivacy(ctx, posts, req.RequesterId)
+	posts, err = s.filterPostsByPrivacy(ctx, posts, req.RequesterId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to retrieve reels")
+	}
+	if len(posts) == 0 {
+		nextCursor = "" // Nothing to page through if the viewer can't see the profile
+	}
 
 	var grpcPosts []*pb.Post
 	for _, post := range posts {
@@ -1132,7 +1644,7 @@ func (s *server) GetUserReels(ctx context.Context, req *pb.GetUserContentRequest
 			IsReel:           post.IsReel,
 		})
 	}
-	return &pb.GetHomeFeedResponse{Posts: grpcPosts}, nil
+	return &pb.GetHomeFeedResponse{Posts: grpcPosts, NextCursor: nextCursor}, nil
 }
 
 // --- Implement GetUserContentCount ---
@@ -1141,12 +1653,12 @@ func (s *server) GetUserContentCount(ctx context.Context, req *pb.GetUserContent
 	var reelCount int64
 
 	// 1. Get count of regular posts
-	s.db.Model(&Post{}).
+	s.db.Model(&Post{}).Scopes(notArchived).
 		Where("author_id = ? AND is_reel = ?", req.UserId, false).
 		Count(&postCount)
 
 	// 2. Get count of reels
-	s.db.Model(&Post{}).
+	s.db.Model(&Post{}).Scopes(notArchived).
 		Where("author_id = ? AND is_reel = ?", req.UserId, true).
 		Count(&reelCount)
 
@@ -1157,95 +1669,117 @@ func (s *server) GetUserContentCount(ctx context.Context, req *pb.GetUserContent
 }
 
 // --- Helper function to convert GORM Collection to gRPC Collection ---
-func (s *server) gormToGrpcCollection(collection *Collection) *pb.Collection {
-	// TODO: Get 4 cover image URLs
-	return &pb.Collection{
-		Id:        strconv.FormatUint(uint64(collection.ID), 10),
-		UserId:    strconv.FormatInt(collection.UserID, 10),
-		Name:      collection.Name,
-		IsDefault: collection.IsDefault,
-	}
+// Covers and counts are filled in separately by attachCollectionCovers
+func (s *server) gormToGrpcCollection(collection *Collection, viewerRole string) *pb.Collection {
+	grpcCollection := &pb.Collection{
+		Id:         strconv.FormatUint(uint64(collection.ID), 10),
+		UserId:     strconv.FormatInt(collection.UserID, 10),
+		Name:       collection.Name,
+		IsDefault:  collection.IsDefault,
+		Privacy:    collection.Privacy,
+		ViewerRole: viewerRole,
+	}
+	if grpcCollection.Privacy == "" {
+		grpcCollection.Privacy = collectionPrivate
+	}
+	if collection.CoverPostID != nil {
+		grpcCollection.CoverPostId = int64(*collection.CoverPostID)
+	}
+	// Only the owner hands out the link
+	if viewerRole == collectionRoleOwner && collection.Privacy != collectionPrivate && collection.ShareToken != nil {
+		grpcCollection.ShareToken = *collection.ShareToken
+	}
+	return grpcCollection
 }
 
 // --- GPRC: CreateCollection ---
 func (s *server) CreateCollection(ctx context.Context, req *pb.CreateCollectionRequest) (*pb.Collection, error) {
 	newCollection := Collection{
-		UserID: req.UserId,
-		Name:   req.Name,
+		UserID:  req.UserId,
+		Name:    req.Name,
+		Privacy: collectionPrivate,
 	}
 	if result := s.db.Create(&newCollection); result.Error != nil {
 		return nil, status.Error(codes.Internal, "Failed to create collection")
 	}
-	return s.gormToGrpcCollection(&newCollection), nil
+	return s.gormToGrpcCollection(&newCollection, collectionRoleOwner), nil
 }
 
 // --- GPRC: GetUserCollections ---
+// Returns the user's own collections and the ones they joined as a member
 func (s *server) GetUserCollections(ctx context.Context, req *pb.GetUserCollectionsRequest) (*pb.GetUserCollectionsResponse, error) {
+	var memberships []CollectionMember
+	if err := s.db.Where("user_id = ? AND status = ?", req.UserId, memberAccepted).Find(&memberships).Error; err != nil {
+		return nil, status.Error(codes.Internal, "Failed to retrieve collections")
+	}
+	roles := make(map[uint]string, len(memberships))
+	joinedIDs := make([]uint, 0, len(memberships))
+	for _, m := range memberships {
+		roles[m.CollectionID] = m.Role
+		joinedIDs = append(joinedIDs, m.CollectionID)
+	}
+
+	query := s.db.Where("user_id = ?", req.UserId)
+	if len(joinedIDs) > 0 {
+		query = s.db.Where("user_id = ? OR id IN ?", req.UserId, joinedIDs)
+	}
 	var collections []Collection
-	if err := s.db.Where("user_id = ?", req.UserId).Order("created_at DESC").Find(&collections).Error; err != nil {
+	if err := query.Order("created_at DESC").Find(&collections).Error; err != nil {
 		return nil, status.Error(codes.Internal, "Failed to retrieve collections")
 	}
 
 	var grpcCollections []*pb.Collection
-	for _, c := range collections {
-		grpcCollections = append(grpcCollections, s.gormToGrpcCollection(&c))
+	for i := range collections {
+		role := collectionRoleOwner
+		if collections[i].UserID != req.UserId {
+			role = roles[collections[i].ID]
+		}
+		grpcCollections = append(grpcCollections, s.gormToGrpcCollection(&collections[i], role))
 	}
+	s.attachCollectionCovers(ctx, collections, grpcCollections, req.UserId)
 
 	return &pb.GetUserCollectionsResponse{Collections: grpcCollections}, nil
 }
 
 // --- GPRC: GetPostsInCollection ---
 func (s *server) GetPostsInCollection(ctx context.Context, req *pb.GetPostsInCollectionRequest) (*pb.GetHomeFeedResponse, error) {
-	// 1. Verify this user owns this collection
-	var collection Collection
-	if err := s.db.First(&collection, req.CollectionId).Error; err != nil {
-		return nil, status.Error(codes.NotFound, "Collection not found")
-	}
-	if collection.UserID != req.UserId {
-		return nil, status.Error(codes.PermissionDenied, "You do not own this collection")
-	}
-
-	// 2. Get all Post IDs from the join table
-	var postIDs []uint
-	result := s.db.Model(&SavedPost{}).Where("collection_id = ?", req.CollectionId).Order("created_at DESC").Pluck("post_id", &postIDs)
-	if result.Error != nil {
-		log.Printf("Error querying SavedPost: %v", result.Error)
-	}
-	log.Printf("GetPostsInCollection: collection_id=%d, found %d post IDs: %v (rows affected: %d)", req.CollectionId, len(postIDs), postIDs, result.RowsAffected)
-
-	// Debug: Let's also count total records in SavedPost
-	var totalCount int64
-	s.db.Model(&SavedPost{}).Where("collection_id = ?", req.CollectionId).Count(&totalCount)
-	log.Printf("Total SavedPost records for collection %d: %d", req.CollectionId, totalCount)
-
-	if len(postIDs) == 0 {
-		return &pb.GetHomeFeedResponse{Posts: []*pb.Post{}}, nil
+	// 1. Verify this user is a member, or has the share link
+	if _, _, err := s.authorizeCollection(req.CollectionId, req.UserId, req.ShareToken, false); err != nil {
+		return nil, err
 	}
 
-	// 3. Get all posts matching those IDs
+	// 2. Get the saved posts in the collection's order
 	var posts []Post
-	if err := s.db.Where("id IN ?", postIDs).
+	if err := s.db.Joins("JOIN saved_posts ON saved_posts.post_id = posts.id").
+		Where("saved_posts.collection_id = ? AND posts.archived_at IS NULL", req.CollectionId).
+		Order(savedPostOrder).
 		Limit(int(req.PageSize)).
 		Offset(int(req.PageOffset)).
 		Find(&posts).Error; err != nil {
+		log.Printf("Error querying posts in collection %d: %v", req.CollectionId, err)
 		return nil, status.Error(codes.Internal, "Failed to retrieve posts")
 	}
+	if len(posts) == 0 {
+		return &pb.GetHomeFeedResponse{Posts: []*pb.Post{}}, nil
+	}
 
-	// 4. Convert and return
-	var grpcPosts []*pb.Post
-	for _, post := range posts {
-		grpcPosts = append(grpcPosts, s.gormToGrpcPost(&post)) // Assumes you have a gormToGrpcPost helper
+	// 3. Members only see posts they could see anyway
+	posts, err := s.filterPostsByPrivacy(ctx, posts, req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to retrieve posts")
 	}
-	return &pb.GetHomeFeedResponse{Posts: grpcPosts}, nil
+	return &pb.GetHomeFeedResponse{Posts: s.enrichPosts(ctx, posts, req.UserId)}, nil
 }
 
 // --- GPRC: GetCollectionsForPost ---
 func (s *server) GetCollectionsForPost(ctx context.Context, req *pb.GetCollectionsForPostRequest) (*pb.GetCollectionsForPostResponse, error) {
-	// Get all collection IDs that contain this post for this user
+	// Get all collection IDs that contain this post and that this user can edit
+	editable := s.db.Model(&CollectionMember{}).Select("collection_id").
+		Where("user_id = ? AND status = ? AND role = ?", req.UserId, memberAccepted, collectionRoleEditor)
 	var savedPosts []SavedPost
 	if err := s.db.
 		Joins("JOIN collections ON saved_posts.collection_id = collections.id").
-		Where("saved_posts.post_id = ? AND collections.user_id = ?", req.PostId, req.UserId).
+		Where("saved_posts.post_id = ? AND (collections.user_id = ? OR collections.id IN (?))", req.PostId, req.UserId, editable).
 		Find(&savedPosts).Error; err != nil {
 		log.Printf("Error getting collections for post %d: %v", req.PostId, err)
 		return nil, status.Error(codes.Internal, "Failed to get collections for post")
@@ -1303,24 +1837,19 @@ func (s *server) SavePostToCollection(ctx context.Context, req *pb.SavePostToCol
 		}
 		log.Printf("Using default collection ID %d for user %d", collection.ID, req.UserId)
 	} else {
-		// Use the specified collection
-		var col Collection
-		if err := s.db.First(&col, req.CollectionId).Error; err != nil {
-			log.Printf("Collection %d not found: %v", req.CollectionId, err)
-			return nil, status.Error(codes.NotFound, "Collection not found")
-		}
-		collection = &col
-
-		log.Printf("SavePostToCollection: collection.UserID=%d, req.UserId=%d", collection.UserID, req.UserId)
-		if collection.UserID != req.UserId {
-			return nil, status.Error(codes.PermissionDenied, "You do not own this collection")
+		// Use the specified collection; owners and editors can add to it
+		collection, _, err = s.authorizeCollection(req.CollectionId, req.UserId, "", true)
+		if err != nil {
+			log.Printf("SavePostToCollection: user %d can't add to collection %d: %v", req.UserId, req.CollectionId, err)
+			return nil, err
 		}
 	}
 
-	// 2. Save the post to the actual collection (use collection.ID, not req.CollectionId)
+	// 2. Save the post on top of the actual collection (use collection.ID, not req.CollectionId)
 	savedPost := SavedPost{
 		CollectionID: uint(collection.ID),
 		PostID:       uint(req.PostId),
+		Position:     s.nextSavedPostPosition(s.db, collection.ID),
 	}
 	log.Printf("Attempting to save post %d to collection %d", req.PostId, collection.ID)
 	result := s.db.Create(&savedPost)
@@ -1334,6 +1863,9 @@ func (s *server) SavePostToCollection(ctx context.Context, req *pb.SavePostToCol
 	}
 	log.Printf("Successfully saved post %d to collection %d (Rows affected: %d)", req.PostId, collection.ID, result.RowsAffected)
 
+	// Insights count a save once per collection owner, not once per collection
+	s.recordFirstSave(req.PostId, collection.UserID)
+
 	// Verify the save by immediately querying
 	var count int64
 	s.db.Model(&SavedPost{}).Where("collection_id = ? AND post_id = ?", collection.ID, req.PostId).Count(&count)
@@ -1346,13 +1878,9 @@ func (s *server) SavePostToCollection(ctx context.Context, req *pb.SavePostToCol
 func (s *server) UnsavePostFromCollection(ctx context.Context, req *pb.UnsavePostFromCollectionRequest) (*pb.UnsavePostFromCollectionResponse, error) {
 	log.Printf("UnsavePostFromCollection: collection_id=%d, post_id=%d, user_id=%d", req.CollectionId, req.PostId, req.UserId)
 
-	// 1. Verify this user owns this collection
-	var collection Collection
-	if err := s.db.First(&collection, req.CollectionId).Error; err != nil {
-		return nil, status.Error(codes.NotFound, "Collection not found")
-	}
-	if collection.UserID != req.UserId {
-		return nil, status.Error(codes.PermissionDenied, "You do not own this collection")
+	// 1. Verify this user can edit this collection
+	if _, _, err := s.authorizeCollection(req.CollectionId, req.UserId, "", true); err != nil {
+		return nil, err
 	}
 
 	// 2. Unsave the post - use explicit WHERE to ensure we only delete from the specified collection
@@ -1362,6 +1890,8 @@ func (s *server) UnsavePostFromCollection(ctx context.Context, req *pb.UnsavePos
 	if result.RowsAffected == 0 {
 		return nil, status.Error(codes.NotFound, "Post was not saved in this collection")
 	}
+	// A removed cover falls back to the automatic one
+	s.db.Model(&Collection{}).Where("id = ? AND cover_post_id = ?", req.CollectionId, req.PostId).Update("cover_post_id", nil)
 
 	return &pb.UnsavePostFromCollectionResponse{Message: "Post unsaved successfully"}, nil
 }
@@ -1384,6 +1914,9 @@ func (s *server) DeleteCollection(ctx context.Context, req *pb.DeleteCollectionR
 	if err := s.db.Where("collection_id = ?", req.CollectionId).Delete(&SavedPost{}).Error; err != nil {
 		return nil, status.Error(codes.Internal, "Failed to clear collection items")
 	}
+	if err := s.db.Where("collection_id = ?", req.CollectionId).Delete(&CollectionMember{}).Error; err != nil {
+		return nil, status.Error(codes.Internal, "Failed to remove collection members")
+	}
 	if err := s.db.Delete(&collection).Error; err != nil {
 		return nil, status.Error(codes.Internal, "Failed to delete collection")
 	}
@@ -1409,16 +1942,16 @@ func (s *server) RenameCollection(ctx context.Context, req *pb.RenameCollectionR
 		return nil, status.Error(codes.Internal, "Failed to rename collection")
 	}
 
-	return s.gormToGrpcCollection(&collection), nil
+	return s.gormToGrpcCollection(&collection, collectionRoleOwner), nil
 }
 
 // gormToGrpcPost converts our GORM Post model to the gRPC Post message
 func (s *server) gormToGrpcPost(post *Post) *pb.Post {
-	// Get real LikeCount and CommentCount
-	var likeCount int64
-	s.db.Model(&PostLike{}).Where("post_id = ?", post.ID).Count(&likeCount)
-	var commentCount int64
-	s.db.Model(&Comment{}).Where("post_id = ?", post.ID).Count(&commentCount)
+	// No viewer here, so a hidden count stays hidden
+	likeCount := post.LikeCount
+	if post.HideLikeCount {
+		likeCount = 0
+	}
 
 	return &pb.Post{
 		Id:               strconv.FormatUint(uint64(post.ID), 10),
@@ -1428,6 +1961,9 @@ func (s *server) gormToGrpcPost(post *Post) *pb.Post {
 		CreatedAt:        post.CreatedAt.Format(time.RFC3339),
 		IsReel:           post.IsReel,
 		CommentsDisabled: post.CommentsDisabled,
+		CommentAudience:  post.CommentAudience,
+		HideLikeCount:    post.HideLikeCount,
+		IsArchived:       post.ArchivedAt != nil,
 		ThumbnailUrl:     post.ThumbnailURL,
 
 		// Use the saved denormalized data
@@ -1435,9 +1971,9 @@ func (s *server) gormToGrpcPost(post *Post) *pb.Post {
 		AuthorProfileUrl: post.AuthorProfileURL,
 		AuthorIsVerified: post.AuthorIsVerified,
 
-		// Real-time counts from database
+		// Denormalized counters, kept in step by LikePost/CommentOnPost and friends
 		LikeCount:    likeCount,
-		CommentCount: commentCount,
+		CommentCount: post.CommentCount,
 	}
 }
 
@@ -1453,6 +1989,11 @@ func (s *server) GetPost(ctx context.Context, req *pb.GetPostRequest) (*pb.Post,
 		return nil, status.Error(codes.Internal, "Database error")
 	}
 
+	// Archived posts are only visible to their author (ViewerId 0 is an internal call)
+	if post.ArchivedAt != nil && req.ViewerId != 0 && req.ViewerId != post.AuthorID {
+		return nil, status.Error(codes.NotFound, "Post not found")
+	}
+
 	// Use enrichPostProto to get viewer-specific data (is_liked, is_saved)
 	grpcPost := s.enrichPostProto(ctx, &post, req.ViewerId)
 
@@ -1466,7 +2007,7 @@ func (s *server) GetPosts(ctx context.Context, req *pb.GetPostsRequest) (*pb.Get
 	}
 
 	var posts []Post
-	if err := s.db.Where("id IN ?", req.PostIds).Find(&posts).Error; err != nil {
+	if err := s.db.Scopes(notArchived).Where("id IN ?", req.PostIds).Find(&posts).Error; err != nil {
 		return nil, status.Error(codes.Internal, "Failed to retrieve posts")
 	}
 
@@ -1480,6 +2021,8 @@ func (s *server) GetPosts(ctx context.Context, req *pb.GetPostsRequest) (*pb.Get
 }
 
 // --- GPRC: DeletePost (Admin) ---
+// DeletePost moves a post to Recently Deleted. Comments, likes, saves and media are
+// kept so RestorePost can bring it back; worker-service purges it after trashRetention.
 func (s *server) DeletePost(ctx context.Context, req *pb.DeletePostRequest) (*pb.DeletePostResponse, error) {
 	log.Printf("DeletePost request from user %d for post %d", req.AdminUserId, req.PostId)
 
@@ -1494,88 +2037,182 @@ func (s *server) DeletePost(ctx context.Context, req *pb.DeletePostRequest) (*pb
 		return nil, status.Error(codes.PermissionDenied, "You can only delete your own posts")
 	}
 
-	// We can't delete a composite primary key (like PostLike) with just GORM,
-	// so we'll use a transaction and delete related data manually.
+	// gorm.Model makes this a soft delete: deleted_at is set and every
+	// default-scoped query stops returning the post
+	if result := s.db.Delete(&Post{}, req.PostId); result.Error != nil {
+		log.Printf("Failed to delete post %d: %v", req.PostId, result.Error)
+		return nil, status.Error(codes.Internal, "Failed to delete post")
+	} else if result.RowsAffected == 0 {
+		return nil, status.Error(codes.NotFound, "Post not found")
+	}
 
-	err := s.db.Transaction(func(tx *gorm.DB) error {
-		// 1. Delete associated comments
-		if err := tx.Where("post_id = ?", req.PostId).Delete(&Comment{}).Error; err != nil {
-			return err
-		}
+	s.clearPostCaches(ctx, req.PostId)
+	s.publishFeedEvent(ctx, "post.removed", post.ID)
 
-		// 2. Delete associated likes
-		if err := tx.Where("post_id = ?", req.PostId).Delete(&PostLike{}).Error; err != nil {
-			return err
-		}
+	log.Printf("Moved post %d to recently deleted", req.PostId)
 
-		// 3. Delete from "Saved" collections (join table)
-		if err := tx.Where("post_id = ?", req.PostId).Delete(&SavedPost{}).Error; err != nil {
-			return err
-		}
+	return &pb.DeletePostResponse{Message: "Post moved to Recently Deleted. You can restore it within 30 days."}, nil
+}
 
-		// 4. Finally, delete the post itself
-		if result := tx.Delete(&Post{}, req.PostId); result.Error != nil {
-			return result.Error
-		} else if result.RowsAffected == 0 {
-			return status.Error(codes.NotFound, "Post not found")
-		}
+// --- GRPC: RestorePost ---
+func (s *server) RestorePost(ctx context.Context, req *pb.RestorePostRequest) (*pb.RestorePostResponse, error) {
+	var post Post
+	if err := s.db.Unscoped().Where("deleted_at IS NOT NULL").First(&post, req.PostId).Error; err == gorm.ErrRecordNotFound {
+		return nil, status.Error(codes.NotFound, "Post not found in Recently Deleted")
+	} else if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to retrieve post")
+	}
+	if post.AuthorID != req.UserId {
+		return nil, status.Error(codes.PermissionDenied, "You can only restore your own posts")
+	}
+	if time.Since(post.DeletedAt.Time) > trashRetention {
+		// Past the window; the worker just hasn't purged it yet
+		return nil, status.Error(codes.NotFound, "Post not found in Recently Deleted")
+	}
 
-		return nil // Commit
-	})
+	if err := s.db.Unscoped().Model(&Post{}).Where("id = ?", req.PostId).Update("deleted_at", nil).Error; err != nil {
+		return nil, status.Error(codes.Internal, "Failed to restore post")
+	}
+
+	s.clearPostCaches(ctx, req.PostId)
+	s.publishFeedEvent(ctx, "post.restored", post.ID)
 
+	return &pb.RestorePostResponse{Message: "Post restored"}, nil
+}
+
+// --- GRPC: GetRecentlyDeleted ---
+func (s *server) GetRecentlyDeleted(ctx context.Context, req *pb.GetRecentlyDeletedRequest) (*pb.GetRecentlyDeletedResponse, error) {
+	cursor, err := decodeCursor(req.Cursor)
 	if err != nil {
-		log.Printf("Failed to delete post %d: %v", req.PostId, err)
-		// Check if it's the "not found" error from our transaction
-		if st, ok := status.FromError(err); ok && st.Code() == codes.NotFound {
-			return nil, st.Err()
-		}
-		return nil, status.Error(codes.Internal, "Failed to delete post and associated data")
+		return nil, err
 	}
+	pageSize := normalizePageSize(req.PageSize)
 
-	// --- ADDED: Delete media from MinIO ---
-	if len(post.MediaURLs) > 0 {
-		bucketName := "media" // Standard bucket name used across all services
-		for _, url := range post.MediaURLs {
-			// MediaURLs now store object paths directly (e.g., "user-123/posts/video.mp4")
-			// not full URLs, so we can use them directly as object names
-			objectName := url
-			// Handle legacy URLs if they still exist (contains http://)
-			if strings.Contains(url, "http://") || strings.Contains(url, "https://") {
-				objectName = url[strings.LastIndex(url, "/")+1:]
-			}
-			if objectName != "" {
-				err := s.minioClient.RemoveObject(ctx, bucketName, objectName, minio.RemoveObjectOptions{})
-				if err != nil {
-					log.Printf("Failed to delete object %s from MinIO: %v", objectName, err)
-					// Do not fail the whole request, just log it
-				} else {
-					log.Printf("Successfully deleted object %s from MinIO bucket '%s'", objectName, bucketName)
-				}
-			}
-		}
+	// The cursor's time is deleted_at here
+	query := s.db.Unscoped().
+		Where("author_id = ? AND deleted_at IS NOT NULL AND deleted_at > ?", req.UserId, time.Now().Add(-trashRetention))
+	if cursor != nil {
+		query = query.Where("deleted_at < ? OR (deleted_at = ? AND id < ?)", cursor.CreatedAt, cursor.CreatedAt, cursor.ID)
+	}
+	var posts []Post
+	if err := query.Order("deleted_at DESC, id DESC").Limit(pageSize + 1).Find(&posts).Error; err != nil {
+		return nil, status.Error(codes.Internal, "Failed to retrieve recently deleted posts")
 	}
 
-	// --- Clear Redis cache for this post ---
-	cacheKey := fmt.Sprintf("post:%d", req.PostId)
+	nextCursor := ""
+	if len(posts) > pageSize {
+		posts = posts[:pageSize]
+		last := posts[len(posts)-1]
+		nextCursor = encodeCursor(pageCursor{CreatedAt: last.DeletedAt.Time, ID: last.ID})
+	}
+
+	grpcPosts := s.enrichPosts(ctx, posts, req.UserId)
+	deleted := make([]*pb.DeletedPost, 0, len(posts))
+	for i := range posts {
+		deletedAt := posts[i].DeletedAt.Time
+		deleted = append(deleted, &pb.DeletedPost{
+			Post:      grpcPosts[i],
+			DeletedAt: deletedAt.Format(time.RFC3339),
+			PurgeAt:   deletedAt.Add(trashRetention).Format(time.RFC3339),
+		})
+	}
+
+	return &pb.GetRecentlyDeletedResponse{Posts: deleted, NextCursor: nextCursor}, nil
+}
+
+// clearPostCaches drops the cached post
+func (s *server) clearPostCaches(ctx context.Context, postID int64) {
+	cacheKey := fmt.Sprintf("post:%d", postID)
 	if err := s.rdb.Del(ctx, cacheKey).Err(); err != nil {
 		log.Printf("Failed to delete cache key %s: %v", cacheKey, err)
 	}
+}
 
-	// Also clear feed caches that might contain this post
-	feedPattern := "feed:*"
-	iter := s.rdb.Scan(ctx, 0, feedPattern, 0).Iterator()
-	for iter.Next(ctx) {
-		if err := s.rdb.Del(ctx, iter.Val()).Err(); err != nil {
-			log.Printf("Failed to delete cache key %s: %v", iter.Val(), err)
-		}
+// notArchived limits a post query to posts still shown on profiles and in feeds
+func notArchived(db *gorm.DB) *gorm.DB {
+	return db.Where("archived_at IS NULL")
+}
+
+// --- GRPC: ArchivePost ---
+func (s *server) ArchivePost(ctx context.Context, req *pb.ArchivePostRequest) (*pb.ArchivePostResponse, error) {
+	if err := s.setPostArchived(ctx, req.PostId, req.UserId, true); err != nil {
+		return nil, err
 	}
-	if err := iter.Err(); err != nil {
-		log.Printf("Error scanning feed cache keys: %v", err)
+	return &pb.ArchivePostResponse{Message: "Post archived"}, nil
+}
+
+// --- GRPC: UnarchivePost ---
+func (s *server) UnarchivePost(ctx context.Context, req *pb.ArchivePostRequest) (*pb.UnarchivePostResponse, error) {
+	if err := s.setPostArchived(ctx, req.PostId, req.UserId, false); err != nil {
+		return nil, err
 	}
+	return &pb.UnarchivePostResponse{Message: "Post restored to profile"}, nil
+}
 
-	log.Printf("Successfully deleted post %d and its associations", req.PostId)
+// setPostArchived flips a post in or out of the archive. Likes, comments and saves
+// are left untouched so an unarchived post comes back exactly as it was.
+func (s *server) setPostArchived(ctx context.Context, postID, userID int64, archived bool) error {
+	var post Post
+	if err := s.db.Select("id", "author_id", "archived_at").First(&post, postID).Error; err == gorm.ErrRecordNotFound {
+		return status.Error(codes.NotFound, "Post not found")
+	} else if err != nil {
+		return status.Error(codes.Internal, "Failed to retrieve post")
+	}
+	if post.AuthorID != userID {
+		return status.Error(codes.PermissionDenied, "You can only archive your own posts")
+	}
+	if archived == (post.ArchivedAt != nil) {
+		return nil // Already in the requested state
+	}
 
-	return &pb.DeletePostResponse{Message: "Post deleted successfully"}, nil
+	var archivedAt *time.Time
+	if archived {
+		now := time.Now()
+		archivedAt = &now
+	}
+	if err := s.db.Model(&Post{}).Where("id = ?", postID).Update("archived_at", archivedAt).Error; err != nil {
+		return status.Error(codes.Internal, "Failed to update post")
+	}
+
+	// The post appears in (or disappears from) feeds
+	s.clearPostCaches(ctx, postID)
+	if archived {
+		s.publishFeedEvent(ctx, "post.removed", post.ID)
+	} else {
+		s.publishFeedEvent(ctx, "post.restored", post.ID)
+	}
+
+	return nil
+}
+
+// --- GRPC: GetArchivedPosts ---
+func (s *server) GetArchivedPosts(ctx context.Context, req *pb.GetArchivedPostsRequest) (*pb.GetArchivedPostsResponse, error) {
+	cursor, err := decodeCursor(req.Cursor)
+	if err != nil {
+		return nil, err
+	}
+	pageSize := normalizePageSize(req.PageSize)
+
+	// The cursor's time is archived_at here, not created_at
+	query := s.db.Where("author_id = ? AND archived_at IS NOT NULL", req.UserId)
+	if cursor != nil {
+		query = query.Where("archived_at < ? OR (archived_at = ? AND id < ?)", cursor.CreatedAt, cursor.CreatedAt, cursor.ID)
+	}
+	var posts []Post
+	if err := query.Order("archived_at DESC, id DESC").Limit(pageSize + 1).Find(&posts).Error; err != nil {
+		return nil, status.Error(codes.Internal, "Failed to retrieve archived posts")
+	}
+
+	nextCursor := ""
+	if len(posts) > pageSize {
+		posts = posts[:pageSize]
+		last := posts[len(posts)-1]
+		nextCursor = encodeCursor(pageCursor{CreatedAt: *last.ArchivedAt, ID: last.ID})
+	}
+
+	grpcPosts := s.enrichPosts(ctx, posts, req.UserId)
+
+	return &pb.GetArchivedPostsResponse{Posts: grpcPosts, NextCursor: nextCursor}, nil
 }
 
 // --- Implement SharePost ---
@@ -1612,6 +2249,7 @@ func (s *server) SharePost(ctx context.Context, req *pb.SharePostRequest) (*pb.S
 		log.Printf("Failed to share post: %v", err)
 		return nil, status.Error(codes.Internal, "Failed to share post")
 	}
+	s.recordPostMetric(req.PostId, metricShare)
 
 	// Send notification to post author (if not sharing own post)
 	if post.AuthorID != req.UserId {
@@ -1724,51 +2362,99 @@ func (s *server) GetSharedPosts(ctx context.Context, req *pb.GetSharedPostsReque
 	return &pb.GetSharedPostsResponse{SharedPosts: items}, nil
 }
 
+// enrichPostProto converts one post for a viewer; see enrichPosts
 func (s *server) enrichPostProto(ctx context.Context, post *Post, viewerID int64) *pb.Post {
-	var likeCount int64
-	var commentCount int64
-	var isLiked bool
-	var isSaved bool
-
-	// 1. Count Likes
-	s.db.Model(&PostLike{}).Where("post_id = ?", post.ID).Count(&likeCount)
-
-	// 2. Count Comments
-	s.db.Model(&Comment{}).Where("post_id = ?", post.ID).Count(&commentCount)
-
-	// 3. Check if Viewer Liked
-	if viewerID != 0 {
-		var count int64
-		s.db.Model(&PostLike{}).Where("post_id = ? AND user_id = ?", post.ID, viewerID).Count(&count)
-		isLiked = count > 0
-	}
-
-	// 4. Check if Viewer Saved
-	if viewerID != 0 {
-		// We need to check if the post ID exists in ANY of the user's collections
-		// Join SavedPost with Collection
-		var count int64
-		s.db.Table("saved_posts").
+	return s.enrichPosts(ctx, []Post{*post}, viewerID)[0]
+}
+
+// enrichPosts converts a page of posts for a viewer. Counts come from the post's
+// like_count and comment_count columns; whether the viewer liked or saved each post
+// takes one query apiece for the whole page.
+func (s *server) enrichPosts(ctx context.Context, posts []Post, viewerID int64) []*pb.Post {
+	liked := make(map[int64]bool)
+	saved := make(map[int64]bool)
+	rels := s.newRelationshipCache(viewerID)
+
+	if viewerID != 0 && len(posts) > 0 {
+		postIDs := make([]int64, len(posts))
+		var authorIDs []int64
+		for i := range posts {
+			postIDs[i] = int64(posts[i].ID)
+			if posts[i].CommentAudience == commentAudienceFollowing || posts[i].CommentAudience == commentAudienceFollowers {
+				authorIDs = append(authorIDs, posts[i].AuthorID)
+			}
+		}
+
+		// 1. Which of these the viewer liked
+		var likedIDs []int64
+		if err := s.db.Model(&PostLike{}).
+			Where("user_id = ? AND post_id IN ?", viewerID, postIDs).
+			Pluck("post_id", &likedIDs).Error; err != nil {
+			log.Printf("Failed to load likes for user %d: %v", viewerID, err)
+		}
+		for _, id := range likedIDs {
+			liked[id] = true
+		}
+
+		// 2. Which of these are in ANY of the viewer's collections
+		var savedIDs []int64
+		if err := s.db.Table("saved_posts").
 			Joins("JOIN collections ON saved_posts.collection_id = collections.id").
-			Where("saved_posts.post_id = ? AND collections.user_id = ?", post.ID, viewerID).
-			Count(&count)
-		isSaved = count > 0
+			Where("saved_posts.post_id IN ? AND collections.user_id = ?", postIDs, viewerID).
+			Pluck("saved_posts.post_id", &savedIDs).Error; err != nil {
+			log.Printf("Failed to load saves for user %d: %v", viewerID, err)
+		}
+		for _, id := range savedIDs {
+			saved[id] = true
+		}
+
+		// 3. Relationships for posts with a restricted comment audience
+		if err := rels.prefetch(ctx, authorIDs); err != nil {
+			log.Printf("Failed to load relationships for user %d: %v", viewerID, err)
+		}
 	}
 
-	return &pb.Post{
-		Id:               strconv.FormatUint(uint64(post.ID), 10),
-		AuthorId:         post.AuthorID, // FIXED: Include author_id
-		Caption:          post.Caption,
-		AuthorUsername:   post.AuthorUsername,
-		AuthorProfileUrl: post.AuthorProfileURL,
-		AuthorIsVerified: post.AuthorIsVerified,
-		MediaUrls:        post.MediaURLs,
-		CreatedAt:        post.CreatedAt.Format(time.RFC3339),
-		IsReel:           post.IsReel,
-		Location:         post.Location,
-		LikeCount:        likeCount,
-		CommentCount:     commentCount,
-		IsLiked:          isLiked,
-		IsSaved:          isSaved,
+	grpcPosts := make([]*pb.Post, 0, len(posts))
+	for i := range posts {
+		post := &posts[i]
+
+		// Like count is hidden from everyone but the author if they asked
+		var likeCount int64
+		if !post.HideLikeCount || viewerID == post.AuthorID {
+			likeCount = post.LikeCount
+		}
+
+		// Whether the viewer can comment drives the composer state
+		var canComment bool
+		if viewerID != 0 {
+			allowed, err := s.canComment(ctx, rels, post)
+			if err != nil {
+				log.Printf("Failed to check comment audience for post %d: %v", post.ID, err)
+			}
+			canComment = allowed
+		}
+
+		grpcPosts = append(grpcPosts, &pb.Post{
+			Id:               strconv.FormatUint(uint64(post.ID), 10),
+			AuthorId:         post.AuthorID, // FIXED: Include author_id
+			Caption:          post.Caption,
+			AuthorUsername:   post.AuthorUsername,
+			AuthorProfileUrl: post.AuthorProfileURL,
+			AuthorIsVerified: post.AuthorIsVerified,
+			MediaUrls:        post.MediaURLs,
+			CreatedAt:        post.CreatedAt.Format(time.RFC3339),
+			IsReel:           post.IsReel,
+			Location:         post.Location,
+			LikeCount:        likeCount,
+			CommentCount:     post.CommentCount,
+			IsLiked:          liked[int64(post.ID)],
+			IsSaved:          saved[int64(post.ID)],
+			CommentsDisabled: post.CommentsDisabled,
+			CommentAudience:  post.CommentAudience,
+			CanComment:       canComment,
+			HideLikeCount:    post.HideLikeCount,
+			IsArchived:       post.ArchivedAt != nil,
+		})
 	}
+	return grpcPosts
 }
@@ -1,10 +1,24 @@
 package main
 
 import (
+	"context"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
 	"testing"
+	"time"
 
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
+
+	hashtagPb "github.com/hoshibmatchi/hashtag-service/proto"
+	messagePb "github.com/hoshibmatchi/message-service/proto"
+	pb "github.com/hoshibmatchi/post-service/proto"
+	userPb "github.com/hoshibmatchi/user-service/proto"
 )
 
 // setupTestDB creates an in-memory SQLite database for testing
@@ -20,13 +34,123 @@ func setupTestDB() (*gorm.DB, error) {
 	db.AutoMigrate(&Comment{})
 	db.AutoMigrate(&CommentLike{})
 	db.AutoMigrate(&Collection{})
+	db.AutoMigrate(&CollectionMember{})
 	db.AutoMigrate(&SavedPost{})
 	db.AutoMigrate(&PostCollaborator{})
 	db.AutoMigrate(&SharedPost{})
+	db.AutoMigrate(&ReelWatch{})
+	db.AutoMigrate(&ReelStat{})
+	db.AutoMigrate(&NotInterested{})
+	db.AutoMigrate(&PostViewer{})
+	db.AutoMigrate(&PostDailyMetric{})
 
 	return db, nil
 }
 
+// fakeUserClient stubs the user-service calls post-service makes.
+// Everyone is public and unblocked unless listed in private or blocked,
+// and users with even IDs follow each other with the viewer.
+type fakeUserClient struct {
+	userPb.UserServiceClient
+	blocked      map[int64]bool    // Users blocked in either direction with the viewer
+	blockedPairs map[[2]int64]bool // {viewer, target} pairs blocked for that viewer only
+	private      map[int64]bool    // Private accounts
+	following    []int64           // Accounts the viewer follows
+	keywords     []string          // Every author's comment filter
+	down         bool              // GetRelationships fails, as in a user-service outage
+
+	relationshipCalls int
+}
+
+func (f *fakeUserClient) GetCommentFilterKeywords(ctx context.Context, in *userPb.GetCommentFilterKeywordsRequest, opts ...grpc.CallOption) (*userPb.GetCommentFilterKeywordsResponse, error) {
+	return &userPb.GetCommentFilterKeywordsResponse{Keywords: f.keywords}, nil
+}
+
+func (f *fakeUserClient) GetRelationships(ctx context.Context, in *userPb.GetRelationshipsRequest, opts ...grpc.CallOption) (*userPb.GetRelationshipsResponse, error) {
+	f.relationshipCalls++
+	if f.down {
+		return nil, status.Error(codes.Unavailable, "user-service unavailable")
+	}
+	res := &userPb.GetRelationshipsResponse{Relationships: map[int64]*userPb.Relationship{}}
+	for _, id := range in.TargetIds {
+		res.Relationships[id] = &userPb.Relationship{
+			TargetId:      id,
+			Exists:        true,
+			Blocked:       f.blocked[id] || f.blockedPairs[[2]int64{in.ViewerId, id}],
+			IsPrivate:     f.private[id],
+			ViewerFollows: id%2 == 0,
+			FollowsViewer: id%2 == 0,
+		}
+	}
+	return res, nil
+}
+
+func (f *fakeUserClient) GetUserSummaries(ctx context.Context, in *userPb.GetUserSummariesRequest, opts ...grpc.CallOption) (*userPb.GetUserSummariesResponse, error) {
+	res := &userPb.GetUserSummariesResponse{}
+	for _, id := range in.UserIds {
+		if f.blocked[id] {
+			continue
+		}
+		res.Users = append(res.Users, &userPb.UserSummary{
+			User:               &userPb.UserInfo{UserId: id, Username: "user" + strconv.FormatInt(id, 10)},
+			IsFollowedByViewer: id%2 == 0,
+			IsPrivate:          f.private[id],
+		})
+	}
+	return res, nil
+}
+
+func (f *fakeUserClient) GetFollowingList(ctx context.Context, in *userPb.GetFollowingListRequest, opts ...grpc.CallOption) (*userPb.GetFollowingListResponse, error) {
+	return &userPb.GetFollowingListResponse{FollowingUserIds: append(append([]int64{}, f.following...), in.UserId)}, nil
+}
+
+func (f *fakeUserClient) GetFollowerGrowth(ctx context.Context, in *userPb.GetFollowerGrowthRequest, opts ...grpc.CallOption) (*userPb.GetFollowerGrowthResponse, error) {
+	return &userPb.GetFollowerGrowthResponse{
+		FollowerCount: 10,
+		Days:          []*userPb.DailyCount{{Date: in.Since, Count: 1}, {Date: time.Now().UTC().Format("2006-01-02"), Count: 2}},
+	}, nil
+}
+
+// fakeHashtagClient returns fixed related posts for GetRelatedPosts
+type fakeHashtagClient struct {
+	hashtagPb.HashtagServiceClient
+	related []*hashtagPb.RelatedPost
+}
+
+func (f *fakeHashtagClient) GetRelatedPosts(ctx context.Context, in *hashtagPb.GetRelatedPostsRequest, opts ...grpc.CallOption) (*hashtagPb.GetRelatedPostsResponse, error) {
+	return &hashtagPb.GetRelatedPostsResponse{Posts: f.related}, nil
+}
+
+// fakeMessageClient returns fixed DM counts for GetDirectMessageCounts
+type fakeMessageClient struct {
+	messagePb.MessageServiceClient
+	counts map[int64]int32
+	groups map[string][]int64 // Conversation ID -> other participants
+	sent   []*messagePb.SendMessageRequest
+}
+
+func (f *fakeMessageClient) GetDirectMessageCounts(ctx context.Context, in *messagePb.GetDirectMessageCountsRequest, opts ...grpc.CallOption) (*messagePb.GetDirectMessageCountsResponse, error) {
+	return &messagePb.GetDirectMessageCountsResponse{Counts: f.counts}, nil
+}
+
+// CreateConversation returns "dm<peer>" for a 1:1 conversation
+func (f *fakeMessageClient) CreateConversation(ctx context.Context, in *messagePb.CreateConversationRequest, opts ...grpc.CallOption) (*messagePb.Conversation, error) {
+	return &messagePb.Conversation{Id: fmt.Sprintf("dm%d", in.ParticipantIds[0])}, nil
+}
+
+func (f *fakeMessageClient) GetConversationParticipants(ctx context.Context, in *messagePb.GetConversationParticipantsRequest, opts ...grpc.CallOption) (*messagePb.GetConversationParticipantsResponse, error) {
+	ids, ok := f.groups[in.ConversationId]
+	if !ok {
+		return nil, status.Error(codes.PermissionDenied, "User is not a participant of this conversation")
+	}
+	return &messagePb.GetConversationParticipantsResponse{ParticipantIds: ids, IsGroup: true}, nil
+}
+
+func (f *fakeMessageClient) SendMessage(ctx context.Context, in *messagePb.SendMessageRequest, opts ...grpc.CallOption) (*messagePb.SendMessageResponse, error) {
+	f.sent = append(f.sent, in)
+	return &messagePb.SendMessageResponse{}, nil
+}
+
 func TestPostCreation(t *testing.T) {
 	db, err := setupTestDB()
 	if err != nil {
@@ -337,3 +461,1344 @@ func TestCommentLike(t *testing.T) {
 		t.Errorf("Expected comment_id %d, got %d", comment.ID, found.CommentID)
 	}
 }
+
+func TestCursorRoundTrip(t *testing.T) {
+	original := pageCursor{CreatedAt: time.Now().UTC().Truncate(time.Microsecond), ID: 42, Score: 7}
+
+	decoded, err := decodeCursor(encodeCursor(original))
+	if err != nil {
+		t.Fatalf("Failed to decode cursor: %v", err)
+	}
+	if !decoded.CreatedAt.Equal(original.CreatedAt) || decoded.ID != original.ID || decoded.Score != original.Score {
+		t.Errorf("Expected %+v, got %+v", original, *decoded)
+	}
+
+	if c, err := decodeCursor(""); err != nil || c != nil {
+		t.Errorf("Expected empty cursor to decode to nil, got %+v (err %v)", c, err)
+	}
+	if _, err := decodeCursor("not-a-cursor"); err == nil {
+		t.Error("Expected error for malformed cursor")
+	}
+}
+
+func TestGetCommentsByPostPagination(t *testing.T) {
+	db, err := setupTestDB()
+	if err != nil {
+		t.Fatalf("Failed to setup test database: %v", err)
+	}
+	s := &server{db: db}
+
+	post := Post{AuthorID: 1, Caption: "Test post", AuthorUsername: "testuser"}
+	db.Create(&post)
+
+	// Five top-level comments, each a second apart, with one reply on the first
+	base := time.Now().Add(-time.Hour)
+	var topLevel []Comment
+	for i := 0; i < 5; i++ {
+		comment := Comment{UserID: 2, PostID: int64(post.ID), Content: "comment", LikeCount: int64(i % 3)}
+		comment.CreatedAt = base.Add(time.Duration(i) * time.Second)
+		db.Create(&comment)
+		topLevel = append(topLevel, comment)
+	}
+	db.Create(&Comment{UserID: 3, PostID: int64(post.ID), Content: "reply", ParentCommentID: topLevel[0].ID})
+	db.Create(&CommentLike{UserID: 9, CommentID: int64(topLevel[4].ID)})
+
+	ctx := context.Background()
+	seen := map[string]bool{}
+	cursor := ""
+	for page := 0; ; page++ {
+		res, err := s.GetCommentsByPost(ctx, &pb.GetCommentsByPostRequest{PostId: int64(post.ID), PageSize: 2, Cursor: cursor, ViewerId: 9})
+		if err != nil {
+			t.Fatalf("GetCommentsByPost failed: %v", err)
+		}
+		for _, c := range res.Comments {
+			if c.ParentCommentId != 0 {
+				t.Errorf("Expected only top-level comments, got reply %s", c.Id)
+			}
+			if seen[c.Id] {
+				t.Errorf("Comment %s returned twice", c.Id)
+			}
+			seen[c.Id] = true
+		}
+		if page == 0 {
+			if res.Comments[0].Id != strconv.FormatUint(uint64(topLevel[4].ID), 10) || !res.Comments[0].IsLiked {
+				t.Errorf("Expected newest comment first and liked by viewer, got %+v", res.Comments[0])
+			}
+		}
+		if res.NextCursor == "" {
+			break
+		}
+		cursor = res.NextCursor
+	}
+	if len(seen) != 5 {
+		t.Errorf("Expected 5 top-level comments across pages, got %d", len(seen))
+	}
+
+	top, err := s.GetCommentsByPost(ctx, &pb.GetCommentsByPostRequest{PostId: int64(post.ID), PageSize: 5, Sort: "top"})
+	if err != nil {
+		t.Fatalf("GetCommentsByPost (top) failed: %v", err)
+	}
+	for i := 1; i < len(top.Comments); i++ {
+		if top.Comments[i].LikeCount > top.Comments[i-1].LikeCount {
+			t.Errorf("Expected comments sorted by like count, got %d after %d", top.Comments[i].LikeCount, top.Comments[i-1].LikeCount)
+		}
+	}
+
+	replies, err := s.GetCommentReplies(ctx, &pb.GetCommentRepliesRequest{CommentId: int64(topLevel[0].ID)})
+	if err != nil {
+		t.Fatalf("GetCommentReplies failed: %v", err)
+	}
+	if len(replies.Comments) != 1 || replies.Comments[0].Content != "reply" {
+		t.Errorf("Expected the single reply, got %+v", replies.Comments)
+	}
+	for _, c := range top.Comments {
+		if c.Id == strconv.FormatUint(uint64(topLevel[0].ID), 10) && c.ReplyCount != 1 {
+			t.Errorf("Expected reply_count 1, got %d", c.ReplyCount)
+		}
+	}
+}
+
+func TestMatchesCommentFilter(t *testing.T) {
+	keywords := []string{"spam", "buy followers"}
+
+	tests := []struct {
+		content  string
+		expected bool
+	}{
+		{"Nice photo!", false},
+		{"This is SPAM", true},
+		{"Want to Buy Followers cheap?", true},
+		{"buy some followers", false},
+	}
+
+	for _, tt := range tests {
+		if got := matchesCommentFilter(tt.content, keywords); got != tt.expected {
+			t.Errorf("matchesCommentFilter(%q) = %v, expected %v", tt.content, got, tt.expected)
+		}
+	}
+
+	if matchesCommentFilter("spam", nil) {
+		t.Error("Expected no match with an empty filter")
+	}
+}
+
+func TestCommentModeration(t *testing.T) {
+	db, err := setupTestDB()
+	if err != nil {
+		t.Fatalf("Failed to setup test database: %v", err)
+	}
+	s := &server{db: db}
+	ctx := context.Background()
+
+	post := Post{AuthorID: 1, Caption: "Test post", AuthorUsername: "author"}
+	db.Create(&post)
+
+	var comments []Comment
+	for i := 0; i < 5; i++ {
+		comment := Comment{UserID: 2, PostID: int64(post.ID), Content: "comment"}
+		db.Create(&comment)
+		comments = append(comments, comment)
+	}
+
+	// Only the post author may pin
+	if _, err := s.PinComment(ctx, &pb.PinCommentRequest{UserId: 2, CommentId: int64(comments[0].ID), Pinned: true}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("Expected PermissionDenied for non-author pin, got %v", err)
+	}
+
+	for i := 0; i < maxPinnedComments; i++ {
+		if _, err := s.PinComment(ctx, &pb.PinCommentRequest{UserId: 1, CommentId: int64(comments[i].ID), Pinned: true}); err != nil {
+			t.Fatalf("Failed to pin comment: %v", err)
+		}
+	}
+	if _, err := s.PinComment(ctx, &pb.PinCommentRequest{UserId: 1, CommentId: int64(comments[3].ID), Pinned: true}); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("Expected FailedPrecondition past the pin limit, got %v", err)
+	}
+
+	// Hidden comments are only visible to their commenter
+	if _, err := s.HideComment(ctx, &pb.HideCommentRequest{UserId: 1, CommentId: int64(comments[4].ID), Hidden: true}); err != nil {
+		t.Fatalf("Failed to hide comment: %v", err)
+	}
+
+	res, err := s.GetCommentsByPost(ctx, &pb.GetCommentsByPostRequest{PostId: int64(post.ID), ViewerId: 3})
+	if err != nil {
+		t.Fatalf("GetCommentsByPost failed: %v", err)
+	}
+	if len(res.Comments) != 4 {
+		t.Fatalf("Expected 4 visible comments for another viewer, got %d", len(res.Comments))
+	}
+	for i := 0; i < maxPinnedComments; i++ {
+		if !res.Comments[i].IsPinned {
+			t.Errorf("Expected pinned comments first, got %+v at %d", res.Comments[i], i)
+		}
+	}
+
+	res, err = s.GetCommentsByPost(ctx, &pb.GetCommentsByPostRequest{PostId: int64(post.ID), ViewerId: 2})
+	if err != nil {
+		t.Fatalf("GetCommentsByPost failed: %v", err)
+	}
+	if len(res.Comments) != 5 {
+		t.Errorf("Expected the commenter to still see their hidden comment, got %d comments", len(res.Comments))
+	}
+}
+
+func TestCommentKeywordFilter(t *testing.T) {
+	db, err := setupTestDB()
+	if err != nil {
+		t.Fatalf("Failed to setup test database: %v", err)
+	}
+	users := &fakeUserClient{keywords: []string{"spam", "100%"}}
+	s := &server{db: db, userClient: users}
+	ctx := context.Background()
+
+	post := Post{AuthorID: 1, Caption: "Test post", AuthorUsername: "author"}
+	db.Create(&post)
+	base := time.Now().Add(-time.Hour)
+	for i, content := range []string{"nice", "SPAM here", "great", "100% real", "1000 likes", "spam again"} {
+		comment := Comment{UserID: 2, PostID: int64(post.ID), Content: content}
+		comment.CreatedAt = base.Add(time.Duration(i) * time.Second)
+		db.Create(&comment)
+	}
+
+	// Pages stay full while matches are left out; the % in a keyword is literal
+	pageSizes := []int{}
+	cursor := ""
+	for {
+		res, err := s.GetCommentsByPost(ctx, &pb.GetCommentsByPostRequest{PostId: int64(post.ID), PageSize: 2, Cursor: cursor, ViewerId: 3})
+		if err != nil {
+			t.Fatalf("GetCommentsByPost failed: %v", err)
+		}
+		pageSizes = append(pageSizes, len(res.Comments))
+		if cursor = res.NextCursor; cursor == "" {
+			break
+		}
+	}
+	if len(pageSizes) != 2 || pageSizes[0] != 2 || pageSizes[1] != 1 {
+		t.Errorf("Expected pages of 2 and 1 filtered comments, got %v", pageSizes)
+	}
+
+	// The commenter still sees theirs, flagged as hidden
+	res, err := s.GetCommentsByPost(ctx, &pb.GetCommentsByPostRequest{PostId: int64(post.ID), PageSize: 10, ViewerId: 2})
+	if err != nil {
+		t.Fatalf("GetCommentsByPost failed: %v", err)
+	}
+	hidden := 0
+	for _, c := range res.Comments {
+		if c.IsHidden {
+			hidden++
+		}
+	}
+	if len(res.Comments) != 6 || hidden != 3 {
+		t.Errorf("Expected 6 comments with 3 flagged for the commenter, got %d with %d flagged", len(res.Comments), hidden)
+	}
+
+	// Nothing is stored, so removing the keywords brings the comments back
+	var stored int64
+	db.Model(&Comment{}).Where("is_hidden = ?", true).Count(&stored)
+	if stored != 0 {
+		t.Errorf("Expected keyword matches not to be stored as hidden, got %d", stored)
+	}
+	users.keywords = nil
+	res, err = s.GetCommentsByPost(ctx, &pb.GetCommentsByPostRequest{PostId: int64(post.ID), PageSize: 10, ViewerId: 3})
+	if err != nil {
+		t.Fatalf("GetCommentsByPost failed: %v", err)
+	}
+	if len(res.Comments) != 6 {
+		t.Errorf("Expected every comment once the filter is cleared, got %d", len(res.Comments))
+	}
+}
+
+func TestCanCommentAudience(t *testing.T) {
+	s := &server{}
+	ctx := context.Background()
+
+	tests := []struct {
+		name     string
+		post     Post
+		userID   int64
+		expected bool
+	}{
+		{"everyone", Post{AuthorID: 1, CommentAudience: commentAudienceEveryone}, 2, true},
+		{"off", Post{AuthorID: 1, CommentAudience: commentAudienceOff, CommentsDisabled: true}, 2, false},
+		{"legacy disabled flag", Post{AuthorID: 1, CommentAudience: commentAudienceEveryone, CommentsDisabled: true}, 2, false},
+		{"author on own post", Post{AuthorID: 1, CommentAudience: commentAudienceOff, CommentsDisabled: true}, 1, true},
+		{"author with followers only", Post{AuthorID: 1, CommentAudience: commentAudienceFollowers}, 1, true},
+	}
+
+	for _, tt := range tests {
+		got, err := s.canComment(ctx, s.newRelationshipCache(tt.userID), &tt.post)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", tt.name, err)
+		}
+		if got != tt.expected {
+			t.Errorf("%s: canComment = %v, expected %v", tt.name, got, tt.expected)
+		}
+	}
+
+	if isValidCommentAudience("friends") {
+		t.Error("Expected unknown audience to be rejected")
+	}
+}
+
+func TestGetPostLikers(t *testing.T) {
+	db, err := setupTestDB()
+	if err != nil {
+		t.Fatalf("Failed to setup test database: %v", err)
+	}
+	s := &server{db: db, userClient: &fakeUserClient{blocked: map[int64]bool{3: true}}}
+	ctx := context.Background()
+
+	post := Post{AuthorID: 1, Caption: "Likes", HideLikeCount: true}
+	db.Create(&post)
+
+	base := time.Now().Add(-time.Hour)
+	for i := int64(2); i <= 6; i++ {
+		db.Create(&PostLike{UserID: i, PostID: int64(post.ID), CreatedAt: base.Add(time.Duration(i) * time.Minute)})
+	}
+
+	res, err := s.GetPostLikers(ctx, &pb.GetPostLikersRequest{PostId: int64(post.ID), ViewerId: 7, PageSize: 3})
+	if err != nil {
+		t.Fatalf("GetPostLikers failed: %v", err)
+	}
+	// Newest first: 6, 5, 4 on the first page
+	if len(res.Likers) != 3 || res.Likers[0].UserId != 6 || res.Likers[2].UserId != 4 {
+		t.Fatalf("Unexpected first page: %+v", res.Likers)
+	}
+	if !res.Likers[0].IsFollowing || res.Likers[1].IsFollowing {
+		t.Errorf("Expected follow state from user-service, got %+v", res.Likers)
+	}
+	if !res.LikeCountHidden || res.LikeCount != 0 {
+		t.Errorf("Expected hidden like count for a non-author, got %d", res.LikeCount)
+	}
+	if res.NextCursor == "" {
+		t.Fatal("Expected a next cursor")
+	}
+
+	// Second page: user 3 is blocked, so only 2 remains
+	res, err = s.GetPostLikers(ctx, &pb.GetPostLikersRequest{PostId: int64(post.ID), ViewerId: 7, PageSize: 3, Cursor: res.NextCursor})
+	if err != nil {
+		t.Fatalf("GetPostLikers failed: %v", err)
+	}
+	if len(res.Likers) != 1 || res.Likers[0].UserId != 2 || res.NextCursor != "" {
+		t.Errorf("Unexpected second page: %+v (cursor %q)", res.Likers, res.NextCursor)
+	}
+
+	// The author still sees the count
+	res, err = s.GetPostLikers(ctx, &pb.GetPostLikersRequest{PostId: int64(post.ID), ViewerId: 1})
+	if err != nil {
+		t.Fatalf("GetPostLikers failed: %v", err)
+	}
+	if res.LikeCountHidden || res.LikeCount != 5 {
+		t.Errorf("Expected the author to see 5 likes, got %d (hidden=%v)", res.LikeCount, res.LikeCountHidden)
+	}
+}
+
+func TestArchivedPosts(t *testing.T) {
+	db, err := setupTestDB()
+	if err != nil {
+		t.Fatalf("Failed to setup test database: %v", err)
+	}
+	s := &server{db: db}
+	ctx := context.Background()
+
+	var posts []Post
+	for i := 0; i < 3; i++ {
+		post := Post{AuthorID: 1, Caption: "Post " + strconv.Itoa(i)}
+		db.Create(&post)
+		posts = append(posts, post)
+	}
+	db.Create(&PostLike{UserID: 2, PostID: int64(posts[0].ID)})
+	db.Model(&posts[0]).Update("like_count", 1)
+
+	// Archive two posts, the older one first
+	base := time.Now().Add(-time.Hour)
+	db.Model(&posts[0]).Update("archived_at", base)
+	db.Model(&posts[1]).Update("archived_at", base.Add(time.Minute))
+
+	if _, err := s.ArchivePost(ctx, &pb.ArchivePostRequest{UserId: 2, PostId: int64(posts[2].ID)}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("Expected PermissionDenied archiving someone else's post, got %v", err)
+	}
+
+	count, err := s.GetUserContentCount(ctx, &pb.GetUserContentCountRequest{UserId: 1})
+	if err != nil {
+		t.Fatalf("GetUserContentCount failed: %v", err)
+	}
+	if count.PostCount != 1 {
+		t.Errorf("Expected archived posts to be left out of the count, got %d", count.PostCount)
+	}
+
+	res, err := s.GetArchivedPosts(ctx, &pb.GetArchivedPostsRequest{UserId: 1, PageSize: 1})
+	if err != nil {
+		t.Fatalf("GetArchivedPosts failed: %v", err)
+	}
+	if len(res.Posts) != 1 || res.Posts[0].Id != strconv.Itoa(int(posts[1].ID)) || !res.Posts[0].IsArchived {
+		t.Fatalf("Expected the most recently archived post first, got %+v", res.Posts)
+	}
+
+	res, err = s.GetArchivedPosts(ctx, &pb.GetArchivedPostsRequest{UserId: 1, PageSize: 1, Cursor: res.NextCursor})
+	if err != nil {
+		t.Fatalf("GetArchivedPosts failed: %v", err)
+	}
+	if len(res.Posts) != 1 || res.Posts[0].Id != strconv.Itoa(int(posts[0].ID)) || res.NextCursor != "" {
+		t.Fatalf("Unexpected second page: %+v", res.Posts)
+	}
+	// Engagement survives archiving
+	if res.Posts[0].LikeCount != 1 {
+		t.Errorf("Expected the archived post to keep its like, got %d", res.Posts[0].LikeCount)
+	}
+
+	if _, err := s.GetPost(ctx, &pb.GetPostRequest{PostId: int64(posts[0].ID), ViewerId: 2}); status.Code(err) != codes.NotFound {
+		t.Errorf("Expected archived post to be hidden from other viewers, got %v", err)
+	}
+}
+
+func TestRecentlyDeleted(t *testing.T) {
+	db, err := setupTestDB()
+	if err != nil {
+		t.Fatalf("Failed to setup test database: %v", err)
+	}
+	s := &server{db: db}
+	ctx := context.Background()
+
+	recent := Post{AuthorID: 1, Caption: "Recent"}
+	expired := Post{AuthorID: 1, Caption: "Expired"}
+	db.Create(&recent)
+	db.Create(&expired)
+	db.Create(&Comment{UserID: 2, PostID: int64(recent.ID), Content: "Still here"})
+	db.Model(&recent).Update("comment_count", 1)
+
+	// Deleting only sets deleted_at
+	db.Delete(&recent)
+	db.Unscoped().Model(&expired).Update("deleted_at", time.Now().Add(-trashRetention-time.Hour))
+
+	var visible int64
+	db.Model(&Post{}).Count(&visible)
+	if visible != 0 {
+		t.Errorf("Expected deleted posts to be hidden from default queries, got %d", visible)
+	}
+
+	res, err := s.GetRecentlyDeleted(ctx, &pb.GetRecentlyDeletedRequest{UserId: 1})
+	if err != nil {
+		t.Fatalf("GetRecentlyDeleted failed: %v", err)
+	}
+	if len(res.Posts) != 1 || res.Posts[0].Post.Id != strconv.Itoa(int(recent.ID)) {
+		t.Fatalf("Expected only the post inside the window, got %+v", res.Posts)
+	}
+	if res.Posts[0].Post.CommentCount != 1 {
+		t.Errorf("Expected comments to survive deletion, got %d", res.Posts[0].Post.CommentCount)
+	}
+
+	if _, err := s.RestorePost(ctx, &pb.RestorePostRequest{UserId: 2, PostId: int64(recent.ID)}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("Expected PermissionDenied restoring someone else's post, got %v", err)
+	}
+	if _, err := s.RestorePost(ctx, &pb.RestorePostRequest{UserId: 1, PostId: int64(expired.ID)}); status.Code(err) != codes.NotFound {
+		t.Errorf("Expected NotFound restoring past the window, got %v", err)
+	}
+}
+
+func TestMergeTimelinePage(t *testing.T) {
+	base := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	post := func(id uint, minutesAgo int) Post {
+		p := Post{}
+		p.ID = id
+		p.CreatedAt = base.Add(-time.Duration(minutesAgo) * time.Minute)
+		return p
+	}
+	ids := func(posts []Post) []uint {
+		var out []uint
+		for _, p := range posts {
+			out = append(out, p.ID)
+		}
+		return out
+	}
+
+	// Pushed posts and a pulled celebrity post interleave by time; duplicates collapse
+	timeline := []Post{post(5, 1), post(3, 3), post(2, 5)}
+	pulled := []Post{post(4, 2), post(5, 1), post(1, 10)}
+	page, next := mergeTimelinePage(timeline, pulled, nil, 3, nil)
+	if got := ids(page); len(got) != 3 || got[0] != 5 || got[1] != 4 || got[2] != 3 {
+		t.Fatalf("Expected [5 4 3], got %v", got)
+	}
+	if next == nil || next.ID != 3 {
+		t.Fatalf("Expected next cursor at post 3, got %+v", next)
+	}
+
+	// The cursor is strict, so the next page starts after post 3
+	page, next = mergeTimelinePage(timeline, pulled, next, 3, nil)
+	if got := ids(page); len(got) != 2 || got[0] != 2 || got[1] != 1 {
+		t.Fatalf("Expected [2 1], got %v", got)
+	}
+	if next != nil {
+		t.Errorf("Expected no next cursor on the last page, got %+v", next)
+	}
+
+	// With more timeline entries unread, pulled posts older than the floor wait for a later page
+	floor := post(2, 5).CreatedAt
+	page, next = mergeTimelinePage(timeline, pulled, nil, 10, &floor)
+	if got := ids(page); len(got) != 4 || got[3] != 2 {
+		t.Fatalf("Expected post 1 held back below the floor, got %v", got)
+	}
+	if next == nil || next.ID != 2 {
+		t.Errorf("Expected next cursor at post 2, got %+v", next)
+	}
+}
+
+func TestDefaultRankerScore(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	ranker := newDefaultRanker()
+	post := func(authorID int64, hoursAgo float64, likes, comments int64, media ...string) *Post {
+		p := &Post{AuthorID: authorID, LikeCount: likes, CommentCount: comments, MediaURLs: media}
+		p.CreatedAt = now.Add(-time.Duration(hoursAgo * float64(time.Hour)))
+		return p
+	}
+	signals := &rankSignals{Now: now, Affinity: map[int64]authorAffinity{
+		2: {Likes: 10, Comments: 3, Messages: 5},
+	}}
+
+	tests := []struct {
+		name          string
+		higher, lower *Post
+	}{
+		{"newer beats older", post(1, 1, 0, 0, "a.jpg"), post(1, 24, 0, 0, "a.jpg")},
+		{"close author beats stranger", post(2, 6, 0, 0, "a.jpg"), post(1, 5, 0, 0, "a.jpg")},
+		{"fast engagement beats none", post(1, 2, 50, 10, "a.jpg"), post(1, 1, 0, 0, "a.jpg")},
+		{"video beats image", post(1, 3, 0, 0, "a.mp4"), post(1, 3, 0, 0, "a.jpg")},
+		{"carousel beats text", post(1, 3, 0, 0, "a.jpg", "b.jpg"), post(1, 3, 0, 0)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hi, lo := ranker.Score(tt.higher, signals), ranker.Score(tt.lower, signals)
+			if hi <= lo {
+				t.Errorf("Expected %.6f > %.6f", hi, lo)
+			}
+		})
+	}
+
+	// A post from the future (clock skew) scores as if brand new
+	if got, want := ranker.Score(post(1, -2, 0, 0, "a.jpg"), signals), ranker.Score(post(1, 0, 0, 0, "a.jpg"), signals); got != want {
+		t.Errorf("Expected future post to score %.6f, got %.6f", want, got)
+	}
+}
+
+func TestRankPage(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	signals := &rankSignals{Now: now, Affinity: map[int64]authorAffinity{3: {Messages: 20}}}
+	var candidates []Post
+	for i := 1; i <= 5; i++ {
+		p := Post{AuthorID: int64(i), MediaURLs: []string{"a.jpg"}}
+		p.ID = uint(i)
+		p.CreatedAt = now.Add(-time.Duration(i) * time.Hour)
+		candidates = append(candidates, p)
+	}
+
+	// Author 3's DMs lift post 3 above the newer posts 1 and 2
+	var got []uint
+	var cursor *pageCursor
+	for {
+		page, next := rankPage(newDefaultRanker(), candidates, signals, cursor, 2)
+		for _, p := range page {
+			got = append(got, p.ID)
+		}
+		if next == nil {
+			break
+		}
+		if !next.CreatedAt.Equal(now) {
+			t.Fatalf("Expected cursor to carry the scoring clock, got %v", next.CreatedAt)
+		}
+		cursor = next
+	}
+	want := []uint{3, 1, 2, 4, 5}
+	if len(got) != len(want) {
+		t.Fatalf("Expected %v, got %v", want, got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("Expected %v, got %v", want, got)
+		}
+	}
+}
+
+func TestLoadAuthorAffinity(t *testing.T) {
+	db, err := setupTestDB()
+	if err != nil {
+		t.Fatalf("Failed to setup test database: %v", err)
+	}
+	s := &server{db: db, messageClient: &fakeMessageClient{counts: map[int64]int32{3: 4}}}
+
+	for _, authorID := range []int64{2, 2, 3} {
+		db.Create(&Post{AuthorID: authorID, Caption: "post"})
+	}
+	var posts []Post
+	db.Order("id").Find(&posts)
+	db.Create(&PostLike{UserID: 1, PostID: int64(posts[0].ID), CreatedAt: time.Now()})
+	db.Create(&PostLike{UserID: 1, PostID: int64(posts[1].ID), CreatedAt: time.Now()})
+	db.Create(&PostLike{UserID: 1, PostID: int64(posts[2].ID), CreatedAt: time.Now().AddDate(0, 0, -affinityWindowDays-1)}) // Too old
+	db.Create(&Comment{UserID: 1, PostID: int64(posts[2].ID), Content: "nice"})
+
+	affinity := s.loadAuthorAffinity(context.Background(), 1, []int64{2, 3})
+	if a := affinity[2]; a.Likes != 2 || a.Comments != 0 || a.Messages != 0 {
+		t.Errorf("Expected 2 likes for author 2, got %+v", a)
+	}
+	if a := affinity[3]; a.Likes != 0 || a.Comments != 1 || a.Messages != 4 {
+		t.Errorf("Expected 1 comment and 4 DMs for author 3, got %+v", a)
+	}
+}
+
+func TestExploreCandidates(t *testing.T) {
+	db, err := setupTestDB()
+	if err != nil {
+		t.Fatalf("Failed to setup test database: %v", err)
+	}
+	ctx := context.Background()
+
+	create := func(authorID int64, isReel bool) uint {
+		post := Post{AuthorID: authorID, Caption: "post", IsReel: isReel}
+		db.Create(&post)
+		return post.ID
+	}
+	likedByFollowee := create(3, false) // Public author, found through a followee's like
+	privateStranger := create(5, false) // Private and not followed
+	privateFollowed := create(4, false) // Private but followed (even IDs are followed in the fake)
+	blockedAuthor := create(7, false)
+	own := create(1, false)
+	alreadyLiked := create(3, false)
+	related := create(9, false) // Shares hashtags with alreadyLiked
+	reel := create(3, true)
+
+	db.Create(&PostLike{UserID: 2, PostID: int64(likedByFollowee), CreatedAt: time.Now()})
+	db.Create(&PostLike{UserID: 1, PostID: int64(alreadyLiked), CreatedAt: time.Now()})
+
+	s := &server{
+		db: db,
+		userClient: &fakeUserClient{
+			following: []int64{2},
+			private:   map[int64]bool{4: true, 5: true},
+			blocked:   map[int64]bool{7: true},
+		},
+		hashtagClient: &fakeHashtagClient{related: []*hashtagPb.RelatedPost{{PostId: int64(related), SharedHashtags: 2}}},
+	}
+
+	posts, signals, err := s.exploreCandidates(ctx, 1)
+	if err != nil {
+		t.Fatalf("exploreCandidates failed: %v", err)
+	}
+	got := make(map[uint]bool)
+	for _, post := range posts {
+		got[post.ID] = true
+	}
+	for _, id := range []uint{likedByFollowee, privateFollowed, related} {
+		if !got[id] {
+			t.Errorf("Expected post %d in explore candidates", id)
+		}
+	}
+	for _, id := range []uint{privateStranger, blockedAuthor, own, alreadyLiked, reel} {
+		if got[id] {
+			t.Errorf("Expected post %d to be excluded from explore candidates", id)
+		}
+	}
+	if signals.FolloweeLikes[likedByFollowee] != 1 || signals.HashtagMatches[related] != 2 {
+		t.Errorf("Expected followee like and hashtag signals, got %v and %v", signals.FolloweeLikes, signals.HashtagMatches)
+	}
+
+	// Hashtag and followee signals outrank raw recency
+	now := time.Now()
+	ranked, _ := rankPage(exploreRanker{}, posts, &rankSignals{Now: now, HashtagMatches: signals.HashtagMatches, FolloweeLikes: signals.FolloweeLikes}, nil, 10)
+	if len(ranked) != 3 || ranked[0].ID != related || ranked[1].ID != likedByFollowee {
+		t.Errorf("Expected related post then followee-liked post first, got %+v", ranked)
+	}
+
+	// Seen posts are skipped
+	unseen := filterSeenPosts(posts, []string{strconv.FormatUint(uint64(related), 10)})
+	if len(unseen) != 2 {
+		t.Errorf("Expected 2 unseen posts, got %d", len(unseen))
+	}
+}
+
+func TestFilterPostsByPrivacy(t *testing.T) {
+	users := &fakeUserClient{
+		private: map[int64]bool{3: true, 4: true},
+		blocked: map[int64]bool{5: true},
+	}
+	s := &server{userClient: users}
+
+	var posts []Post
+	for _, authorID := range []int64{1, 2, 3, 4, 5, 2, 3, 6} {
+		post := Post{AuthorID: authorID}
+		post.ID = uint(len(posts) + 1)
+		posts = append(posts, post)
+	}
+
+	visible, err := s.filterPostsByPrivacy(context.Background(), posts, 1)
+	if err != nil {
+		t.Fatalf("filterPostsByPrivacy failed: %v", err)
+	}
+	var authors []int64
+	for _, post := range visible {
+		authors = append(authors, post.AuthorID)
+	}
+	// Own post, public 2 and 6, private-but-followed 4; private 3 and blocked 5 are dropped
+	want := []int64{1, 2, 4, 2, 6}
+	if len(authors) != len(want) {
+		t.Fatalf("Expected authors %v, got %v", want, authors)
+	}
+	for i := range want {
+		if authors[i] != want[i] {
+			t.Fatalf("Expected authors %v, got %v", want, authors)
+		}
+	}
+	if users.relationshipCalls != 1 {
+		t.Errorf("Expected one batched relationship lookup, got %d", users.relationshipCalls)
+	}
+
+	// An outage is an error, not an empty page
+	db, err := setupTestDB()
+	if err != nil {
+		t.Fatalf("Failed to setup test database: %v", err)
+	}
+	s.db = db
+	db.Create(&Post{AuthorID: 2})
+	users.down = true
+	if _, err := s.GetUserPosts(context.Background(), &pb.GetUserContentRequest{UserId: 2, RequesterId: 1}); status.Code(err) != codes.Internal {
+		t.Errorf("Expected Internal while relationships can't be loaded, got %v", err)
+	}
+}
+
+func TestEnrichPosts(t *testing.T) {
+	db, err := setupTestDB()
+	if err != nil {
+		t.Fatalf("Failed to setup test database: %v", err)
+	}
+	users := &fakeUserClient{}
+	s := &server{db: db, userClient: users}
+
+	posts := []Post{
+		{AuthorID: 2, LikeCount: 5, CommentCount: 2, CommentAudience: commentAudienceFollowers},
+		{AuthorID: 3, LikeCount: 4, HideLikeCount: true, CommentAudience: commentAudienceFollowing},
+		{AuthorID: 4, LikeCount: 1, CommentAudience: commentAudienceFollowers},
+	}
+	for i := range posts {
+		db.Create(&posts[i])
+	}
+
+	collection := Collection{UserID: 1, Name: "Saved"}
+	db.Create(&collection)
+	db.Create(&SavedPost{CollectionID: collection.ID, PostID: posts[0].ID})
+	db.Create(&PostLike{UserID: 1, PostID: int64(posts[0].ID)})
+	db.Create(&PostLike{UserID: 2, PostID: int64(posts[2].ID)}) // Someone else's like
+
+	res := s.enrichPosts(context.Background(), posts, 1)
+	if len(res) != 3 {
+		t.Fatalf("Expected 3 posts, got %d", len(res))
+	}
+	if !res[0].IsLiked || !res[0].IsSaved || res[0].LikeCount != 5 || res[0].CommentCount != 2 {
+		t.Errorf("Unexpected first post: %+v", res[0])
+	}
+	if res[1].IsLiked || res[1].IsSaved || res[1].LikeCount != 0 {
+		t.Errorf("Expected a hidden like count and no viewer state, got %+v", res[1])
+	}
+	if res[2].IsLiked {
+		t.Error("Expected another user's like not to count as the viewer's")
+	}
+
+	// The viewer follows 2 and 4; 3 doesn't follow the viewer back
+	if !res[0].CanComment || res[1].CanComment || !res[2].CanComment {
+		t.Errorf("Unexpected comment permissions: %v %v %v", res[0].CanComment, res[1].CanComment, res[2].CanComment)
+	}
+	if users.relationshipCalls != 1 {
+		t.Errorf("Expected one batched relationship lookup, got %d", users.relationshipCalls)
+	}
+}
+
+func TestGetUserPostsCursor(t *testing.T) {
+	db, err := setupTestDB()
+	if err != nil {
+		t.Fatalf("Failed to setup test database: %v", err)
+	}
+	s := &server{db: db}
+	ctx := context.Background()
+
+	base := time.Now().Add(-time.Hour)
+	for i := 0; i < 5; i++ {
+		post := Post{AuthorID: 1, Caption: "Post " + strconv.Itoa(i)}
+		post.CreatedAt = base.Add(time.Duration(i) * time.Minute)
+		db.Create(&post)
+	}
+
+	req := &pb.GetUserContentRequest{UserId: 1, RequesterId: 1, PageSize: 2}
+	res, err := s.GetUserPosts(ctx, req)
+	if err != nil {
+		t.Fatalf("GetUserPosts failed: %v", err)
+	}
+	if len(res.Posts) != 2 || res.Posts[0].Caption != "Post 4" || res.NextCursor == "" {
+		t.Fatalf("Unexpected first page: %+v (cursor %q)", res.Posts, res.NextCursor)
+	}
+
+	// A new post arriving between pages must not shift later pages
+	db.Create(&Post{AuthorID: 1, Caption: "Newest"})
+
+	seen := map[string]bool{"Post 4": true, "Post 3": true}
+	for res.NextCursor != "" {
+		req.Cursor = res.NextCursor
+		if res, err = s.GetUserPosts(ctx, req); err != nil {
+			t.Fatalf("GetUserPosts failed: %v", err)
+		}
+		for _, post := range res.Posts {
+			if seen[post.Caption] {
+				t.Errorf("Post %q returned twice", post.Caption)
+			}
+			seen[post.Caption] = true
+		}
+	}
+	if len(seen) != 5 || seen["Newest"] {
+		t.Errorf("Expected exactly the 5 original posts across pages, got %v", seen)
+	}
+
+	if _, err := s.GetUserPosts(ctx, &pb.GetUserContentRequest{UserId: 1, Cursor: "not a cursor"}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected InvalidArgument for a bad cursor, got %v", err)
+	}
+}
+
+func TestRecordReelWatch(t *testing.T) {
+	db, err := setupTestDB()
+	if err != nil {
+		t.Fatalf("Failed to setup test database: %v", err)
+	}
+	s := &server{db: db, userClient: &fakeUserClient{}}
+	ctx := context.Background()
+
+	reel := Post{AuthorID: 2, IsReel: true}
+	photo := Post{AuthorID: 2}
+	db.Create(&reel)
+	db.Create(&photo)
+
+	watches := []*pb.RecordReelWatchRequest{
+		{UserId: 1, PostId: int64(reel.ID), WatchMs: 1200, Skipped: true},
+		{UserId: 1, PostId: int64(reel.ID), WatchMs: 16000, DurationMs: 8000, Replays: 1}, // Completed by length
+		{UserId: 3, PostId: int64(reel.ID), WatchMs: 8000, Completed: true},
+	}
+	for _, w := range watches {
+		if _, err := s.RecordReelWatch(ctx, w); err != nil {
+			t.Fatalf("RecordReelWatch failed: %v", err)
+		}
+	}
+
+	var stat ReelStat
+	db.First(&stat, "post_id = ?", reel.ID)
+	if stat.Viewers != 2 || stat.Plays != 3 || stat.Completions != 2 || stat.Skips != 1 || stat.Replays != 1 || stat.WatchMs != 25200 {
+		t.Errorf("Unexpected reel stats: %+v", stat)
+	}
+
+	var watch ReelWatch
+	db.First(&watch, "user_id = ? AND post_id = ?", 1, reel.ID)
+	if watch.Plays != 2 || !watch.Completed || watch.Skipped {
+		t.Errorf("Unexpected watch record: %+v", watch)
+	}
+
+	if _, err := s.RecordReelWatch(ctx, &pb.RecordReelWatchRequest{UserId: 1, PostId: int64(photo.ID)}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected InvalidArgument for a non-reel, got %v", err)
+	}
+	if _, err := s.RecordReelWatch(ctx, &pb.RecordReelWatchRequest{UserId: 1, PostId: int64(reel.ID), WatchMs: -1}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected InvalidArgument for negative watch time, got %v", err)
+	}
+}
+
+func TestReelsFeedSkipsWatched(t *testing.T) {
+	db, err := setupTestDB()
+	if err != nil {
+		t.Fatalf("Failed to setup test database: %v", err)
+	}
+	s := &server{db: db, userClient: &fakeUserClient{}}
+	ctx := context.Background()
+
+	var reels []Post
+	for i := 0; i < 3; i++ {
+		reel := Post{AuthorID: 2, IsReel: true}
+		db.Create(&reel)
+		reels = append(reels, reel)
+	}
+	db.Create(&Post{AuthorID: 1, IsReel: true}) // The viewer's own reel is never served
+
+	// Reel 0 keeps people watching, reel 2 gets skipped
+	db.Create(&ReelStat{PostID: int64(reels[0].ID), Plays: 50, Completions: 45})
+	db.Create(&ReelStat{PostID: int64(reels[2].ID), Plays: 50, Completions: 2, Skips: 40})
+
+	res, err := s.GetReelsFeed(ctx, &pb.GetHomeFeedRequest{UserId: 1})
+	if err != nil {
+		t.Fatalf("GetReelsFeed failed: %v", err)
+	}
+	want := []uint{reels[0].ID, reels[1].ID, reels[2].ID}
+	if len(res.Posts) != len(want) {
+		t.Fatalf("Expected %d reels, got %d", len(want), len(res.Posts))
+	}
+	for i, id := range want {
+		if res.Posts[i].Id != strconv.Itoa(int(id)) {
+			t.Fatalf("Expected reels ranked by completion, got %v at %d", res.Posts[i].Id, i)
+		}
+	}
+
+	// Once watched, a reel drops out of the feed
+	if _, err := s.RecordReelWatch(ctx, &pb.RecordReelWatchRequest{UserId: 1, PostId: int64(reels[0].ID), WatchMs: 5000}); err != nil {
+		t.Fatalf("RecordReelWatch failed: %v", err)
+	}
+	res, err = s.GetReelsFeed(ctx, &pb.GetHomeFeedRequest{UserId: 1})
+	if err != nil {
+		t.Fatalf("GetReelsFeed failed: %v", err)
+	}
+	if len(res.Posts) != 2 || res.Posts[0].Id != strconv.Itoa(int(reels[1].ID)) {
+		t.Errorf("Expected the watched reel to be left out, got %+v", res.Posts)
+	}
+
+	// With everything watched the feed falls back to rewatching, on every page
+	for _, reel := range reels[1:] {
+		if _, err := s.RecordReelWatch(ctx, &pb.RecordReelWatchRequest{UserId: 1, PostId: int64(reel.ID), WatchMs: 5000}); err != nil {
+			t.Fatalf("RecordReelWatch failed: %v", err)
+		}
+	}
+	var rewatched []string
+	cursor := ""
+	for page := 0; page < len(reels)+1; page++ {
+		res, err = s.GetReelsFeed(ctx, &pb.GetHomeFeedRequest{UserId: 1, PageSize: 1, Cursor: cursor})
+		if err != nil {
+			t.Fatalf("GetReelsFeed failed: %v", err)
+		}
+		for _, post := range res.Posts {
+			rewatched = append(rewatched, post.Id)
+		}
+		if cursor = res.NextCursor; cursor == "" {
+			break
+		}
+	}
+	if len(rewatched) != len(reels) {
+		t.Errorf("Expected to page through all %d watched reels, got %v", len(reels), rewatched)
+	}
+}
+
+func TestNotInterested(t *testing.T) {
+	db, err := setupTestDB()
+	if err != nil {
+		t.Fatalf("Failed to setup test database: %v", err)
+	}
+	users := &fakeUserClient{}
+	s := &server{db: db, userClient: users}
+	ctx := context.Background()
+
+	skipped := Post{AuthorID: 2, AuthorUsername: "two", IsReel: true}
+	tagged := Post{AuthorID: 2, AuthorUsername: "two", IsReel: true, Caption: "look #Cats"}
+	other := Post{AuthorID: 3, AuthorUsername: "three", IsReel: true}
+	kept := Post{AuthorID: 2, AuthorUsername: "two", IsReel: true}
+	for _, p := range []*Post{&skipped, &tagged, &other, &kept} {
+		db.Create(p)
+	}
+	feedIDs := func() []string {
+		res, err := s.GetReelsFeed(ctx, &pb.GetHomeFeedRequest{UserId: 1})
+		if err != nil {
+			t.Fatalf("GetReelsFeed failed: %v", err)
+		}
+		ids := make([]string, len(res.Posts))
+		for i, p := range res.Posts {
+			ids[i] = p.Id
+		}
+		return ids
+	}
+
+	if _, err := s.MarkNotInterested(ctx, &pb.MarkNotInterestedRequest{UserId: 1, Kind: "post", PostId: int64(skipped.ID)}); err != nil {
+		t.Fatalf("MarkNotInterested post failed: %v", err)
+	}
+	if _, err := s.MarkNotInterested(ctx, &pb.MarkNotInterestedRequest{UserId: 1, Kind: "hashtag", Hashtag: "#cats"}); err != nil {
+		t.Fatalf("MarkNotInterested hashtag failed: %v", err)
+	}
+	// An author can be muted through one of their posts
+	author, err := s.MarkNotInterested(ctx, &pb.MarkNotInterestedRequest{UserId: 1, Kind: "author", PostId: int64(other.ID)})
+	if err != nil {
+		t.Fatalf("MarkNotInterested author failed: %v", err)
+	}
+	if author.AuthorId != 3 || author.AuthorUsername != "three" {
+		t.Errorf("Expected author 3 to be resolved from the post, got %+v", author)
+	}
+	again, err := s.MarkNotInterested(ctx, &pb.MarkNotInterestedRequest{UserId: 1, Kind: "author", PostId: int64(other.ID)})
+	if err != nil || again.Id != author.Id {
+		t.Errorf("Expected marking twice to return the same signal, got %+v, %v", again, err)
+	}
+
+	if _, err := s.MarkNotInterested(ctx, &pb.MarkNotInterestedRequest{UserId: 2, Kind: "post", PostId: int64(kept.ID)}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected InvalidArgument for own post, got %v", err)
+	}
+	if _, err := s.MarkNotInterested(ctx, &pb.MarkNotInterestedRequest{UserId: 1, Kind: "mood"}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected InvalidArgument for unknown kind, got %v", err)
+	}
+
+	// Posts the viewer can't see are reported missing, whatever the kind
+	hidden := Post{AuthorID: 5, AuthorUsername: "five", IsReel: true}
+	db.Create(&hidden)
+	users.private = map[int64]bool{5: true}
+	for _, kind := range []string{"post", "author"} {
+		res, err := s.MarkNotInterested(ctx, &pb.MarkNotInterestedRequest{UserId: 1, Kind: kind, PostId: int64(hidden.ID)})
+		if status.Code(err) != codes.NotFound || res != nil {
+			t.Errorf("Expected NotFound marking a private post by %s, got %+v, %v", kind, res, err)
+		}
+	}
+
+	if ids := feedIDs(); len(ids) != 1 || ids[0] != strconv.Itoa(int(kept.ID)) {
+		t.Errorf("Expected only the unmarked reel, got %v", ids)
+	}
+
+	list, err := s.GetNotInterested(ctx, &pb.GetNotInterestedRequest{UserId: 1, PageSize: 2})
+	if err != nil {
+		t.Fatalf("GetNotInterested failed: %v", err)
+	}
+	if len(list.Signals) != 2 || list.NextCursor == "" {
+		t.Fatalf("Expected a first page of 2 signals, got %d (cursor %q)", len(list.Signals), list.NextCursor)
+	}
+	rest, err := s.GetNotInterested(ctx, &pb.GetNotInterestedRequest{UserId: 1, PageSize: 2, Cursor: list.NextCursor})
+	if err != nil || len(rest.Signals) != 1 || rest.NextCursor != "" {
+		t.Fatalf("Expected a last page of 1 signal, got %+v, %v", rest, err)
+	}
+
+	signalID, _ := strconv.ParseInt(author.Id, 10, 64)
+	if _, err := s.UndoNotInterested(ctx, &pb.UndoNotInterestedRequest{UserId: 2, SignalId: signalID}); status.Code(err) != codes.NotFound {
+		t.Errorf("Expected NotFound when undoing someone else's signal, got %v", err)
+	}
+	if _, err := s.UndoNotInterested(ctx, &pb.UndoNotInterestedRequest{UserId: 1, SignalId: signalID}); err != nil {
+		t.Fatalf("UndoNotInterested failed: %v", err)
+	}
+	if ids := feedIDs(); len(ids) != 2 {
+		t.Errorf("Expected the unmuted author's reel back, got %v", ids)
+	}
+}
+
+func TestInsights(t *testing.T) {
+	db, err := setupTestDB()
+	if err != nil {
+		t.Fatalf("Failed to setup test database: %v", err)
+	}
+	s := &server{db: db, userClient: &fakeUserClient{}}
+	ctx := context.Background()
+
+	popular := Post{AuthorID: 1, Caption: "popular"}
+	quiet := Post{AuthorID: 1, Caption: "quiet"}
+	db.Create(&popular)
+	db.Create(&quiet)
+	popularID, quietID := int64(popular.ID), int64(quiet.ID)
+
+	impressions := []struct {
+		viewer  int64
+		surface string
+		posts   []int64
+		want    int32
+	}{
+		{3, "home", []int64{popularID, quietID}, 2},
+		{3, "home", []int64{popularID}, 1},
+		{5, "explore", []int64{popularID}, 1},
+		{1, "profile", []int64{popularID}, 0}, // The author's own views don't count
+	}
+	for _, imp := range impressions {
+		res, err := s.RecordImpressions(ctx, &pb.RecordImpressionsRequest{UserId: imp.viewer, Surface: imp.surface, PostIds: imp.posts})
+		if err != nil {
+			t.Fatalf("RecordImpressions failed: %v", err)
+		}
+		if res.Recorded != imp.want {
+			t.Errorf("Expected %d impressions recorded for user %d, got %d", imp.want, imp.viewer, res.Recorded)
+		}
+	}
+	if _, err := s.RecordImpressions(ctx, &pb.RecordImpressionsRequest{UserId: 3, Surface: "billboard", PostIds: []int64{popularID}}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected InvalidArgument for an unknown surface, got %v", err)
+	}
+	if _, err := s.RecordProfileVisit(ctx, &pb.RecordProfileVisitRequest{UserId: 3, PostId: popularID}); err != nil {
+		t.Fatalf("RecordProfileVisit failed: %v", err)
+	}
+	s.recordPostMetric(popularID, metricLike)
+
+	if _, err := s.GetPostInsights(ctx, &pb.GetPostInsightsRequest{UserId: 3, PostId: popularID}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("Expected PermissionDenied for a non-author, got %v", err)
+	}
+	post, err := s.GetPostInsights(ctx, &pb.GetPostInsightsRequest{UserId: 1, PostId: popularID})
+	if err != nil {
+		t.Fatalf("GetPostInsights failed: %v", err)
+	}
+	if post.Impressions != 3 || post.Reach != 2 || post.ProfileVisits != 1 {
+		t.Errorf("Expected 3 impressions, reach 2 and 1 profile visit, got %+v", post)
+	}
+	if post.ImpressionsBySurface["home"] != 2 || post.ImpressionsBySurface["explore"] != 1 {
+		t.Errorf("Unexpected surface breakdown %v", post.ImpressionsBySurface)
+	}
+	if len(post.Days) != defaultInsightDays {
+		t.Fatalf("Expected %d days, got %d", defaultInsightDays, len(post.Days))
+	}
+	today := post.Days[len(post.Days)-1]
+	if today.Date != insightDay(time.Now()) || today.Impressions != 3 || today.Reach != 2 || today.Likes != 1 {
+		t.Errorf("Unexpected activity for today %+v", today)
+	}
+
+	account, err := s.GetAccountInsights(ctx, &pb.GetAccountInsightsRequest{UserId: 1, Days: 7})
+	if err != nil {
+		t.Fatalf("GetAccountInsights failed: %v", err)
+	}
+	if account.Impressions != 4 || account.Reach != 2 || account.Likes != 1 {
+		t.Errorf("Expected 4 impressions from 2 accounts and 1 like, got %+v", account)
+	}
+	if account.FollowerCount != 10 || account.FollowersGained != 3 || len(account.Days) != 7 {
+		t.Errorf("Expected 10 followers, 3 gained over 7 days, got %d, %d over %d", account.FollowerCount, account.FollowersGained, len(account.Days))
+	}
+	if len(account.TopPosts) != 2 || account.TopPosts[0].Post.Id != strconv.Itoa(int(popularID)) || account.TopPosts[0].Engagement != 1 {
+		t.Errorf("Expected the popular post first, got %+v", account.TopPosts)
+	}
+}
+
+func TestCollectionMembers(t *testing.T) {
+	db, err := setupTestDB()
+	if err != nil {
+		t.Fatalf("Failed to setup test database: %v", err)
+	}
+	s := &server{db: db, userClient: &fakeUserClient{}}
+	ctx := context.Background()
+
+	post := Post{AuthorID: 2, Caption: "beach"}
+	db.Create(&post)
+	db.Create(&Collection{UserID: 9, Name: "Other"}) // Collection ID 1 means "default" to SavePostToCollection
+	created, err := s.CreateCollection(ctx, &pb.CreateCollectionRequest{UserId: 1, Name: "Trip"})
+	if err != nil {
+		t.Fatalf("CreateCollection failed: %v", err)
+	}
+	collectionID, _ := strconv.ParseInt(created.Id, 10, 64)
+	save := func(userID int64) error {
+		_, err := s.SavePostToCollection(ctx, &pb.SavePostToCollectionRequest{UserId: userID, CollectionId: collectionID, PostId: int64(post.ID)})
+		return err
+	}
+	view := func(userID int64, token string) error {
+		_, err := s.GetPostsInCollection(ctx, &pb.GetPostsInCollectionRequest{UserId: userID, CollectionId: collectionID, PageSize: 20, ShareToken: token})
+		return err
+	}
+
+	if err := view(3, ""); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("Expected PermissionDenied for a stranger, got %v", err)
+	}
+	if _, err := s.InviteCollectionMember(ctx, &pb.InviteCollectionMemberRequest{UserId: 1, CollectionId: collectionID, InviteeId: 3, Role: "owner"}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected InvalidArgument for an owner invite, got %v", err)
+	}
+	for invitee, role := range map[int64]string{3: "editor", 5: "viewer"} {
+		if _, err := s.InviteCollectionMember(ctx, &pb.InviteCollectionMemberRequest{UserId: 1, CollectionId: collectionID, InviteeId: invitee, Role: role}); err != nil {
+			t.Fatalf("InviteCollectionMember failed: %v", err)
+		}
+	}
+	if err := save(3); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("Expected PermissionDenied before accepting, got %v", err)
+	}
+	invites, err := s.GetCollectionInvites(ctx, &pb.GetCollectionInvitesRequest{UserId: 3})
+	if err != nil || len(invites.Invites) != 1 || invites.Invites[0].Role != "editor" {
+		t.Fatalf("Expected one editor invite, got %+v, %v", invites, err)
+	}
+	for _, invitee := range []int64{3, 5} {
+		if _, err := s.RespondToCollectionInvite(ctx, &pb.RespondToCollectionInviteRequest{UserId: invitee, CollectionId: collectionID, Accept: true}); err != nil {
+			t.Fatalf("RespondToCollectionInvite failed: %v", err)
+		}
+	}
+
+	// Editors add posts, viewers only look
+	if err := save(3); err != nil {
+		t.Fatalf("Expected the editor to save, got %v", err)
+	}
+	if err := save(5); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("Expected PermissionDenied for a viewer saving, got %v", err)
+	}
+	posts, err := s.GetPostsInCollection(ctx, &pb.GetPostsInCollectionRequest{UserId: 5, CollectionId: collectionID, PageSize: 20})
+	if err != nil || len(posts.Posts) != 1 {
+		t.Fatalf("Expected the viewer to see 1 post, got %+v, %v", posts, err)
+	}
+
+	mine, err := s.GetUserCollections(ctx, &pb.GetUserCollectionsRequest{UserId: 3})
+	if err != nil || len(mine.Collections) != 1 || mine.Collections[0].ViewerRole != "editor" {
+		t.Errorf("Expected the joined collection with the editor role, got %+v, %v", mine, err)
+	}
+	members, err := s.GetCollectionMembers(ctx, &pb.GetCollectionMembersRequest{UserId: 5, CollectionId: collectionID})
+	if err != nil || len(members.Members) != 3 || members.Members[0].Role != "owner" {
+		t.Errorf("Expected the owner and two members, got %+v, %v", members, err)
+	}
+
+	// Share by link
+	shared, err := s.SetCollectionPrivacy(ctx, &pb.SetCollectionPrivacyRequest{UserId: 1, CollectionId: collectionID, Privacy: "link"})
+	if err != nil || shared.ShareToken == "" {
+		t.Fatalf("Expected a share token, got %+v, %v", shared, err)
+	}
+	if err := view(7, "wrong"); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("Expected PermissionDenied with a wrong token, got %v", err)
+	}
+	viaLink, err := s.GetCollection(ctx, &pb.GetCollectionRequest{UserId: 7, CollectionId: collectionID, ShareToken: shared.ShareToken})
+	if err != nil || viaLink.ViewerRole != "viewer" || viaLink.ShareToken != "" {
+		t.Errorf("Expected view-only access without the token echoed back, got %+v, %v", viaLink, err)
+	}
+	if _, err := s.SetCollectionPrivacy(ctx, &pb.SetCollectionPrivacyRequest{UserId: 1, CollectionId: collectionID, Privacy: "private"}); err != nil {
+		t.Fatalf("SetCollectionPrivacy failed: %v", err)
+	}
+	if err := view(7, shared.ShareToken); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("Expected the link to stop working once private, got %v", err)
+	}
+
+	// Members can leave; only the owner removes others
+	if _, err := s.RemoveCollectionMember(ctx, &pb.RemoveCollectionMemberRequest{UserId: 5, CollectionId: collectionID, MemberId: 3}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("Expected PermissionDenied removing another member, got %v", err)
+	}
+	if _, err := s.RemoveCollectionMember(ctx, &pb.RemoveCollectionMemberRequest{UserId: 5, CollectionId: collectionID, MemberId: 5}); err != nil {
+		t.Fatalf("Leaving failed: %v", err)
+	}
+	if err := view(5, ""); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("Expected PermissionDenied after leaving, got %v", err)
+	}
+}
+
+func TestCollectionOrderingAndMoves(t *testing.T) {
+	db, err := setupTestDB()
+	if err != nil {
+		t.Fatalf("Failed to setup test database: %v", err)
+	}
+	s := &server{db: db, userClient: &fakeUserClient{}}
+	ctx := context.Background()
+
+	db.Create(&Collection{UserID: 9, Name: "Other"}) // Collection ID 1 means "default" to SavePostToCollection
+	newCollection := func(name string) int64 {
+		created, err := s.CreateCollection(ctx, &pb.CreateCollectionRequest{UserId: 1, Name: name})
+		if err != nil {
+			t.Fatalf("CreateCollection failed: %v", err)
+		}
+		id, _ := strconv.ParseInt(created.Id, 10, 64)
+		return id
+	}
+	trip, food := newCollection("Trip"), newCollection("Food")
+	var ids []int64
+	for i := 0; i < 3; i++ {
+		post := Post{AuthorID: 2, Caption: "post", MediaURLs: []string{fmt.Sprintf("media/%d.jpg", i)}}
+		db.Create(&post)
+		ids = append(ids, int64(post.ID))
+		if _, err := s.SavePostToCollection(ctx, &pb.SavePostToCollectionRequest{UserId: 1, CollectionId: trip, PostId: int64(post.ID)}); err != nil {
+			t.Fatalf("SavePostToCollection failed: %v", err)
+		}
+	}
+	order := func(collectionID int64) []int64 {
+		resp, err := s.GetPostsInCollection(ctx, &pb.GetPostsInCollectionRequest{UserId: 1, CollectionId: collectionID, PageSize: 20})
+		if err != nil {
+			t.Fatalf("GetPostsInCollection failed: %v", err)
+		}
+		var got []int64
+		for _, p := range resp.Posts {
+			id, _ := strconv.ParseInt(p.Id, 10, 64)
+			got = append(got, id)
+		}
+		return got
+	}
+	if got := order(trip); !reflect.DeepEqual(got, []int64{ids[2], ids[1], ids[0]}) {
+		t.Fatalf("Expected newest saves on top, got %v", got)
+	}
+
+	// Reordering two posts swaps just those two
+	if _, err := s.ReorderCollectionPosts(ctx, &pb.ReorderCollectionPostsRequest{UserId: 1, CollectionId: trip, PostIds: []int64{ids[0], ids[2]}}); err != nil {
+		t.Fatalf("ReorderCollectionPosts failed: %v", err)
+	}
+	if got := order(trip); !reflect.DeepEqual(got, []int64{ids[0], ids[1], ids[2]}) {
+		t.Errorf("Expected the reordered posts to swap, got %v", got)
+	}
+
+	// Covers follow the order until one is chosen; counts come with the collections
+	if _, err := s.SetCollectionCover(ctx, &pb.SetCollectionCoverRequest{UserId: 1, CollectionId: trip, PostId: 999}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected InvalidArgument for a cover outside the collection, got %v", err)
+	}
+	cover, err := s.SetCollectionCover(ctx, &pb.SetCollectionCoverRequest{UserId: 1, CollectionId: trip, PostId: ids[1]})
+	if err != nil || cover.CoverImageUrls[0] != "media/1.jpg" || len(cover.CoverImageUrls) != 3 {
+		t.Fatalf("Expected the chosen cover first, got %+v, %v", cover, err)
+	}
+
+	// A move is all or nothing
+	if _, err := s.MoveSavedPosts(ctx, &pb.MoveSavedPostsRequest{UserId: 1, FromCollectionId: trip, ToCollectionId: food, PostIds: []int64{ids[1], 999}}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected InvalidArgument for a post outside the source, got %v", err)
+	}
+	if _, err := s.MoveSavedPosts(ctx, &pb.MoveSavedPostsRequest{UserId: 3, FromCollectionId: trip, ToCollectionId: food, PostIds: []int64{ids[1]}}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("Expected PermissionDenied for a stranger, got %v", err)
+	}
+	moved, err := s.MoveSavedPosts(ctx, &pb.MoveSavedPostsRequest{UserId: 1, FromCollectionId: trip, ToCollectionId: food, PostIds: []int64{ids[1], ids[0]}})
+	if err != nil || moved.Moved != 2 {
+		t.Fatalf("Expected 2 posts moved, got %+v, %v", moved, err)
+	}
+	if got := order(food); !reflect.DeepEqual(got, []int64{ids[1], ids[0]}) {
+		t.Errorf("Expected moved posts on top in the given order, got %v", got)
+	}
+	copied, err := s.MoveSavedPosts(ctx, &pb.MoveSavedPostsRequest{UserId: 1, FromCollectionId: food, ToCollectionId: trip, PostIds: []int64{ids[0], ids[1]}, Copy: true})
+	if err != nil || copied.Moved != 2 || copied.Skipped != 0 {
+		t.Fatalf("Expected 2 posts copied, got %+v, %v", copied, err)
+	}
+
+	collections, err := s.GetUserCollections(ctx, &pb.GetUserCollectionsRequest{UserId: 1})
+	if err != nil {
+		t.Fatalf("GetUserCollections failed: %v", err)
+	}
+	counts := map[string]int64{}
+	for _, c := range collections.Collections {
+		counts[c.Name] = c.PostCount
+		if c.Name == "Trip" && c.CoverPostId != 0 {
+			t.Errorf("Expected the moved cover to be cleared, got %d", c.CoverPostId)
+		}
+	}
+	if counts["Trip"] != 3 || counts["Food"] != 2 {
+		t.Errorf("Expected 3 posts in Trip and 2 in Food, got %v", counts)
+	}
+}
+
+func TestSendPostToDirect(t *testing.T) {
+	db, err := setupTestDB()
+	if err != nil {
+		t.Fatalf("Failed to setup test database: %v", err)
+	}
+	messages := &fakeMessageClient{groups: map[string][]int64{"g1": {3, 6}, "g2": {3, 5}}}
+	users := &fakeUserClient{blockedPairs: map[[2]int64]bool{{5, 2}: true}} // 5 can't see the author
+	s := &server{db: db, userClient: users, messageClient: messages}
+	ctx := context.Background()
+
+	post := Post{AuthorID: 2, AuthorUsername: "user2", Caption: strings.Repeat("a", 150), MediaURLs: []string{"media/1.jpg"}}
+	db.Create(&post)
+
+	if _, err := s.SendPostToDirect(ctx, &pb.SendPostToDirectRequest{UserId: 1, PostId: int64(post.ID)}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected InvalidArgument without recipients, got %v", err)
+	}
+
+	res, err := s.SendPostToDirect(ctx, &pb.SendPostToDirectRequest{
+		UserId:          1,
+		PostId:          int64(post.ID),
+		RecipientIds:    []int64{3, 3, 5, 1},
+		ConversationIds: []string{"g1", "g2", "dm3", "nope"},
+		Message:         "look",
+	})
+	if err != nil {
+		t.Fatalf("SendPostToDirect failed: %v", err)
+	}
+	var sent []string
+	failed := map[string]string{}
+	for _, r := range res.Results {
+		key := r.ConversationId
+		if r.RecipientId != 0 {
+			key = fmt.Sprintf("user%d", r.RecipientId)
+		}
+		if r.Sent {
+			sent = append(sent, key)
+		} else {
+			failed[key] = r.Error
+		}
+	}
+	if !reflect.DeepEqual(sent, []string{"user3", "g1"}) {
+		t.Errorf("Expected sends to user3 and g1, got %v (failed: %v)", sent, failed)
+	}
+	if failed["user5"] == "" || failed["user1"] == "" || failed["g2"] == "" || failed["nope"] == "" {
+		t.Errorf("Expected user5, user1, g2 and nope to fail, got %v", failed)
+	}
+
+	// Each send is a shared_post message with a trimmed preview
+	if len(messages.sent) != 2 {
+		t.Fatalf("Expected 2 messages, got %d", len(messages.sent))
+	}
+	msg := messages.sent[0]
+	if msg.Type != "shared_post" || msg.Content != "look" || msg.SharedPost.PostId != int64(post.ID) ||
+		msg.SharedPost.ThumbnailUrl != "media/1.jpg" || len([]rune(msg.SharedPost.Caption)) != sharedPostCaptionRunes+1 {
+		t.Errorf("Unexpected message %+v", msg)
+	}
+
+	var updated Post
+	db.First(&updated, post.ID)
+	if updated.ShareCount != 1 {
+		t.Errorf("Expected one share for the whole send, got %d", updated.ShareCount)
+	}
+}
@@ -0,0 +1,203 @@
+package main
+
+import (
+	"context"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+
+	pb "github.com/hoshibmatchi/post-service/proto"
+	userPb "github.com/hoshibmatchi/user-service/proto"
+)
+
+// What a "not interested" signal applies to
+const (
+	notInterestedPost    = "post"
+	notInterestedAuthor  = "author"
+	notInterestedHashtag = "hashtag"
+)
+
+// NotInterested is a viewer's request to stop seeing a post, an author or a
+// hashtag in explore and reels
+type NotInterested struct {
+	ID             uint   `gorm:"primaryKey"`
+	UserID         int64  `gorm:"uniqueIndex:idx_not_interested_target"`
+	Kind           string `gorm:"type:varchar(10);uniqueIndex:idx_not_interested_target"`
+	TargetID       int64  `gorm:"uniqueIndex:idx_not_interested_target"` // Post or author ID; 0 for hashtags
+	Hashtag        string `gorm:"type:varchar(100);uniqueIndex:idx_not_interested_target"`
+	AuthorUsername string // Denormalized for the review list
+	CreatedAt      time.Time
+}
+
+func (n *NotInterested) toProto() *pb.NotInterestedSignal {
+	signal := &pb.NotInterestedSignal{
+		Id:             strconv.FormatUint(uint64(n.ID), 10),
+		Kind:           n.Kind,
+		AuthorUsername: n.AuthorUsername,
+		Hashtag:        n.Hashtag,
+		CreatedAt:      n.CreatedAt.Format(time.RFC3339),
+	}
+	switch n.Kind {
+	case notInterestedPost:
+		signal.PostId = n.TargetID
+	case notInterestedAuthor:
+		signal.AuthorId = n.TargetID
+	}
+	return signal
+}
+
+// excludeNotInterested drops posts and authors the viewer marked as not interested
+func excludeNotInterested(db *gorm.DB, viewerID int64) func(*gorm.DB) *gorm.DB {
+	return func(query *gorm.DB) *gorm.DB {
+		return query.
+			Where("posts.id NOT IN (?)", db.Model(&NotInterested{}).Select("target_id").Where("user_id = ? AND kind = ?", viewerID, notInterestedPost)).
+			Where("posts.author_id NOT IN (?)", db.Model(&NotInterested{}).Select("target_id").Where("user_id = ? AND kind = ?", viewerID, notInterestedAuthor))
+	}
+}
+
+// filterNotInterestedHashtags drops posts whose caption uses a hashtag the viewer
+// marked as not interested. Hashtags live in hashtag-service, so captions are
+// matched here rather than in SQL.
+func (s *server) filterNotInterestedHashtags(posts []Post, viewerID int64) []Post {
+	var hashtags []string
+	if err := s.db.Model(&NotInterested{}).
+		Where("user_id = ? AND kind = ?", viewerID, notInterestedHashtag).
+		Pluck("hashtag", &hashtags).Error; err != nil {
+		log.Printf("Failed to load muted hashtags for user %d: %v", viewerID, err)
+		return posts
+	}
+	if len(hashtags) == 0 {
+		return posts
+	}
+	muted := make(map[string]bool, len(hashtags))
+	for _, tag := range hashtags {
+		muted[tag] = true
+	}
+
+	filtered := make([]Post, 0, len(posts))
+	for _, post := range posts {
+		keep := true
+		for _, match := range hashtagRegex.FindAllStringSubmatch(post.Caption, -1) {
+			if muted[strings.ToLower(match[1])] {
+				keep = false
+				break
+			}
+		}
+		if keep {
+			filtered = append(filtered, post)
+		}
+	}
+	return filtered
+}
+
+// --- GRPC: MarkNotInterested ---
+// Marking the same thing twice returns the existing signal
+func (s *server) MarkNotInterested(ctx context.Context, req *pb.MarkNotInterestedRequest) (*pb.NotInterestedSignal, error) {
+	signal := NotInterested{UserID: req.UserId, Kind: req.Kind}
+
+	switch req.Kind {
+	case notInterestedPost, notInterestedAuthor:
+		if req.Kind == notInterestedPost || req.AuthorId == 0 {
+			if req.PostId == 0 {
+				return nil, status.Error(codes.InvalidArgument, "post_id is required")
+			}
+			var post Post
+			if err := s.db.Select("id", "author_id", "author_username").First(&post, req.PostId).Error; err == gorm.ErrRecordNotFound {
+				return nil, status.Error(codes.NotFound, "Post not found")
+			} else if err != nil {
+				return nil, status.Error(codes.Internal, "Failed to retrieve post")
+			}
+			// Don't confirm a post, or reveal its author, to someone who can't see it
+			if !s.canViewPost(ctx, &post, req.UserId) {
+				return nil, status.Error(codes.NotFound, "Post not found")
+			}
+			signal.TargetID = int64(post.ID)
+			if req.Kind == notInterestedAuthor {
+				signal.TargetID = post.AuthorID
+			}
+			req.AuthorId = post.AuthorID
+			signal.AuthorUsername = post.AuthorUsername
+		} else {
+			userData, err := s.userClient.GetUserData(ctx, &userPb.GetUserDataRequest{UserId: req.AuthorId})
+			if status.Code(err) == codes.NotFound {
+				return nil, status.Error(codes.NotFound, "User not found")
+			} else if err != nil {
+				log.Printf("Failed to get user data for user %d: %v", req.AuthorId, err)
+				return nil, status.Error(codes.Internal, "Failed to retrieve author details")
+			}
+			signal.TargetID = req.AuthorId
+			signal.AuthorUsername = userData.Username
+		}
+		if req.AuthorId == req.UserId {
+			return nil, status.Error(codes.InvalidArgument, "You can't mark your own content as not interested")
+		}
+
+	case notInterestedHashtag:
+		tag := strings.ToLower(strings.TrimPrefix(strings.TrimSpace(req.Hashtag), "#"))
+		if tag == "" || hashtagRegex.FindString("#"+tag) != "#"+tag {
+			return nil, status.Error(codes.InvalidArgument, "Invalid hashtag")
+		}
+		signal.Hashtag = tag
+
+	default:
+		return nil, status.Error(codes.InvalidArgument, "Kind must be one of post, author or hashtag")
+	}
+
+	if err := s.db.
+		Where(NotInterested{UserID: signal.UserID, Kind: signal.Kind, TargetID: signal.TargetID, Hashtag: signal.Hashtag}).
+		Attrs(NotInterested{AuthorUsername: signal.AuthorUsername}).
+		FirstOrCreate(&signal).Error; err != nil {
+		log.Printf("Failed to save not-interested signal for user %d: %v", req.UserId, err)
+		return nil, status.Error(codes.Internal, "Failed to save preference")
+	}
+
+	return signal.toProto(), nil
+}
+
+// --- GRPC: GetNotInterested ---
+func (s *server) GetNotInterested(ctx context.Context, req *pb.GetNotInterestedRequest) (*pb.GetNotInterestedResponse, error) {
+	cursor, err := decodeCursor(req.Cursor)
+	if err != nil {
+		return nil, err
+	}
+	pageSize := normalizePageSize(req.PageSize)
+
+	query := s.db.Where("user_id = ?", req.UserId)
+	if cursor != nil {
+		query = query.Where("created_at < ? OR (created_at = ? AND id < ?)", cursor.CreatedAt, cursor.CreatedAt, cursor.ID)
+	}
+	var rows []NotInterested
+	if err := query.Order("created_at DESC, id DESC").Limit(pageSize + 1).Find(&rows).Error; err != nil {
+		return nil, status.Error(codes.Internal, "Failed to retrieve preferences")
+	}
+
+	nextCursor := ""
+	if len(rows) > pageSize {
+		rows = rows[:pageSize]
+		last := rows[len(rows)-1]
+		nextCursor = encodeCursor(pageCursor{CreatedAt: last.CreatedAt, ID: last.ID})
+	}
+
+	signals := make([]*pb.NotInterestedSignal, 0, len(rows))
+	for i := range rows {
+		signals = append(signals, rows[i].toProto())
+	}
+	return &pb.GetNotInterestedResponse{Signals: signals, NextCursor: nextCursor}, nil
+}
+
+// --- GRPC: UndoNotInterested ---
+func (s *server) UndoNotInterested(ctx context.Context, req *pb.UndoNotInterestedRequest) (*pb.UndoNotInterestedResponse, error) {
+	result := s.db.Where("id = ? AND user_id = ?", req.SignalId, req.UserId).Delete(&NotInterested{})
+	if result.Error != nil {
+		return nil, status.Error(codes.Internal, "Failed to remove preference")
+	}
+	if result.RowsAffected == 0 {
+		return nil, status.Error(codes.NotFound, "Preference not found")
+	}
+	return &pb.UndoNotInterestedResponse{Message: "Preference removed"}, nil
+}
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"context"
+
+	userPb "github.com/hoshibmatchi/user-service/proto"
+)
+
+// relationshipBatchLimit matches the user-service GetRelationships cap
+const relationshipBatchLimit = 500
+
+// relationshipCache memoizes a viewer's relationships for the length of one request,
+// so checking a page of posts costs one GetRelationships call instead of several
+// user-service calls per post
+type relationshipCache struct {
+	client   userPb.UserServiceClient
+	viewerID int64
+	rels     map[int64]*userPb.Relationship
+}
+
+func (s *server) newRelationshipCache(viewerID int64) *relationshipCache {
+	return &relationshipCache{
+		client:   s.userClient,
+		viewerID: viewerID,
+		rels:     make(map[int64]*userPb.Relationship),
+	}
+}
+
+// prefetch loads every relationship not cached yet, in batches
+func (c *relationshipCache) prefetch(ctx context.Context, userIDs []int64) error {
+	var missing []int64
+	queued := make(map[int64]bool)
+	for _, id := range userIDs {
+		if _, ok := c.rels[id]; !ok && id != c.viewerID && !queued[id] {
+			queued[id] = true
+			missing = append(missing, id)
+		}
+	}
+
+	for start := 0; start < len(missing); start += relationshipBatchLimit {
+		end := start + relationshipBatchLimit
+		if end > len(missing) {
+			end = len(missing)
+		}
+		res, err := c.client.GetRelationships(ctx, &userPb.GetRelationshipsRequest{
+			ViewerId:  c.viewerID,
+			TargetIds: missing[start:end],
+		})
+		if err != nil {
+			return err
+		}
+		for id, rel := range res.Relationships {
+			c.rels[id] = rel
+		}
+	}
+	return nil
+}
+
+// get returns the viewer's relationship with one user
+func (c *relationshipCache) get(ctx context.Context, userID int64) (*userPb.Relationship, error) {
+	if err := c.prefetch(ctx, []int64{userID}); err != nil {
+		return nil, err
+	}
+	rel, ok := c.rels[userID]
+	if !ok {
+		return &userPb.Relationship{TargetId: userID}, nil
+	}
+	return rel, nil
+}
+
+// canView reports whether the viewer may see the author's posts: not blocked
+// either way, and either a public account or one the viewer follows.
+// Lookup failures deny access.
+func (c *relationshipCache) canView(ctx context.Context, authorID int64) bool {
+	if authorID == c.viewerID {
+		return true
+	}
+	rel, err := c.get(ctx, authorID)
+	if err != nil {
+		return false
+	}
+	return rel.Exists && !rel.Blocked && (!rel.IsPrivate || rel.ViewerFollows)
+}
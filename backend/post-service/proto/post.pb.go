@@ -33,6 +33,8 @@ type CreatePostRequest struct {
 	CollaboratorIds  []int64                `protobuf:"varint,6,rep,packed,name=collaborator_ids,json=collaboratorIds,proto3" json:"collaborator_ids,omitempty"`
 	Location         string                 `protobuf:"bytes,8,opt,name=location,proto3" json:"location,omitempty"`
 	ThumbnailUrl     string                 `protobuf:"bytes,7,opt,name=thumbnail_url,json=thumbnailUrl,proto3" json:"thumbnail_url,omitempty"`
+	CommentAudience  string                 `protobuf:"bytes,9,opt,name=comment_audience,json=commentAudience,proto3" json:"comment_audience,omitempty"` // "everyone", "following", "followers" or "off"; empty uses the author's default
+	HideLikeCount    bool                   `protobuf:"varint,10,opt,name=hide_like_count,json=hideLikeCount,proto3" json:"hide_like_count,omitempty"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -123,6 +125,20 @@ func (x *CreatePostRequest) GetThumbnailUrl() string {
 	return ""
 }
 
+func (x *CreatePostRequest) GetCommentAudience() string {
+	if x != nil {
+		return x.CommentAudience
+	}
+	return ""
+}
+
+func (x *CreatePostRequest) GetHideLikeCount() bool {
+	if x != nil {
+		return x.HideLikeCount
+	}
+	return false
+}
+
 // The created Post
 type Post struct {
 	state    protoimpl.MessageState `protogen:"open.v1"`
@@ -140,14 +156,18 @@ type Post struct {
 	CommentsDisabled bool   `protobuf:"varint,10,opt,name=comments_disabled,json=commentsDisabled,proto3" json:"comments_disabled,omitempty"`
 	ThumbnailUrl     string `protobuf:"bytes,11,opt,name=thumbnail_url,json=thumbnailUrl,proto3" json:"thumbnail_url,omitempty"`
 	// Counts (we'll implement the logic for these later)
-	LikeCount     int64  `protobuf:"varint,12,opt,name=like_count,json=likeCount,proto3" json:"like_count,omitempty"`
-	CommentCount  int64  `protobuf:"varint,13,opt,name=comment_count,json=commentCount,proto3" json:"comment_count,omitempty"`
-	ShareCount    int64  `protobuf:"varint,14,opt,name=share_count,json=shareCount,proto3" json:"share_count,omitempty"`
-	IsLiked       bool   `protobuf:"varint,15,opt,name=is_liked,json=isLiked,proto3" json:"is_liked,omitempty"` // Context-aware: Did the requesting user like this?
-	IsSaved       bool   `protobuf:"varint,16,opt,name=is_saved,json=isSaved,proto3" json:"is_saved,omitempty"`
-	Location      string `protobuf:"bytes,17,opt,name=location,proto3" json:"location,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	LikeCount       int64  `protobuf:"varint,12,opt,name=like_count,json=likeCount,proto3" json:"like_count,omitempty"`
+	CommentCount    int64  `protobuf:"varint,13,opt,name=comment_count,json=commentCount,proto3" json:"comment_count,omitempty"`
+	ShareCount      int64  `protobuf:"varint,14,opt,name=share_count,json=shareCount,proto3" json:"share_count,omitempty"`
+	IsLiked         bool   `protobuf:"varint,15,opt,name=is_liked,json=isLiked,proto3" json:"is_liked,omitempty"` // Context-aware: Did the requesting user like this?
+	IsSaved         bool   `protobuf:"varint,16,opt,name=is_saved,json=isSaved,proto3" json:"is_saved,omitempty"`
+	Location        string `protobuf:"bytes,17,opt,name=location,proto3" json:"location,omitempty"`
+	CommentAudience string `protobuf:"bytes,18,opt,name=comment_audience,json=commentAudience,proto3" json:"comment_audience,omitempty"`
+	CanComment      bool   `protobuf:"varint,19,opt,name=can_comment,json=canComment,proto3" json:"can_comment,omitempty"`            // Context-aware: Can the requesting user comment on this?
+	HideLikeCount   bool   `protobuf:"varint,20,opt,name=hide_like_count,json=hideLikeCount,proto3" json:"hide_like_count,omitempty"` // like_count is 0 for everyone but the author when set
+	IsArchived      bool   `protobuf:"varint,21,opt,name=is_archived,json=isArchived,proto3" json:"is_archived,omitempty"`            // Only ever true for the author
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *Post) Reset() {
@@ -299,6 +319,34 @@ func (x *Post) GetLocation() string {
 	return ""
 }
 
+func (x *Post) GetCommentAudience() string {
+	if x != nil {
+		return x.CommentAudience
+	}
+	return ""
+}
+
+func (x *Post) GetCanComment() bool {
+	if x != nil {
+		return x.CanComment
+	}
+	return false
+}
+
+func (x *Post) GetHideLikeCount() bool {
+	if x != nil {
+		return x.HideLikeCount
+	}
+	return false
+}
+
+func (x *Post) GetIsArchived() bool {
+	if x != nil {
+		return x.IsArchived
+	}
+	return false
+}
+
 type CreatePostResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Post          *Post                  `protobuf:"bytes,1,opt,name=post,proto3" json:"post,omitempty"`
@@ -536,31 +584,31 @@ func (x *UnlikePostResponse) GetMessage() string {
 	return ""
 }
 
-// --- Comment on a Post ---
-type CommentOnPostRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	UserId          int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                              // From JWT
-	PostId          int64                  `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`                              // From URL
-	Content         string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`                                           // Text or GIF URL
-	ParentCommentId int64                  `protobuf:"varint,4,opt,name=parent_comment_id,json=parentCommentId,proto3" json:"parent_comment_id,omitempty"` // 0 for a top-level comment
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+// --- Post Likers ---
+type GetPostLikersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostId        int64                  `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	ViewerId      int64                  `protobuf:"varint,2,opt,name=viewer_id,json=viewerId,proto3" json:"viewer_id,omitempty"` // From JWT
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Cursor        string                 `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"` // next_cursor from the previous page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CommentOnPostRequest) Reset() {
-	*x = CommentOnPostRequest{}
+func (x *GetPostLikersRequest) Reset() {
+	*x = GetPostLikersRequest{}
 	mi := &file_post_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CommentOnPostRequest) String() string {
+func (x *GetPostLikersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CommentOnPostRequest) ProtoMessage() {}
+func (*GetPostLikersRequest) ProtoMessage() {}
 
-func (x *CommentOnPostRequest) ProtoReflect() protoreflect.Message {
+func (x *GetPostLikersRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_post_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -572,72 +620,67 @@ func (x *CommentOnPostRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CommentOnPostRequest.ProtoReflect.Descriptor instead.
-func (*CommentOnPostRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetPostLikersRequest.ProtoReflect.Descriptor instead.
+func (*GetPostLikersRequest) Descriptor() ([]byte, []int) {
 	return file_post_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *CommentOnPostRequest) GetUserId() int64 {
+func (x *GetPostLikersRequest) GetPostId() int64 {
 	if x != nil {
-		return x.UserId
+		return x.PostId
 	}
 	return 0
 }
 
-func (x *CommentOnPostRequest) GetPostId() int64 {
+func (x *GetPostLikersRequest) GetViewerId() int64 {
 	if x != nil {
-		return x.PostId
+		return x.ViewerId
 	}
 	return 0
 }
 
-func (x *CommentOnPostRequest) GetContent() string {
+func (x *GetPostLikersRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.Content
+		return x.PageSize
 	}
-	return ""
+	return 0
 }
 
-func (x *CommentOnPostRequest) GetParentCommentId() int64 {
+func (x *GetPostLikersRequest) GetCursor() string {
 	if x != nil {
-		return x.ParentCommentId
+		return x.Cursor
 	}
-	return 0
+	return ""
 }
 
-// Response for a comment includes denormalized data
-type CommentResponse struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Content          string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
-	AuthorUsername   string                 `protobuf:"bytes,3,opt,name=author_username,json=authorUsername,proto3" json:"author_username,omitempty"`
-	AuthorProfileUrl string                 `protobuf:"bytes,4,opt,name=author_profile_url,json=authorProfileUrl,proto3" json:"author_profile_url,omitempty"`
-	CreatedAt        string                 `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	PostId           int64                  `protobuf:"varint,6,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
-	ParentCommentId  int64                  `protobuf:"varint,7,opt,name=parent_comment_id,json=parentCommentId,proto3" json:"parent_comment_id,omitempty"`
-	UserId           int64                  `protobuf:"varint,8,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	LikeCount        int64                  `protobuf:"varint,9,opt,name=like_count,json=likeCount,proto3" json:"like_count,omitempty"`
-	IsLiked          bool                   `protobuf:"varint,10,opt,name=is_liked,json=isLiked,proto3" json:"is_liked,omitempty"`
-	ReplyCount       int64                  `protobuf:"varint,11,opt,name=reply_count,json=replyCount,proto3" json:"reply_count,omitempty"`
-	AuthorIsVerified bool                   `protobuf:"varint,12,opt,name=author_is_verified,json=authorIsVerified,proto3" json:"author_is_verified,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+type PostLiker struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	UserId            int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username          string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Name              string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	ProfilePictureUrl string                 `protobuf:"bytes,4,opt,name=profile_picture_url,json=profilePictureUrl,proto3" json:"profile_picture_url,omitempty"`
+	IsVerified        bool                   `protobuf:"varint,5,opt,name=is_verified,json=isVerified,proto3" json:"is_verified,omitempty"`
+	IsFollowing       bool                   `protobuf:"varint,6,opt,name=is_following,json=isFollowing,proto3" json:"is_following,omitempty"`   // Does the viewer follow this user?
+	FollowStatus      string                 `protobuf:"bytes,7,opt,name=follow_status,json=followStatus,proto3" json:"follow_status,omitempty"` // pending, approved, or empty
+	LikedAt           string                 `protobuf:"bytes,8,opt,name=liked_at,json=likedAt,proto3" json:"liked_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *CommentResponse) Reset() {
-	*x = CommentResponse{}
+func (x *PostLiker) Reset() {
+	*x = PostLiker{}
 	mi := &file_post_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CommentResponse) String() string {
+func (x *PostLiker) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CommentResponse) ProtoMessage() {}
+func (*PostLiker) ProtoMessage() {}
 
-func (x *CommentResponse) ProtoReflect() protoreflect.Message {
+func (x *PostLiker) ProtoReflect() protoreflect.Message {
 	mi := &file_post_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -649,118 +692,91 @@ func (x *CommentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CommentResponse.ProtoReflect.Descriptor instead.
-func (*CommentResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use PostLiker.ProtoReflect.Descriptor instead.
+func (*PostLiker) Descriptor() ([]byte, []int) {
 	return file_post_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *CommentResponse) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *CommentResponse) GetContent() string {
+func (x *PostLiker) GetUserId() int64 {
 	if x != nil {
-		return x.Content
+		return x.UserId
 	}
-	return ""
+	return 0
 }
 
-func (x *CommentResponse) GetAuthorUsername() string {
+func (x *PostLiker) GetUsername() string {
 	if x != nil {
-		return x.AuthorUsername
+		return x.Username
 	}
 	return ""
 }
 
-func (x *CommentResponse) GetAuthorProfileUrl() string {
+func (x *PostLiker) GetName() string {
 	if x != nil {
-		return x.AuthorProfileUrl
+		return x.Name
 	}
 	return ""
 }
 
-func (x *CommentResponse) GetCreatedAt() string {
+func (x *PostLiker) GetProfilePictureUrl() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.ProfilePictureUrl
 	}
 	return ""
 }
 
-func (x *CommentResponse) GetPostId() int64 {
-	if x != nil {
-		return x.PostId
-	}
-	return 0
-}
-
-func (x *CommentResponse) GetParentCommentId() int64 {
-	if x != nil {
-		return x.ParentCommentId
-	}
-	return 0
-}
-
-func (x *CommentResponse) GetUserId() int64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
-}
-
-func (x *CommentResponse) GetLikeCount() int64 {
+func (x *PostLiker) GetIsVerified() bool {
 	if x != nil {
-		return x.LikeCount
+		return x.IsVerified
 	}
-	return 0
+	return false
 }
 
-func (x *CommentResponse) GetIsLiked() bool {
+func (x *PostLiker) GetIsFollowing() bool {
 	if x != nil {
-		return x.IsLiked
+		return x.IsFollowing
 	}
 	return false
 }
 
-func (x *CommentResponse) GetReplyCount() int64 {
+func (x *PostLiker) GetFollowStatus() string {
 	if x != nil {
-		return x.ReplyCount
+		return x.FollowStatus
 	}
-	return 0
+	return ""
 }
 
-func (x *CommentResponse) GetAuthorIsVerified() bool {
+func (x *PostLiker) GetLikedAt() string {
 	if x != nil {
-		return x.AuthorIsVerified
+		return x.LikedAt
 	}
-	return false
+	return ""
 }
 
-// --- Delete a Comment ---
-type DeleteCommentRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`          // From JWT (to verify ownership)
-	CommentId     int64                  `protobuf:"varint,2,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"` // From URL
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type GetPostLikersResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Likers          []*PostLiker           `protobuf:"bytes,1,rep,name=likers,proto3" json:"likers,omitempty"`
+	NextCursor      string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"` // Empty on the last page
+	LikeCount       int64                  `protobuf:"varint,3,opt,name=like_count,json=likeCount,proto3" json:"like_count,omitempty"`   // 0 when hidden from the viewer
+	LikeCountHidden bool                   `protobuf:"varint,4,opt,name=like_count_hidden,json=likeCountHidden,proto3" json:"like_count_hidden,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *DeleteCommentRequest) Reset() {
-	*x = DeleteCommentRequest{}
+func (x *GetPostLikersResponse) Reset() {
+	*x = GetPostLikersResponse{}
 	mi := &file_post_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteCommentRequest) String() string {
+func (x *GetPostLikersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteCommentRequest) ProtoMessage() {}
+func (*GetPostLikersResponse) ProtoMessage() {}
 
-func (x *DeleteCommentRequest) ProtoReflect() protoreflect.Message {
+func (x *GetPostLikersResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_post_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -772,46 +788,62 @@ func (x *DeleteCommentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteCommentRequest.ProtoReflect.Descriptor instead.
-func (*DeleteCommentRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetPostLikersResponse.ProtoReflect.Descriptor instead.
+func (*GetPostLikersResponse) Descriptor() ([]byte, []int) {
 	return file_post_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *DeleteCommentRequest) GetUserId() int64 {
+func (x *GetPostLikersResponse) GetLikers() []*PostLiker {
 	if x != nil {
-		return x.UserId
+		return x.Likers
 	}
-	return 0
+	return nil
 }
 
-func (x *DeleteCommentRequest) GetCommentId() int64 {
+func (x *GetPostLikersResponse) GetNextCursor() string {
 	if x != nil {
-		return x.CommentId
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *GetPostLikersResponse) GetLikeCount() int64 {
+	if x != nil {
+		return x.LikeCount
 	}
 	return 0
 }
 
-type DeleteCommentResponse struct {
+func (x *GetPostLikersResponse) GetLikeCountHidden() bool {
+	if x != nil {
+		return x.LikeCountHidden
+	}
+	return false
+}
+
+type SetHideLikeCountRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"` // "Comment deleted"
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // From JWT, must be the post author
+	PostId        int64                  `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Hidden        bool                   `protobuf:"varint,3,opt,name=hidden,proto3" json:"hidden,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteCommentResponse) Reset() {
-	*x = DeleteCommentResponse{}
+func (x *SetHideLikeCountRequest) Reset() {
+	*x = SetHideLikeCountRequest{}
 	mi := &file_post_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteCommentResponse) String() string {
+func (x *SetHideLikeCountRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteCommentResponse) ProtoMessage() {}
+func (*SetHideLikeCountRequest) ProtoMessage() {}
 
-func (x *DeleteCommentResponse) ProtoReflect() protoreflect.Message {
+func (x *SetHideLikeCountRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_post_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -823,41 +855,53 @@ func (x *DeleteCommentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteCommentResponse.ProtoReflect.Descriptor instead.
-func (*DeleteCommentResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetHideLikeCountRequest.ProtoReflect.Descriptor instead.
+func (*SetHideLikeCountRequest) Descriptor() ([]byte, []int) {
 	return file_post_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *DeleteCommentResponse) GetMessage() string {
+func (x *SetHideLikeCountRequest) GetUserId() int64 {
 	if x != nil {
-		return x.Message
+		return x.UserId
 	}
-	return ""
+	return 0
 }
 
-// --- Like/Unlike a Comment ---
-type LikeCommentRequest struct {
+func (x *SetHideLikeCountRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *SetHideLikeCountRequest) GetHidden() bool {
+	if x != nil {
+		return x.Hidden
+	}
+	return false
+}
+
+type SetHideLikeCountResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`          // From JWT
-	CommentId     int64                  `protobuf:"varint,2,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"` // From URL
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LikeCommentRequest) Reset() {
-	*x = LikeCommentRequest{}
+func (x *SetHideLikeCountResponse) Reset() {
+	*x = SetHideLikeCountResponse{}
 	mi := &file_post_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LikeCommentRequest) String() string {
+func (x *SetHideLikeCountResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LikeCommentRequest) ProtoMessage() {}
+func (*SetHideLikeCountResponse) ProtoMessage() {}
 
-func (x *LikeCommentRequest) ProtoReflect() protoreflect.Message {
+func (x *SetHideLikeCountResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_post_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -869,46 +913,43 @@ func (x *LikeCommentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LikeCommentRequest.ProtoReflect.Descriptor instead.
-func (*LikeCommentRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetHideLikeCountResponse.ProtoReflect.Descriptor instead.
+func (*SetHideLikeCountResponse) Descriptor() ([]byte, []int) {
 	return file_post_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *LikeCommentRequest) GetUserId() int64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
-}
-
-func (x *LikeCommentRequest) GetCommentId() int64 {
+func (x *SetHideLikeCountResponse) GetMessage() string {
 	if x != nil {
-		return x.CommentId
+		return x.Message
 	}
-	return 0
+	return ""
 }
 
-type LikeCommentResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"` // "Comment liked"
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// --- Comment on a Post ---
+type CommentOnPostRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserId          int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                              // From JWT
+	PostId          int64                  `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`                              // From URL
+	Content         string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`                                           // Text or GIF URL
+	ParentCommentId int64                  `protobuf:"varint,4,opt,name=parent_comment_id,json=parentCommentId,proto3" json:"parent_comment_id,omitempty"` // 0 for a top-level comment
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *LikeCommentResponse) Reset() {
-	*x = LikeCommentResponse{}
+func (x *CommentOnPostRequest) Reset() {
+	*x = CommentOnPostRequest{}
 	mi := &file_post_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LikeCommentResponse) String() string {
+func (x *CommentOnPostRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LikeCommentResponse) ProtoMessage() {}
+func (*CommentOnPostRequest) ProtoMessage() {}
 
-func (x *LikeCommentResponse) ProtoReflect() protoreflect.Message {
+func (x *CommentOnPostRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_post_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -920,39 +961,75 @@ func (x *LikeCommentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LikeCommentResponse.ProtoReflect.Descriptor instead.
-func (*LikeCommentResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CommentOnPostRequest.ProtoReflect.Descriptor instead.
+func (*CommentOnPostRequest) Descriptor() ([]byte, []int) {
 	return file_post_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *LikeCommentResponse) GetMessage() string {
+func (x *CommentOnPostRequest) GetUserId() int64 {
 	if x != nil {
-		return x.Message
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CommentOnPostRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *CommentOnPostRequest) GetContent() string {
+	if x != nil {
+		return x.Content
 	}
 	return ""
 }
 
-type UnlikeCommentResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"` // "Comment unliked"
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *CommentOnPostRequest) GetParentCommentId() int64 {
+	if x != nil {
+		return x.ParentCommentId
+	}
+	return 0
 }
 
-func (x *UnlikeCommentResponse) Reset() {
-	*x = UnlikeCommentResponse{}
+// Response for a comment includes denormalized data
+type CommentResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Content          string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	AuthorUsername   string                 `protobuf:"bytes,3,opt,name=author_username,json=authorUsername,proto3" json:"author_username,omitempty"`
+	AuthorProfileUrl string                 `protobuf:"bytes,4,opt,name=author_profile_url,json=authorProfileUrl,proto3" json:"author_profile_url,omitempty"`
+	CreatedAt        string                 `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	PostId           int64                  `protobuf:"varint,6,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	ParentCommentId  int64                  `protobuf:"varint,7,opt,name=parent_comment_id,json=parentCommentId,proto3" json:"parent_comment_id,omitempty"`
+	UserId           int64                  `protobuf:"varint,8,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	LikeCount        int64                  `protobuf:"varint,9,opt,name=like_count,json=likeCount,proto3" json:"like_count,omitempty"`
+	IsLiked          bool                   `protobuf:"varint,10,opt,name=is_liked,json=isLiked,proto3" json:"is_liked,omitempty"`
+	ReplyCount       int64                  `protobuf:"varint,11,opt,name=reply_count,json=replyCount,proto3" json:"reply_count,omitempty"`
+	AuthorIsVerified bool                   `protobuf:"varint,12,opt,name=author_is_verified,json=authorIsVerified,proto3" json:"author_is_verified,omitempty"`
+	IsEdited         bool                   `protobuf:"varint,13,opt,name=is_edited,json=isEdited,proto3" json:"is_edited,omitempty"`
+	IsPinned         bool                   `protobuf:"varint,14,opt,name=is_pinned,json=isPinned,proto3" json:"is_pinned,omitempty"`
+	IsHidden         bool                   `protobuf:"varint,15,opt,name=is_hidden,json=isHidden,proto3" json:"is_hidden,omitempty"` // Only ever true for the commenter (or the post author with include_hidden)
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CommentResponse) Reset() {
+	*x = CommentResponse{}
 	mi := &file_post_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UnlikeCommentResponse) String() string {
+func (x *CommentResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnlikeCommentResponse) ProtoMessage() {}
+func (*CommentResponse) ProtoMessage() {}
 
-func (x *UnlikeCommentResponse) ProtoReflect() protoreflect.Message {
+func (x *CommentResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_post_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -964,42 +1041,139 @@ func (x *UnlikeCommentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnlikeCommentResponse.ProtoReflect.Descriptor instead.
-func (*UnlikeCommentResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CommentResponse.ProtoReflect.Descriptor instead.
+func (*CommentResponse) Descriptor() ([]byte, []int) {
 	return file_post_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *UnlikeCommentResponse) GetMessage() string {
+func (x *CommentResponse) GetId() string {
 	if x != nil {
-		return x.Message
+		return x.Id
 	}
 	return ""
 }
 
-// --- Get Comments for a Post ---
-type GetCommentsByPostRequest struct {
+func (x *CommentResponse) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *CommentResponse) GetAuthorUsername() string {
+	if x != nil {
+		return x.AuthorUsername
+	}
+	return ""
+}
+
+func (x *CommentResponse) GetAuthorProfileUrl() string {
+	if x != nil {
+		return x.AuthorProfileUrl
+	}
+	return ""
+}
+
+func (x *CommentResponse) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *CommentResponse) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *CommentResponse) GetParentCommentId() int64 {
+	if x != nil {
+		return x.ParentCommentId
+	}
+	return 0
+}
+
+func (x *CommentResponse) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CommentResponse) GetLikeCount() int64 {
+	if x != nil {
+		return x.LikeCount
+	}
+	return 0
+}
+
+func (x *CommentResponse) GetIsLiked() bool {
+	if x != nil {
+		return x.IsLiked
+	}
+	return false
+}
+
+func (x *CommentResponse) GetReplyCount() int64 {
+	if x != nil {
+		return x.ReplyCount
+	}
+	return 0
+}
+
+func (x *CommentResponse) GetAuthorIsVerified() bool {
+	if x != nil {
+		return x.AuthorIsVerified
+	}
+	return false
+}
+
+func (x *CommentResponse) GetIsEdited() bool {
+	if x != nil {
+		return x.IsEdited
+	}
+	return false
+}
+
+func (x *CommentResponse) GetIsPinned() bool {
+	if x != nil {
+		return x.IsPinned
+	}
+	return false
+}
+
+func (x *CommentResponse) GetIsHidden() bool {
+	if x != nil {
+		return x.IsHidden
+	}
+	return false
+}
+
+// --- Delete a Comment ---
+type DeleteCommentRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PostId        int64                  `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
-	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageOffset    int32                  `protobuf:"varint,3,opt,name=page_offset,json=pageOffset,proto3" json:"page_offset,omitempty"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`          // From JWT (to verify ownership)
+	CommentId     int64                  `protobuf:"varint,2,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"` // From URL
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCommentsByPostRequest) Reset() {
-	*x = GetCommentsByPostRequest{}
+func (x *DeleteCommentRequest) Reset() {
+	*x = DeleteCommentRequest{}
 	mi := &file_post_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCommentsByPostRequest) String() string {
+func (x *DeleteCommentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCommentsByPostRequest) ProtoMessage() {}
+func (*DeleteCommentRequest) ProtoMessage() {}
 
-func (x *GetCommentsByPostRequest) ProtoReflect() protoreflect.Message {
+func (x *DeleteCommentRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_post_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1011,53 +1185,46 @@ func (x *GetCommentsByPostRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCommentsByPostRequest.ProtoReflect.Descriptor instead.
-func (*GetCommentsByPostRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteCommentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCommentRequest) Descriptor() ([]byte, []int) {
 	return file_post_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *GetCommentsByPostRequest) GetPostId() int64 {
-	if x != nil {
-		return x.PostId
-	}
-	return 0
-}
-
-func (x *GetCommentsByPostRequest) GetPageSize() int32 {
+func (x *DeleteCommentRequest) GetUserId() int64 {
 	if x != nil {
-		return x.PageSize
+		return x.UserId
 	}
 	return 0
 }
 
-func (x *GetCommentsByPostRequest) GetPageOffset() int32 {
+func (x *DeleteCommentRequest) GetCommentId() int64 {
 	if x != nil {
-		return x.PageOffset
+		return x.CommentId
 	}
 	return 0
 }
 
-type GetCommentsByPostResponse struct {
+type DeleteCommentResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Comments      []*CommentResponse     `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"` // "Comment deleted"
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCommentsByPostResponse) Reset() {
-	*x = GetCommentsByPostResponse{}
+func (x *DeleteCommentResponse) Reset() {
+	*x = DeleteCommentResponse{}
 	mi := &file_post_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCommentsByPostResponse) String() string {
+func (x *DeleteCommentResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCommentsByPostResponse) ProtoMessage() {}
+func (*DeleteCommentResponse) ProtoMessage() {}
 
-func (x *GetCommentsByPostResponse) ProtoReflect() protoreflect.Message {
+func (x *DeleteCommentResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_post_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1069,43 +1236,3938 @@ func (x *GetCommentsByPostResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCommentsByPostResponse.ProtoReflect.Descriptor instead.
-func (*GetCommentsByPostResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteCommentResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCommentResponse) Descriptor() ([]byte, []int) {
 	return file_post_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *GetCommentsByPostResponse) GetComments() []*CommentResponse {
+func (x *DeleteCommentResponse) GetMessage() string {
 	if x != nil {
-		return x.Comments
+		return x.Message
 	}
-	return nil
+	return ""
 }
 
-// --- Get Home Feed ---
-type GetHomeFeedRequest struct {
+// --- Comment Audience ---
+type UpdateCommentAudienceRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`             // From JWT
-	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`       // For pagination
-	PageOffset    int32                  `protobuf:"varint,3,opt,name=page_offset,json=pageOffset,proto3" json:"page_offset,omitempty"` // For pagination
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // From JWT, must be the post author
+	PostId        int64                  `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Audience      string                 `protobuf:"bytes,3,opt,name=audience,proto3" json:"audience,omitempty"` // "everyone", "following", "followers" or "off"
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetHomeFeedRequest) Reset() {
-	*x = GetHomeFeedRequest{}
+func (x *UpdateCommentAudienceRequest) Reset() {
+	*x = UpdateCommentAudienceRequest{}
+	mi := &file_post_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCommentAudienceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCommentAudienceRequest) ProtoMessage() {}
+
+func (x *UpdateCommentAudienceRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_post_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCommentAudienceRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCommentAudienceRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *UpdateCommentAudienceRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UpdateCommentAudienceRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *UpdateCommentAudienceRequest) GetAudience() string {
+	if x != nil {
+		return x.Audience
+	}
+	return ""
+}
+
+type UpdateCommentAudienceResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Message         string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	CommentAudience string                 `protobuf:"bytes,2,opt,name=comment_audience,json=commentAudience,proto3" json:"comment_audience,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UpdateCommentAudienceResponse) Reset() {
+	*x = UpdateCommentAudienceResponse{}
+	mi := &file_post_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCommentAudienceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCommentAudienceResponse) ProtoMessage() {}
+
+func (x *UpdateCommentAudienceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCommentAudienceResponse.ProtoReflect.Descriptor instead.
+func (*UpdateCommentAudienceResponse) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *UpdateCommentAudienceResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *UpdateCommentAudienceResponse) GetCommentAudience() string {
+	if x != nil {
+		return x.CommentAudience
+	}
+	return ""
+}
+
+// --- Edit a Comment ---
+type EditCommentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`          // From JWT (must be the commenter)
+	CommentId     int64                  `protobuf:"varint,2,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"` // From URL
+	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EditCommentRequest) Reset() {
+	*x = EditCommentRequest{}
+	mi := &file_post_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EditCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditCommentRequest) ProtoMessage() {}
+
+func (x *EditCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditCommentRequest.ProtoReflect.Descriptor instead.
+func (*EditCommentRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *EditCommentRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *EditCommentRequest) GetCommentId() int64 {
+	if x != nil {
+		return x.CommentId
+	}
+	return 0
+}
+
+func (x *EditCommentRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+// --- Pin / Unpin a Comment (post author only, max 3 per post) ---
+type PinCommentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`          // From JWT
+	CommentId     int64                  `protobuf:"varint,2,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"` // From URL
+	Pinned        bool                   `protobuf:"varint,3,opt,name=pinned,proto3" json:"pinned,omitempty"`                        // false to unpin
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PinCommentRequest) Reset() {
+	*x = PinCommentRequest{}
+	mi := &file_post_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PinCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PinCommentRequest) ProtoMessage() {}
+
+func (x *PinCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PinCommentRequest.ProtoReflect.Descriptor instead.
+func (*PinCommentRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *PinCommentRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *PinCommentRequest) GetCommentId() int64 {
+	if x != nil {
+		return x.CommentId
+	}
+	return 0
+}
+
+func (x *PinCommentRequest) GetPinned() bool {
+	if x != nil {
+		return x.Pinned
+	}
+	return false
+}
+
+type PinCommentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PinCommentResponse) Reset() {
+	*x = PinCommentResponse{}
+	mi := &file_post_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PinCommentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PinCommentResponse) ProtoMessage() {}
+
+func (x *PinCommentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PinCommentResponse.ProtoReflect.Descriptor instead.
+func (*PinCommentResponse) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *PinCommentResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// --- Hide / Unhide a Comment (post author only) ---
+type HideCommentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`          // From JWT
+	CommentId     int64                  `protobuf:"varint,2,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"` // From URL
+	Hidden        bool                   `protobuf:"varint,3,opt,name=hidden,proto3" json:"hidden,omitempty"`                        // false to unhide
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HideCommentRequest) Reset() {
+	*x = HideCommentRequest{}
+	mi := &file_post_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HideCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HideCommentRequest) ProtoMessage() {}
+
+func (x *HideCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HideCommentRequest.ProtoReflect.Descriptor instead.
+func (*HideCommentRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *HideCommentRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *HideCommentRequest) GetCommentId() int64 {
+	if x != nil {
+		return x.CommentId
+	}
+	return 0
+}
+
+func (x *HideCommentRequest) GetHidden() bool {
+	if x != nil {
+		return x.Hidden
+	}
+	return false
+}
+
+type HideCommentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HideCommentResponse) Reset() {
+	*x = HideCommentResponse{}
+	mi := &file_post_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HideCommentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HideCommentResponse) ProtoMessage() {}
+
+func (x *HideCommentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HideCommentResponse.ProtoReflect.Descriptor instead.
+func (*HideCommentResponse) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *HideCommentResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// --- Like/Unlike a Comment ---
+type LikeCommentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`          // From JWT
+	CommentId     int64                  `protobuf:"varint,2,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"` // From URL
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LikeCommentRequest) Reset() {
+	*x = LikeCommentRequest{}
+	mi := &file_post_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LikeCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LikeCommentRequest) ProtoMessage() {}
+
+func (x *LikeCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LikeCommentRequest.ProtoReflect.Descriptor instead.
+func (*LikeCommentRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *LikeCommentRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *LikeCommentRequest) GetCommentId() int64 {
+	if x != nil {
+		return x.CommentId
+	}
+	return 0
+}
+
+type LikeCommentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"` // "Comment liked"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LikeCommentResponse) Reset() {
+	*x = LikeCommentResponse{}
+	mi := &file_post_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LikeCommentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LikeCommentResponse) ProtoMessage() {}
+
+func (x *LikeCommentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LikeCommentResponse.ProtoReflect.Descriptor instead.
+func (*LikeCommentResponse) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *LikeCommentResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type UnlikeCommentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"` // "Comment unliked"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlikeCommentResponse) Reset() {
+	*x = UnlikeCommentResponse{}
+	mi := &file_post_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlikeCommentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlikeCommentResponse) ProtoMessage() {}
+
+func (x *UnlikeCommentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlikeCommentResponse.ProtoReflect.Descriptor instead.
+func (*UnlikeCommentResponse) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *UnlikeCommentResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// --- Get Comments for a Post ---
+type GetCommentsByPostRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostId        int64                  `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageOffset    int32                  `protobuf:"varint,3,opt,name=page_offset,json=pageOffset,proto3" json:"page_offset,omitempty"`          // Ignored when cursor is set
+	ViewerId      int64                  `protobuf:"varint,4,opt,name=viewer_id,json=viewerId,proto3" json:"viewer_id,omitempty"`                // From JWT, for is_liked
+	Cursor        string                 `protobuf:"bytes,5,opt,name=cursor,proto3" json:"cursor,omitempty"`                                     // Opaque, from a previous next_cursor
+	Sort          string                 `protobuf:"bytes,6,opt,name=sort,proto3" json:"sort,omitempty"`                                         // "newest" (default) or "top"
+	IncludeHidden bool                   `protobuf:"varint,7,opt,name=include_hidden,json=includeHidden,proto3" json:"include_hidden,omitempty"` // Only honored for the post author
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCommentsByPostRequest) Reset() {
+	*x = GetCommentsByPostRequest{}
+	mi := &file_post_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCommentsByPostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCommentsByPostRequest) ProtoMessage() {}
+
+func (x *GetCommentsByPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCommentsByPostRequest.ProtoReflect.Descriptor instead.
+func (*GetCommentsByPostRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetCommentsByPostRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *GetCommentsByPostRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetCommentsByPostRequest) GetPageOffset() int32 {
+	if x != nil {
+		return x.PageOffset
+	}
+	return 0
+}
+
+func (x *GetCommentsByPostRequest) GetViewerId() int64 {
+	if x != nil {
+		return x.ViewerId
+	}
+	return 0
+}
+
+func (x *GetCommentsByPostRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *GetCommentsByPostRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *GetCommentsByPostRequest) GetIncludeHidden() bool {
+	if x != nil {
+		return x.IncludeHidden
+	}
+	return false
+}
+
+type GetCommentsByPostResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Comments      []*CommentResponse     `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"` // Empty when there are no more results
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCommentsByPostResponse) Reset() {
+	*x = GetCommentsByPostResponse{}
+	mi := &file_post_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCommentsByPostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCommentsByPostResponse) ProtoMessage() {}
+
+func (x *GetCommentsByPostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCommentsByPostResponse.ProtoReflect.Descriptor instead.
+func (*GetCommentsByPostResponse) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetCommentsByPostResponse) GetComments() []*CommentResponse {
+	if x != nil {
+		return x.Comments
+	}
+	return nil
+}
+
+func (x *GetCommentsByPostResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+// --- Get Replies for a Comment ---
+type GetCommentRepliesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CommentId     int64                  `protobuf:"varint,1,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"` // The top-level comment
+	ViewerId      int64                  `protobuf:"varint,2,opt,name=viewer_id,json=viewerId,proto3" json:"viewer_id,omitempty"`    // From JWT, for is_liked
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Cursor        string                 `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCommentRepliesRequest) Reset() {
+	*x = GetCommentRepliesRequest{}
+	mi := &file_post_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCommentRepliesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCommentRepliesRequest) ProtoMessage() {}
+
+func (x *GetCommentRepliesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCommentRepliesRequest.ProtoReflect.Descriptor instead.
+func (*GetCommentRepliesRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetCommentRepliesRequest) GetCommentId() int64 {
+	if x != nil {
+		return x.CommentId
+	}
+	return 0
+}
+
+func (x *GetCommentRepliesRequest) GetViewerId() int64 {
+	if x != nil {
+		return x.ViewerId
+	}
+	return 0
+}
+
+func (x *GetCommentRepliesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetCommentRepliesRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+// --- Get Home Feed ---
+type GetHomeFeedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`             // From JWT
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`       // For pagination
+	PageOffset    int32                  `protobuf:"varint,3,opt,name=page_offset,json=pageOffset,proto3" json:"page_offset,omitempty"` // Deprecated: ignored, feeds page by cursor
+	Cursor        string                 `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`                            // next_cursor from the previous page
+	Sort          string                 `protobuf:"bytes,5,opt,name=sort,proto3" json:"sort,omitempty"`                                // "chronological" (default) or "ranked"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHomeFeedRequest) Reset() {
+	*x = GetHomeFeedRequest{}
+	mi := &file_post_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHomeFeedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHomeFeedRequest) ProtoMessage() {}
+
+func (x *GetHomeFeedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHomeFeedRequest.ProtoReflect.Descriptor instead.
+func (*GetHomeFeedRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetHomeFeedRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetHomeFeedRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetHomeFeedRequest) GetPageOffset() int32 {
+	if x != nil {
+		return x.PageOffset
+	}
+	return 0
+}
+
+func (x *GetHomeFeedRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *GetHomeFeedRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+// We can re-use the Post message we already defined
+type GetHomeFeedResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Posts         []*Post                `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"` // Empty on the last page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHomeFeedResponse) Reset() {
+	*x = GetHomeFeedResponse{}
+	mi := &file_post_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHomeFeedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHomeFeedResponse) ProtoMessage() {}
+
+func (x *GetHomeFeedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHomeFeedResponse.ProtoReflect.Descriptor instead.
+func (*GetHomeFeedResponse) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetHomeFeedResponse) GetPosts() []*Post {
+	if x != nil {
+		return x.Posts
+	}
+	return nil
+}
+
+func (x *GetHomeFeedResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+// --- Home timelines (internal) ---
+type FanOutPostRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostId        int64                  `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"` // New or restored post to push into followers' timelines
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FanOutPostRequest) Reset() {
+	*x = FanOutPostRequest{}
+	mi := &file_post_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FanOutPostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FanOutPostRequest) ProtoMessage() {}
+
+func (x *FanOutPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FanOutPostRequest.ProtoReflect.Descriptor instead.
+func (*FanOutPostRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *FanOutPostRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+type RetractPostRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostId        int64                  `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"` // Deleted or archived post to pull from followers' timelines
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetractPostRequest) Reset() {
+	*x = RetractPostRequest{}
+	mi := &file_post_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetractPostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetractPostRequest) ProtoMessage() {}
+
+func (x *RetractPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetractPostRequest.ProtoReflect.Descriptor instead.
+func (*RetractPostRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *RetractPostRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+type SyncTimelineAuthorRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // Timeline owner
+	AuthorId      int64                  `protobuf:"varint,2,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	Following     bool                   `protobuf:"varint,3,opt,name=following,proto3" json:"following,omitempty"` // true adds the author's recent posts, false removes them
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncTimelineAuthorRequest) Reset() {
+	*x = SyncTimelineAuthorRequest{}
+	mi := &file_post_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncTimelineAuthorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncTimelineAuthorRequest) ProtoMessage() {}
+
+func (x *SyncTimelineAuthorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncTimelineAuthorRequest.ProtoReflect.Descriptor instead.
+func (*SyncTimelineAuthorRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *SyncTimelineAuthorRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SyncTimelineAuthorRequest) GetAuthorId() int64 {
+	if x != nil {
+		return x.AuthorId
+	}
+	return 0
+}
+
+func (x *SyncTimelineAuthorRequest) GetFollowing() bool {
+	if x != nil {
+		return x.Following
+	}
+	return false
+}
+
+type TimelineUpdateResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	TimelinesUpdated int32                  `protobuf:"varint,1,opt,name=timelines_updated,json=timelinesUpdated,proto3" json:"timelines_updated,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *TimelineUpdateResponse) Reset() {
+	*x = TimelineUpdateResponse{}
+	mi := &file_post_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimelineUpdateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimelineUpdateResponse) ProtoMessage() {}
+
+func (x *TimelineUpdateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimelineUpdateResponse.ProtoReflect.Descriptor instead.
+func (*TimelineUpdateResponse) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *TimelineUpdateResponse) GetTimelinesUpdated() int32 {
+	if x != nil {
+		return x.TimelinesUpdated
+	}
+	return 0
+}
+
+// --- Reel watch signals (drive reels feed ranking) ---
+type RecordReelWatchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // From JWT
+	PostId        int64                  `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	WatchMs       int64                  `protobuf:"varint,3,opt,name=watch_ms,json=watchMs,proto3" json:"watch_ms,omitempty"`          // Time watched in this viewing, replays included
+	DurationMs    int64                  `protobuf:"varint,4,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"` // Length of the reel, if the client knows it
+	Completed     bool                   `protobuf:"varint,5,opt,name=completed,proto3" json:"completed,omitempty"`                     // Played to the end at least once
+	Replays       int32                  `protobuf:"varint,6,opt,name=replays,proto3" json:"replays,omitempty"`                         // Times it looped or was restarted after finishing
+	Skipped       bool                   `protobuf:"varint,7,opt,name=skipped,proto3" json:"skipped,omitempty"`                         // Swiped away within the first few seconds
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordReelWatchRequest) Reset() {
+	*x = RecordReelWatchRequest{}
+	mi := &file_post_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordReelWatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordReelWatchRequest) ProtoMessage() {}
+
+func (x *RecordReelWatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordReelWatchRequest.ProtoReflect.Descriptor instead.
+func (*RecordReelWatchRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *RecordReelWatchRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *RecordReelWatchRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *RecordReelWatchRequest) GetWatchMs() int64 {
+	if x != nil {
+		return x.WatchMs
+	}
+	return 0
+}
+
+func (x *RecordReelWatchRequest) GetDurationMs() int64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+func (x *RecordReelWatchRequest) GetCompleted() bool {
+	if x != nil {
+		return x.Completed
+	}
+	return false
+}
+
+func (x *RecordReelWatchRequest) GetReplays() int32 {
+	if x != nil {
+		return x.Replays
+	}
+	return 0
+}
+
+func (x *RecordReelWatchRequest) GetSkipped() bool {
+	if x != nil {
+		return x.Skipped
+	}
+	return false
+}
+
+type RecordReelWatchResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordReelWatchResponse) Reset() {
+	*x = RecordReelWatchResponse{}
+	mi := &file_post_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordReelWatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordReelWatchResponse) ProtoMessage() {}
+
+func (x *RecordReelWatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordReelWatchResponse.ProtoReflect.Descriptor instead.
+func (*RecordReelWatchResponse) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *RecordReelWatchResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// --- Not interested (excluded from explore and reels) ---
+type MarkNotInterestedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // From JWT
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`                    // "post", "author" or "hashtag"
+	PostId        int64                  `protobuf:"varint,3,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"` // Required for "post"; for "author" it can stand in for author_id
+	AuthorId      int64                  `protobuf:"varint,4,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	Hashtag       string                 `protobuf:"bytes,5,opt,name=hashtag,proto3" json:"hashtag,omitempty"` // Required for "hashtag", with or without the leading #
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkNotInterestedRequest) Reset() {
+	*x = MarkNotInterestedRequest{}
+	mi := &file_post_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkNotInterestedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkNotInterestedRequest) ProtoMessage() {}
+
+func (x *MarkNotInterestedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkNotInterestedRequest.ProtoReflect.Descriptor instead.
+func (*MarkNotInterestedRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *MarkNotInterestedRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *MarkNotInterestedRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *MarkNotInterestedRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *MarkNotInterestedRequest) GetAuthorId() int64 {
+	if x != nil {
+		return x.AuthorId
+	}
+	return 0
+}
+
+func (x *MarkNotInterestedRequest) GetHashtag() string {
+	if x != nil {
+		return x.Hashtag
+	}
+	return ""
+}
+
+type NotInterestedSignal struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Kind           string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	PostId         int64                  `protobuf:"varint,3,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`       // Set for "post"
+	AuthorId       int64                  `protobuf:"varint,4,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"` // Set for "author"
+	AuthorUsername string                 `protobuf:"bytes,5,opt,name=author_username,json=authorUsername,proto3" json:"author_username,omitempty"`
+	Hashtag        string                 `protobuf:"bytes,6,opt,name=hashtag,proto3" json:"hashtag,omitempty"` // Set for "hashtag"
+	CreatedAt      string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *NotInterestedSignal) Reset() {
+	*x = NotInterestedSignal{}
+	mi := &file_post_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotInterestedSignal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotInterestedSignal) ProtoMessage() {}
+
+func (x *NotInterestedSignal) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotInterestedSignal.ProtoReflect.Descriptor instead.
+func (*NotInterestedSignal) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *NotInterestedSignal) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *NotInterestedSignal) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *NotInterestedSignal) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *NotInterestedSignal) GetAuthorId() int64 {
+	if x != nil {
+		return x.AuthorId
+	}
+	return 0
+}
+
+func (x *NotInterestedSignal) GetAuthorUsername() string {
+	if x != nil {
+		return x.AuthorUsername
+	}
+	return ""
+}
+
+func (x *NotInterestedSignal) GetHashtag() string {
+	if x != nil {
+		return x.Hashtag
+	}
+	return ""
+}
+
+func (x *NotInterestedSignal) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type GetNotInterestedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // From JWT
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Cursor        string                 `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"` // next_cursor from the previous page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNotInterestedRequest) Reset() {
+	*x = GetNotInterestedRequest{}
+	mi := &file_post_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNotInterestedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNotInterestedRequest) ProtoMessage() {}
+
+func (x *GetNotInterestedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNotInterestedRequest.ProtoReflect.Descriptor instead.
+func (*GetNotInterestedRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetNotInterestedRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetNotInterestedRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetNotInterestedRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type GetNotInterestedResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Signals       []*NotInterestedSignal `protobuf:"bytes,1,rep,name=signals,proto3" json:"signals,omitempty"`                         // Newest first
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"` // Empty on the last page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNotInterestedResponse) Reset() {
+	*x = GetNotInterestedResponse{}
+	mi := &file_post_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNotInterestedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNotInterestedResponse) ProtoMessage() {}
+
+func (x *GetNotInterestedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNotInterestedResponse.ProtoReflect.Descriptor instead.
+func (*GetNotInterestedResponse) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GetNotInterestedResponse) GetSignals() []*NotInterestedSignal {
+	if x != nil {
+		return x.Signals
+	}
+	return nil
+}
+
+func (x *GetNotInterestedResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type UndoNotInterestedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // From JWT
+	SignalId      int64                  `protobuf:"varint,2,opt,name=signal_id,json=signalId,proto3" json:"signal_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UndoNotInterestedRequest) Reset() {
+	*x = UndoNotInterestedRequest{}
+	mi := &file_post_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UndoNotInterestedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UndoNotInterestedRequest) ProtoMessage() {}
+
+func (x *UndoNotInterestedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UndoNotInterestedRequest.ProtoReflect.Descriptor instead.
+func (*UndoNotInterestedRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *UndoNotInterestedRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UndoNotInterestedRequest) GetSignalId() int64 {
+	if x != nil {
+		return x.SignalId
+	}
+	return 0
+}
+
+type UndoNotInterestedResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UndoNotInterestedResponse) Reset() {
+	*x = UndoNotInterestedResponse{}
+	mi := &file_post_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UndoNotInterestedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UndoNotInterestedResponse) ProtoMessage() {}
+
+func (x *UndoNotInterestedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UndoNotInterestedResponse.ProtoReflect.Descriptor instead.
+func (*UndoNotInterestedResponse) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *UndoNotInterestedResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// --- Creator insights ---
+type RecordImpressionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`           // From JWT
+	Surface       string                 `protobuf:"bytes,2,opt,name=surface,proto3" json:"surface,omitempty"`                        // "home", "explore", "reels", "profile", "hashtag" or "collection"
+	PostIds       []int64                `protobuf:"varint,3,rep,packed,name=post_ids,json=postIds,proto3" json:"post_ids,omitempty"` // Posts shown on screen, max 100
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordImpressionsRequest) Reset() {
+	*x = RecordImpressionsRequest{}
+	mi := &file_post_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordImpressionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordImpressionsRequest) ProtoMessage() {}
+
+func (x *RecordImpressionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordImpressionsRequest.ProtoReflect.Descriptor instead.
+func (*RecordImpressionsRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *RecordImpressionsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *RecordImpressionsRequest) GetSurface() string {
+	if x != nil {
+		return x.Surface
+	}
+	return ""
+}
+
+func (x *RecordImpressionsRequest) GetPostIds() []int64 {
+	if x != nil {
+		return x.PostIds
+	}
+	return nil
+}
+
+type RecordImpressionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Recorded      int32                  `protobuf:"varint,1,opt,name=recorded,proto3" json:"recorded,omitempty"` // Impressions counted (own and hidden posts are skipped)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordImpressionsResponse) Reset() {
+	*x = RecordImpressionsResponse{}
+	mi := &file_post_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordImpressionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordImpressionsResponse) ProtoMessage() {}
+
+func (x *RecordImpressionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordImpressionsResponse.ProtoReflect.Descriptor instead.
+func (*RecordImpressionsResponse) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *RecordImpressionsResponse) GetRecorded() int32 {
+	if x != nil {
+		return x.Recorded
+	}
+	return 0
+}
+
+// A viewer opened the author's profile from one of their posts
+type RecordProfileVisitRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // From JWT
+	PostId        int64                  `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordProfileVisitRequest) Reset() {
+	*x = RecordProfileVisitRequest{}
+	mi := &file_post_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordProfileVisitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordProfileVisitRequest) ProtoMessage() {}
+
+func (x *RecordProfileVisitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordProfileVisitRequest.ProtoReflect.Descriptor instead.
+func (*RecordProfileVisitRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *RecordProfileVisitRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *RecordProfileVisitRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+type RecordProfileVisitResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordProfileVisitResponse) Reset() {
+	*x = RecordProfileVisitResponse{}
+	mi := &file_post_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordProfileVisitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordProfileVisitResponse) ProtoMessage() {}
+
+func (x *RecordProfileVisitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordProfileVisitResponse.ProtoReflect.Descriptor instead.
+func (*RecordProfileVisitResponse) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *RecordProfileVisitResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// One day of activity. Counts are what happened that day, not running totals.
+type InsightDay struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Date            string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"` // YYYY-MM-DD (UTC)
+	Impressions     int64                  `protobuf:"varint,2,opt,name=impressions,proto3" json:"impressions,omitempty"`
+	Reach           int64                  `protobuf:"varint,3,opt,name=reach,proto3" json:"reach,omitempty"` // Accounts that saw the post for the first time (summed over posts in account insights)
+	ProfileVisits   int64                  `protobuf:"varint,4,opt,name=profile_visits,json=profileVisits,proto3" json:"profile_visits,omitempty"`
+	Likes           int64                  `protobuf:"varint,5,opt,name=likes,proto3" json:"likes,omitempty"`
+	Saves           int64                  `protobuf:"varint,6,opt,name=saves,proto3" json:"saves,omitempty"`
+	Shares          int64                  `protobuf:"varint,7,opt,name=shares,proto3" json:"shares,omitempty"`
+	FollowersGained int64                  `protobuf:"varint,8,opt,name=followers_gained,json=followersGained,proto3" json:"followers_gained,omitempty"` // Account insights only
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *InsightDay) Reset() {
+	*x = InsightDay{}
+	mi := &file_post_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InsightDay) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InsightDay) ProtoMessage() {}
+
+func (x *InsightDay) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InsightDay.ProtoReflect.Descriptor instead.
+func (*InsightDay) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *InsightDay) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *InsightDay) GetImpressions() int64 {
+	if x != nil {
+		return x.Impressions
+	}
+	return 0
+}
+
+func (x *InsightDay) GetReach() int64 {
+	if x != nil {
+		return x.Reach
+	}
+	return 0
+}
+
+func (x *InsightDay) GetProfileVisits() int64 {
+	if x != nil {
+		return x.ProfileVisits
+	}
+	return 0
+}
+
+func (x *InsightDay) GetLikes() int64 {
+	if x != nil {
+		return x.Likes
+	}
+	return 0
+}
+
+func (x *InsightDay) GetSaves() int64 {
+	if x != nil {
+		return x.Saves
+	}
+	return 0
+}
+
+func (x *InsightDay) GetShares() int64 {
+	if x != nil {
+		return x.Shares
+	}
+	return 0
+}
+
+func (x *InsightDay) GetFollowersGained() int64 {
+	if x != nil {
+		return x.FollowersGained
+	}
+	return 0
+}
+
+type GetPostInsightsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // From JWT, must be the author
+	PostId        int64                  `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Days          int32                  `protobuf:"varint,3,opt,name=days,proto3" json:"days,omitempty"` // Length of the daily series, default 30, max 90
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPostInsightsRequest) Reset() {
+	*x = GetPostInsightsRequest{}
+	mi := &file_post_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPostInsightsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPostInsightsRequest) ProtoMessage() {}
+
+func (x *GetPostInsightsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPostInsightsRequest.ProtoReflect.Descriptor instead.
+func (*GetPostInsightsRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetPostInsightsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetPostInsightsRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *GetPostInsightsRequest) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+type PostInsights struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	PostId int64                  `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	// Lifetime totals
+	Impressions          int64            `protobuf:"varint,2,opt,name=impressions,proto3" json:"impressions,omitempty"`
+	Reach                int64            `protobuf:"varint,3,opt,name=reach,proto3" json:"reach,omitempty"` // Unique accounts that saw the post
+	ProfileVisits        int64            `protobuf:"varint,4,opt,name=profile_visits,json=profileVisits,proto3" json:"profile_visits,omitempty"`
+	Likes                int64            `protobuf:"varint,5,opt,name=likes,proto3" json:"likes,omitempty"`
+	Comments             int64            `protobuf:"varint,6,opt,name=comments,proto3" json:"comments,omitempty"`
+	Saves                int64            `protobuf:"varint,7,opt,name=saves,proto3" json:"saves,omitempty"`
+	Shares               int64            `protobuf:"varint,8,opt,name=shares,proto3" json:"shares,omitempty"`
+	ImpressionsBySurface map[string]int64 `protobuf:"bytes,9,rep,name=impressions_by_surface,json=impressionsBySurface,proto3" json:"impressions_by_surface,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	Days                 []*InsightDay    `protobuf:"bytes,10,rep,name=days,proto3" json:"days,omitempty"` // Oldest first, one entry per day
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *PostInsights) Reset() {
+	*x = PostInsights{}
+	mi := &file_post_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostInsights) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostInsights) ProtoMessage() {}
+
+func (x *PostInsights) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostInsights.ProtoReflect.Descriptor instead.
+func (*PostInsights) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *PostInsights) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *PostInsights) GetImpressions() int64 {
+	if x != nil {
+		return x.Impressions
+	}
+	return 0
+}
+
+func (x *PostInsights) GetReach() int64 {
+	if x != nil {
+		return x.Reach
+	}
+	return 0
+}
+
+func (x *PostInsights) GetProfileVisits() int64 {
+	if x != nil {
+		return x.ProfileVisits
+	}
+	return 0
+}
+
+func (x *PostInsights) GetLikes() int64 {
+	if x != nil {
+		return x.Likes
+	}
+	return 0
+}
+
+func (x *PostInsights) GetComments() int64 {
+	if x != nil {
+		return x.Comments
+	}
+	return 0
+}
+
+func (x *PostInsights) GetSaves() int64 {
+	if x != nil {
+		return x.Saves
+	}
+	return 0
+}
+
+func (x *PostInsights) GetShares() int64 {
+	if x != nil {
+		return x.Shares
+	}
+	return 0
+}
+
+func (x *PostInsights) GetImpressionsBySurface() map[string]int64 {
+	if x != nil {
+		return x.ImpressionsBySurface
+	}
+	return nil
+}
+
+func (x *PostInsights) GetDays() []*InsightDay {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+type GetAccountInsightsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // From JWT
+	Days          int32                  `protobuf:"varint,2,opt,name=days,proto3" json:"days,omitempty"`                   // Default 30, max 90
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAccountInsightsRequest) Reset() {
+	*x = GetAccountInsightsRequest{}
+	mi := &file_post_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccountInsightsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountInsightsRequest) ProtoMessage() {}
+
+func (x *GetAccountInsightsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountInsightsRequest.ProtoReflect.Descriptor instead.
+func (*GetAccountInsightsRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *GetAccountInsightsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetAccountInsightsRequest) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+type TopPost struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Post          *Post                  `protobuf:"bytes,1,opt,name=post,proto3" json:"post,omitempty"`
+	Impressions   int64                  `protobuf:"varint,2,opt,name=impressions,proto3" json:"impressions,omitempty"` // Within the period
+	Engagement    int64                  `protobuf:"varint,3,opt,name=engagement,proto3" json:"engagement,omitempty"`   // Likes, saves and shares within the period
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TopPost) Reset() {
+	*x = TopPost{}
+	mi := &file_post_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TopPost) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopPost) ProtoMessage() {}
+
+func (x *TopPost) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopPost.ProtoReflect.Descriptor instead.
+func (*TopPost) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *TopPost) GetPost() *Post {
+	if x != nil {
+		return x.Post
+	}
+	return nil
+}
+
+func (x *TopPost) GetImpressions() int64 {
+	if x != nil {
+		return x.Impressions
+	}
+	return 0
+}
+
+func (x *TopPost) GetEngagement() int64 {
+	if x != nil {
+		return x.Engagement
+	}
+	return 0
+}
+
+type AccountInsights struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Totals for the period
+	FollowerCount   int64         `protobuf:"varint,1,opt,name=follower_count,json=followerCount,proto3" json:"follower_count,omitempty"` // Current
+	FollowersGained int64         `protobuf:"varint,2,opt,name=followers_gained,json=followersGained,proto3" json:"followers_gained,omitempty"`
+	Impressions     int64         `protobuf:"varint,3,opt,name=impressions,proto3" json:"impressions,omitempty"`
+	Reach           int64         `protobuf:"varint,4,opt,name=reach,proto3" json:"reach,omitempty"` // Unique accounts that saw any of the account's posts for the first time
+	ProfileVisits   int64         `protobuf:"varint,5,opt,name=profile_visits,json=profileVisits,proto3" json:"profile_visits,omitempty"`
+	Likes           int64         `protobuf:"varint,6,opt,name=likes,proto3" json:"likes,omitempty"`
+	Saves           int64         `protobuf:"varint,7,opt,name=saves,proto3" json:"saves,omitempty"`
+	Shares          int64         `protobuf:"varint,8,opt,name=shares,proto3" json:"shares,omitempty"`
+	Days            []*InsightDay `protobuf:"bytes,9,rep,name=days,proto3" json:"days,omitempty"`                          // Oldest first, one entry per day
+	TopPosts        []*TopPost    `protobuf:"bytes,10,rep,name=top_posts,json=topPosts,proto3" json:"top_posts,omitempty"` // Most seen posts in the period
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *AccountInsights) Reset() {
+	*x = AccountInsights{}
+	mi := &file_post_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountInsights) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountInsights) ProtoMessage() {}
+
+func (x *AccountInsights) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountInsights.ProtoReflect.Descriptor instead.
+func (*AccountInsights) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *AccountInsights) GetFollowerCount() int64 {
+	if x != nil {
+		return x.FollowerCount
+	}
+	return 0
+}
+
+func (x *AccountInsights) GetFollowersGained() int64 {
+	if x != nil {
+		return x.FollowersGained
+	}
+	return 0
+}
+
+func (x *AccountInsights) GetImpressions() int64 {
+	if x != nil {
+		return x.Impressions
+	}
+	return 0
+}
+
+func (x *AccountInsights) GetReach() int64 {
+	if x != nil {
+		return x.Reach
+	}
+	return 0
+}
+
+func (x *AccountInsights) GetProfileVisits() int64 {
+	if x != nil {
+		return x.ProfileVisits
+	}
+	return 0
+}
+
+func (x *AccountInsights) GetLikes() int64 {
+	if x != nil {
+		return x.Likes
+	}
+	return 0
+}
+
+func (x *AccountInsights) GetSaves() int64 {
+	if x != nil {
+		return x.Saves
+	}
+	return 0
+}
+
+func (x *AccountInsights) GetShares() int64 {
+	if x != nil {
+		return x.Shares
+	}
+	return 0
+}
+
+func (x *AccountInsights) GetDays() []*InsightDay {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+func (x *AccountInsights) GetTopPosts() []*TopPost {
+	if x != nil {
+		return x.TopPosts
+	}
+	return nil
+}
+
+// --- Get User's Posts/Reels ---
+type GetUserContentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // The profile owner
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageOffset    int32                  `protobuf:"varint,3,opt,name=page_offset,json=pageOffset,proto3" json:"page_offset,omitempty"`    // Deprecated: ignored, use cursor
+	RequesterId   int64                  `protobuf:"varint,4,opt,name=requester_id,json=requesterId,proto3" json:"requester_id,omitempty"` // Who is viewing (from JWT)
+	Cursor        string                 `protobuf:"bytes,5,opt,name=cursor,proto3" json:"cursor,omitempty"`                               // next_cursor from the previous page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserContentRequest) Reset() {
+	*x = GetUserContentRequest{}
+	mi := &file_post_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserContentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserContentRequest) ProtoMessage() {}
+
+func (x *GetUserContentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserContentRequest.ProtoReflect.Descriptor instead.
+func (*GetUserContentRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *GetUserContentRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetUserContentRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetUserContentRequest) GetPageOffset() int32 {
+	if x != nil {
+		return x.PageOffset
+	}
+	return 0
+}
+
+func (x *GetUserContentRequest) GetRequesterId() int64 {
+	if x != nil {
+		return x.RequesterId
+	}
+	return 0
+}
+
+func (x *GetUserContentRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type GetUserContentCountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserContentCountRequest) Reset() {
+	*x = GetUserContentCountRequest{}
+	mi := &file_post_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserContentCountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserContentCountRequest) ProtoMessage() {}
+
+func (x *GetUserContentCountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserContentCountRequest.ProtoReflect.Descriptor instead.
+func (*GetUserContentCountRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *GetUserContentCountRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type GetUserContentCountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostCount     int64                  `protobuf:"varint,1,opt,name=post_count,json=postCount,proto3" json:"post_count,omitempty"`
+	ReelCount     int64                  `protobuf:"varint,2,opt,name=reel_count,json=reelCount,proto3" json:"reel_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserContentCountResponse) Reset() {
+	*x = GetUserContentCountResponse{}
+	mi := &file_post_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserContentCountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserContentCountResponse) ProtoMessage() {}
+
+func (x *GetUserContentCountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserContentCountResponse.ProtoReflect.Descriptor instead.
+func (*GetUserContentCountResponse) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *GetUserContentCountResponse) GetPostCount() int64 {
+	if x != nil {
+		return x.PostCount
+	}
+	return 0
+}
+
+func (x *GetUserContentCountResponse) GetReelCount() int64 {
+	if x != nil {
+		return x.ReelCount
+	}
+	return 0
+}
+
+// --- Collection Messages ---
+type Collection struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId         string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // The owner
+	Name           string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	IsDefault      bool                   `protobuf:"varint,4,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
+	Privacy        string                 `protobuf:"bytes,5,opt,name=privacy,proto3" json:"privacy,omitempty"`                                       // "private" (members only), "link" (anyone with the share link) or "public"
+	ShareToken     string                 `protobuf:"bytes,6,opt,name=share_token,json=shareToken,proto3" json:"share_token,omitempty"`               // Only shown to the owner, and only while privacy is "link" or "public"
+	ViewerRole     string                 `protobuf:"bytes,7,opt,name=viewer_role,json=viewerRole,proto3" json:"viewer_role,omitempty"`               // "owner", "editor" or "viewer"
+	CoverPostId    int64                  `protobuf:"varint,8,opt,name=cover_post_id,json=coverPostId,proto3" json:"cover_post_id,omitempty"`         // The chosen cover; 0 while the cover follows the collection's order
+	CoverImageUrls []string               `protobuf:"bytes,9,rep,name=cover_image_urls,json=coverImageUrls,proto3" json:"cover_image_urls,omitempty"` // Up to 4, the chosen cover first, then the top of the collection
+	PostCount      int64                  `protobuf:"varint,10,opt,name=post_count,json=postCount,proto3" json:"post_count,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Collection) Reset() {
+	*x = Collection{}
+	mi := &file_post_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Collection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Collection) ProtoMessage() {}
+
+func (x *Collection) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Collection.ProtoReflect.Descriptor instead.
+func (*Collection) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *Collection) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Collection) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Collection) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Collection) GetIsDefault() bool {
+	if x != nil {
+		return x.IsDefault
+	}
+	return false
+}
+
+func (x *Collection) GetPrivacy() string {
+	if x != nil {
+		return x.Privacy
+	}
+	return ""
+}
+
+func (x *Collection) GetShareToken() string {
+	if x != nil {
+		return x.ShareToken
+	}
+	return ""
+}
+
+func (x *Collection) GetViewerRole() string {
+	if x != nil {
+		return x.ViewerRole
+	}
+	return ""
+}
+
+func (x *Collection) GetCoverPostId() int64 {
+	if x != nil {
+		return x.CoverPostId
+	}
+	return 0
+}
+
+func (x *Collection) GetCoverImageUrls() []string {
+	if x != nil {
+		return x.CoverImageUrls
+	}
+	return nil
+}
+
+func (x *Collection) GetPostCount() int64 {
+	if x != nil {
+		return x.PostCount
+	}
+	return 0
+}
+
+// --- Create Collection ---
+type CreateCollectionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCollectionRequest) Reset() {
+	*x = CreateCollectionRequest{}
+	mi := &file_post_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCollectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCollectionRequest) ProtoMessage() {}
+
+func (x *CreateCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCollectionRequest.ProtoReflect.Descriptor instead.
+func (*CreateCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *CreateCollectionRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CreateCollectionRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// --- Get User Collections ---
+type GetUserCollectionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserCollectionsRequest) Reset() {
+	*x = GetUserCollectionsRequest{}
+	mi := &file_post_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserCollectionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserCollectionsRequest) ProtoMessage() {}
+
+func (x *GetUserCollectionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserCollectionsRequest.ProtoReflect.Descriptor instead.
+func (*GetUserCollectionsRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *GetUserCollectionsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type GetUserCollectionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Collections   []*Collection          `protobuf:"bytes,1,rep,name=collections,proto3" json:"collections,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserCollectionsResponse) Reset() {
+	*x = GetUserCollectionsResponse{}
+	mi := &file_post_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserCollectionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserCollectionsResponse) ProtoMessage() {}
+
+func (x *GetUserCollectionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserCollectionsResponse.ProtoReflect.Descriptor instead.
+func (*GetUserCollectionsResponse) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *GetUserCollectionsResponse) GetCollections() []*Collection {
+	if x != nil {
+		return x.Collections
+	}
+	return nil
+}
+
+// --- Get Posts in Collection ---
+type GetPostsInCollectionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // For validation
+	CollectionId  int64                  `protobuf:"varint,2,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageOffset    int32                  `protobuf:"varint,4,opt,name=page_offset,json=pageOffset,proto3" json:"page_offset,omitempty"`
+	ShareToken    string                 `protobuf:"bytes,5,opt,name=share_token,json=shareToken,proto3" json:"share_token,omitempty"` // Lets non-members view a collection shared by link
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPostsInCollectionRequest) Reset() {
+	*x = GetPostsInCollectionRequest{}
+	mi := &file_post_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPostsInCollectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPostsInCollectionRequest) ProtoMessage() {}
+
+func (x *GetPostsInCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPostsInCollectionRequest.ProtoReflect.Descriptor instead.
+func (*GetPostsInCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *GetPostsInCollectionRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetPostsInCollectionRequest) GetCollectionId() int64 {
+	if x != nil {
+		return x.CollectionId
+	}
+	return 0
+}
+
+func (x *GetPostsInCollectionRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetPostsInCollectionRequest) GetPageOffset() int32 {
+	if x != nil {
+		return x.PageOffset
+	}
+	return 0
+}
+
+func (x *GetPostsInCollectionRequest) GetShareToken() string {
+	if x != nil {
+		return x.ShareToken
+	}
+	return ""
+}
+
+// --- Get Collections for a Post ---
+type GetCollectionsForPostRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // For validation
+	PostId        int64                  `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCollectionsForPostRequest) Reset() {
+	*x = GetCollectionsForPostRequest{}
+	mi := &file_post_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCollectionsForPostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCollectionsForPostRequest) ProtoMessage() {}
+
+func (x *GetCollectionsForPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCollectionsForPostRequest.ProtoReflect.Descriptor instead.
+func (*GetCollectionsForPostRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *GetCollectionsForPostRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetCollectionsForPostRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+type GetCollectionsForPostResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CollectionIds []string               `protobuf:"bytes,1,rep,name=collection_ids,json=collectionIds,proto3" json:"collection_ids,omitempty"` // IDs of collections that contain this post
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCollectionsForPostResponse) Reset() {
+	*x = GetCollectionsForPostResponse{}
+	mi := &file_post_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCollectionsForPostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCollectionsForPostResponse) ProtoMessage() {}
+
+func (x *GetCollectionsForPostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCollectionsForPostResponse.ProtoReflect.Descriptor instead.
+func (*GetCollectionsForPostResponse) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *GetCollectionsForPostResponse) GetCollectionIds() []string {
+	if x != nil {
+		return x.CollectionIds
+	}
+	return nil
+}
+
+// --- Save/Unsave Post ---
+type SavePostToCollectionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // For validation
+	CollectionId  int64                  `protobuf:"varint,2,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	PostId        int64                  `protobuf:"varint,3,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SavePostToCollectionRequest) Reset() {
+	*x = SavePostToCollectionRequest{}
+	mi := &file_post_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SavePostToCollectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SavePostToCollectionRequest) ProtoMessage() {}
+
+func (x *SavePostToCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SavePostToCollectionRequest.ProtoReflect.Descriptor instead.
+func (*SavePostToCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *SavePostToCollectionRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SavePostToCollectionRequest) GetCollectionId() int64 {
+	if x != nil {
+		return x.CollectionId
+	}
+	return 0
+}
+
+func (x *SavePostToCollectionRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+type SavePostToCollectionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SavePostToCollectionResponse) Reset() {
+	*x = SavePostToCollectionResponse{}
+	mi := &file_post_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SavePostToCollectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SavePostToCollectionResponse) ProtoMessage() {}
+
+func (x *SavePostToCollectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SavePostToCollectionResponse.ProtoReflect.Descriptor instead.
+func (*SavePostToCollectionResponse) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *SavePostToCollectionResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type UnsavePostFromCollectionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // For validation
+	CollectionId  int64                  `protobuf:"varint,2,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	PostId        int64                  `protobuf:"varint,3,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnsavePostFromCollectionRequest) Reset() {
+	*x = UnsavePostFromCollectionRequest{}
+	mi := &file_post_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnsavePostFromCollectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsavePostFromCollectionRequest) ProtoMessage() {}
+
+func (x *UnsavePostFromCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsavePostFromCollectionRequest.ProtoReflect.Descriptor instead.
+func (*UnsavePostFromCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *UnsavePostFromCollectionRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UnsavePostFromCollectionRequest) GetCollectionId() int64 {
+	if x != nil {
+		return x.CollectionId
+	}
+	return 0
+}
+
+func (x *UnsavePostFromCollectionRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+type UnsavePostFromCollectionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnsavePostFromCollectionResponse) Reset() {
+	*x = UnsavePostFromCollectionResponse{}
+	mi := &file_post_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnsavePostFromCollectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsavePostFromCollectionResponse) ProtoMessage() {}
+
+func (x *UnsavePostFromCollectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsavePostFromCollectionResponse.ProtoReflect.Descriptor instead.
+func (*UnsavePostFromCollectionResponse) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *UnsavePostFromCollectionResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// --- Delete/Rename Collection ---
+type DeleteCollectionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CollectionId  int64                  `protobuf:"varint,2,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCollectionRequest) Reset() {
+	*x = DeleteCollectionRequest{}
+	mi := &file_post_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCollectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCollectionRequest) ProtoMessage() {}
+
+func (x *DeleteCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCollectionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *DeleteCollectionRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *DeleteCollectionRequest) GetCollectionId() int64 {
+	if x != nil {
+		return x.CollectionId
+	}
+	return 0
+}
+
+type DeleteCollectionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCollectionResponse) Reset() {
+	*x = DeleteCollectionResponse{}
+	mi := &file_post_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCollectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCollectionResponse) ProtoMessage() {}
+
+func (x *DeleteCollectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCollectionResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCollectionResponse) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *DeleteCollectionResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type RenameCollectionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CollectionId  int64                  `protobuf:"varint,2,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	NewName       string                 `protobuf:"bytes,3,opt,name=new_name,json=newName,proto3" json:"new_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameCollectionRequest) Reset() {
+	*x = RenameCollectionRequest{}
+	mi := &file_post_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameCollectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameCollectionRequest) ProtoMessage() {}
+
+func (x *RenameCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameCollectionRequest.ProtoReflect.Descriptor instead.
+func (*RenameCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *RenameCollectionRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *RenameCollectionRequest) GetCollectionId() int64 {
+	if x != nil {
+		return x.CollectionId
+	}
+	return 0
+}
+
+func (x *RenameCollectionRequest) GetNewName() string {
+	if x != nil {
+		return x.NewName
+	}
+	return ""
+}
+
+// --- Shared collections ---
+// The owner can invite editors (add and remove posts) and viewers (read only).
+// Members see the collection alongside their own.
+type GetCollectionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CollectionId  int64                  `protobuf:"varint,2,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	ShareToken    string                 `protobuf:"bytes,3,opt,name=share_token,json=shareToken,proto3" json:"share_token,omitempty"` // For non-members opening a share link
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCollectionRequest) Reset() {
+	*x = GetCollectionRequest{}
+	mi := &file_post_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCollectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCollectionRequest) ProtoMessage() {}
+
+func (x *GetCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCollectionRequest.ProtoReflect.Descriptor instead.
+func (*GetCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *GetCollectionRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetCollectionRequest) GetCollectionId() int64 {
+	if x != nil {
+		return x.CollectionId
+	}
+	return 0
+}
+
+func (x *GetCollectionRequest) GetShareToken() string {
+	if x != nil {
+		return x.ShareToken
+	}
+	return ""
+}
+
+type SetCollectionPrivacyRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // Must be the owner
+	CollectionId   int64                  `protobuf:"varint,2,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	Privacy        string                 `protobuf:"bytes,3,opt,name=privacy,proto3" json:"privacy,omitempty"`                                        // "private", "link" or "public"
+	ResetShareLink bool                   `protobuf:"varint,4,opt,name=reset_share_link,json=resetShareLink,proto3" json:"reset_share_link,omitempty"` // Issue a new share token, invalidating the old link
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SetCollectionPrivacyRequest) Reset() {
+	*x = SetCollectionPrivacyRequest{}
+	mi := &file_post_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCollectionPrivacyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCollectionPrivacyRequest) ProtoMessage() {}
+
+func (x *SetCollectionPrivacyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCollectionPrivacyRequest.ProtoReflect.Descriptor instead.
+func (*SetCollectionPrivacyRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *SetCollectionPrivacyRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SetCollectionPrivacyRequest) GetCollectionId() int64 {
+	if x != nil {
+		return x.CollectionId
+	}
+	return 0
+}
+
+func (x *SetCollectionPrivacyRequest) GetPrivacy() string {
+	if x != nil {
+		return x.Privacy
+	}
+	return ""
+}
+
+func (x *SetCollectionPrivacyRequest) GetResetShareLink() bool {
+	if x != nil {
+		return x.ResetShareLink
+	}
+	return false
+}
+
+type CollectionMember struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CollectionId  string                 `protobuf:"bytes,1,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`     // "owner", "editor" or "viewer"
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"` // "pending" or "accepted"
+	InvitedBy     int64                  `protobuf:"varint,5,opt,name=invited_by,json=invitedBy,proto3" json:"invited_by,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CollectionMember) Reset() {
+	*x = CollectionMember{}
+	mi := &file_post_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CollectionMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectionMember) ProtoMessage() {}
+
+func (x *CollectionMember) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectionMember.ProtoReflect.Descriptor instead.
+func (*CollectionMember) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *CollectionMember) GetCollectionId() string {
+	if x != nil {
+		return x.CollectionId
+	}
+	return ""
+}
+
+func (x *CollectionMember) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CollectionMember) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *CollectionMember) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *CollectionMember) GetInvitedBy() int64 {
+	if x != nil {
+		return x.InvitedBy
+	}
+	return 0
+}
+
+func (x *CollectionMember) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type InviteCollectionMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // Must be the owner
+	CollectionId  int64                  `protobuf:"varint,2,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	InviteeId     int64                  `protobuf:"varint,3,opt,name=invitee_id,json=inviteeId,proto3" json:"invitee_id,omitempty"`
+	Role          string                 `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"` // "editor" or "viewer"; inviting an existing member changes their role
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InviteCollectionMemberRequest) Reset() {
+	*x = InviteCollectionMemberRequest{}
+	mi := &file_post_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InviteCollectionMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteCollectionMemberRequest) ProtoMessage() {}
+
+func (x *InviteCollectionMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteCollectionMemberRequest.ProtoReflect.Descriptor instead.
+func (*InviteCollectionMemberRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *InviteCollectionMemberRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *InviteCollectionMemberRequest) GetCollectionId() int64 {
+	if x != nil {
+		return x.CollectionId
+	}
+	return 0
+}
+
+func (x *InviteCollectionMemberRequest) GetInviteeId() int64 {
+	if x != nil {
+		return x.InviteeId
+	}
+	return 0
+}
+
+func (x *InviteCollectionMemberRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type RespondToCollectionInviteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // The invitee
+	CollectionId  int64                  `protobuf:"varint,2,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	Accept        bool                   `protobuf:"varint,3,opt,name=accept,proto3" json:"accept,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RespondToCollectionInviteRequest) Reset() {
+	*x = RespondToCollectionInviteRequest{}
+	mi := &file_post_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RespondToCollectionInviteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RespondToCollectionInviteRequest) ProtoMessage() {}
+
+func (x *RespondToCollectionInviteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RespondToCollectionInviteRequest.ProtoReflect.Descriptor instead.
+func (*RespondToCollectionInviteRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *RespondToCollectionInviteRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *RespondToCollectionInviteRequest) GetCollectionId() int64 {
+	if x != nil {
+		return x.CollectionId
+	}
+	return 0
+}
+
+func (x *RespondToCollectionInviteRequest) GetAccept() bool {
+	if x != nil {
+		return x.Accept
+	}
+	return false
+}
+
+type RespondToCollectionInviteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RespondToCollectionInviteResponse) Reset() {
+	*x = RespondToCollectionInviteResponse{}
+	mi := &file_post_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RespondToCollectionInviteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RespondToCollectionInviteResponse) ProtoMessage() {}
+
+func (x *RespondToCollectionInviteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RespondToCollectionInviteResponse.ProtoReflect.Descriptor instead.
+func (*RespondToCollectionInviteResponse) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *RespondToCollectionInviteResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type GetCollectionInvitesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCollectionInvitesRequest) Reset() {
+	*x = GetCollectionInvitesRequest{}
+	mi := &file_post_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCollectionInvitesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCollectionInvitesRequest) ProtoMessage() {}
+
+func (x *GetCollectionInvitesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCollectionInvitesRequest.ProtoReflect.Descriptor instead.
+func (*GetCollectionInvitesRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *GetCollectionInvitesRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type CollectionInvite struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Collection    *Collection            `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	Role          string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	InvitedBy     int64                  `protobuf:"varint,3,opt,name=invited_by,json=invitedBy,proto3" json:"invited_by,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CollectionInvite) Reset() {
+	*x = CollectionInvite{}
+	mi := &file_post_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CollectionInvite) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectionInvite) ProtoMessage() {}
+
+func (x *CollectionInvite) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectionInvite.ProtoReflect.Descriptor instead.
+func (*CollectionInvite) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *CollectionInvite) GetCollection() *Collection {
+	if x != nil {
+		return x.Collection
+	}
+	return nil
+}
+
+func (x *CollectionInvite) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *CollectionInvite) GetInvitedBy() int64 {
+	if x != nil {
+		return x.InvitedBy
+	}
+	return 0
+}
+
+func (x *CollectionInvite) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type GetCollectionInvitesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invites       []*CollectionInvite    `protobuf:"bytes,1,rep,name=invites,proto3" json:"invites,omitempty"` // Pending, newest first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCollectionInvitesResponse) Reset() {
+	*x = GetCollectionInvitesResponse{}
+	mi := &file_post_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCollectionInvitesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCollectionInvitesResponse) ProtoMessage() {}
+
+func (x *GetCollectionInvitesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCollectionInvitesResponse.ProtoReflect.Descriptor instead.
+func (*GetCollectionInvitesResponse) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *GetCollectionInvitesResponse) GetInvites() []*CollectionInvite {
+	if x != nil {
+		return x.Invites
+	}
+	return nil
+}
+
+type GetCollectionMembersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // Must be a member
+	CollectionId  int64                  `protobuf:"varint,2,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCollectionMembersRequest) Reset() {
+	*x = GetCollectionMembersRequest{}
+	mi := &file_post_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCollectionMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCollectionMembersRequest) ProtoMessage() {}
+
+func (x *GetCollectionMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCollectionMembersRequest.ProtoReflect.Descriptor instead.
+func (*GetCollectionMembersRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *GetCollectionMembersRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetCollectionMembersRequest) GetCollectionId() int64 {
+	if x != nil {
+		return x.CollectionId
+	}
+	return 0
+}
+
+type GetCollectionMembersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Members       []*CollectionMember    `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"` // Owner first, then members by join date
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCollectionMembersResponse) Reset() {
+	*x = GetCollectionMembersResponse{}
+	mi := &file_post_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCollectionMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCollectionMembersResponse) ProtoMessage() {}
+
+func (x *GetCollectionMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCollectionMembersResponse.ProtoReflect.Descriptor instead.
+func (*GetCollectionMembersResponse) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *GetCollectionMembersResponse) GetMembers() []*CollectionMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type RemoveCollectionMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // The owner, or the member leaving
+	CollectionId  int64                  `protobuf:"varint,2,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	MemberId      int64                  `protobuf:"varint,3,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveCollectionMemberRequest) Reset() {
+	*x = RemoveCollectionMemberRequest{}
+	mi := &file_post_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetHomeFeedRequest) String() string {
+func (x *RemoveCollectionMemberRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetHomeFeedRequest) ProtoMessage() {}
+func (*RemoveCollectionMemberRequest) ProtoMessage() {}
 
-func (x *GetHomeFeedRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[16]
+func (x *RemoveCollectionMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1116,55 +5178,54 @@ func (x *GetHomeFeedRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetHomeFeedRequest.ProtoReflect.Descriptor instead.
-func (*GetHomeFeedRequest) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use RemoveCollectionMemberRequest.ProtoReflect.Descriptor instead.
+func (*RemoveCollectionMemberRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{81}
 }
 
-func (x *GetHomeFeedRequest) GetUserId() int64 {
+func (x *RemoveCollectionMemberRequest) GetUserId() int64 {
 	if x != nil {
 		return x.UserId
 	}
 	return 0
 }
 
-func (x *GetHomeFeedRequest) GetPageSize() int32 {
+func (x *RemoveCollectionMemberRequest) GetCollectionId() int64 {
 	if x != nil {
-		return x.PageSize
+		return x.CollectionId
 	}
 	return 0
 }
 
-func (x *GetHomeFeedRequest) GetPageOffset() int32 {
+func (x *RemoveCollectionMemberRequest) GetMemberId() int64 {
 	if x != nil {
-		return x.PageOffset
+		return x.MemberId
 	}
 	return 0
 }
 
-// We can re-use the Post message we already defined
-type GetHomeFeedResponse struct {
+type RemoveCollectionMemberResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Posts         []*Post                `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts,omitempty"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetHomeFeedResponse) Reset() {
-	*x = GetHomeFeedResponse{}
-	mi := &file_post_proto_msgTypes[17]
+func (x *RemoveCollectionMemberResponse) Reset() {
+	*x = RemoveCollectionMemberResponse{}
+	mi := &file_post_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetHomeFeedResponse) String() string {
+func (x *RemoveCollectionMemberResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetHomeFeedResponse) ProtoMessage() {}
+func (*RemoveCollectionMemberResponse) ProtoMessage() {}
 
-func (x *GetHomeFeedResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[17]
+func (x *RemoveCollectionMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1175,44 +5236,42 @@ func (x *GetHomeFeedResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetHomeFeedResponse.ProtoReflect.Descriptor instead.
-func (*GetHomeFeedResponse) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use RemoveCollectionMemberResponse.ProtoReflect.Descriptor instead.
+func (*RemoveCollectionMemberResponse) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{82}
 }
 
-func (x *GetHomeFeedResponse) GetPosts() []*Post {
+func (x *RemoveCollectionMemberResponse) GetMessage() string {
 	if x != nil {
-		return x.Posts
+		return x.Message
 	}
-	return nil
+	return ""
 }
 
-// --- Get User's Posts/Reels ---
-type GetUserContentRequest struct {
+// --- Get Post ---
+type GetPostRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // The profile owner
-	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageOffset    int32                  `protobuf:"varint,3,opt,name=page_offset,json=pageOffset,proto3" json:"page_offset,omitempty"`
-	RequesterId   int64                  `protobuf:"varint,4,opt,name=requester_id,json=requesterId,proto3" json:"requester_id,omitempty"` // Who is viewing (from JWT)
+	PostId        int64                  `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	ViewerId      int64                  `protobuf:"varint,2,opt,name=viewer_id,json=viewerId,proto3" json:"viewer_id,omitempty"` // For checking is_liked and is_saved
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserContentRequest) Reset() {
-	*x = GetUserContentRequest{}
-	mi := &file_post_proto_msgTypes[18]
+func (x *GetPostRequest) Reset() {
+	*x = GetPostRequest{}
+	mi := &file_post_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserContentRequest) String() string {
+func (x *GetPostRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserContentRequest) ProtoMessage() {}
+func (*GetPostRequest) ProtoMessage() {}
 
-func (x *GetUserContentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[18]
+func (x *GetPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1223,61 +5282,48 @@ func (x *GetUserContentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserContentRequest.ProtoReflect.Descriptor instead.
-func (*GetUserContentRequest) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{18}
-}
-
-func (x *GetUserContentRequest) GetUserId() int64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
-}
-
-func (x *GetUserContentRequest) GetPageSize() int32 {
-	if x != nil {
-		return x.PageSize
-	}
-	return 0
+// Deprecated: Use GetPostRequest.ProtoReflect.Descriptor instead.
+func (*GetPostRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{83}
 }
 
-func (x *GetUserContentRequest) GetPageOffset() int32 {
+func (x *GetPostRequest) GetPostId() int64 {
 	if x != nil {
-		return x.PageOffset
+		return x.PostId
 	}
 	return 0
 }
 
-func (x *GetUserContentRequest) GetRequesterId() int64 {
+func (x *GetPostRequest) GetViewerId() int64 {
 	if x != nil {
-		return x.RequesterId
+		return x.ViewerId
 	}
 	return 0
 }
 
-type GetUserContentCountRequest struct {
+// --- Get Posts (Batched) ---
+type GetPostsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PostIds       []int64                `protobuf:"varint,1,rep,packed,name=post_ids,json=postIds,proto3" json:"post_ids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserContentCountRequest) Reset() {
-	*x = GetUserContentCountRequest{}
-	mi := &file_post_proto_msgTypes[19]
+func (x *GetPostsRequest) Reset() {
+	*x = GetPostsRequest{}
+	mi := &file_post_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserContentCountRequest) String() string {
+func (x *GetPostsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserContentCountRequest) ProtoMessage() {}
+func (*GetPostsRequest) ProtoMessage() {}
 
-func (x *GetUserContentCountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[19]
+func (x *GetPostsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1288,41 +5334,40 @@ func (x *GetUserContentCountRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserContentCountRequest.ProtoReflect.Descriptor instead.
-func (*GetUserContentCountRequest) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use GetPostsRequest.ProtoReflect.Descriptor instead.
+func (*GetPostsRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{84}
 }
 
-func (x *GetUserContentCountRequest) GetUserId() int64 {
+func (x *GetPostsRequest) GetPostIds() []int64 {
 	if x != nil {
-		return x.UserId
+		return x.PostIds
 	}
-	return 0
+	return nil
 }
 
-type GetUserContentCountResponse struct {
+type GetPostsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PostCount     int64                  `protobuf:"varint,1,opt,name=post_count,json=postCount,proto3" json:"post_count,omitempty"`
-	ReelCount     int64                  `protobuf:"varint,2,opt,name=reel_count,json=reelCount,proto3" json:"reel_count,omitempty"`
+	Posts         []*Post                `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserContentCountResponse) Reset() {
-	*x = GetUserContentCountResponse{}
-	mi := &file_post_proto_msgTypes[20]
+func (x *GetPostsResponse) Reset() {
+	*x = GetPostsResponse{}
+	mi := &file_post_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserContentCountResponse) String() string {
+func (x *GetPostsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserContentCountResponse) ProtoMessage() {}
+func (*GetPostsResponse) ProtoMessage() {}
 
-func (x *GetUserContentCountResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[20]
+func (x *GetPostsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1333,51 +5378,42 @@ func (x *GetUserContentCountResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserContentCountResponse.ProtoReflect.Descriptor instead.
-func (*GetUserContentCountResponse) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{20}
-}
-
-func (x *GetUserContentCountResponse) GetPostCount() int64 {
-	if x != nil {
-		return x.PostCount
-	}
-	return 0
+// Deprecated: Use GetPostsResponse.ProtoReflect.Descriptor instead.
+func (*GetPostsResponse) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{85}
 }
 
-func (x *GetUserContentCountResponse) GetReelCount() int64 {
+func (x *GetPostsResponse) GetPosts() []*Post {
 	if x != nil {
-		return x.ReelCount
+		return x.Posts
 	}
-	return 0
+	return nil
 }
 
-// --- Collection Messages ---
-type Collection struct {
+// --- Admin: Delete Post ---
+type DeletePostRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	IsDefault     bool                   `protobuf:"varint,4,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"` // TODO: Add cover_image_urls from the 4 most recent posts
+	PostId        int64                  `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	AdminUserId   int64                  `protobuf:"varint,2,opt,name=admin_user_id,json=adminUserId,proto3" json:"admin_user_id,omitempty"` // From JWT, for logging
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Collection) Reset() {
-	*x = Collection{}
-	mi := &file_post_proto_msgTypes[21]
+func (x *DeletePostRequest) Reset() {
+	*x = DeletePostRequest{}
+	mi := &file_post_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Collection) String() string {
+func (x *DeletePostRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Collection) ProtoMessage() {}
+func (*DeletePostRequest) ProtoMessage() {}
 
-func (x *Collection) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[21]
+func (x *DeletePostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1388,63 +5424,47 @@ func (x *Collection) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Collection.ProtoReflect.Descriptor instead.
-func (*Collection) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{21}
-}
-
-func (x *Collection) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *Collection) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
+// Deprecated: Use DeletePostRequest.ProtoReflect.Descriptor instead.
+func (*DeletePostRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{86}
 }
 
-func (x *Collection) GetName() string {
+func (x *DeletePostRequest) GetPostId() int64 {
 	if x != nil {
-		return x.Name
+		return x.PostId
 	}
-	return ""
+	return 0
 }
 
-func (x *Collection) GetIsDefault() bool {
+func (x *DeletePostRequest) GetAdminUserId() int64 {
 	if x != nil {
-		return x.IsDefault
+		return x.AdminUserId
 	}
-	return false
+	return 0
 }
 
-// --- Create Collection ---
-type CreateCollectionRequest struct {
+type DeletePostResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"` // e.g., "Post deleted successfully"
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateCollectionRequest) Reset() {
-	*x = CreateCollectionRequest{}
-	mi := &file_post_proto_msgTypes[22]
+func (x *DeletePostResponse) Reset() {
+	*x = DeletePostResponse{}
+	mi := &file_post_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateCollectionRequest) String() string {
+func (x *DeletePostResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateCollectionRequest) ProtoMessage() {}
+func (*DeletePostResponse) ProtoMessage() {}
 
-func (x *CreateCollectionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[22]
+func (x *DeletePostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1455,48 +5475,42 @@ func (x *CreateCollectionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateCollectionRequest.ProtoReflect.Descriptor instead.
-func (*CreateCollectionRequest) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{22}
-}
-
-func (x *CreateCollectionRequest) GetUserId() int64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
+// Deprecated: Use DeletePostResponse.ProtoReflect.Descriptor instead.
+func (*DeletePostResponse) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{87}
 }
 
-func (x *CreateCollectionRequest) GetName() string {
+func (x *DeletePostResponse) GetMessage() string {
 	if x != nil {
-		return x.Name
+		return x.Message
 	}
 	return ""
 }
 
-// --- Get User Collections ---
-type GetUserCollectionsRequest struct {
+// --- Recently Deleted (30-day trash) ---
+type RestorePostRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // From JWT, must be the post author
+	PostId        int64                  `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserCollectionsRequest) Reset() {
-	*x = GetUserCollectionsRequest{}
-	mi := &file_post_proto_msgTypes[23]
+func (x *RestorePostRequest) Reset() {
+	*x = RestorePostRequest{}
+	mi := &file_post_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserCollectionsRequest) String() string {
+func (x *RestorePostRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserCollectionsRequest) ProtoMessage() {}
+func (*RestorePostRequest) ProtoMessage() {}
 
-func (x *GetUserCollectionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[23]
+func (x *RestorePostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1507,40 +5521,47 @@ func (x *GetUserCollectionsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserCollectionsRequest.ProtoReflect.Descriptor instead.
-func (*GetUserCollectionsRequest) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use RestorePostRequest.ProtoReflect.Descriptor instead.
+func (*RestorePostRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{88}
 }
 
-func (x *GetUserCollectionsRequest) GetUserId() int64 {
+func (x *RestorePostRequest) GetUserId() int64 {
 	if x != nil {
 		return x.UserId
 	}
 	return 0
 }
 
-type GetUserCollectionsResponse struct {
+func (x *RestorePostRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+type RestorePostResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Collections   []*Collection          `protobuf:"bytes,1,rep,name=collections,proto3" json:"collections,omitempty"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserCollectionsResponse) Reset() {
-	*x = GetUserCollectionsResponse{}
-	mi := &file_post_proto_msgTypes[24]
+func (x *RestorePostResponse) Reset() {
+	*x = RestorePostResponse{}
+	mi := &file_post_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserCollectionsResponse) String() string {
+func (x *RestorePostResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserCollectionsResponse) ProtoMessage() {}
+func (*RestorePostResponse) ProtoMessage() {}
 
-func (x *GetUserCollectionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[24]
+func (x *RestorePostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1551,44 +5572,42 @@ func (x *GetUserCollectionsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserCollectionsResponse.ProtoReflect.Descriptor instead.
-func (*GetUserCollectionsResponse) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use RestorePostResponse.ProtoReflect.Descriptor instead.
+func (*RestorePostResponse) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{89}
 }
 
-func (x *GetUserCollectionsResponse) GetCollections() []*Collection {
+func (x *RestorePostResponse) GetMessage() string {
 	if x != nil {
-		return x.Collections
+		return x.Message
 	}
-	return nil
+	return ""
 }
 
-// --- Get Posts in Collection ---
-type GetPostsInCollectionRequest struct {
+type GetRecentlyDeletedRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // For validation
-	CollectionId  int64                  `protobuf:"varint,2,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
-	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageOffset    int32                  `protobuf:"varint,4,opt,name=page_offset,json=pageOffset,proto3" json:"page_offset,omitempty"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // From JWT
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Cursor        string                 `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPostsInCollectionRequest) Reset() {
-	*x = GetPostsInCollectionRequest{}
-	mi := &file_post_proto_msgTypes[25]
+func (x *GetRecentlyDeletedRequest) Reset() {
+	*x = GetRecentlyDeletedRequest{}
+	mi := &file_post_proto_msgTypes[90]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPostsInCollectionRequest) String() string {
+func (x *GetRecentlyDeletedRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPostsInCollectionRequest) ProtoMessage() {}
+func (*GetRecentlyDeletedRequest) ProtoMessage() {}
 
-func (x *GetPostsInCollectionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[25]
+func (x *GetRecentlyDeletedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[90]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1599,63 +5618,56 @@ func (x *GetPostsInCollectionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPostsInCollectionRequest.ProtoReflect.Descriptor instead.
-func (*GetPostsInCollectionRequest) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use GetRecentlyDeletedRequest.ProtoReflect.Descriptor instead.
+func (*GetRecentlyDeletedRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{90}
 }
 
-func (x *GetPostsInCollectionRequest) GetUserId() int64 {
+func (x *GetRecentlyDeletedRequest) GetUserId() int64 {
 	if x != nil {
 		return x.UserId
 	}
 	return 0
 }
 
-func (x *GetPostsInCollectionRequest) GetCollectionId() int64 {
-	if x != nil {
-		return x.CollectionId
-	}
-	return 0
-}
-
-func (x *GetPostsInCollectionRequest) GetPageSize() int32 {
+func (x *GetRecentlyDeletedRequest) GetPageSize() int32 {
 	if x != nil {
 		return x.PageSize
 	}
 	return 0
 }
 
-func (x *GetPostsInCollectionRequest) GetPageOffset() int32 {
+func (x *GetRecentlyDeletedRequest) GetCursor() string {
 	if x != nil {
-		return x.PageOffset
+		return x.Cursor
 	}
-	return 0
+	return ""
 }
 
-// --- Get Collections for a Post ---
-type GetCollectionsForPostRequest struct {
+type DeletedPost struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // For validation
-	PostId        int64                  `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Post          *Post                  `protobuf:"bytes,1,opt,name=post,proto3" json:"post,omitempty"`
+	DeletedAt     string                 `protobuf:"bytes,2,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	PurgeAt       string                 `protobuf:"bytes,3,opt,name=purge_at,json=purgeAt,proto3" json:"purge_at,omitempty"` // When the worker removes it for good
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCollectionsForPostRequest) Reset() {
-	*x = GetCollectionsForPostRequest{}
-	mi := &file_post_proto_msgTypes[26]
+func (x *DeletedPost) Reset() {
+	*x = DeletedPost{}
+	mi := &file_post_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCollectionsForPostRequest) String() string {
+func (x *DeletedPost) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCollectionsForPostRequest) ProtoMessage() {}
+func (*DeletedPost) ProtoMessage() {}
 
-func (x *GetCollectionsForPostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[26]
+func (x *DeletedPost) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1666,47 +5678,55 @@ func (x *GetCollectionsForPostRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCollectionsForPostRequest.ProtoReflect.Descriptor instead.
-func (*GetCollectionsForPostRequest) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use DeletedPost.ProtoReflect.Descriptor instead.
+func (*DeletedPost) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{91}
 }
 
-func (x *GetCollectionsForPostRequest) GetUserId() int64 {
+func (x *DeletedPost) GetPost() *Post {
 	if x != nil {
-		return x.UserId
+		return x.Post
 	}
-	return 0
+	return nil
 }
 
-func (x *GetCollectionsForPostRequest) GetPostId() int64 {
+func (x *DeletedPost) GetDeletedAt() string {
 	if x != nil {
-		return x.PostId
+		return x.DeletedAt
 	}
-	return 0
+	return ""
 }
 
-type GetCollectionsForPostResponse struct {
+func (x *DeletedPost) GetPurgeAt() string {
+	if x != nil {
+		return x.PurgeAt
+	}
+	return ""
+}
+
+type GetRecentlyDeletedResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CollectionIds []string               `protobuf:"bytes,1,rep,name=collection_ids,json=collectionIds,proto3" json:"collection_ids,omitempty"` // IDs of collections that contain this post
+	Posts         []*DeletedPost         `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts,omitempty"` // Most recently deleted first
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCollectionsForPostResponse) Reset() {
-	*x = GetCollectionsForPostResponse{}
-	mi := &file_post_proto_msgTypes[27]
+func (x *GetRecentlyDeletedResponse) Reset() {
+	*x = GetRecentlyDeletedResponse{}
+	mi := &file_post_proto_msgTypes[92]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCollectionsForPostResponse) String() string {
+func (x *GetRecentlyDeletedResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCollectionsForPostResponse) ProtoMessage() {}
+func (*GetRecentlyDeletedResponse) ProtoMessage() {}
 
-func (x *GetCollectionsForPostResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[27]
+func (x *GetRecentlyDeletedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1717,43 +5737,49 @@ func (x *GetCollectionsForPostResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCollectionsForPostResponse.ProtoReflect.Descriptor instead.
-func (*GetCollectionsForPostResponse) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use GetRecentlyDeletedResponse.ProtoReflect.Descriptor instead.
+func (*GetRecentlyDeletedResponse) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{92}
 }
 
-func (x *GetCollectionsForPostResponse) GetCollectionIds() []string {
+func (x *GetRecentlyDeletedResponse) GetPosts() []*DeletedPost {
 	if x != nil {
-		return x.CollectionIds
+		return x.Posts
 	}
 	return nil
 }
 
-// --- Save/Unsave Post ---
-type SavePostToCollectionRequest struct {
+func (x *GetRecentlyDeletedResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+// --- Archive (hide from profile and feeds, keep engagement) ---
+type ArchivePostRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // For validation
-	CollectionId  int64                  `protobuf:"varint,2,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
-	PostId        int64                  `protobuf:"varint,3,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // From JWT, must be the post author
+	PostId        int64                  `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SavePostToCollectionRequest) Reset() {
-	*x = SavePostToCollectionRequest{}
-	mi := &file_post_proto_msgTypes[28]
+func (x *ArchivePostRequest) Reset() {
+	*x = ArchivePostRequest{}
+	mi := &file_post_proto_msgTypes[93]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SavePostToCollectionRequest) String() string {
+func (x *ArchivePostRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SavePostToCollectionRequest) ProtoMessage() {}
+func (*ArchivePostRequest) ProtoMessage() {}
 
-func (x *SavePostToCollectionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[28]
+func (x *ArchivePostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[93]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1764,54 +5790,91 @@ func (x *SavePostToCollectionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SavePostToCollectionRequest.ProtoReflect.Descriptor instead.
-func (*SavePostToCollectionRequest) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use ArchivePostRequest.ProtoReflect.Descriptor instead.
+func (*ArchivePostRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{93}
 }
 
-func (x *SavePostToCollectionRequest) GetUserId() int64 {
+func (x *ArchivePostRequest) GetUserId() int64 {
 	if x != nil {
 		return x.UserId
 	}
 	return 0
 }
 
-func (x *SavePostToCollectionRequest) GetCollectionId() int64 {
+func (x *ArchivePostRequest) GetPostId() int64 {
 	if x != nil {
-		return x.CollectionId
+		return x.PostId
 	}
 	return 0
 }
 
-func (x *SavePostToCollectionRequest) GetPostId() int64 {
+type ArchivePostResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchivePostResponse) Reset() {
+	*x = ArchivePostResponse{}
+	mi := &file_post_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchivePostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchivePostResponse) ProtoMessage() {}
+
+func (x *ArchivePostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[94]
 	if x != nil {
-		return x.PostId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-type SavePostToCollectionResponse struct {
+// Deprecated: Use ArchivePostResponse.ProtoReflect.Descriptor instead.
+func (*ArchivePostResponse) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *ArchivePostResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type UnarchivePostResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SavePostToCollectionResponse) Reset() {
-	*x = SavePostToCollectionResponse{}
-	mi := &file_post_proto_msgTypes[29]
+func (x *UnarchivePostResponse) Reset() {
+	*x = UnarchivePostResponse{}
+	mi := &file_post_proto_msgTypes[95]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SavePostToCollectionResponse) String() string {
+func (x *UnarchivePostResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SavePostToCollectionResponse) ProtoMessage() {}
+func (*UnarchivePostResponse) ProtoMessage() {}
 
-func (x *SavePostToCollectionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[29]
+func (x *UnarchivePostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[95]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1822,42 +5885,42 @@ func (x *SavePostToCollectionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SavePostToCollectionResponse.ProtoReflect.Descriptor instead.
-func (*SavePostToCollectionResponse) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use UnarchivePostResponse.ProtoReflect.Descriptor instead.
+func (*UnarchivePostResponse) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{95}
 }
 
-func (x *SavePostToCollectionResponse) GetMessage() string {
+func (x *UnarchivePostResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-type UnsavePostFromCollectionRequest struct {
+type GetArchivedPostsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // For validation
-	CollectionId  int64                  `protobuf:"varint,2,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
-	PostId        int64                  `protobuf:"varint,3,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // From JWT
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Cursor        string                 `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UnsavePostFromCollectionRequest) Reset() {
-	*x = UnsavePostFromCollectionRequest{}
-	mi := &file_post_proto_msgTypes[30]
+func (x *GetArchivedPostsRequest) Reset() {
+	*x = GetArchivedPostsRequest{}
+	mi := &file_post_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UnsavePostFromCollectionRequest) String() string {
+func (x *GetArchivedPostsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnsavePostFromCollectionRequest) ProtoMessage() {}
+func (*GetArchivedPostsRequest) ProtoMessage() {}
 
-func (x *UnsavePostFromCollectionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[30]
+func (x *GetArchivedPostsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[96]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1868,54 +5931,55 @@ func (x *UnsavePostFromCollectionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnsavePostFromCollectionRequest.ProtoReflect.Descriptor instead.
-func (*UnsavePostFromCollectionRequest) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use GetArchivedPostsRequest.ProtoReflect.Descriptor instead.
+func (*GetArchivedPostsRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{96}
 }
 
-func (x *UnsavePostFromCollectionRequest) GetUserId() int64 {
+func (x *GetArchivedPostsRequest) GetUserId() int64 {
 	if x != nil {
 		return x.UserId
 	}
 	return 0
 }
 
-func (x *UnsavePostFromCollectionRequest) GetCollectionId() int64 {
+func (x *GetArchivedPostsRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.CollectionId
+		return x.PageSize
 	}
 	return 0
 }
 
-func (x *UnsavePostFromCollectionRequest) GetPostId() int64 {
+func (x *GetArchivedPostsRequest) GetCursor() string {
 	if x != nil {
-		return x.PostId
+		return x.Cursor
 	}
-	return 0
+	return ""
 }
 
-type UnsavePostFromCollectionResponse struct {
+type GetArchivedPostsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Posts         []*Post                `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts,omitempty"` // Most recently archived first
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UnsavePostFromCollectionResponse) Reset() {
-	*x = UnsavePostFromCollectionResponse{}
-	mi := &file_post_proto_msgTypes[31]
+func (x *GetArchivedPostsResponse) Reset() {
+	*x = GetArchivedPostsResponse{}
+	mi := &file_post_proto_msgTypes[97]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UnsavePostFromCollectionResponse) String() string {
+func (x *GetArchivedPostsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnsavePostFromCollectionResponse) ProtoMessage() {}
+func (*GetArchivedPostsResponse) ProtoMessage() {}
 
-func (x *UnsavePostFromCollectionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[31]
+func (x *GetArchivedPostsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[97]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1926,42 +5990,50 @@ func (x *UnsavePostFromCollectionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnsavePostFromCollectionResponse.ProtoReflect.Descriptor instead.
-func (*UnsavePostFromCollectionResponse) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use GetArchivedPostsResponse.ProtoReflect.Descriptor instead.
+func (*GetArchivedPostsResponse) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{97}
 }
 
-func (x *UnsavePostFromCollectionResponse) GetMessage() string {
+func (x *GetArchivedPostsResponse) GetPosts() []*Post {
 	if x != nil {
-		return x.Message
+		return x.Posts
+	}
+	return nil
+}
+
+func (x *GetArchivedPostsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
 	}
 	return ""
 }
 
-// --- Delete/Rename Collection ---
-type DeleteCollectionRequest struct {
+// --- Share Post ---
+type SharePostRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	CollectionId  int64                  `protobuf:"varint,2,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // From JWT - who is sharing
+	PostId        int64                  `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"` // The post being shared
+	Caption       string                 `protobuf:"bytes,3,opt,name=caption,proto3" json:"caption,omitempty"`              // Optional comment when sharing
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteCollectionRequest) Reset() {
-	*x = DeleteCollectionRequest{}
-	mi := &file_post_proto_msgTypes[32]
+func (x *SharePostRequest) Reset() {
+	*x = SharePostRequest{}
+	mi := &file_post_proto_msgTypes[98]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteCollectionRequest) String() string {
+func (x *SharePostRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteCollectionRequest) ProtoMessage() {}
+func (*SharePostRequest) ProtoMessage() {}
 
-func (x *DeleteCollectionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[32]
+func (x *SharePostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[98]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1972,47 +6044,55 @@ func (x *DeleteCollectionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteCollectionRequest.ProtoReflect.Descriptor instead.
-func (*DeleteCollectionRequest) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use SharePostRequest.ProtoReflect.Descriptor instead.
+func (*SharePostRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{98}
 }
 
-func (x *DeleteCollectionRequest) GetUserId() int64 {
+func (x *SharePostRequest) GetUserId() int64 {
 	if x != nil {
 		return x.UserId
 	}
 	return 0
 }
 
-func (x *DeleteCollectionRequest) GetCollectionId() int64 {
+func (x *SharePostRequest) GetPostId() int64 {
 	if x != nil {
-		return x.CollectionId
+		return x.PostId
 	}
 	return 0
 }
 
-type DeleteCollectionResponse struct {
+func (x *SharePostRequest) GetCaption() string {
+	if x != nil {
+		return x.Caption
+	}
+	return ""
+}
+
+type SharePostResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	SharedPostId  int64                  `protobuf:"varint,2,opt,name=shared_post_id,json=sharedPostId,proto3" json:"shared_post_id,omitempty"` // ID of the SharedPost record
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteCollectionResponse) Reset() {
-	*x = DeleteCollectionResponse{}
-	mi := &file_post_proto_msgTypes[33]
+func (x *SharePostResponse) Reset() {
+	*x = SharePostResponse{}
+	mi := &file_post_proto_msgTypes[99]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteCollectionResponse) String() string {
+func (x *SharePostResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteCollectionResponse) ProtoMessage() {}
+func (*SharePostResponse) ProtoMessage() {}
 
-func (x *DeleteCollectionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[33]
+func (x *SharePostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[99]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2023,42 +6103,52 @@ func (x *DeleteCollectionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteCollectionResponse.ProtoReflect.Descriptor instead.
-func (*DeleteCollectionResponse) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use SharePostResponse.ProtoReflect.Descriptor instead.
+func (*SharePostResponse) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{99}
 }
 
-func (x *DeleteCollectionResponse) GetMessage() string {
+func (x *SharePostResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-type RenameCollectionRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	CollectionId  int64                  `protobuf:"varint,2,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
-	NewName       string                 `protobuf:"bytes,3,opt,name=new_name,json=newName,proto3" json:"new_name,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *SharePostResponse) GetSharedPostId() int64 {
+	if x != nil {
+		return x.SharedPostId
+	}
+	return 0
 }
 
-func (x *RenameCollectionRequest) Reset() {
-	*x = RenameCollectionRequest{}
-	mi := &file_post_proto_msgTypes[34]
+// --- Send Post to Direct ---
+type SendPostToDirectRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserId          int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // From JWT - who is sending
+	PostId          int64                  `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	RecipientIds    []int64                `protobuf:"varint,3,rep,packed,name=recipient_ids,json=recipientIds,proto3" json:"recipient_ids,omitempty"`  // Sent in a 1:1 conversation, created if needed
+	ConversationIds []string               `protobuf:"bytes,4,rep,name=conversation_ids,json=conversationIds,proto3" json:"conversation_ids,omitempty"` // Existing conversations, including groups
+	Message         string                 `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`                                        // Optional note sent with the post
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SendPostToDirectRequest) Reset() {
+	*x = SendPostToDirectRequest{}
+	mi := &file_post_proto_msgTypes[100]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RenameCollectionRequest) String() string {
+func (x *SendPostToDirectRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RenameCollectionRequest) ProtoMessage() {}
+func (*SendPostToDirectRequest) ProtoMessage() {}
 
-func (x *RenameCollectionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[34]
+func (x *SendPostToDirectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[100]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2069,56 +6159,71 @@ func (x *RenameCollectionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RenameCollectionRequest.ProtoReflect.Descriptor instead.
-func (*RenameCollectionRequest) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use SendPostToDirectRequest.ProtoReflect.Descriptor instead.
+func (*SendPostToDirectRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{100}
 }
 
-func (x *RenameCollectionRequest) GetUserId() int64 {
+func (x *SendPostToDirectRequest) GetUserId() int64 {
 	if x != nil {
 		return x.UserId
 	}
 	return 0
 }
 
-func (x *RenameCollectionRequest) GetCollectionId() int64 {
+func (x *SendPostToDirectRequest) GetPostId() int64 {
 	if x != nil {
-		return x.CollectionId
+		return x.PostId
 	}
 	return 0
 }
 
-func (x *RenameCollectionRequest) GetNewName() string {
+func (x *SendPostToDirectRequest) GetRecipientIds() []int64 {
 	if x != nil {
-		return x.NewName
+		return x.RecipientIds
+	}
+	return nil
+}
+
+func (x *SendPostToDirectRequest) GetConversationIds() []string {
+	if x != nil {
+		return x.ConversationIds
+	}
+	return nil
+}
+
+func (x *SendPostToDirectRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
 	}
 	return ""
 }
 
-// --- Get Post ---
-type GetPostRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	PostId        int64                  `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
-	ViewerId      int64                  `protobuf:"varint,2,opt,name=viewer_id,json=viewerId,proto3" json:"viewer_id,omitempty"` // For checking is_liked and is_saved
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type DirectSendResult struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	RecipientId    int64                  `protobuf:"varint,1,opt,name=recipient_id,json=recipientId,proto3" json:"recipient_id,omitempty"`         // Set for recipient_ids
+	ConversationId string                 `protobuf:"bytes,2,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"` // The conversation the post went to, when sent
+	Sent           bool                   `protobuf:"varint,3,opt,name=sent,proto3" json:"sent,omitempty"`
+	Error          string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"` // Why it wasn't sent
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *GetPostRequest) Reset() {
-	*x = GetPostRequest{}
-	mi := &file_post_proto_msgTypes[35]
+func (x *DirectSendResult) Reset() {
+	*x = DirectSendResult{}
+	mi := &file_post_proto_msgTypes[101]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPostRequest) String() string {
+func (x *DirectSendResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPostRequest) ProtoMessage() {}
+func (*DirectSendResult) ProtoMessage() {}
 
-func (x *GetPostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[35]
+func (x *DirectSendResult) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[101]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2129,48 +6234,61 @@ func (x *GetPostRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPostRequest.ProtoReflect.Descriptor instead.
-func (*GetPostRequest) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use DirectSendResult.ProtoReflect.Descriptor instead.
+func (*DirectSendResult) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{101}
 }
 
-func (x *GetPostRequest) GetPostId() int64 {
+func (x *DirectSendResult) GetRecipientId() int64 {
 	if x != nil {
-		return x.PostId
+		return x.RecipientId
 	}
 	return 0
 }
 
-func (x *GetPostRequest) GetViewerId() int64 {
+func (x *DirectSendResult) GetConversationId() string {
 	if x != nil {
-		return x.ViewerId
+		return x.ConversationId
 	}
-	return 0
+	return ""
 }
 
-// --- Get Posts (Batched) ---
-type GetPostsRequest struct {
+func (x *DirectSendResult) GetSent() bool {
+	if x != nil {
+		return x.Sent
+	}
+	return false
+}
+
+func (x *DirectSendResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type SendPostToDirectResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PostIds       []int64                `protobuf:"varint,1,rep,packed,name=post_ids,json=postIds,proto3" json:"post_ids,omitempty"`
+	Results       []*DirectSendResult    `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"` // One per recipient, then one per conversation
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPostsRequest) Reset() {
-	*x = GetPostsRequest{}
-	mi := &file_post_proto_msgTypes[36]
+func (x *SendPostToDirectResponse) Reset() {
+	*x = SendPostToDirectResponse{}
+	mi := &file_post_proto_msgTypes[102]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPostsRequest) String() string {
+func (x *SendPostToDirectResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPostsRequest) ProtoMessage() {}
+func (*SendPostToDirectResponse) ProtoMessage() {}
 
-func (x *GetPostsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[36]
+func (x *SendPostToDirectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[102]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2181,40 +6299,42 @@ func (x *GetPostsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPostsRequest.ProtoReflect.Descriptor instead.
-func (*GetPostsRequest) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use SendPostToDirectResponse.ProtoReflect.Descriptor instead.
+func (*SendPostToDirectResponse) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{102}
 }
 
-func (x *GetPostsRequest) GetPostIds() []int64 {
+func (x *SendPostToDirectResponse) GetResults() []*DirectSendResult {
 	if x != nil {
-		return x.PostIds
+		return x.Results
 	}
 	return nil
 }
 
-type GetPostsResponse struct {
+// --- Unshare Post ---
+type UnsharePostRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Posts         []*Post                `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts,omitempty"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // From JWT
+	PostId        int64                  `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"` // The post to unshare
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPostsResponse) Reset() {
-	*x = GetPostsResponse{}
-	mi := &file_post_proto_msgTypes[37]
+func (x *UnsharePostRequest) Reset() {
+	*x = UnsharePostRequest{}
+	mi := &file_post_proto_msgTypes[103]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPostsResponse) String() string {
+func (x *UnsharePostRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPostsResponse) ProtoMessage() {}
+func (*UnsharePostRequest) ProtoMessage() {}
 
-func (x *GetPostsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[37]
+func (x *UnsharePostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[103]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2225,42 +6345,47 @@ func (x *GetPostsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPostsResponse.ProtoReflect.Descriptor instead.
-func (*GetPostsResponse) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use UnsharePostRequest.ProtoReflect.Descriptor instead.
+func (*UnsharePostRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{103}
 }
 
-func (x *GetPostsResponse) GetPosts() []*Post {
+func (x *UnsharePostRequest) GetUserId() int64 {
 	if x != nil {
-		return x.Posts
+		return x.UserId
 	}
-	return nil
+	return 0
 }
 
-// --- Admin: Delete Post ---
-type DeletePostRequest struct {
+func (x *UnsharePostRequest) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+type UnsharePostResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PostId        int64                  `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
-	AdminUserId   int64                  `protobuf:"varint,2,opt,name=admin_user_id,json=adminUserId,proto3" json:"admin_user_id,omitempty"` // From JWT, for logging
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeletePostRequest) Reset() {
-	*x = DeletePostRequest{}
-	mi := &file_post_proto_msgTypes[38]
+func (x *UnsharePostResponse) Reset() {
+	*x = UnsharePostResponse{}
+	mi := &file_post_proto_msgTypes[104]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeletePostRequest) String() string {
+func (x *UnsharePostResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeletePostRequest) ProtoMessage() {}
+func (*UnsharePostResponse) ProtoMessage() {}
 
-func (x *DeletePostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[38]
+func (x *UnsharePostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[104]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2271,47 +6396,43 @@ func (x *DeletePostRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeletePostRequest.ProtoReflect.Descriptor instead.
-func (*DeletePostRequest) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{38}
-}
-
-func (x *DeletePostRequest) GetPostId() int64 {
-	if x != nil {
-		return x.PostId
-	}
-	return 0
+// Deprecated: Use UnsharePostResponse.ProtoReflect.Descriptor instead.
+func (*UnsharePostResponse) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{104}
 }
 
-func (x *DeletePostRequest) GetAdminUserId() int64 {
+func (x *UnsharePostResponse) GetMessage() string {
 	if x != nil {
-		return x.AdminUserId
+		return x.Message
 	}
-	return 0
+	return ""
 }
 
-type DeletePostResponse struct {
+// --- Get Shared Posts ---
+type GetSharedPostsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"` // e.g., "Post deleted successfully"
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // Get posts shared by this user
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageOffset    int32                  `protobuf:"varint,3,opt,name=page_offset,json=pageOffset,proto3" json:"page_offset,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeletePostResponse) Reset() {
-	*x = DeletePostResponse{}
-	mi := &file_post_proto_msgTypes[39]
+func (x *GetSharedPostsRequest) Reset() {
+	*x = GetSharedPostsRequest{}
+	mi := &file_post_proto_msgTypes[105]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeletePostResponse) String() string {
+func (x *GetSharedPostsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeletePostResponse) ProtoMessage() {}
+func (*GetSharedPostsRequest) ProtoMessage() {}
 
-func (x *DeletePostResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[39]
+func (x *GetSharedPostsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[105]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2322,43 +6443,58 @@ func (x *DeletePostResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeletePostResponse.ProtoReflect.Descriptor instead.
-func (*DeletePostResponse) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use GetSharedPostsRequest.ProtoReflect.Descriptor instead.
+func (*GetSharedPostsRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{105}
 }
 
-func (x *DeletePostResponse) GetMessage() string {
+func (x *GetSharedPostsRequest) GetUserId() int64 {
 	if x != nil {
-		return x.Message
+		return x.UserId
 	}
-	return ""
+	return 0
 }
 
-// --- Share Post ---
-type SharePostRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // From JWT - who is sharing
-	PostId        int64                  `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"` // The post being shared
-	Caption       string                 `protobuf:"bytes,3,opt,name=caption,proto3" json:"caption,omitempty"`              // Optional comment when sharing
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *GetSharedPostsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
 }
 
-func (x *SharePostRequest) Reset() {
-	*x = SharePostRequest{}
-	mi := &file_post_proto_msgTypes[40]
+func (x *GetSharedPostsRequest) GetPageOffset() int32 {
+	if x != nil {
+		return x.PageOffset
+	}
+	return 0
+}
+
+type SharedPostItem struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OriginalPost     *Post                  `protobuf:"bytes,2,opt,name=original_post,json=originalPost,proto3" json:"original_post,omitempty"`
+	SharedByUsername string                 `protobuf:"bytes,3,opt,name=shared_by_username,json=sharedByUsername,proto3" json:"shared_by_username,omitempty"`
+	SharedCaption    string                 `protobuf:"bytes,4,opt,name=shared_caption,json=sharedCaption,proto3" json:"shared_caption,omitempty"`
+	SharedAt         string                 `protobuf:"bytes,5,opt,name=shared_at,json=sharedAt,proto3" json:"shared_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SharedPostItem) Reset() {
+	*x = SharedPostItem{}
+	mi := &file_post_proto_msgTypes[106]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SharePostRequest) String() string {
+func (x *SharedPostItem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SharePostRequest) ProtoMessage() {}
+func (*SharedPostItem) ProtoMessage() {}
 
-func (x *SharePostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[40]
+func (x *SharedPostItem) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[106]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2369,55 +6505,68 @@ func (x *SharePostRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SharePostRequest.ProtoReflect.Descriptor instead.
-func (*SharePostRequest) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use SharedPostItem.ProtoReflect.Descriptor instead.
+func (*SharedPostItem) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *SharedPostItem) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SharedPostItem) GetOriginalPost() *Post {
+	if x != nil {
+		return x.OriginalPost
+	}
+	return nil
 }
 
-func (x *SharePostRequest) GetUserId() int64 {
+func (x *SharedPostItem) GetSharedByUsername() string {
 	if x != nil {
-		return x.UserId
+		return x.SharedByUsername
 	}
-	return 0
+	return ""
 }
 
-func (x *SharePostRequest) GetPostId() int64 {
+func (x *SharedPostItem) GetSharedCaption() string {
 	if x != nil {
-		return x.PostId
+		return x.SharedCaption
 	}
-	return 0
+	return ""
 }
 
-func (x *SharePostRequest) GetCaption() string {
+func (x *SharedPostItem) GetSharedAt() string {
 	if x != nil {
-		return x.Caption
+		return x.SharedAt
 	}
 	return ""
 }
 
-type SharePostResponse struct {
+type GetSharedPostsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
-	SharedPostId  int64                  `protobuf:"varint,2,opt,name=shared_post_id,json=sharedPostId,proto3" json:"shared_post_id,omitempty"` // ID of the SharedPost record
+	SharedPosts   []*SharedPostItem      `protobuf:"bytes,1,rep,name=shared_posts,json=sharedPosts,proto3" json:"shared_posts,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SharePostResponse) Reset() {
-	*x = SharePostResponse{}
-	mi := &file_post_proto_msgTypes[41]
+func (x *GetSharedPostsResponse) Reset() {
+	*x = GetSharedPostsResponse{}
+	mi := &file_post_proto_msgTypes[107]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SharePostResponse) String() string {
+func (x *GetSharedPostsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SharePostResponse) ProtoMessage() {}
+func (*GetSharedPostsResponse) ProtoMessage() {}
 
-func (x *SharePostResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[41]
+func (x *GetSharedPostsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[107]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2428,49 +6577,43 @@ func (x *SharePostResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SharePostResponse.ProtoReflect.Descriptor instead.
-func (*SharePostResponse) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{41}
-}
-
-func (x *SharePostResponse) GetMessage() string {
-	if x != nil {
-		return x.Message
-	}
-	return ""
+// Deprecated: Use GetSharedPostsResponse.ProtoReflect.Descriptor instead.
+func (*GetSharedPostsResponse) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{107}
 }
 
-func (x *SharePostResponse) GetSharedPostId() int64 {
+func (x *GetSharedPostsResponse) GetSharedPosts() []*SharedPostItem {
 	if x != nil {
-		return x.SharedPostId
+		return x.SharedPosts
 	}
-	return 0
+	return nil
 }
 
-// --- Unshare Post ---
-type UnsharePostRequest struct {
+// --- Collection Covers, Ordering and Bulk Moves ---
+type SetCollectionCoverRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // From JWT
-	PostId        int64                  `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"` // The post to unshare
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CollectionId  int64                  `protobuf:"varint,2,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	PostId        int64                  `protobuf:"varint,3,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"` // Must be in the collection; 0 goes back to the automatic cover
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UnsharePostRequest) Reset() {
-	*x = UnsharePostRequest{}
-	mi := &file_post_proto_msgTypes[42]
+func (x *SetCollectionCoverRequest) Reset() {
+	*x = SetCollectionCoverRequest{}
+	mi := &file_post_proto_msgTypes[108]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UnsharePostRequest) String() string {
+func (x *SetCollectionCoverRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnsharePostRequest) ProtoMessage() {}
+func (*SetCollectionCoverRequest) ProtoMessage() {}
 
-func (x *UnsharePostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[42]
+func (x *SetCollectionCoverRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[108]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2481,47 +6624,58 @@ func (x *UnsharePostRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnsharePostRequest.ProtoReflect.Descriptor instead.
-func (*UnsharePostRequest) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{42}
+// Deprecated: Use SetCollectionCoverRequest.ProtoReflect.Descriptor instead.
+func (*SetCollectionCoverRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{108}
 }
 
-func (x *UnsharePostRequest) GetUserId() int64 {
+func (x *SetCollectionCoverRequest) GetUserId() int64 {
 	if x != nil {
 		return x.UserId
 	}
 	return 0
 }
 
-func (x *UnsharePostRequest) GetPostId() int64 {
+func (x *SetCollectionCoverRequest) GetCollectionId() int64 {
+	if x != nil {
+		return x.CollectionId
+	}
+	return 0
+}
+
+func (x *SetCollectionCoverRequest) GetPostId() int64 {
 	if x != nil {
 		return x.PostId
 	}
 	return 0
 }
 
-type UnsharePostResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+type ReorderCollectionPostsRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	UserId       int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CollectionId int64                  `protobuf:"varint,2,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	// Saved posts in their new order, first on top. Only these posts move: they
+	// swap places among themselves, so a client can reorder just the page it shows.
+	PostIds       []int64 `protobuf:"varint,3,rep,packed,name=post_ids,json=postIds,proto3" json:"post_ids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UnsharePostResponse) Reset() {
-	*x = UnsharePostResponse{}
-	mi := &file_post_proto_msgTypes[43]
+func (x *ReorderCollectionPostsRequest) Reset() {
+	*x = ReorderCollectionPostsRequest{}
+	mi := &file_post_proto_msgTypes[109]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UnsharePostResponse) String() string {
+func (x *ReorderCollectionPostsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnsharePostResponse) ProtoMessage() {}
+func (*ReorderCollectionPostsRequest) ProtoMessage() {}
 
-func (x *UnsharePostResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[43]
+func (x *ReorderCollectionPostsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[109]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2532,43 +6686,54 @@ func (x *UnsharePostResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnsharePostResponse.ProtoReflect.Descriptor instead.
-func (*UnsharePostResponse) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use ReorderCollectionPostsRequest.ProtoReflect.Descriptor instead.
+func (*ReorderCollectionPostsRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{109}
 }
 
-func (x *UnsharePostResponse) GetMessage() string {
+func (x *ReorderCollectionPostsRequest) GetUserId() int64 {
 	if x != nil {
-		return x.Message
+		return x.UserId
 	}
-	return ""
+	return 0
 }
 
-// --- Get Shared Posts ---
-type GetSharedPostsRequest struct {
+func (x *ReorderCollectionPostsRequest) GetCollectionId() int64 {
+	if x != nil {
+		return x.CollectionId
+	}
+	return 0
+}
+
+func (x *ReorderCollectionPostsRequest) GetPostIds() []int64 {
+	if x != nil {
+		return x.PostIds
+	}
+	return nil
+}
+
+type ReorderCollectionPostsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // Get posts shared by this user
-	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageOffset    int32                  `protobuf:"varint,3,opt,name=page_offset,json=pageOffset,proto3" json:"page_offset,omitempty"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSharedPostsRequest) Reset() {
-	*x = GetSharedPostsRequest{}
-	mi := &file_post_proto_msgTypes[44]
+func (x *ReorderCollectionPostsResponse) Reset() {
+	*x = ReorderCollectionPostsResponse{}
+	mi := &file_post_proto_msgTypes[110]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSharedPostsRequest) String() string {
+func (x *ReorderCollectionPostsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSharedPostsRequest) ProtoMessage() {}
+func (*ReorderCollectionPostsResponse) ProtoMessage() {}
 
-func (x *GetSharedPostsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[44]
+func (x *ReorderCollectionPostsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[110]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2579,58 +6744,44 @@ func (x *GetSharedPostsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSharedPostsRequest.ProtoReflect.Descriptor instead.
-func (*GetSharedPostsRequest) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{44}
-}
-
-func (x *GetSharedPostsRequest) GetUserId() int64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
-}
-
-func (x *GetSharedPostsRequest) GetPageSize() int32 {
-	if x != nil {
-		return x.PageSize
-	}
-	return 0
+// Deprecated: Use ReorderCollectionPostsResponse.ProtoReflect.Descriptor instead.
+func (*ReorderCollectionPostsResponse) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{110}
 }
 
-func (x *GetSharedPostsRequest) GetPageOffset() int32 {
+func (x *ReorderCollectionPostsResponse) GetMessage() string {
 	if x != nil {
-		return x.PageOffset
+		return x.Message
 	}
-	return 0
+	return ""
 }
 
-type SharedPostItem struct {
+type MoveSavedPostsRequest struct {
 	state            protoimpl.MessageState `protogen:"open.v1"`
-	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	OriginalPost     *Post                  `protobuf:"bytes,2,opt,name=original_post,json=originalPost,proto3" json:"original_post,omitempty"`
-	SharedByUsername string                 `protobuf:"bytes,3,opt,name=shared_by_username,json=sharedByUsername,proto3" json:"shared_by_username,omitempty"`
-	SharedCaption    string                 `protobuf:"bytes,4,opt,name=shared_caption,json=sharedCaption,proto3" json:"shared_caption,omitempty"`
-	SharedAt         string                 `protobuf:"bytes,5,opt,name=shared_at,json=sharedAt,proto3" json:"shared_at,omitempty"`
+	UserId           int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	FromCollectionId int64                  `protobuf:"varint,2,opt,name=from_collection_id,json=fromCollectionId,proto3" json:"from_collection_id,omitempty"`
+	ToCollectionId   int64                  `protobuf:"varint,3,opt,name=to_collection_id,json=toCollectionId,proto3" json:"to_collection_id,omitempty"`
+	PostIds          []int64                `protobuf:"varint,4,rep,packed,name=post_ids,json=postIds,proto3" json:"post_ids,omitempty"` // Must all be in the source collection
+	Copy             bool                   `protobuf:"varint,5,opt,name=copy,proto3" json:"copy,omitempty"`                             // Keep the posts in the source collection too
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
 
-func (x *SharedPostItem) Reset() {
-	*x = SharedPostItem{}
-	mi := &file_post_proto_msgTypes[45]
+func (x *MoveSavedPostsRequest) Reset() {
+	*x = MoveSavedPostsRequest{}
+	mi := &file_post_proto_msgTypes[111]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SharedPostItem) String() string {
+func (x *MoveSavedPostsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SharedPostItem) ProtoMessage() {}
+func (*MoveSavedPostsRequest) ProtoMessage() {}
 
-func (x *SharedPostItem) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[45]
+func (x *MoveSavedPostsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[111]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2641,68 +6792,69 @@ func (x *SharedPostItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SharedPostItem.ProtoReflect.Descriptor instead.
-func (*SharedPostItem) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{45}
+// Deprecated: Use MoveSavedPostsRequest.ProtoReflect.Descriptor instead.
+func (*MoveSavedPostsRequest) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{111}
 }
 
-func (x *SharedPostItem) GetId() string {
+func (x *MoveSavedPostsRequest) GetUserId() int64 {
 	if x != nil {
-		return x.Id
+		return x.UserId
 	}
-	return ""
+	return 0
 }
 
-func (x *SharedPostItem) GetOriginalPost() *Post {
+func (x *MoveSavedPostsRequest) GetFromCollectionId() int64 {
 	if x != nil {
-		return x.OriginalPost
+		return x.FromCollectionId
 	}
-	return nil
+	return 0
 }
 
-func (x *SharedPostItem) GetSharedByUsername() string {
+func (x *MoveSavedPostsRequest) GetToCollectionId() int64 {
 	if x != nil {
-		return x.SharedByUsername
+		return x.ToCollectionId
 	}
-	return ""
+	return 0
 }
 
-func (x *SharedPostItem) GetSharedCaption() string {
+func (x *MoveSavedPostsRequest) GetPostIds() []int64 {
 	if x != nil {
-		return x.SharedCaption
+		return x.PostIds
 	}
-	return ""
+	return nil
 }
 
-func (x *SharedPostItem) GetSharedAt() string {
+func (x *MoveSavedPostsRequest) GetCopy() bool {
 	if x != nil {
-		return x.SharedAt
+		return x.Copy
 	}
-	return ""
+	return false
 }
 
-type GetSharedPostsResponse struct {
+type MoveSavedPostsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	SharedPosts   []*SharedPostItem      `protobuf:"bytes,1,rep,name=shared_posts,json=sharedPosts,proto3" json:"shared_posts,omitempty"`
+	Moved         int32                  `protobuf:"varint,1,opt,name=moved,proto3" json:"moved,omitempty"`     // Added to the target collection, on top, in the given order
+	Skipped       int32                  `protobuf:"varint,2,opt,name=skipped,proto3" json:"skipped,omitempty"` // Already in the target collection
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSharedPostsResponse) Reset() {
-	*x = GetSharedPostsResponse{}
-	mi := &file_post_proto_msgTypes[46]
+func (x *MoveSavedPostsResponse) Reset() {
+	*x = MoveSavedPostsResponse{}
+	mi := &file_post_proto_msgTypes[112]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSharedPostsResponse) String() string {
+func (x *MoveSavedPostsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSharedPostsResponse) ProtoMessage() {}
+func (*MoveSavedPostsResponse) ProtoMessage() {}
 
-func (x *GetSharedPostsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_post_proto_msgTypes[46]
+func (x *MoveSavedPostsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_post_proto_msgTypes[112]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2713,16 +6865,23 @@ func (x *GetSharedPostsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSharedPostsResponse.ProtoReflect.Descriptor instead.
-func (*GetSharedPostsResponse) Descriptor() ([]byte, []int) {
-	return file_post_proto_rawDescGZIP(), []int{46}
+// Deprecated: Use MoveSavedPostsResponse.ProtoReflect.Descriptor instead.
+func (*MoveSavedPostsResponse) Descriptor() ([]byte, []int) {
+	return file_post_proto_rawDescGZIP(), []int{112}
 }
 
-func (x *GetSharedPostsResponse) GetSharedPosts() []*SharedPostItem {
+func (x *MoveSavedPostsResponse) GetMoved() int32 {
 	if x != nil {
-		return x.SharedPosts
+		return x.Moved
 	}
-	return nil
+	return 0
+}
+
+func (x *MoveSavedPostsResponse) GetSkipped() int32 {
+	if x != nil {
+		return x.Skipped
+	}
+	return 0
 }
 
 var File_post_proto protoreflect.FileDescriptor
@@ -2731,7 +6890,7 @@ const file_post_proto_rawDesc = "" +
 	"\n" +
 	"\n" +
 	"post.proto\x12\x04post\x1a\n" +
-	"user.proto\"\x9b\x02\n" +
+	"user.proto\"\xee\x02\n" +
 	"\x11CreatePostRequest\x12\x1b\n" +
 	"\tauthor_id\x18\x01 \x01(\x03R\bauthorId\x12\x18\n" +
 	"\acaption\x18\x02 \x01(\tR\acaption\x12\x1d\n" +
@@ -2741,7 +6900,10 @@ const file_post_proto_rawDesc = "" +
 	"\ais_reel\x18\x05 \x01(\bR\x06isReel\x12)\n" +
 	"\x10collaborator_ids\x18\x06 \x03(\x03R\x0fcollaboratorIds\x12\x1a\n" +
 	"\blocation\x18\b \x01(\tR\blocation\x12#\n" +
-	"\rthumbnail_url\x18\a \x01(\tR\fthumbnailUrl\"\xb2\x04\n" +
+	"\rthumbnail_url\x18\a \x01(\tR\fthumbnailUrl\x12)\n" +
+	"\x10comment_audience\x18\t \x01(\tR\x0fcommentAudience\x12&\n" +
+	"\x0fhide_like_count\x18\n" +
+	" \x01(\bR\rhideLikeCount\"\xc7\x05\n" +
 	"\x04Post\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
 	"\tauthor_id\x18\x02 \x01(\x03R\bauthorId\x12\x18\n" +
@@ -2764,7 +6926,13 @@ const file_post_proto_rawDesc = "" +
 	"shareCount\x12\x19\n" +
 	"\bis_liked\x18\x0f \x01(\bR\aisLiked\x12\x19\n" +
 	"\bis_saved\x18\x10 \x01(\bR\aisSaved\x12\x1a\n" +
-	"\blocation\x18\x11 \x01(\tR\blocation\"4\n" +
+	"\blocation\x18\x11 \x01(\tR\blocation\x12)\n" +
+	"\x10comment_audience\x18\x12 \x01(\tR\x0fcommentAudience\x12\x1f\n" +
+	"\vcan_comment\x18\x13 \x01(\bR\n" +
+	"canComment\x12&\n" +
+	"\x0fhide_like_count\x18\x14 \x01(\bR\rhideLikeCount\x12\x1f\n" +
+	"\vis_archived\x18\x15 \x01(\bR\n" +
+	"isArchived\"4\n" +
 	"\x12CreatePostResponse\x12\x1e\n" +
 	"\x04post\x18\x01 \x01(\v2\n" +
 	".post.PostR\x04post\"C\n" +
@@ -2777,12 +6945,40 @@ const file_post_proto_rawDesc = "" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x17\n" +
 	"\apost_id\x18\x02 \x01(\x03R\x06postId\".\n" +
 	"\x12UnlikePostResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"\x81\x01\n" +
+	"\x14GetPostLikersRequest\x12\x17\n" +
+	"\apost_id\x18\x01 \x01(\x03R\x06postId\x12\x1b\n" +
+	"\tviewer_id\x18\x02 \x01(\x03R\bviewerId\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12\x16\n" +
+	"\x06cursor\x18\x04 \x01(\tR\x06cursor\"\x88\x02\n" +
+	"\tPostLiker\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12.\n" +
+	"\x13profile_picture_url\x18\x04 \x01(\tR\x11profilePictureUrl\x12\x1f\n" +
+	"\vis_verified\x18\x05 \x01(\bR\n" +
+	"isVerified\x12!\n" +
+	"\fis_following\x18\x06 \x01(\bR\visFollowing\x12#\n" +
+	"\rfollow_status\x18\a \x01(\tR\ffollowStatus\x12\x19\n" +
+	"\bliked_at\x18\b \x01(\tR\alikedAt\"\xac\x01\n" +
+	"\x15GetPostLikersResponse\x12'\n" +
+	"\x06likers\x18\x01 \x03(\v2\x0f.post.PostLikerR\x06likers\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\x12\x1d\n" +
+	"\n" +
+	"like_count\x18\x03 \x01(\x03R\tlikeCount\x12*\n" +
+	"\x11like_count_hidden\x18\x04 \x01(\bR\x0flikeCountHidden\"c\n" +
+	"\x17SetHideLikeCountRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x17\n" +
+	"\apost_id\x18\x02 \x01(\x03R\x06postId\x12\x16\n" +
+	"\x06hidden\x18\x03 \x01(\bR\x06hidden\"4\n" +
+	"\x18SetHideLikeCountResponse\x12\x18\n" +
 	"\amessage\x18\x01 \x01(\tR\amessage\"\x8e\x01\n" +
 	"\x14CommentOnPostRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x17\n" +
 	"\apost_id\x18\x02 \x01(\x03R\x06postId\x12\x18\n" +
 	"\acontent\x18\x03 \x01(\tR\acontent\x12*\n" +
-	"\x11parent_comment_id\x18\x04 \x01(\x03R\x0fparentCommentId\"\x98\x03\n" +
+	"\x11parent_comment_id\x18\x04 \x01(\x03R\x0fparentCommentId\"\xef\x03\n" +
 	"\x0fCommentResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
 	"\acontent\x18\x02 \x01(\tR\acontent\x12'\n" +
@@ -2799,12 +6995,41 @@ const file_post_proto_rawDesc = "" +
 	" \x01(\bR\aisLiked\x12\x1f\n" +
 	"\vreply_count\x18\v \x01(\x03R\n" +
 	"replyCount\x12,\n" +
-	"\x12author_is_verified\x18\f \x01(\bR\x10authorIsVerified\"N\n" +
+	"\x12author_is_verified\x18\f \x01(\bR\x10authorIsVerified\x12\x1b\n" +
+	"\tis_edited\x18\r \x01(\bR\bisEdited\x12\x1b\n" +
+	"\tis_pinned\x18\x0e \x01(\bR\bisPinned\x12\x1b\n" +
+	"\tis_hidden\x18\x0f \x01(\bR\bisHidden\"N\n" +
 	"\x14DeleteCommentRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1d\n" +
 	"\n" +
 	"comment_id\x18\x02 \x01(\x03R\tcommentId\"1\n" +
 	"\x15DeleteCommentResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"l\n" +
+	"\x1cUpdateCommentAudienceRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x17\n" +
+	"\apost_id\x18\x02 \x01(\x03R\x06postId\x12\x1a\n" +
+	"\baudience\x18\x03 \x01(\tR\baudience\"d\n" +
+	"\x1dUpdateCommentAudienceResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12)\n" +
+	"\x10comment_audience\x18\x02 \x01(\tR\x0fcommentAudience\"f\n" +
+	"\x12EditCommentRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1d\n" +
+	"\n" +
+	"comment_id\x18\x02 \x01(\x03R\tcommentId\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\tR\acontent\"c\n" +
+	"\x11PinCommentRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1d\n" +
+	"\n" +
+	"comment_id\x18\x02 \x01(\x03R\tcommentId\x12\x16\n" +
+	"\x06pinned\x18\x03 \x01(\bR\x06pinned\".\n" +
+	"\x12PinCommentResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"d\n" +
+	"\x12HideCommentRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1d\n" +
+	"\n" +
+	"comment_id\x18\x02 \x01(\x03R\tcommentId\x12\x16\n" +
+	"\x06hidden\x18\x03 \x01(\bR\x06hidden\"/\n" +
+	"\x13HideCommentResponse\x12\x18\n" +
 	"\amessage\x18\x01 \x01(\tR\amessage\"L\n" +
 	"\x12LikeCommentRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1d\n" +
@@ -2813,55 +7038,195 @@ const file_post_proto_rawDesc = "" +
 	"\x13LikeCommentResponse\x12\x18\n" +
 	"\amessage\x18\x01 \x01(\tR\amessage\"1\n" +
 	"\x15UnlikeCommentResponse\x12\x18\n" +
-	"\amessage\x18\x01 \x01(\tR\amessage\"q\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"\xe1\x01\n" +
 	"\x18GetCommentsByPostRequest\x12\x17\n" +
 	"\apost_id\x18\x01 \x01(\x03R\x06postId\x12\x1b\n" +
 	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1f\n" +
 	"\vpage_offset\x18\x03 \x01(\x05R\n" +
-	"pageOffset\"N\n" +
+	"pageOffset\x12\x1b\n" +
+	"\tviewer_id\x18\x04 \x01(\x03R\bviewerId\x12\x16\n" +
+	"\x06cursor\x18\x05 \x01(\tR\x06cursor\x12\x12\n" +
+	"\x04sort\x18\x06 \x01(\tR\x04sort\x12%\n" +
+	"\x0einclude_hidden\x18\a \x01(\bR\rincludeHidden\"o\n" +
 	"\x19GetCommentsByPostResponse\x121\n" +
-	"\bcomments\x18\x01 \x03(\v2\x15.post.CommentResponseR\bcomments\"k\n" +
+	"\bcomments\x18\x01 \x03(\v2\x15.post.CommentResponseR\bcomments\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"\x8b\x01\n" +
+	"\x18GetCommentRepliesRequest\x12\x1d\n" +
+	"\n" +
+	"comment_id\x18\x01 \x01(\x03R\tcommentId\x12\x1b\n" +
+	"\tviewer_id\x18\x02 \x01(\x03R\bviewerId\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12\x16\n" +
+	"\x06cursor\x18\x04 \x01(\tR\x06cursor\"\x97\x01\n" +
 	"\x12GetHomeFeedRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1b\n" +
 	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1f\n" +
 	"\vpage_offset\x18\x03 \x01(\x05R\n" +
-	"pageOffset\"7\n" +
+	"pageOffset\x12\x16\n" +
+	"\x06cursor\x18\x04 \x01(\tR\x06cursor\x12\x12\n" +
+	"\x04sort\x18\x05 \x01(\tR\x04sort\"X\n" +
 	"\x13GetHomeFeedResponse\x12 \n" +
 	"\x05posts\x18\x01 \x03(\v2\n" +
-	".post.PostR\x05posts\"\x91\x01\n" +
+	".post.PostR\x05posts\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\",\n" +
+	"\x11FanOutPostRequest\x12\x17\n" +
+	"\apost_id\x18\x01 \x01(\x03R\x06postId\"-\n" +
+	"\x12RetractPostRequest\x12\x17\n" +
+	"\apost_id\x18\x01 \x01(\x03R\x06postId\"o\n" +
+	"\x19SyncTimelineAuthorRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1b\n" +
+	"\tauthor_id\x18\x02 \x01(\x03R\bauthorId\x12\x1c\n" +
+	"\tfollowing\x18\x03 \x01(\bR\tfollowing\"E\n" +
+	"\x16TimelineUpdateResponse\x12+\n" +
+	"\x11timelines_updated\x18\x01 \x01(\x05R\x10timelinesUpdated\"\xd8\x01\n" +
+	"\x16RecordReelWatchRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x17\n" +
+	"\apost_id\x18\x02 \x01(\x03R\x06postId\x12\x19\n" +
+	"\bwatch_ms\x18\x03 \x01(\x03R\awatchMs\x12\x1f\n" +
+	"\vduration_ms\x18\x04 \x01(\x03R\n" +
+	"durationMs\x12\x1c\n" +
+	"\tcompleted\x18\x05 \x01(\bR\tcompleted\x12\x18\n" +
+	"\areplays\x18\x06 \x01(\x05R\areplays\x12\x18\n" +
+	"\askipped\x18\a \x01(\bR\askipped\"3\n" +
+	"\x17RecordReelWatchResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"\x97\x01\n" +
+	"\x18MarkNotInterestedRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x17\n" +
+	"\apost_id\x18\x03 \x01(\x03R\x06postId\x12\x1b\n" +
+	"\tauthor_id\x18\x04 \x01(\x03R\bauthorId\x12\x18\n" +
+	"\ahashtag\x18\x05 \x01(\tR\ahashtag\"\xd1\x01\n" +
+	"\x13NotInterestedSignal\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x17\n" +
+	"\apost_id\x18\x03 \x01(\x03R\x06postId\x12\x1b\n" +
+	"\tauthor_id\x18\x04 \x01(\x03R\bauthorId\x12'\n" +
+	"\x0fauthor_username\x18\x05 \x01(\tR\x0eauthorUsername\x12\x18\n" +
+	"\ahashtag\x18\x06 \x01(\tR\ahashtag\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\tR\tcreatedAt\"g\n" +
+	"\x17GetNotInterestedRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x16\n" +
+	"\x06cursor\x18\x03 \x01(\tR\x06cursor\"p\n" +
+	"\x18GetNotInterestedResponse\x123\n" +
+	"\asignals\x18\x01 \x03(\v2\x19.post.NotInterestedSignalR\asignals\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"P\n" +
+	"\x18UndoNotInterestedRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1b\n" +
+	"\tsignal_id\x18\x02 \x01(\x03R\bsignalId\"5\n" +
+	"\x19UndoNotInterestedResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"h\n" +
+	"\x18RecordImpressionsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x18\n" +
+	"\asurface\x18\x02 \x01(\tR\asurface\x12\x19\n" +
+	"\bpost_ids\x18\x03 \x03(\x03R\apostIds\"7\n" +
+	"\x19RecordImpressionsResponse\x12\x1a\n" +
+	"\brecorded\x18\x01 \x01(\x05R\brecorded\"M\n" +
+	"\x19RecordProfileVisitRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x17\n" +
+	"\apost_id\x18\x02 \x01(\x03R\x06postId\"6\n" +
+	"\x1aRecordProfileVisitResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"\xee\x01\n" +
+	"\n" +
+	"InsightDay\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12 \n" +
+	"\vimpressions\x18\x02 \x01(\x03R\vimpressions\x12\x14\n" +
+	"\x05reach\x18\x03 \x01(\x03R\x05reach\x12%\n" +
+	"\x0eprofile_visits\x18\x04 \x01(\x03R\rprofileVisits\x12\x14\n" +
+	"\x05likes\x18\x05 \x01(\x03R\x05likes\x12\x14\n" +
+	"\x05saves\x18\x06 \x01(\x03R\x05saves\x12\x16\n" +
+	"\x06shares\x18\a \x01(\x03R\x06shares\x12)\n" +
+	"\x10followers_gained\x18\b \x01(\x03R\x0ffollowersGained\"^\n" +
+	"\x16GetPostInsightsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x17\n" +
+	"\apost_id\x18\x02 \x01(\x03R\x06postId\x12\x12\n" +
+	"\x04days\x18\x03 \x01(\x05R\x04days\"\xb9\x03\n" +
+	"\fPostInsights\x12\x17\n" +
+	"\apost_id\x18\x01 \x01(\x03R\x06postId\x12 \n" +
+	"\vimpressions\x18\x02 \x01(\x03R\vimpressions\x12\x14\n" +
+	"\x05reach\x18\x03 \x01(\x03R\x05reach\x12%\n" +
+	"\x0eprofile_visits\x18\x04 \x01(\x03R\rprofileVisits\x12\x14\n" +
+	"\x05likes\x18\x05 \x01(\x03R\x05likes\x12\x1a\n" +
+	"\bcomments\x18\x06 \x01(\x03R\bcomments\x12\x14\n" +
+	"\x05saves\x18\a \x01(\x03R\x05saves\x12\x16\n" +
+	"\x06shares\x18\b \x01(\x03R\x06shares\x12b\n" +
+	"\x16impressions_by_surface\x18\t \x03(\v2,.post.PostInsights.ImpressionsBySurfaceEntryR\x14impressionsBySurface\x12$\n" +
+	"\x04days\x18\n" +
+	" \x03(\v2\x10.post.InsightDayR\x04days\x1aG\n" +
+	"\x19ImpressionsBySurfaceEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\"H\n" +
+	"\x19GetAccountInsightsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x12\n" +
+	"\x04days\x18\x02 \x01(\x05R\x04days\"k\n" +
+	"\aTopPost\x12\x1e\n" +
+	"\x04post\x18\x01 \x01(\v2\n" +
+	".post.PostR\x04post\x12 \n" +
+	"\vimpressions\x18\x02 \x01(\x03R\vimpressions\x12\x1e\n" +
+	"\n" +
+	"engagement\x18\x03 \x01(\x03R\n" +
+	"engagement\"\xd8\x02\n" +
+	"\x0fAccountInsights\x12%\n" +
+	"\x0efollower_count\x18\x01 \x01(\x03R\rfollowerCount\x12)\n" +
+	"\x10followers_gained\x18\x02 \x01(\x03R\x0ffollowersGained\x12 \n" +
+	"\vimpressions\x18\x03 \x01(\x03R\vimpressions\x12\x14\n" +
+	"\x05reach\x18\x04 \x01(\x03R\x05reach\x12%\n" +
+	"\x0eprofile_visits\x18\x05 \x01(\x03R\rprofileVisits\x12\x14\n" +
+	"\x05likes\x18\x06 \x01(\x03R\x05likes\x12\x14\n" +
+	"\x05saves\x18\a \x01(\x03R\x05saves\x12\x16\n" +
+	"\x06shares\x18\b \x01(\x03R\x06shares\x12$\n" +
+	"\x04days\x18\t \x03(\v2\x10.post.InsightDayR\x04days\x12*\n" +
+	"\ttop_posts\x18\n" +
+	" \x03(\v2\r.post.TopPostR\btopPosts\"\xa9\x01\n" +
 	"\x15GetUserContentRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1b\n" +
 	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1f\n" +
 	"\vpage_offset\x18\x03 \x01(\x05R\n" +
 	"pageOffset\x12!\n" +
-	"\frequester_id\x18\x04 \x01(\x03R\vrequesterId\"5\n" +
+	"\frequester_id\x18\x04 \x01(\x03R\vrequesterId\x12\x16\n" +
+	"\x06cursor\x18\x05 \x01(\tR\x06cursor\"5\n" +
 	"\x1aGetUserContentCountRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\"[\n" +
 	"\x1bGetUserContentCountResponse\x12\x1d\n" +
 	"\n" +
 	"post_count\x18\x01 \x01(\x03R\tpostCount\x12\x1d\n" +
 	"\n" +
-	"reel_count\x18\x02 \x01(\x03R\treelCount\"h\n" +
+	"reel_count\x18\x02 \x01(\x03R\treelCount\"\xb1\x02\n" +
 	"\n" +
 	"Collection\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x12\n" +
 	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1d\n" +
 	"\n" +
-	"is_default\x18\x04 \x01(\bR\tisDefault\"F\n" +
+	"is_default\x18\x04 \x01(\bR\tisDefault\x12\x18\n" +
+	"\aprivacy\x18\x05 \x01(\tR\aprivacy\x12\x1f\n" +
+	"\vshare_token\x18\x06 \x01(\tR\n" +
+	"shareToken\x12\x1f\n" +
+	"\vviewer_role\x18\a \x01(\tR\n" +
+	"viewerRole\x12\"\n" +
+	"\rcover_post_id\x18\b \x01(\x03R\vcoverPostId\x12(\n" +
+	"\x10cover_image_urls\x18\t \x03(\tR\x0ecoverImageUrls\x12\x1d\n" +
+	"\n" +
+	"post_count\x18\n" +
+	" \x01(\x03R\tpostCount\"F\n" +
 	"\x17CreateCollectionRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\"4\n" +
 	"\x19GetUserCollectionsRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\"P\n" +
 	"\x1aGetUserCollectionsResponse\x122\n" +
-	"\vcollections\x18\x01 \x03(\v2\x10.post.CollectionR\vcollections\"\x99\x01\n" +
+	"\vcollections\x18\x01 \x03(\v2\x10.post.CollectionR\vcollections\"\xba\x01\n" +
 	"\x1bGetPostsInCollectionRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12#\n" +
 	"\rcollection_id\x18\x02 \x01(\x03R\fcollectionId\x12\x1b\n" +
 	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12\x1f\n" +
 	"\vpage_offset\x18\x04 \x01(\x05R\n" +
-	"pageOffset\"P\n" +
+	"pageOffset\x12\x1f\n" +
+	"\vshare_token\x18\x05 \x01(\tR\n" +
+	"shareToken\"P\n" +
 	"\x1cGetCollectionsForPostRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x17\n" +
 	"\apost_id\x18\x02 \x01(\x03R\x06postId\"F\n" +
@@ -2887,7 +7252,62 @@ const file_post_proto_rawDesc = "" +
 	"\x17RenameCollectionRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12#\n" +
 	"\rcollection_id\x18\x02 \x01(\x03R\fcollectionId\x12\x19\n" +
-	"\bnew_name\x18\x03 \x01(\tR\anewName\"F\n" +
+	"\bnew_name\x18\x03 \x01(\tR\anewName\"u\n" +
+	"\x14GetCollectionRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12#\n" +
+	"\rcollection_id\x18\x02 \x01(\x03R\fcollectionId\x12\x1f\n" +
+	"\vshare_token\x18\x03 \x01(\tR\n" +
+	"shareToken\"\x9f\x01\n" +
+	"\x1bSetCollectionPrivacyRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12#\n" +
+	"\rcollection_id\x18\x02 \x01(\x03R\fcollectionId\x12\x18\n" +
+	"\aprivacy\x18\x03 \x01(\tR\aprivacy\x12(\n" +
+	"\x10reset_share_link\x18\x04 \x01(\bR\x0eresetShareLink\"\xba\x01\n" +
+	"\x10CollectionMember\x12#\n" +
+	"\rcollection_id\x18\x01 \x01(\tR\fcollectionId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"invited_by\x18\x05 \x01(\x03R\tinvitedBy\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\tR\tcreatedAt\"\x90\x01\n" +
+	"\x1dInviteCollectionMemberRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12#\n" +
+	"\rcollection_id\x18\x02 \x01(\x03R\fcollectionId\x12\x1d\n" +
+	"\n" +
+	"invitee_id\x18\x03 \x01(\x03R\tinviteeId\x12\x12\n" +
+	"\x04role\x18\x04 \x01(\tR\x04role\"x\n" +
+	" RespondToCollectionInviteRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12#\n" +
+	"\rcollection_id\x18\x02 \x01(\x03R\fcollectionId\x12\x16\n" +
+	"\x06accept\x18\x03 \x01(\bR\x06accept\"=\n" +
+	"!RespondToCollectionInviteResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"6\n" +
+	"\x1bGetCollectionInvitesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\"\x96\x01\n" +
+	"\x10CollectionInvite\x120\n" +
+	"\n" +
+	"collection\x18\x01 \x01(\v2\x10.post.CollectionR\n" +
+	"collection\x12\x12\n" +
+	"\x04role\x18\x02 \x01(\tR\x04role\x12\x1d\n" +
+	"\n" +
+	"invited_by\x18\x03 \x01(\x03R\tinvitedBy\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\tR\tcreatedAt\"P\n" +
+	"\x1cGetCollectionInvitesResponse\x120\n" +
+	"\ainvites\x18\x01 \x03(\v2\x16.post.CollectionInviteR\ainvites\"[\n" +
+	"\x1bGetCollectionMembersRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12#\n" +
+	"\rcollection_id\x18\x02 \x01(\x03R\fcollectionId\"P\n" +
+	"\x1cGetCollectionMembersResponse\x120\n" +
+	"\amembers\x18\x01 \x03(\v2\x16.post.CollectionMemberR\amembers\"z\n" +
+	"\x1dRemoveCollectionMemberRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12#\n" +
+	"\rcollection_id\x18\x02 \x01(\x03R\fcollectionId\x12\x1b\n" +
+	"\tmember_id\x18\x03 \x01(\x03R\bmemberId\":\n" +
+	"\x1eRemoveCollectionMemberResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"F\n" +
 	"\x0eGetPostRequest\x12\x17\n" +
 	"\apost_id\x18\x01 \x01(\x03R\x06postId\x12\x1b\n" +
 	"\tviewer_id\x18\x02 \x01(\x03R\bviewerId\",\n" +
@@ -2900,14 +7320,62 @@ const file_post_proto_rawDesc = "" +
 	"\apost_id\x18\x01 \x01(\x03R\x06postId\x12\"\n" +
 	"\radmin_user_id\x18\x02 \x01(\x03R\vadminUserId\".\n" +
 	"\x12DeletePostResponse\x12\x18\n" +
-	"\amessage\x18\x01 \x01(\tR\amessage\"^\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"F\n" +
+	"\x12RestorePostRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x17\n" +
+	"\apost_id\x18\x02 \x01(\x03R\x06postId\"/\n" +
+	"\x13RestorePostResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"i\n" +
+	"\x19GetRecentlyDeletedRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x16\n" +
+	"\x06cursor\x18\x03 \x01(\tR\x06cursor\"g\n" +
+	"\vDeletedPost\x12\x1e\n" +
+	"\x04post\x18\x01 \x01(\v2\n" +
+	".post.PostR\x04post\x12\x1d\n" +
+	"\n" +
+	"deleted_at\x18\x02 \x01(\tR\tdeletedAt\x12\x19\n" +
+	"\bpurge_at\x18\x03 \x01(\tR\apurgeAt\"f\n" +
+	"\x1aGetRecentlyDeletedResponse\x12'\n" +
+	"\x05posts\x18\x01 \x03(\v2\x11.post.DeletedPostR\x05posts\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"F\n" +
+	"\x12ArchivePostRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x17\n" +
+	"\apost_id\x18\x02 \x01(\x03R\x06postId\"/\n" +
+	"\x13ArchivePostResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"1\n" +
+	"\x15UnarchivePostResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"g\n" +
+	"\x17GetArchivedPostsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x16\n" +
+	"\x06cursor\x18\x03 \x01(\tR\x06cursor\"]\n" +
+	"\x18GetArchivedPostsResponse\x12 \n" +
+	"\x05posts\x18\x01 \x03(\v2\n" +
+	".post.PostR\x05posts\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"^\n" +
 	"\x10SharePostRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x17\n" +
 	"\apost_id\x18\x02 \x01(\x03R\x06postId\x12\x18\n" +
 	"\acaption\x18\x03 \x01(\tR\acaption\"S\n" +
 	"\x11SharePostResponse\x12\x18\n" +
 	"\amessage\x18\x01 \x01(\tR\amessage\x12$\n" +
-	"\x0eshared_post_id\x18\x02 \x01(\x03R\fsharedPostId\"F\n" +
+	"\x0eshared_post_id\x18\x02 \x01(\x03R\fsharedPostId\"\xb5\x01\n" +
+	"\x17SendPostToDirectRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x17\n" +
+	"\apost_id\x18\x02 \x01(\x03R\x06postId\x12#\n" +
+	"\rrecipient_ids\x18\x03 \x03(\x03R\frecipientIds\x12)\n" +
+	"\x10conversation_ids\x18\x04 \x03(\tR\x0fconversationIds\x12\x18\n" +
+	"\amessage\x18\x05 \x01(\tR\amessage\"\x88\x01\n" +
+	"\x10DirectSendResult\x12!\n" +
+	"\frecipient_id\x18\x01 \x01(\x03R\vrecipientId\x12'\n" +
+	"\x0fconversation_id\x18\x02 \x01(\tR\x0econversationId\x12\x12\n" +
+	"\x04sent\x18\x03 \x01(\bR\x04sent\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\"L\n" +
+	"\x18SendPostToDirectResponse\x120\n" +
+	"\aresults\x18\x01 \x03(\v2\x16.post.DirectSendResultR\aresults\"F\n" +
 	"\x12UnsharePostRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x17\n" +
 	"\apost_id\x18\x02 \x01(\x03R\x06postId\"/\n" +
@@ -2926,21 +7394,56 @@ const file_post_proto_rawDesc = "" +
 	"\x0eshared_caption\x18\x04 \x01(\tR\rsharedCaption\x12\x1b\n" +
 	"\tshared_at\x18\x05 \x01(\tR\bsharedAt\"Q\n" +
 	"\x16GetSharedPostsResponse\x127\n" +
-	"\fshared_posts\x18\x01 \x03(\v2\x14.post.SharedPostItemR\vsharedPosts2\xf2\x10\n" +
+	"\fshared_posts\x18\x01 \x03(\v2\x14.post.SharedPostItemR\vsharedPosts\"r\n" +
+	"\x19SetCollectionCoverRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12#\n" +
+	"\rcollection_id\x18\x02 \x01(\x03R\fcollectionId\x12\x17\n" +
+	"\apost_id\x18\x03 \x01(\x03R\x06postId\"x\n" +
+	"\x1dReorderCollectionPostsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12#\n" +
+	"\rcollection_id\x18\x02 \x01(\x03R\fcollectionId\x12\x19\n" +
+	"\bpost_ids\x18\x03 \x03(\x03R\apostIds\":\n" +
+	"\x1eReorderCollectionPostsResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"\xb7\x01\n" +
+	"\x15MoveSavedPostsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12,\n" +
+	"\x12from_collection_id\x18\x02 \x01(\x03R\x10fromCollectionId\x12(\n" +
+	"\x10to_collection_id\x18\x03 \x01(\x03R\x0etoCollectionId\x12\x19\n" +
+	"\bpost_ids\x18\x04 \x03(\x03R\apostIds\x12\x12\n" +
+	"\x04copy\x18\x05 \x01(\bR\x04copy\"H\n" +
+	"\x16MoveSavedPostsResponse\x12\x14\n" +
+	"\x05moved\x18\x01 \x01(\x05R\x05moved\x12\x18\n" +
+	"\askipped\x18\x02 \x01(\x05R\askipped2\xb6&\n" +
 	"\vPostService\x12?\n" +
 	"\n" +
 	"CreatePost\x12\x17.post.CreatePostRequest\x1a\x18.post.CreatePostResponse\x129\n" +
 	"\bLikePost\x12\x15.post.LikePostRequest\x1a\x16.post.LikePostResponse\x12=\n" +
 	"\n" +
-	"UnlikePost\x12\x15.post.LikePostRequest\x1a\x18.post.UnlikePostResponse\x12B\n" +
+	"UnlikePost\x12\x15.post.LikePostRequest\x1a\x18.post.UnlikePostResponse\x12H\n" +
+	"\rGetPostLikers\x12\x1a.post.GetPostLikersRequest\x1a\x1b.post.GetPostLikersResponse\x12Q\n" +
+	"\x10SetHideLikeCount\x12\x1d.post.SetHideLikeCountRequest\x1a\x1e.post.SetHideLikeCountResponse\x12B\n" +
 	"\rCommentOnPost\x12\x1a.post.CommentOnPostRequest\x1a\x15.post.CommentResponse\x12T\n" +
-	"\x11GetCommentsByPost\x12\x1e.post.GetCommentsByPostRequest\x1a\x1f.post.GetCommentsByPostResponse\x12H\n" +
-	"\rDeleteComment\x12\x1a.post.DeleteCommentRequest\x1a\x1b.post.DeleteCommentResponse\x12B\n" +
+	"\x11GetCommentsByPost\x12\x1e.post.GetCommentsByPostRequest\x1a\x1f.post.GetCommentsByPostResponse\x12T\n" +
+	"\x11GetCommentReplies\x12\x1e.post.GetCommentRepliesRequest\x1a\x1f.post.GetCommentsByPostResponse\x12H\n" +
+	"\rDeleteComment\x12\x1a.post.DeleteCommentRequest\x1a\x1b.post.DeleteCommentResponse\x12`\n" +
+	"\x15UpdateCommentAudience\x12\".post.UpdateCommentAudienceRequest\x1a#.post.UpdateCommentAudienceResponse\x12>\n" +
+	"\vEditComment\x12\x18.post.EditCommentRequest\x1a\x15.post.CommentResponse\x12?\n" +
+	"\n" +
+	"PinComment\x12\x17.post.PinCommentRequest\x1a\x18.post.PinCommentResponse\x12B\n" +
+	"\vHideComment\x12\x18.post.HideCommentRequest\x1a\x19.post.HideCommentResponse\x12B\n" +
 	"\vLikeComment\x12\x18.post.LikeCommentRequest\x1a\x19.post.LikeCommentResponse\x12F\n" +
 	"\rUnlikeComment\x12\x18.post.LikeCommentRequest\x1a\x1b.post.UnlikeCommentResponse\x12B\n" +
-	"\vGetHomeFeed\x12\x18.post.GetHomeFeedRequest\x1a\x19.post.GetHomeFeedResponse\x12E\n" +
+	"\vGetHomeFeed\x12\x18.post.GetHomeFeedRequest\x1a\x19.post.GetHomeFeedResponse\x12C\n" +
+	"\n" +
+	"FanOutPost\x12\x17.post.FanOutPostRequest\x1a\x1c.post.TimelineUpdateResponse\x12E\n" +
+	"\vRetractPost\x12\x18.post.RetractPostRequest\x1a\x1c.post.TimelineUpdateResponse\x12S\n" +
+	"\x12SyncTimelineAuthor\x12\x1f.post.SyncTimelineAuthorRequest\x1a\x1c.post.TimelineUpdateResponse\x12E\n" +
 	"\x0eGetExploreFeed\x12\x18.post.GetHomeFeedRequest\x1a\x19.post.GetHomeFeedResponse\x12C\n" +
-	"\fGetReelsFeed\x12\x18.post.GetHomeFeedRequest\x1a\x19.post.GetHomeFeedResponse\x12F\n" +
+	"\fGetReelsFeed\x12\x18.post.GetHomeFeedRequest\x1a\x19.post.GetHomeFeedResponse\x12N\n" +
+	"\x0fRecordReelWatch\x12\x1c.post.RecordReelWatchRequest\x1a\x1d.post.RecordReelWatchResponse\x12N\n" +
+	"\x11MarkNotInterested\x12\x1e.post.MarkNotInterestedRequest\x1a\x19.post.NotInterestedSignal\x12Q\n" +
+	"\x10GetNotInterested\x12\x1d.post.GetNotInterestedRequest\x1a\x1e.post.GetNotInterestedResponse\x12T\n" +
+	"\x11UndoNotInterested\x12\x1e.post.UndoNotInterestedRequest\x1a\x1f.post.UndoNotInterestedResponse\x12F\n" +
 	"\fGetUserPosts\x12\x1b.post.GetUserContentRequest\x1a\x19.post.GetHomeFeedResponse\x12F\n" +
 	"\fGetUserReels\x12\x1b.post.GetUserContentRequest\x1a\x19.post.GetHomeFeedResponse\x12Z\n" +
 	"\x13GetUserContentCount\x12 .post.GetUserContentCountRequest\x1a!.post.GetUserContentCountResponse\x12C\n" +
@@ -2951,16 +7454,36 @@ const file_post_proto_rawDesc = "" +
 	"\x14SavePostToCollection\x12!.post.SavePostToCollectionRequest\x1a\".post.SavePostToCollectionResponse\x12i\n" +
 	"\x18UnsavePostFromCollection\x12%.post.UnsavePostFromCollectionRequest\x1a&.post.UnsavePostFromCollectionResponse\x12Q\n" +
 	"\x10DeleteCollection\x12\x1d.post.DeleteCollectionRequest\x1a\x1e.post.DeleteCollectionResponse\x12C\n" +
-	"\x10RenameCollection\x12\x1d.post.RenameCollectionRequest\x1a\x10.post.Collection\x12+\n" +
+	"\x10RenameCollection\x12\x1d.post.RenameCollectionRequest\x1a\x10.post.Collection\x12=\n" +
+	"\rGetCollection\x12\x1a.post.GetCollectionRequest\x1a\x10.post.Collection\x12K\n" +
+	"\x14SetCollectionPrivacy\x12!.post.SetCollectionPrivacyRequest\x1a\x10.post.Collection\x12U\n" +
+	"\x16InviteCollectionMember\x12#.post.InviteCollectionMemberRequest\x1a\x16.post.CollectionMember\x12l\n" +
+	"\x19RespondToCollectionInvite\x12&.post.RespondToCollectionInviteRequest\x1a'.post.RespondToCollectionInviteResponse\x12]\n" +
+	"\x14GetCollectionInvites\x12!.post.GetCollectionInvitesRequest\x1a\".post.GetCollectionInvitesResponse\x12]\n" +
+	"\x14GetCollectionMembers\x12!.post.GetCollectionMembersRequest\x1a\".post.GetCollectionMembersResponse\x12c\n" +
+	"\x16RemoveCollectionMember\x12#.post.RemoveCollectionMemberRequest\x1a$.post.RemoveCollectionMemberResponse\x12G\n" +
+	"\x12SetCollectionCover\x12\x1f.post.SetCollectionCoverRequest\x1a\x10.post.Collection\x12c\n" +
+	"\x16ReorderCollectionPosts\x12#.post.ReorderCollectionPostsRequest\x1a$.post.ReorderCollectionPostsResponse\x12K\n" +
+	"\x0eMoveSavedPosts\x12\x1b.post.MoveSavedPostsRequest\x1a\x1c.post.MoveSavedPostsResponse\x12+\n" +
 	"\aGetPost\x12\x14.post.GetPostRequest\x1a\n" +
 	".post.Post\x129\n" +
 	"\bGetPosts\x12\x15.post.GetPostsRequest\x1a\x16.post.GetPostsResponse\x12?\n" +
 	"\n" +
-	"DeletePost\x12\x17.post.DeletePostRequest\x1a\x18.post.DeletePostResponse\x12<\n" +
-	"\tSharePost\x12\x16.post.SharePostRequest\x1a\x17.post.SharePostResponse\x12B\n" +
+	"DeletePost\x12\x17.post.DeletePostRequest\x1a\x18.post.DeletePostResponse\x12B\n" +
+	"\vRestorePost\x12\x18.post.RestorePostRequest\x1a\x19.post.RestorePostResponse\x12W\n" +
+	"\x12GetRecentlyDeleted\x12\x1f.post.GetRecentlyDeletedRequest\x1a .post.GetRecentlyDeletedResponse\x12B\n" +
+	"\vArchivePost\x12\x18.post.ArchivePostRequest\x1a\x19.post.ArchivePostResponse\x12F\n" +
+	"\rUnarchivePost\x12\x18.post.ArchivePostRequest\x1a\x1b.post.UnarchivePostResponse\x12Q\n" +
+	"\x10GetArchivedPosts\x12\x1d.post.GetArchivedPostsRequest\x1a\x1e.post.GetArchivedPostsResponse\x12<\n" +
+	"\tSharePost\x12\x16.post.SharePostRequest\x1a\x17.post.SharePostResponse\x12Q\n" +
+	"\x10SendPostToDirect\x12\x1d.post.SendPostToDirectRequest\x1a\x1e.post.SendPostToDirectResponse\x12B\n" +
 	"\vUnsharePost\x12\x18.post.UnsharePostRequest\x1a\x19.post.UnsharePostResponse\x12K\n" +
 	"\x0eGetSharedPosts\x12\x1b.post.GetSharedPostsRequest\x1a\x1c.post.GetSharedPostsResponse\x12L\n" +
-	"\x12GetUserTaggedPosts\x12\x1b.post.GetUserContentRequest\x1a\x19.post.GetHomeFeedResponseB,Z*github.com/hoshibmatchi/post-service/protob\x06proto3"
+	"\x12GetUserTaggedPosts\x12\x1b.post.GetUserContentRequest\x1a\x19.post.GetHomeFeedResponse\x12T\n" +
+	"\x11RecordImpressions\x12\x1e.post.RecordImpressionsRequest\x1a\x1f.post.RecordImpressionsResponse\x12W\n" +
+	"\x12RecordProfileVisit\x12\x1f.post.RecordProfileVisitRequest\x1a .post.RecordProfileVisitResponse\x12C\n" +
+	"\x0fGetPostInsights\x12\x1c.post.GetPostInsightsRequest\x1a\x12.post.PostInsights\x12L\n" +
+	"\x12GetAccountInsights\x12\x1f.post.GetAccountInsightsRequest\x1a\x15.post.AccountInsightsB,Z*github.com/hoshibmatchi/post-service/protob\x06proto3"
 
 var (
 	file_post_proto_rawDescOnce sync.Once
@@ -2974,127 +7497,276 @@ func file_post_proto_rawDescGZIP() []byte {
 	return file_post_proto_rawDescData
 }
 
-var file_post_proto_msgTypes = make([]protoimpl.MessageInfo, 47)
+var file_post_proto_msgTypes = make([]protoimpl.MessageInfo, 114)
 var file_post_proto_goTypes = []any{
-	(*CreatePostRequest)(nil),                // 0: post.CreatePostRequest
-	(*Post)(nil),                             // 1: post.Post
-	(*CreatePostResponse)(nil),               // 2: post.CreatePostResponse
-	(*LikePostRequest)(nil),                  // 3: post.LikePostRequest
-	(*LikePostResponse)(nil),                 // 4: post.LikePostResponse
-	(*UnlikePostRequest)(nil),                // 5: post.UnlikePostRequest
-	(*UnlikePostResponse)(nil),               // 6: post.UnlikePostResponse
-	(*CommentOnPostRequest)(nil),             // 7: post.CommentOnPostRequest
-	(*CommentResponse)(nil),                  // 8: post.CommentResponse
-	(*DeleteCommentRequest)(nil),             // 9: post.DeleteCommentRequest
-	(*DeleteCommentResponse)(nil),            // 10: post.DeleteCommentResponse
-	(*LikeCommentRequest)(nil),               // 11: post.LikeCommentRequest
-	(*LikeCommentResponse)(nil),              // 12: post.LikeCommentResponse
-	(*UnlikeCommentResponse)(nil),            // 13: post.UnlikeCommentResponse
-	(*GetCommentsByPostRequest)(nil),         // 14: post.GetCommentsByPostRequest
-	(*GetCommentsByPostResponse)(nil),        // 15: post.GetCommentsByPostResponse
-	(*GetHomeFeedRequest)(nil),               // 16: post.GetHomeFeedRequest
-	(*GetHomeFeedResponse)(nil),              // 17: post.GetHomeFeedResponse
-	(*GetUserContentRequest)(nil),            // 18: post.GetUserContentRequest
-	(*GetUserContentCountRequest)(nil),       // 19: post.GetUserContentCountRequest
-	(*GetUserContentCountResponse)(nil),      // 20: post.GetUserContentCountResponse
-	(*Collection)(nil),                       // 21: post.Collection
-	(*CreateCollectionRequest)(nil),          // 22: post.CreateCollectionRequest
-	(*GetUserCollectionsRequest)(nil),        // 23: post.GetUserCollectionsRequest
-	(*GetUserCollectionsResponse)(nil),       // 24: post.GetUserCollectionsResponse
-	(*GetPostsInCollectionRequest)(nil),      // 25: post.GetPostsInCollectionRequest
-	(*GetCollectionsForPostRequest)(nil),     // 26: post.GetCollectionsForPostRequest
-	(*GetCollectionsForPostResponse)(nil),    // 27: post.GetCollectionsForPostResponse
-	(*SavePostToCollectionRequest)(nil),      // 28: post.SavePostToCollectionRequest
-	(*SavePostToCollectionResponse)(nil),     // 29: post.SavePostToCollectionResponse
-	(*UnsavePostFromCollectionRequest)(nil),  // 30: post.UnsavePostFromCollectionRequest
-	(*UnsavePostFromCollectionResponse)(nil), // 31: post.UnsavePostFromCollectionResponse
-	(*DeleteCollectionRequest)(nil),          // 32: post.DeleteCollectionRequest
-	(*DeleteCollectionResponse)(nil),         // 33: post.DeleteCollectionResponse
-	(*RenameCollectionRequest)(nil),          // 34: post.RenameCollectionRequest
-	(*GetPostRequest)(nil),                   // 35: post.GetPostRequest
-	(*GetPostsRequest)(nil),                  // 36: post.GetPostsRequest
-	(*GetPostsResponse)(nil),                 // 37: post.GetPostsResponse
-	(*DeletePostRequest)(nil),                // 38: post.DeletePostRequest
-	(*DeletePostResponse)(nil),               // 39: post.DeletePostResponse
-	(*SharePostRequest)(nil),                 // 40: post.SharePostRequest
-	(*SharePostResponse)(nil),                // 41: post.SharePostResponse
-	(*UnsharePostRequest)(nil),               // 42: post.UnsharePostRequest
-	(*UnsharePostResponse)(nil),              // 43: post.UnsharePostResponse
-	(*GetSharedPostsRequest)(nil),            // 44: post.GetSharedPostsRequest
-	(*SharedPostItem)(nil),                   // 45: post.SharedPostItem
-	(*GetSharedPostsResponse)(nil),           // 46: post.GetSharedPostsResponse
+	(*CreatePostRequest)(nil),                 // 0: post.CreatePostRequest
+	(*Post)(nil),                              // 1: post.Post
+	(*CreatePostResponse)(nil),                // 2: post.CreatePostResponse
+	(*LikePostRequest)(nil),                   // 3: post.LikePostRequest
+	(*LikePostResponse)(nil),                  // 4: post.LikePostResponse
+	(*UnlikePostRequest)(nil),                 // 5: post.UnlikePostRequest
+	(*UnlikePostResponse)(nil),                // 6: post.UnlikePostResponse
+	(*GetPostLikersRequest)(nil),              // 7: post.GetPostLikersRequest
+	(*PostLiker)(nil),                         // 8: post.PostLiker
+	(*GetPostLikersResponse)(nil),             // 9: post.GetPostLikersResponse
+	(*SetHideLikeCountRequest)(nil),           // 10: post.SetHideLikeCountRequest
+	(*SetHideLikeCountResponse)(nil),          // 11: post.SetHideLikeCountResponse
+	(*CommentOnPostRequest)(nil),              // 12: post.CommentOnPostRequest
+	(*CommentResponse)(nil),                   // 13: post.CommentResponse
+	(*DeleteCommentRequest)(nil),              // 14: post.DeleteCommentRequest
+	(*DeleteCommentResponse)(nil),             // 15: post.DeleteCommentResponse
+	(*UpdateCommentAudienceRequest)(nil),      // 16: post.UpdateCommentAudienceRequest
+	(*UpdateCommentAudienceResponse)(nil),     // 17: post.UpdateCommentAudienceResponse
+	(*EditCommentRequest)(nil),                // 18: post.EditCommentRequest
+	(*PinCommentRequest)(nil),                 // 19: post.PinCommentRequest
+	(*PinCommentResponse)(nil),                // 20: post.PinCommentResponse
+	(*HideCommentRequest)(nil),                // 21: post.HideCommentRequest
+	(*HideCommentResponse)(nil),               // 22: post.HideCommentResponse
+	(*LikeCommentRequest)(nil),                // 23: post.LikeCommentRequest
+	(*LikeCommentResponse)(nil),               // 24: post.LikeCommentResponse
+	(*UnlikeCommentResponse)(nil),             // 25: post.UnlikeCommentResponse
+	(*GetCommentsByPostRequest)(nil),          // 26: post.GetCommentsByPostRequest
+	(*GetCommentsByPostResponse)(nil),         // 27: post.GetCommentsByPostResponse
+	(*GetCommentRepliesRequest)(nil),          // 28: post.GetCommentRepliesRequest
+	(*GetHomeFeedRequest)(nil),                // 29: post.GetHomeFeedRequest
+	(*GetHomeFeedResponse)(nil),               // 30: post.GetHomeFeedResponse
+	(*FanOutPostRequest)(nil),                 // 31: post.FanOutPostRequest
+	(*RetractPostRequest)(nil),                // 32: post.RetractPostRequest
+	(*SyncTimelineAuthorRequest)(nil),         // 33: post.SyncTimelineAuthorRequest
+	(*TimelineUpdateResponse)(nil),            // 34: post.TimelineUpdateResponse
+	(*RecordReelWatchRequest)(nil),            // 35: post.RecordReelWatchRequest
+	(*RecordReelWatchResponse)(nil),           // 36: post.RecordReelWatchResponse
+	(*MarkNotInterestedRequest)(nil),          // 37: post.MarkNotInterestedRequest
+	(*NotInterestedSignal)(nil),               // 38: post.NotInterestedSignal
+	(*GetNotInterestedRequest)(nil),           // 39: post.GetNotInterestedRequest
+	(*GetNotInterestedResponse)(nil),          // 40: post.GetNotInterestedResponse
+	(*UndoNotInterestedRequest)(nil),          // 41: post.UndoNotInterestedRequest
+	(*UndoNotInterestedResponse)(nil),         // 42: post.UndoNotInterestedResponse
+	(*RecordImpressionsRequest)(nil),          // 43: post.RecordImpressionsRequest
+	(*RecordImpressionsResponse)(nil),         // 44: post.RecordImpressionsResponse
+	(*RecordProfileVisitRequest)(nil),         // 45: post.RecordProfileVisitRequest
+	(*RecordProfileVisitResponse)(nil),        // 46: post.RecordProfileVisitResponse
+	(*InsightDay)(nil),                        // 47: post.InsightDay
+	(*GetPostInsightsRequest)(nil),            // 48: post.GetPostInsightsRequest
+	(*PostInsights)(nil),                      // 49: post.PostInsights
+	(*GetAccountInsightsRequest)(nil),         // 50: post.GetAccountInsightsRequest
+	(*TopPost)(nil),                           // 51: post.TopPost
+	(*AccountInsights)(nil),                   // 52: post.AccountInsights
+	(*GetUserContentRequest)(nil),             // 53: post.GetUserContentRequest
+	(*GetUserContentCountRequest)(nil),        // 54: post.GetUserContentCountRequest
+	(*GetUserContentCountResponse)(nil),       // 55: post.GetUserContentCountResponse
+	(*Collection)(nil),                        // 56: post.Collection
+	(*CreateCollectionRequest)(nil),           // 57: post.CreateCollectionRequest
+	(*GetUserCollectionsRequest)(nil),         // 58: post.GetUserCollectionsRequest
+	(*GetUserCollectionsResponse)(nil),        // 59: post.GetUserCollectionsResponse
+	(*GetPostsInCollectionRequest)(nil),       // 60: post.GetPostsInCollectionRequest
+	(*GetCollectionsForPostRequest)(nil),      // 61: post.GetCollectionsForPostRequest
+	(*GetCollectionsForPostResponse)(nil),     // 62: post.GetCollectionsForPostResponse
+	(*SavePostToCollectionRequest)(nil),       // 63: post.SavePostToCollectionRequest
+	(*SavePostToCollectionResponse)(nil),      // 64: post.SavePostToCollectionResponse
+	(*UnsavePostFromCollectionRequest)(nil),   // 65: post.UnsavePostFromCollectionRequest
+	(*UnsavePostFromCollectionResponse)(nil),  // 66: post.UnsavePostFromCollectionResponse
+	(*DeleteCollectionRequest)(nil),           // 67: post.DeleteCollectionRequest
+	(*DeleteCollectionResponse)(nil),          // 68: post.DeleteCollectionResponse
+	(*RenameCollectionRequest)(nil),           // 69: post.RenameCollectionRequest
+	(*GetCollectionRequest)(nil),              // 70: post.GetCollectionRequest
+	(*SetCollectionPrivacyRequest)(nil),       // 71: post.SetCollectionPrivacyRequest
+	(*CollectionMember)(nil),                  // 72: post.CollectionMember
+	(*InviteCollectionMemberRequest)(nil),     // 73: post.InviteCollectionMemberRequest
+	(*RespondToCollectionInviteRequest)(nil),  // 74: post.RespondToCollectionInviteRequest
+	(*RespondToCollectionInviteResponse)(nil), // 75: post.RespondToCollectionInviteResponse
+	(*GetCollectionInvitesRequest)(nil),       // 76: post.GetCollectionInvitesRequest
+	(*CollectionInvite)(nil),                  // 77: post.CollectionInvite
+	(*GetCollectionInvitesResponse)(nil),      // 78: post.GetCollectionInvitesResponse
+	(*GetCollectionMembersRequest)(nil),       // 79: post.GetCollectionMembersRequest
+	(*GetCollectionMembersResponse)(nil),      // 80: post.GetCollectionMembersResponse
+	(*RemoveCollectionMemberRequest)(nil),     // 81: post.RemoveCollectionMemberRequest
+	(*RemoveCollectionMemberResponse)(nil),    // 82: post.RemoveCollectionMemberResponse
+	(*GetPostRequest)(nil),                    // 83: post.GetPostRequest
+	(*GetPostsRequest)(nil),                   // 84: post.GetPostsRequest
+	(*GetPostsResponse)(nil),                  // 85: post.GetPostsResponse
+	(*DeletePostRequest)(nil),                 // 86: post.DeletePostRequest
+	(*DeletePostResponse)(nil),                // 87: post.DeletePostResponse
+	(*RestorePostRequest)(nil),                // 88: post.RestorePostRequest
+	(*RestorePostResponse)(nil),               // 89: post.RestorePostResponse
+	(*GetRecentlyDeletedRequest)(nil),         // 90: post.GetRecentlyDeletedRequest
+	(*DeletedPost)(nil),                       // 91: post.DeletedPost
+	(*GetRecentlyDeletedResponse)(nil),        // 92: post.GetRecentlyDeletedResponse
+	(*ArchivePostRequest)(nil),                // 93: post.ArchivePostRequest
+	(*ArchivePostResponse)(nil),               // 94: post.ArchivePostResponse
+	(*UnarchivePostResponse)(nil),             // 95: post.UnarchivePostResponse
+	(*GetArchivedPostsRequest)(nil),           // 96: post.GetArchivedPostsRequest
+	(*GetArchivedPostsResponse)(nil),          // 97: post.GetArchivedPostsResponse
+	(*SharePostRequest)(nil),                  // 98: post.SharePostRequest
+	(*SharePostResponse)(nil),                 // 99: post.SharePostResponse
+	(*SendPostToDirectRequest)(nil),           // 100: post.SendPostToDirectRequest
+	(*DirectSendResult)(nil),                  // 101: post.DirectSendResult
+	(*SendPostToDirectResponse)(nil),          // 102: post.SendPostToDirectResponse
+	(*UnsharePostRequest)(nil),                // 103: post.UnsharePostRequest
+	(*UnsharePostResponse)(nil),               // 104: post.UnsharePostResponse
+	(*GetSharedPostsRequest)(nil),             // 105: post.GetSharedPostsRequest
+	(*SharedPostItem)(nil),                    // 106: post.SharedPostItem
+	(*GetSharedPostsResponse)(nil),            // 107: post.GetSharedPostsResponse
+	(*SetCollectionCoverRequest)(nil),         // 108: post.SetCollectionCoverRequest
+	(*ReorderCollectionPostsRequest)(nil),     // 109: post.ReorderCollectionPostsRequest
+	(*ReorderCollectionPostsResponse)(nil),    // 110: post.ReorderCollectionPostsResponse
+	(*MoveSavedPostsRequest)(nil),             // 111: post.MoveSavedPostsRequest
+	(*MoveSavedPostsResponse)(nil),            // 112: post.MoveSavedPostsResponse
+	nil,                                       // 113: post.PostInsights.ImpressionsBySurfaceEntry
 }
 var file_post_proto_depIdxs = []int32{
-	1,  // 0: post.CreatePostResponse.post:type_name -> post.Post
-	8,  // 1: post.GetCommentsByPostResponse.comments:type_name -> post.CommentResponse
-	1,  // 2: post.GetHomeFeedResponse.posts:type_name -> post.Post
-	21, // 3: post.GetUserCollectionsResponse.collections:type_name -> post.Collection
-	1,  // 4: post.GetPostsResponse.posts:type_name -> post.Post
-	1,  // 5: post.SharedPostItem.original_post:type_name -> post.Post
-	45, // 6: post.GetSharedPostsResponse.shared_posts:type_name -> post.SharedPostItem
-	0,  // 7: post.PostService.CreatePost:input_type -> post.CreatePostRequest
-	3,  // 8: post.PostService.LikePost:input_type -> post.LikePostRequest
-	3,  // 9: post.PostService.UnlikePost:input_type -> post.LikePostRequest
-	7,  // 10: post.PostService.CommentOnPost:input_type -> post.CommentOnPostRequest
-	14, // 11: post.PostService.GetCommentsByPost:input_type -> post.GetCommentsByPostRequest
-	9,  // 12: post.PostService.DeleteComment:input_type -> post.DeleteCommentRequest
-	11, // 13: post.PostService.LikeComment:input_type -> post.LikeCommentRequest
-	11, // 14: post.PostService.UnlikeComment:input_type -> post.LikeCommentRequest
-	16, // 15: post.PostService.GetHomeFeed:input_type -> post.GetHomeFeedRequest
-	16, // 16: post.PostService.GetExploreFeed:input_type -> post.GetHomeFeedRequest
-	16, // 17: post.PostService.GetReelsFeed:input_type -> post.GetHomeFeedRequest
-	18, // 18: post.PostService.GetUserPosts:input_type -> post.GetUserContentRequest
-	18, // 19: post.PostService.GetUserReels:input_type -> post.GetUserContentRequest
-	19, // 20: post.PostService.GetUserContentCount:input_type -> post.GetUserContentCountRequest
-	22, // 21: post.PostService.CreateCollection:input_type -> post.CreateCollectionRequest
-	23, // 22: post.PostService.GetUserCollections:input_type -> post.GetUserCollectionsRequest
-	25, // 23: post.PostService.GetPostsInCollection:input_type -> post.GetPostsInCollectionRequest
-	26, // 24: post.PostService.GetCollectionsForPost:input_type -> post.GetCollectionsForPostRequest
-	28, // 25: post.PostService.SavePostToCollection:input_type -> post.SavePostToCollectionRequest
-	30, // 26: post.PostService.UnsavePostFromCollection:input_type -> post.UnsavePostFromCollectionRequest
-	32, // 27: post.PostService.DeleteCollection:input_type -> post.DeleteCollectionRequest
-	34, // 28: post.PostService.RenameCollection:input_type -> post.RenameCollectionRequest
-	35, // 29: post.PostService.GetPost:input_type -> post.GetPostRequest
-	36, // 30: post.PostService.GetPosts:input_type -> post.GetPostsRequest
-	38, // 31: post.PostService.DeletePost:input_type -> post.DeletePostRequest
-	40, // 32: post.PostService.SharePost:input_type -> post.SharePostRequest
-	42, // 33: post.PostService.UnsharePost:input_type -> post.UnsharePostRequest
-	44, // 34: post.PostService.GetSharedPosts:input_type -> post.GetSharedPostsRequest
-	18, // 35: post.PostService.GetUserTaggedPosts:input_type -> post.GetUserContentRequest
-	2,  // 36: post.PostService.CreatePost:output_type -> post.CreatePostResponse
-	4,  // 37: post.PostService.LikePost:output_type -> post.LikePostResponse
-	6,  // 38: post.PostService.UnlikePost:output_type -> post.UnlikePostResponse
-	8,  // 39: post.PostService.CommentOnPost:output_type -> post.CommentResponse
-	15, // 40: post.PostService.GetCommentsByPost:output_type -> post.GetCommentsByPostResponse
-	10, // 41: post.PostService.DeleteComment:output_type -> post.DeleteCommentResponse
-	12, // 42: post.PostService.LikeComment:output_type -> post.LikeCommentResponse
-	13, // 43: post.PostService.UnlikeComment:output_type -> post.UnlikeCommentResponse
-	17, // 44: post.PostService.GetHomeFeed:output_type -> post.GetHomeFeedResponse
-	17, // 45: post.PostService.GetExploreFeed:output_type -> post.GetHomeFeedResponse
-	17, // 46: post.PostService.GetReelsFeed:output_type -> post.GetHomeFeedResponse
-	17, // 47: post.PostService.GetUserPosts:output_type -> post.GetHomeFeedResponse
-	17, // 48: post.PostService.GetUserReels:output_type -> post.GetHomeFeedResponse
-	20, // 49: post.PostService.GetUserContentCount:output_type -> post.GetUserContentCountResponse
-	21, // 50: post.PostService.CreateCollection:output_type -> post.Collection
-	24, // 51: post.PostService.GetUserCollections:output_type -> post.GetUserCollectionsResponse
-	17, // 52: post.PostService.GetPostsInCollection:output_type -> post.GetHomeFeedResponse
-	27, // 53: post.PostService.GetCollectionsForPost:output_type -> post.GetCollectionsForPostResponse
-	29, // 54: post.PostService.SavePostToCollection:output_type -> post.SavePostToCollectionResponse
-	31, // 55: post.PostService.UnsavePostFromCollection:output_type -> post.UnsavePostFromCollectionResponse
-	33, // 56: post.PostService.DeleteCollection:output_type -> post.DeleteCollectionResponse
-	21, // 57: post.PostService.RenameCollection:output_type -> post.Collection
-	1,  // 58: post.PostService.GetPost:output_type -> post.Post
-	37, // 59: post.PostService.GetPosts:output_type -> post.GetPostsResponse
-	39, // 60: post.PostService.DeletePost:output_type -> post.DeletePostResponse
-	41, // 61: post.PostService.SharePost:output_type -> post.SharePostResponse
-	43, // 62: post.PostService.UnsharePost:output_type -> post.UnsharePostResponse
-	46, // 63: post.PostService.GetSharedPosts:output_type -> post.GetSharedPostsResponse
-	17, // 64: post.PostService.GetUserTaggedPosts:output_type -> post.GetHomeFeedResponse
-	36, // [36:65] is the sub-list for method output_type
-	7,  // [7:36] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+	1,   // 0: post.CreatePostResponse.post:type_name -> post.Post
+	8,   // 1: post.GetPostLikersResponse.likers:type_name -> post.PostLiker
+	13,  // 2: post.GetCommentsByPostResponse.comments:type_name -> post.CommentResponse
+	1,   // 3: post.GetHomeFeedResponse.posts:type_name -> post.Post
+	38,  // 4: post.GetNotInterestedResponse.signals:type_name -> post.NotInterestedSignal
+	113, // 5: post.PostInsights.impressions_by_surface:type_name -> post.PostInsights.ImpressionsBySurfaceEntry
+	47,  // 6: post.PostInsights.days:type_name -> post.InsightDay
+	1,   // 7: post.TopPost.post:type_name -> post.Post
+	47,  // 8: post.AccountInsights.days:type_name -> post.InsightDay
+	51,  // 9: post.AccountInsights.top_posts:type_name -> post.TopPost
+	56,  // 10: post.GetUserCollectionsResponse.collections:type_name -> post.Collection
+	56,  // 11: post.CollectionInvite.collection:type_name -> post.Collection
+	77,  // 12: post.GetCollectionInvitesResponse.invites:type_name -> post.CollectionInvite
+	72,  // 13: post.GetCollectionMembersResponse.members:type_name -> post.CollectionMember
+	1,   // 14: post.GetPostsResponse.posts:type_name -> post.Post
+	1,   // 15: post.DeletedPost.post:type_name -> post.Post
+	91,  // 16: post.GetRecentlyDeletedResponse.posts:type_name -> post.DeletedPost
+	1,   // 17: post.GetArchivedPostsResponse.posts:type_name -> post.Post
+	101, // 18: post.SendPostToDirectResponse.results:type_name -> post.DirectSendResult
+	1,   // 19: post.SharedPostItem.original_post:type_name -> post.Post
+	106, // 20: post.GetSharedPostsResponse.shared_posts:type_name -> post.SharedPostItem
+	0,   // 21: post.PostService.CreatePost:input_type -> post.CreatePostRequest
+	3,   // 22: post.PostService.LikePost:input_type -> post.LikePostRequest
+	3,   // 23: post.PostService.UnlikePost:input_type -> post.LikePostRequest
+	7,   // 24: post.PostService.GetPostLikers:input_type -> post.GetPostLikersRequest
+	10,  // 25: post.PostService.SetHideLikeCount:input_type -> post.SetHideLikeCountRequest
+	12,  // 26: post.PostService.CommentOnPost:input_type -> post.CommentOnPostRequest
+	26,  // 27: post.PostService.GetCommentsByPost:input_type -> post.GetCommentsByPostRequest
+	28,  // 28: post.PostService.GetCommentReplies:input_type -> post.GetCommentRepliesRequest
+	14,  // 29: post.PostService.DeleteComment:input_type -> post.DeleteCommentRequest
+	16,  // 30: post.PostService.UpdateCommentAudience:input_type -> post.UpdateCommentAudienceRequest
+	18,  // 31: post.PostService.EditComment:input_type -> post.EditCommentRequest
+	19,  // 32: post.PostService.PinComment:input_type -> post.PinCommentRequest
+	21,  // 33: post.PostService.HideComment:input_type -> post.HideCommentRequest
+	23,  // 34: post.PostService.LikeComment:input_type -> post.LikeCommentRequest
+	23,  // 35: post.PostService.UnlikeComment:input_type -> post.LikeCommentRequest
+	29,  // 36: post.PostService.GetHomeFeed:input_type -> post.GetHomeFeedRequest
+	31,  // 37: post.PostService.FanOutPost:input_type -> post.FanOutPostRequest
+	32,  // 38: post.PostService.RetractPost:input_type -> post.RetractPostRequest
+	33,  // 39: post.PostService.SyncTimelineAuthor:input_type -> post.SyncTimelineAuthorRequest
+	29,  // 40: post.PostService.GetExploreFeed:input_type -> post.GetHomeFeedRequest
+	29,  // 41: post.PostService.GetReelsFeed:input_type -> post.GetHomeFeedRequest
+	35,  // 42: post.PostService.RecordReelWatch:input_type -> post.RecordReelWatchRequest
+	37,  // 43: post.PostService.MarkNotInterested:input_type -> post.MarkNotInterestedRequest
+	39,  // 44: post.PostService.GetNotInterested:input_type -> post.GetNotInterestedRequest
+	41,  // 45: post.PostService.UndoNotInterested:input_type -> post.UndoNotInterestedRequest
+	53,  // 46: post.PostService.GetUserPosts:input_type -> post.GetUserContentRequest
+	53,  // 47: post.PostService.GetUserReels:input_type -> post.GetUserContentRequest
+	54,  // 48: post.PostService.GetUserContentCount:input_type -> post.GetUserContentCountRequest
+	57,  // 49: post.PostService.CreateCollection:input_type -> post.CreateCollectionRequest
+	58,  // 50: post.PostService.GetUserCollections:input_type -> post.GetUserCollectionsRequest
+	60,  // 51: post.PostService.GetPostsInCollection:input_type -> post.GetPostsInCollectionRequest
+	61,  // 52: post.PostService.GetCollectionsForPost:input_type -> post.GetCollectionsForPostRequest
+	63,  // 53: post.PostService.SavePostToCollection:input_type -> post.SavePostToCollectionRequest
+	65,  // 54: post.PostService.UnsavePostFromCollection:input_type -> post.UnsavePostFromCollectionRequest
+	67,  // 55: post.PostService.DeleteCollection:input_type -> post.DeleteCollectionRequest
+	69,  // 56: post.PostService.RenameCollection:input_type -> post.RenameCollectionRequest
+	70,  // 57: post.PostService.GetCollection:input_type -> post.GetCollectionRequest
+	71,  // 58: post.PostService.SetCollectionPrivacy:input_type -> post.SetCollectionPrivacyRequest
+	73,  // 59: post.PostService.InviteCollectionMember:input_type -> post.InviteCollectionMemberRequest
+	74,  // 60: post.PostService.RespondToCollectionInvite:input_type -> post.RespondToCollectionInviteRequest
+	76,  // 61: post.PostService.GetCollectionInvites:input_type -> post.GetCollectionInvitesRequest
+	79,  // 62: post.PostService.GetCollectionMembers:input_type -> post.GetCollectionMembersRequest
+	81,  // 63: post.PostService.RemoveCollectionMember:input_type -> post.RemoveCollectionMemberRequest
+	108, // 64: post.PostService.SetCollectionCover:input_type -> post.SetCollectionCoverRequest
+	109, // 65: post.PostService.ReorderCollectionPosts:input_type -> post.ReorderCollectionPostsRequest
+	111, // 66: post.PostService.MoveSavedPosts:input_type -> post.MoveSavedPostsRequest
+	83,  // 67: post.PostService.GetPost:input_type -> post.GetPostRequest
+	84,  // 68: post.PostService.GetPosts:input_type -> post.GetPostsRequest
+	86,  // 69: post.PostService.DeletePost:input_type -> post.DeletePostRequest
+	88,  // 70: post.PostService.RestorePost:input_type -> post.RestorePostRequest
+	90,  // 71: post.PostService.GetRecentlyDeleted:input_type -> post.GetRecentlyDeletedRequest
+	93,  // 72: post.PostService.ArchivePost:input_type -> post.ArchivePostRequest
+	93,  // 73: post.PostService.UnarchivePost:input_type -> post.ArchivePostRequest
+	96,  // 74: post.PostService.GetArchivedPosts:input_type -> post.GetArchivedPostsRequest
+	98,  // 75: post.PostService.SharePost:input_type -> post.SharePostRequest
+	100, // 76: post.PostService.SendPostToDirect:input_type -> post.SendPostToDirectRequest
+	103, // 77: post.PostService.UnsharePost:input_type -> post.UnsharePostRequest
+	105, // 78: post.PostService.GetSharedPosts:input_type -> post.GetSharedPostsRequest
+	53,  // 79: post.PostService.GetUserTaggedPosts:input_type -> post.GetUserContentRequest
+	43,  // 80: post.PostService.RecordImpressions:input_type -> post.RecordImpressionsRequest
+	45,  // 81: post.PostService.RecordProfileVisit:input_type -> post.RecordProfileVisitRequest
+	48,  // 82: post.PostService.GetPostInsights:input_type -> post.GetPostInsightsRequest
+	50,  // 83: post.PostService.GetAccountInsights:input_type -> post.GetAccountInsightsRequest
+	2,   // 84: post.PostService.CreatePost:output_type -> post.CreatePostResponse
+	4,   // 85: post.PostService.LikePost:output_type -> post.LikePostResponse
+	6,   // 86: post.PostService.UnlikePost:output_type -> post.UnlikePostResponse
+	9,   // 87: post.PostService.GetPostLikers:output_type -> post.GetPostLikersResponse
+	11,  // 88: post.PostService.SetHideLikeCount:output_type -> post.SetHideLikeCountResponse
+	13,  // 89: post.PostService.CommentOnPost:output_type -> post.CommentResponse
+	27,  // 90: post.PostService.GetCommentsByPost:output_type -> post.GetCommentsByPostResponse
+	27,  // 91: post.PostService.GetCommentReplies:output_type -> post.GetCommentsByPostResponse
+	15,  // 92: post.PostService.DeleteComment:output_type -> post.DeleteCommentResponse
+	17,  // 93: post.PostService.UpdateCommentAudience:output_type -> post.UpdateCommentAudienceResponse
+	13,  // 94: post.PostService.EditComment:output_type -> post.CommentResponse
+	20,  // 95: post.PostService.PinComment:output_type -> post.PinCommentResponse
+	22,  // 96: post.PostService.HideComment:output_type -> post.HideCommentResponse
+	24,  // 97: post.PostService.LikeComment:output_type -> post.LikeCommentResponse
+	25,  // 98: post.PostService.UnlikeComment:output_type -> post.UnlikeCommentResponse
+	30,  // 99: post.PostService.GetHomeFeed:output_type -> post.GetHomeFeedResponse
+	34,  // 100: post.PostService.FanOutPost:output_type -> post.TimelineUpdateResponse
+	34,  // 101: post.PostService.RetractPost:output_type -> post.TimelineUpdateResponse
+	34,  // 102: post.PostService.SyncTimelineAuthor:output_type -> post.TimelineUpdateResponse
+	30,  // 103: post.PostService.GetExploreFeed:output_type -> post.GetHomeFeedResponse
+	30,  // 104: post.PostService.GetReelsFeed:output_type -> post.GetHomeFeedResponse
+	36,  // 105: post.PostService.RecordReelWatch:output_type -> post.RecordReelWatchResponse
+	38,  // 106: post.PostService.MarkNotInterested:output_type -> post.NotInterestedSignal
+	40,  // 107: post.PostService.GetNotInterested:output_type -> post.GetNotInterestedResponse
+	42,  // 108: post.PostService.UndoNotInterested:output_type -> post.UndoNotInterestedResponse
+	30,  // 109: post.PostService.GetUserPosts:output_type -> post.GetHomeFeedResponse
+	30,  // 110: post.PostService.GetUserReels:output_type -> post.GetHomeFeedResponse
+	55,  // 111: post.PostService.GetUserContentCount:output_type -> post.GetUserContentCountResponse
+	56,  // 112: post.PostService.CreateCollection:output_type -> post.Collection
+	59,  // 113: post.PostService.GetUserCollections:output_type -> post.GetUserCollectionsResponse
+	30,  // 114: post.PostService.GetPostsInCollection:output_type -> post.GetHomeFeedResponse
+	62,  // 115: post.PostService.GetCollectionsForPost:output_type -> post.GetCollectionsForPostResponse
+	64,  // 116: post.PostService.SavePostToCollection:output_type -> post.SavePostToCollectionResponse
+	66,  // 117: post.PostService.UnsavePostFromCollection:output_type -> post.UnsavePostFromCollectionResponse
+	68,  // 118: post.PostService.DeleteCollection:output_type -> post.DeleteCollectionResponse
+	56,  // 119: post.PostService.RenameCollection:output_type -> post.Collection
+	56,  // 120: post.PostService.GetCollection:output_type -> post.Collection
+	56,  // 121: post.PostService.SetCollectionPrivacy:output_type -> post.Collection
+	72,  // 122: post.PostService.InviteCollectionMember:output_type -> post.CollectionMember
+	75,  // 123: post.PostService.RespondToCollectionInvite:output_type -> post.RespondToCollectionInviteResponse
+	78,  // 124: post.PostService.GetCollectionInvites:output_type -> post.GetCollectionInvitesResponse
+	80,  // 125: post.PostService.GetCollectionMembers:output_type -> post.GetCollectionMembersResponse
+	82,  // 126: post.PostService.RemoveCollectionMember:output_type -> post.RemoveCollectionMemberResponse
+	56,  // 127: post.PostService.SetCollectionCover:output_type -> post.Collection
+	110, // 128: post.PostService.ReorderCollectionPosts:output_type -> post.ReorderCollectionPostsResponse
+	112, // 129: post.PostService.MoveSavedPosts:output_type -> post.MoveSavedPostsResponse
+	1,   // 130: post.PostService.GetPost:output_type -> post.Post
+	85,  // 131: post.PostService.GetPosts:output_type -> post.GetPostsResponse
+	87,  // 132: post.PostService.DeletePost:output_type -> post.DeletePostResponse
+	89,  // 133: post.PostService.RestorePost:output_type -> post.RestorePostResponse
+	92,  // 134: post.PostService.GetRecentlyDeleted:output_type -> post.GetRecentlyDeletedResponse
+	94,  // 135: post.PostService.ArchivePost:output_type -> post.ArchivePostResponse
+	95,  // 136: post.PostService.UnarchivePost:output_type -> post.UnarchivePostResponse
+	97,  // 137: post.PostService.GetArchivedPosts:output_type -> post.GetArchivedPostsResponse
+	99,  // 138: post.PostService.SharePost:output_type -> post.SharePostResponse
+	102, // 139: post.PostService.SendPostToDirect:output_type -> post.SendPostToDirectResponse
+	104, // 140: post.PostService.UnsharePost:output_type -> post.UnsharePostResponse
+	107, // 141: post.PostService.GetSharedPosts:output_type -> post.GetSharedPostsResponse
+	30,  // 142: post.PostService.GetUserTaggedPosts:output_type -> post.GetHomeFeedResponse
+	44,  // 143: post.PostService.RecordImpressions:output_type -> post.RecordImpressionsResponse
+	46,  // 144: post.PostService.RecordProfileVisit:output_type -> post.RecordProfileVisitResponse
+	49,  // 145: post.PostService.GetPostInsights:output_type -> post.PostInsights
+	52,  // 146: post.PostService.GetAccountInsights:output_type -> post.AccountInsights
+	84,  // [84:147] is the sub-list for method output_type
+	21,  // [21:84] is the sub-list for method input_type
+	21,  // [21:21] is the sub-list for extension type_name
+	21,  // [21:21] is the sub-list for extension extendee
+	0,   // [0:21] is the sub-list for field type_name
 }
 
 func init() { file_post_proto_init() }
@@ -3108,7 +7780,7 @@ func file_post_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_post_proto_rawDesc), len(file_post_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   47,
+			NumMessages:   114,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,35 +19,69 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	PostService_CreatePost_FullMethodName               = "/post.PostService/CreatePost"
-	PostService_LikePost_FullMethodName                 = "/post.PostService/LikePost"
-	PostService_UnlikePost_FullMethodName               = "/post.PostService/UnlikePost"
-	PostService_CommentOnPost_FullMethodName            = "/post.PostService/CommentOnPost"
-	PostService_GetCommentsByPost_FullMethodName        = "/post.PostService/GetCommentsByPost"
-	PostService_DeleteComment_FullMethodName            = "/post.PostService/DeleteComment"
-	PostService_LikeComment_FullMethodName              = "/post.PostService/LikeComment"
-	PostService_UnlikeComment_FullMethodName            = "/post.PostService/UnlikeComment"
-	PostService_GetHomeFeed_FullMethodName              = "/post.PostService/GetHomeFeed"
-	PostService_GetExploreFeed_FullMethodName           = "/post.PostService/GetExploreFeed"
-	PostService_GetReelsFeed_FullMethodName             = "/post.PostService/GetReelsFeed"
-	PostService_GetUserPosts_FullMethodName             = "/post.PostService/GetUserPosts"
-	PostService_GetUserReels_FullMethodName             = "/post.PostService/GetUserReels"
-	PostService_GetUserContentCount_FullMethodName      = "/post.PostService/GetUserContentCount"
-	PostService_CreateCollection_FullMethodName         = "/post.PostService/CreateCollection"
-	PostService_GetUserCollections_FullMethodName       = "/post.PostService/GetUserCollections"
-	PostService_GetPostsInCollection_FullMethodName     = "/post.PostService/GetPostsInCollection"
-	PostService_GetCollectionsForPost_FullMethodName    = "/post.PostService/GetCollectionsForPost"
-	PostService_SavePostToCollection_FullMethodName     = "/post.PostService/SavePostToCollection"
-	PostService_UnsavePostFromCollection_FullMethodName = "/post.PostService/UnsavePostFromCollection"
-	PostService_DeleteCollection_FullMethodName         = "/post.PostService/DeleteCollection"
-	PostService_RenameCollection_FullMethodName         = "/post.PostService/RenameCollection"
-	PostService_GetPost_FullMethodName                  = "/post.PostService/GetPost"
-	PostService_GetPosts_FullMethodName                 = "/post.PostService/GetPosts"
-	PostService_DeletePost_FullMethodName               = "/post.PostService/DeletePost"
-	PostService_SharePost_FullMethodName                = "/post.PostService/SharePost"
-	PostService_UnsharePost_FullMethodName              = "/post.PostService/UnsharePost"
-	PostService_GetSharedPosts_FullMethodName           = "/post.PostService/GetSharedPosts"
-	PostService_GetUserTaggedPosts_FullMethodName       = "/post.PostService/GetUserTaggedPosts"
+	PostService_CreatePost_FullMethodName                = "/post.PostService/CreatePost"
+	PostService_LikePost_FullMethodName                  = "/post.PostService/LikePost"
+	PostService_UnlikePost_FullMethodName                = "/post.PostService/UnlikePost"
+	PostService_GetPostLikers_FullMethodName             = "/post.PostService/GetPostLikers"
+	PostService_SetHideLikeCount_FullMethodName          = "/post.PostService/SetHideLikeCount"
+	PostService_CommentOnPost_FullMethodName             = "/post.PostService/CommentOnPost"
+	PostService_GetCommentsByPost_FullMethodName         = "/post.PostService/GetCommentsByPost"
+	PostService_GetCommentReplies_FullMethodName         = "/post.PostService/GetCommentReplies"
+	PostService_DeleteComment_FullMethodName             = "/post.PostService/DeleteComment"
+	PostService_UpdateCommentAudience_FullMethodName     = "/post.PostService/UpdateCommentAudience"
+	PostService_EditComment_FullMethodName               = "/post.PostService/EditComment"
+	PostService_PinComment_FullMethodName                = "/post.PostService/PinComment"
+	PostService_HideComment_FullMethodName               = "/post.PostService/HideComment"
+	PostService_LikeComment_FullMethodName               = "/post.PostService/LikeComment"
+	PostService_UnlikeComment_FullMethodName             = "/post.PostService/UnlikeComment"
+	PostService_GetHomeFeed_FullMethodName               = "/post.PostService/GetHomeFeed"
+	PostService_FanOutPost_FullMethodName                = "/post.PostService/FanOutPost"
+	PostService_RetractPost_FullMethodName               = "/post.PostService/RetractPost"
+	PostService_SyncTimelineAuthor_FullMethodName        = "/post.PostService/SyncTimelineAuthor"
+	PostService_GetExploreFeed_FullMethodName            = "/post.PostService/GetExploreFeed"
+	PostService_GetReelsFeed_FullMethodName              = "/post.PostService/GetReelsFeed"
+	PostService_RecordReelWatch_FullMethodName           = "/post.PostService/RecordReelWatch"
+	PostService_MarkNotInterested_FullMethodName         = "/post.PostService/MarkNotInterested"
+	PostService_GetNotInterested_FullMethodName          = "/post.PostService/GetNotInterested"
+	PostService_UndoNotInterested_FullMethodName         = "/post.PostService/UndoNotInterested"
+	PostService_GetUserPosts_FullMethodName              = "/post.PostService/GetUserPosts"
+	PostService_GetUserReels_FullMethodName              = "/post.PostService/GetUserReels"
+	PostService_GetUserContentCount_FullMethodName       = "/post.PostService/GetUserContentCount"
+	PostService_CreateCollection_FullMethodName          = "/post.PostService/CreateCollection"
+	PostService_GetUserCollections_FullMethodName        = "/post.PostService/GetUserCollections"
+	PostService_GetPostsInCollection_FullMethodName      = "/post.PostService/GetPostsInCollection"
+	PostService_GetCollectionsForPost_FullMethodName     = "/post.PostService/GetCollectionsForPost"
+	PostService_SavePostToCollection_FullMethodName      = "/post.PostService/SavePostToCollection"
+	PostService_UnsavePostFromCollection_FullMethodName  = "/post.PostService/UnsavePostFromCollection"
+	PostService_DeleteCollection_FullMethodName          = "/post.PostService/DeleteCollection"
+	PostService_RenameCollection_FullMethodName          = "/post.PostService/RenameCollection"
+	PostService_GetCollection_FullMethodName             = "/post.PostService/GetCollection"
+	PostService_SetCollectionPrivacy_FullMethodName      = "/post.PostService/SetCollectionPrivacy"
+	PostService_InviteCollectionMember_FullMethodName    = "/post.PostService/InviteCollectionMember"
+	PostService_RespondToCollectionInvite_FullMethodName = "/post.PostService/RespondToCollectionInvite"
+	PostService_GetCollectionInvites_FullMethodName      = "/post.PostService/GetCollectionInvites"
+	PostService_GetCollectionMembers_FullMethodName      = "/post.PostService/GetCollectionMembers"
+	PostService_RemoveCollectionMember_FullMethodName    = "/post.PostService/RemoveCollectionMember"
+	PostService_SetCollectionCover_FullMethodName        = "/post.PostService/SetCollectionCover"
+	PostService_ReorderCollectionPosts_FullMethodName    = "/post.PostService/ReorderCollectionPosts"
+	PostService_MoveSavedPosts_FullMethodName            = "/post.PostService/MoveSavedPosts"
+	PostService_GetPost_FullMethodName                   = "/post.PostService/GetPost"
+	PostService_GetPosts_FullMethodName                  = "/post.PostService/GetPosts"
+	PostService_DeletePost_FullMethodName                = "/post.PostService/DeletePost"
+	PostService_RestorePost_FullMethodName               = "/post.PostService/RestorePost"
+	PostService_GetRecentlyDeleted_FullMethodName        = "/post.PostService/GetRecentlyDeleted"
+	PostService_ArchivePost_FullMethodName               = "/post.PostService/ArchivePost"
+	PostService_UnarchivePost_FullMethodName             = "/post.PostService/UnarchivePost"
+	PostService_GetArchivedPosts_FullMethodName          = "/post.PostService/GetArchivedPosts"
+	PostService_SharePost_FullMethodName                 = "/post.PostService/SharePost"
+	PostService_SendPostToDirect_FullMethodName          = "/post.PostService/SendPostToDirect"
+	PostService_UnsharePost_FullMethodName               = "/post.PostService/UnsharePost"
+	PostService_GetSharedPosts_FullMethodName            = "/post.PostService/GetSharedPosts"
+	PostService_GetUserTaggedPosts_FullMethodName        = "/post.PostService/GetUserTaggedPosts"
+	PostService_RecordImpressions_FullMethodName         = "/post.PostService/RecordImpressions"
+	PostService_RecordProfileVisit_FullMethodName        = "/post.PostService/RecordProfileVisit"
+	PostService_GetPostInsights_FullMethodName           = "/post.PostService/GetPostInsights"
+	PostService_GetAccountInsights_FullMethodName        = "/post.PostService/GetAccountInsights"
 )
 
 // PostServiceClient is the client API for PostService service.
@@ -57,14 +91,29 @@ type PostServiceClient interface {
 	CreatePost(ctx context.Context, in *CreatePostRequest, opts ...grpc.CallOption) (*CreatePostResponse, error)
 	LikePost(ctx context.Context, in *LikePostRequest, opts ...grpc.CallOption) (*LikePostResponse, error)
 	UnlikePost(ctx context.Context, in *LikePostRequest, opts ...grpc.CallOption) (*UnlikePostResponse, error)
+	GetPostLikers(ctx context.Context, in *GetPostLikersRequest, opts ...grpc.CallOption) (*GetPostLikersResponse, error)
+	SetHideLikeCount(ctx context.Context, in *SetHideLikeCountRequest, opts ...grpc.CallOption) (*SetHideLikeCountResponse, error)
 	CommentOnPost(ctx context.Context, in *CommentOnPostRequest, opts ...grpc.CallOption) (*CommentResponse, error)
 	GetCommentsByPost(ctx context.Context, in *GetCommentsByPostRequest, opts ...grpc.CallOption) (*GetCommentsByPostResponse, error)
+	GetCommentReplies(ctx context.Context, in *GetCommentRepliesRequest, opts ...grpc.CallOption) (*GetCommentsByPostResponse, error)
 	DeleteComment(ctx context.Context, in *DeleteCommentRequest, opts ...grpc.CallOption) (*DeleteCommentResponse, error)
+	UpdateCommentAudience(ctx context.Context, in *UpdateCommentAudienceRequest, opts ...grpc.CallOption) (*UpdateCommentAudienceResponse, error)
+	EditComment(ctx context.Context, in *EditCommentRequest, opts ...grpc.CallOption) (*CommentResponse, error)
+	PinComment(ctx context.Context, in *PinCommentRequest, opts ...grpc.CallOption) (*PinCommentResponse, error)
+	HideComment(ctx context.Context, in *HideCommentRequest, opts ...grpc.CallOption) (*HideCommentResponse, error)
 	LikeComment(ctx context.Context, in *LikeCommentRequest, opts ...grpc.CallOption) (*LikeCommentResponse, error)
 	UnlikeComment(ctx context.Context, in *LikeCommentRequest, opts ...grpc.CallOption) (*UnlikeCommentResponse, error)
 	GetHomeFeed(ctx context.Context, in *GetHomeFeedRequest, opts ...grpc.CallOption) (*GetHomeFeedResponse, error)
+	// INTERNAL: called by worker-service from feed_fanout_queue to maintain home timelines
+	FanOutPost(ctx context.Context, in *FanOutPostRequest, opts ...grpc.CallOption) (*TimelineUpdateResponse, error)
+	RetractPost(ctx context.Context, in *RetractPostRequest, opts ...grpc.CallOption) (*TimelineUpdateResponse, error)
+	SyncTimelineAuthor(ctx context.Context, in *SyncTimelineAuthorRequest, opts ...grpc.CallOption) (*TimelineUpdateResponse, error)
 	GetExploreFeed(ctx context.Context, in *GetHomeFeedRequest, opts ...grpc.CallOption) (*GetHomeFeedResponse, error)
 	GetReelsFeed(ctx context.Context, in *GetHomeFeedRequest, opts ...grpc.CallOption) (*GetHomeFeedResponse, error)
+	RecordReelWatch(ctx context.Context, in *RecordReelWatchRequest, opts ...grpc.CallOption) (*RecordReelWatchResponse, error)
+	MarkNotInterested(ctx context.Context, in *MarkNotInterestedRequest, opts ...grpc.CallOption) (*NotInterestedSignal, error)
+	GetNotInterested(ctx context.Context, in *GetNotInterestedRequest, opts ...grpc.CallOption) (*GetNotInterestedResponse, error)
+	UndoNotInterested(ctx context.Context, in *UndoNotInterestedRequest, opts ...grpc.CallOption) (*UndoNotInterestedResponse, error)
 	GetUserPosts(ctx context.Context, in *GetUserContentRequest, opts ...grpc.CallOption) (*GetHomeFeedResponse, error)
 	GetUserReels(ctx context.Context, in *GetUserContentRequest, opts ...grpc.CallOption) (*GetHomeFeedResponse, error)
 	GetUserContentCount(ctx context.Context, in *GetUserContentCountRequest, opts ...grpc.CallOption) (*GetUserContentCountResponse, error)
@@ -76,13 +125,34 @@ type PostServiceClient interface {
 	UnsavePostFromCollection(ctx context.Context, in *UnsavePostFromCollectionRequest, opts ...grpc.CallOption) (*UnsavePostFromCollectionResponse, error)
 	DeleteCollection(ctx context.Context, in *DeleteCollectionRequest, opts ...grpc.CallOption) (*DeleteCollectionResponse, error)
 	RenameCollection(ctx context.Context, in *RenameCollectionRequest, opts ...grpc.CallOption) (*Collection, error)
+	GetCollection(ctx context.Context, in *GetCollectionRequest, opts ...grpc.CallOption) (*Collection, error)
+	SetCollectionPrivacy(ctx context.Context, in *SetCollectionPrivacyRequest, opts ...grpc.CallOption) (*Collection, error)
+	InviteCollectionMember(ctx context.Context, in *InviteCollectionMemberRequest, opts ...grpc.CallOption) (*CollectionMember, error)
+	RespondToCollectionInvite(ctx context.Context, in *RespondToCollectionInviteRequest, opts ...grpc.CallOption) (*RespondToCollectionInviteResponse, error)
+	GetCollectionInvites(ctx context.Context, in *GetCollectionInvitesRequest, opts ...grpc.CallOption) (*GetCollectionInvitesResponse, error)
+	GetCollectionMembers(ctx context.Context, in *GetCollectionMembersRequest, opts ...grpc.CallOption) (*GetCollectionMembersResponse, error)
+	RemoveCollectionMember(ctx context.Context, in *RemoveCollectionMemberRequest, opts ...grpc.CallOption) (*RemoveCollectionMemberResponse, error)
+	SetCollectionCover(ctx context.Context, in *SetCollectionCoverRequest, opts ...grpc.CallOption) (*Collection, error)
+	ReorderCollectionPosts(ctx context.Context, in *ReorderCollectionPostsRequest, opts ...grpc.CallOption) (*ReorderCollectionPostsResponse, error)
+	MoveSavedPosts(ctx context.Context, in *MoveSavedPostsRequest, opts ...grpc.CallOption) (*MoveSavedPostsResponse, error)
 	GetPost(ctx context.Context, in *GetPostRequest, opts ...grpc.CallOption) (*Post, error)
 	GetPosts(ctx context.Context, in *GetPostsRequest, opts ...grpc.CallOption) (*GetPostsResponse, error)
 	DeletePost(ctx context.Context, in *DeletePostRequest, opts ...grpc.CallOption) (*DeletePostResponse, error)
+	RestorePost(ctx context.Context, in *RestorePostRequest, opts ...grpc.CallOption) (*RestorePostResponse, error)
+	GetRecentlyDeleted(ctx context.Context, in *GetRecentlyDeletedRequest, opts ...grpc.CallOption) (*GetRecentlyDeletedResponse, error)
+	ArchivePost(ctx context.Context, in *ArchivePostRequest, opts ...grpc.CallOption) (*ArchivePostResponse, error)
+	UnarchivePost(ctx context.Context, in *ArchivePostRequest, opts ...grpc.CallOption) (*UnarchivePostResponse, error)
+	GetArchivedPosts(ctx context.Context, in *GetArchivedPostsRequest, opts ...grpc.CallOption) (*GetArchivedPostsResponse, error)
 	SharePost(ctx context.Context, in *SharePostRequest, opts ...grpc.CallOption) (*SharePostResponse, error)
+	SendPostToDirect(ctx context.Context, in *SendPostToDirectRequest, opts ...grpc.CallOption) (*SendPostToDirectResponse, error)
 	UnsharePost(ctx context.Context, in *UnsharePostRequest, opts ...grpc.CallOption) (*UnsharePostResponse, error)
 	GetSharedPosts(ctx context.Context, in *GetSharedPostsRequest, opts ...grpc.CallOption) (*GetSharedPostsResponse, error)
 	GetUserTaggedPosts(ctx context.Context, in *GetUserContentRequest, opts ...grpc.CallOption) (*GetHomeFeedResponse, error)
+	// Creator insights
+	RecordImpressions(ctx context.Context, in *RecordImpressionsRequest, opts ...grpc.CallOption) (*RecordImpressionsResponse, error)
+	RecordProfileVisit(ctx context.Context, in *RecordProfileVisitRequest, opts ...grpc.CallOption) (*RecordProfileVisitResponse, error)
+	GetPostInsights(ctx context.Context, in *GetPostInsightsRequest, opts ...grpc.CallOption) (*PostInsights, error)
+	GetAccountInsights(ctx context.Context, in *GetAccountInsightsRequest, opts ...grpc.CallOption) (*AccountInsights, error)
 }
 
 type postServiceClient struct {
@@ -123,6 +193,26 @@ func (c *postServiceClient) UnlikePost(ctx context.Context, in *LikePostRequest,
 	return out, nil
 }
 
+func (c *postServiceClient) GetPostLikers(ctx context.Context, in *GetPostLikersRequest, opts ...grpc.CallOption) (*GetPostLikersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPostLikersResponse)
+	err := c.cc.Invoke(ctx, PostService_GetPostLikers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) SetHideLikeCount(ctx context.Context, in *SetHideLikeCountRequest, opts ...grpc.CallOption) (*SetHideLikeCountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetHideLikeCountResponse)
+	err := c.cc.Invoke(ctx, PostService_SetHideLikeCount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *postServiceClient) CommentOnPost(ctx context.Context, in *CommentOnPostRequest, opts ...grpc.CallOption) (*CommentResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CommentResponse)
@@ -143,6 +233,16 @@ func (c *postServiceClient) GetCommentsByPost(ctx context.Context, in *GetCommen
 	return out, nil
 }
 
+func (c *postServiceClient) GetCommentReplies(ctx context.Context, in *GetCommentRepliesRequest, opts ...grpc.CallOption) (*GetCommentsByPostResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCommentsByPostResponse)
+	err := c.cc.Invoke(ctx, PostService_GetCommentReplies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *postServiceClient) DeleteComment(ctx context.Context, in *DeleteCommentRequest, opts ...grpc.CallOption) (*DeleteCommentResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(DeleteCommentResponse)
@@ -153,6 +253,46 @@ func (c *postServiceClient) DeleteComment(ctx context.Context, in *DeleteComment
 	return out, nil
 }
 
+func (c *postServiceClient) UpdateCommentAudience(ctx context.Context, in *UpdateCommentAudienceRequest, opts ...grpc.CallOption) (*UpdateCommentAudienceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateCommentAudienceResponse)
+	err := c.cc.Invoke(ctx, PostService_UpdateCommentAudience_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) EditComment(ctx context.Context, in *EditCommentRequest, opts ...grpc.CallOption) (*CommentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CommentResponse)
+	err := c.cc.Invoke(ctx, PostService_EditComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) PinComment(ctx context.Context, in *PinCommentRequest, opts ...grpc.CallOption) (*PinCommentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PinCommentResponse)
+	err := c.cc.Invoke(ctx, PostService_PinComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) HideComment(ctx context.Context, in *HideCommentRequest, opts ...grpc.CallOption) (*HideCommentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HideCommentResponse)
+	err := c.cc.Invoke(ctx, PostService_HideComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *postServiceClient) LikeComment(ctx context.Context, in *LikeCommentRequest, opts ...grpc.CallOption) (*LikeCommentResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(LikeCommentResponse)
@@ -183,6 +323,36 @@ func (c *postServiceClient) GetHomeFeed(ctx context.Context, in *GetHomeFeedRequ
 	return out, nil
 }
 
+func (c *postServiceClient) FanOutPost(ctx context.Context, in *FanOutPostRequest, opts ...grpc.CallOption) (*TimelineUpdateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TimelineUpdateResponse)
+	err := c.cc.Invoke(ctx, PostService_FanOutPost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) RetractPost(ctx context.Context, in *RetractPostRequest, opts ...grpc.CallOption) (*TimelineUpdateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TimelineUpdateResponse)
+	err := c.cc.Invoke(ctx, PostService_RetractPost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) SyncTimelineAuthor(ctx context.Context, in *SyncTimelineAuthorRequest, opts ...grpc.CallOption) (*TimelineUpdateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TimelineUpdateResponse)
+	err := c.cc.Invoke(ctx, PostService_SyncTimelineAuthor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *postServiceClient) GetExploreFeed(ctx context.Context, in *GetHomeFeedRequest, opts ...grpc.CallOption) (*GetHomeFeedResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetHomeFeedResponse)
@@ -203,6 +373,46 @@ func (c *postServiceClient) GetReelsFeed(ctx context.Context, in *GetHomeFeedReq
 	return out, nil
 }
 
+func (c *postServiceClient) RecordReelWatch(ctx context.Context, in *RecordReelWatchRequest, opts ...grpc.CallOption) (*RecordReelWatchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecordReelWatchResponse)
+	err := c.cc.Invoke(ctx, PostService_RecordReelWatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) MarkNotInterested(ctx context.Context, in *MarkNotInterestedRequest, opts ...grpc.CallOption) (*NotInterestedSignal, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NotInterestedSignal)
+	err := c.cc.Invoke(ctx, PostService_MarkNotInterested_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) GetNotInterested(ctx context.Context, in *GetNotInterestedRequest, opts ...grpc.CallOption) (*GetNotInterestedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetNotInterestedResponse)
+	err := c.cc.Invoke(ctx, PostService_GetNotInterested_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) UndoNotInterested(ctx context.Context, in *UndoNotInterestedRequest, opts ...grpc.CallOption) (*UndoNotInterestedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UndoNotInterestedResponse)
+	err := c.cc.Invoke(ctx, PostService_UndoNotInterested_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *postServiceClient) GetUserPosts(ctx context.Context, in *GetUserContentRequest, opts ...grpc.CallOption) (*GetHomeFeedResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetHomeFeedResponse)
@@ -313,6 +523,106 @@ func (c *postServiceClient) RenameCollection(ctx context.Context, in *RenameColl
 	return out, nil
 }
 
+func (c *postServiceClient) GetCollection(ctx context.Context, in *GetCollectionRequest, opts ...grpc.CallOption) (*Collection, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Collection)
+	err := c.cc.Invoke(ctx, PostService_GetCollection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) SetCollectionPrivacy(ctx context.Context, in *SetCollectionPrivacyRequest, opts ...grpc.CallOption) (*Collection, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Collection)
+	err := c.cc.Invoke(ctx, PostService_SetCollectionPrivacy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) InviteCollectionMember(ctx context.Context, in *InviteCollectionMemberRequest, opts ...grpc.CallOption) (*CollectionMember, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CollectionMember)
+	err := c.cc.Invoke(ctx, PostService_InviteCollectionMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) RespondToCollectionInvite(ctx context.Context, in *RespondToCollectionInviteRequest, opts ...grpc.CallOption) (*RespondToCollectionInviteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RespondToCollectionInviteResponse)
+	err := c.cc.Invoke(ctx, PostService_RespondToCollectionInvite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) GetCollectionInvites(ctx context.Context, in *GetCollectionInvitesRequest, opts ...grpc.CallOption) (*GetCollectionInvitesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCollectionInvitesResponse)
+	err := c.cc.Invoke(ctx, PostService_GetCollectionInvites_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) GetCollectionMembers(ctx context.Context, in *GetCollectionMembersRequest, opts ...grpc.CallOption) (*GetCollectionMembersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCollectionMembersResponse)
+	err := c.cc.Invoke(ctx, PostService_GetCollectionMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) RemoveCollectionMember(ctx context.Context, in *RemoveCollectionMemberRequest, opts ...grpc.CallOption) (*RemoveCollectionMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveCollectionMemberResponse)
+	err := c.cc.Invoke(ctx, PostService_RemoveCollectionMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) SetCollectionCover(ctx context.Context, in *SetCollectionCoverRequest, opts ...grpc.CallOption) (*Collection, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Collection)
+	err := c.cc.Invoke(ctx, PostService_SetCollectionCover_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) ReorderCollectionPosts(ctx context.Context, in *ReorderCollectionPostsRequest, opts ...grpc.CallOption) (*ReorderCollectionPostsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReorderCollectionPostsResponse)
+	err := c.cc.Invoke(ctx, PostService_ReorderCollectionPosts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) MoveSavedPosts(ctx context.Context, in *MoveSavedPostsRequest, opts ...grpc.CallOption) (*MoveSavedPostsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MoveSavedPostsResponse)
+	err := c.cc.Invoke(ctx, PostService_MoveSavedPosts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *postServiceClient) GetPost(ctx context.Context, in *GetPostRequest, opts ...grpc.CallOption) (*Post, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Post)
@@ -343,6 +653,56 @@ func (c *postServiceClient) DeletePost(ctx context.Context, in *DeletePostReques
 	return out, nil
 }
 
+func (c *postServiceClient) RestorePost(ctx context.Context, in *RestorePostRequest, opts ...grpc.CallOption) (*RestorePostResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestorePostResponse)
+	err := c.cc.Invoke(ctx, PostService_RestorePost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) GetRecentlyDeleted(ctx context.Context, in *GetRecentlyDeletedRequest, opts ...grpc.CallOption) (*GetRecentlyDeletedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRecentlyDeletedResponse)
+	err := c.cc.Invoke(ctx, PostService_GetRecentlyDeleted_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) ArchivePost(ctx context.Context, in *ArchivePostRequest, opts ...grpc.CallOption) (*ArchivePostResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ArchivePostResponse)
+	err := c.cc.Invoke(ctx, PostService_ArchivePost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) UnarchivePost(ctx context.Context, in *ArchivePostRequest, opts ...grpc.CallOption) (*UnarchivePostResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnarchivePostResponse)
+	err := c.cc.Invoke(ctx, PostService_UnarchivePost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) GetArchivedPosts(ctx context.Context, in *GetArchivedPostsRequest, opts ...grpc.CallOption) (*GetArchivedPostsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetArchivedPostsResponse)
+	err := c.cc.Invoke(ctx, PostService_GetArchivedPosts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *postServiceClient) SharePost(ctx context.Context, in *SharePostRequest, opts ...grpc.CallOption) (*SharePostResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(SharePostResponse)
@@ -353,6 +713,16 @@ func (c *postServiceClient) SharePost(ctx context.Context, in *SharePostRequest,
 	return out, nil
 }
 
+func (c *postServiceClient) SendPostToDirect(ctx context.Context, in *SendPostToDirectRequest, opts ...grpc.CallOption) (*SendPostToDirectResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SendPostToDirectResponse)
+	err := c.cc.Invoke(ctx, PostService_SendPostToDirect_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *postServiceClient) UnsharePost(ctx context.Context, in *UnsharePostRequest, opts ...grpc.CallOption) (*UnsharePostResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UnsharePostResponse)
@@ -383,6 +753,46 @@ func (c *postServiceClient) GetUserTaggedPosts(ctx context.Context, in *GetUserC
 	return out, nil
 }
 
+func (c *postServiceClient) RecordImpressions(ctx context.Context, in *RecordImpressionsRequest, opts ...grpc.CallOption) (*RecordImpressionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecordImpressionsResponse)
+	err := c.cc.Invoke(ctx, PostService_RecordImpressions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) RecordProfileVisit(ctx context.Context, in *RecordProfileVisitRequest, opts ...grpc.CallOption) (*RecordProfileVisitResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecordProfileVisitResponse)
+	err := c.cc.Invoke(ctx, PostService_RecordProfileVisit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) GetPostInsights(ctx context.Context, in *GetPostInsightsRequest, opts ...grpc.CallOption) (*PostInsights, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PostInsights)
+	err := c.cc.Invoke(ctx, PostService_GetPostInsights_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) GetAccountInsights(ctx context.Context, in *GetAccountInsightsRequest, opts ...grpc.CallOption) (*AccountInsights, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AccountInsights)
+	err := c.cc.Invoke(ctx, PostService_GetAccountInsights_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PostServiceServer is the server API for PostService service.
 // All implementations must embed UnimplementedPostServiceServer
 // for forward compatibility.
@@ -390,14 +800,29 @@ type PostServiceServer interface {
 	CreatePost(context.Context, *CreatePostRequest) (*CreatePostResponse, error)
 	LikePost(context.Context, *LikePostRequest) (*LikePostResponse, error)
 	UnlikePost(context.Context, *LikePostRequest) (*UnlikePostResponse, error)
+	GetPostLikers(context.Context, *GetPostLikersRequest) (*GetPostLikersResponse, error)
+	SetHideLikeCount(context.Context, *SetHideLikeCountRequest) (*SetHideLikeCountResponse, error)
 	CommentOnPost(context.Context, *CommentOnPostRequest) (*CommentResponse, error)
 	GetCommentsByPost(context.Context, *GetCommentsByPostRequest) (*GetCommentsByPostResponse, error)
+	GetCommentReplies(context.Context, *GetCommentRepliesRequest) (*GetCommentsByPostResponse, error)
 	DeleteComment(context.Context, *DeleteCommentRequest) (*DeleteCommentResponse, error)
+	UpdateCommentAudience(context.Context, *UpdateCommentAudienceRequest) (*UpdateCommentAudienceResponse, error)
+	EditComment(context.Context, *EditCommentRequest) (*CommentResponse, error)
+	PinComment(context.Context, *PinCommentRequest) (*PinCommentResponse, error)
+	HideComment(context.Context, *HideCommentRequest) (*HideCommentResponse, error)
 	LikeComment(context.Context, *LikeCommentRequest) (*LikeCommentResponse, error)
 	UnlikeComment(context.Context, *LikeCommentRequest) (*UnlikeCommentResponse, error)
 	GetHomeFeed(context.Context, *GetHomeFeedRequest) (*GetHomeFeedResponse, error)
+	// INTERNAL: called by worker-service from feed_fanout_queue to maintain home timelines
+	FanOutPost(context.Context, *FanOutPostRequest) (*TimelineUpdateResponse, error)
+	RetractPost(context.Context, *RetractPostRequest) (*TimelineUpdateResponse, error)
+	SyncTimelineAuthor(context.Context, *SyncTimelineAuthorRequest) (*TimelineUpdateResponse, error)
 	GetExploreFeed(context.Context, *GetHomeFeedRequest) (*GetHomeFeedResponse, error)
 	GetReelsFeed(context.Context, *GetHomeFeedRequest) (*GetHomeFeedResponse, error)
+	RecordReelWatch(context.Context, *RecordReelWatchRequest) (*RecordReelWatchResponse, error)
+	MarkNotInterested(context.Context, *MarkNotInterestedRequest) (*NotInterestedSignal, error)
+	GetNotInterested(context.Context, *GetNotInterestedRequest) (*GetNotInterestedResponse, error)
+	UndoNotInterested(context.Context, *UndoNotInterestedRequest) (*UndoNotInterestedResponse, error)
 	GetUserPosts(context.Context, *GetUserContentRequest) (*GetHomeFeedResponse, error)
 	GetUserReels(context.Context, *GetUserContentRequest) (*GetHomeFeedResponse, error)
 	GetUserContentCount(context.Context, *GetUserContentCountRequest) (*GetUserContentCountResponse, error)
@@ -409,13 +834,34 @@ type PostServiceServer interface {
 	UnsavePostFromCollection(context.Context, *UnsavePostFromCollectionRequest) (*UnsavePostFromCollectionResponse, error)
 	DeleteCollection(context.Context, *DeleteCollectionRequest) (*DeleteCollectionResponse, error)
 	RenameCollection(context.Context, *RenameCollectionRequest) (*Collection, error)
+	GetCollection(context.Context, *GetCollectionRequest) (*Collection, error)
+	SetCollectionPrivacy(context.Context, *SetCollectionPrivacyRequest) (*Collection, error)
+	InviteCollectionMember(context.Context, *InviteCollectionMemberRequest) (*CollectionMember, error)
+	RespondToCollectionInvite(context.Context, *RespondToCollectionInviteRequest) (*RespondToCollectionInviteResponse, error)
+	GetCollectionInvites(context.Context, *GetCollectionInvitesRequest) (*GetCollectionInvitesResponse, error)
+	GetCollectionMembers(context.Context, *GetCollectionMembersRequest) (*GetCollectionMembersResponse, error)
+	RemoveCollectionMember(context.Context, *RemoveCollectionMemberRequest) (*RemoveCollectionMemberResponse, error)
+	SetCollectionCover(context.Context, *SetCollectionCoverRequest) (*Collection, error)
+	ReorderCollectionPosts(context.Context, *ReorderCollectionPostsRequest) (*ReorderCollectionPostsResponse, error)
+	MoveSavedPosts(context.Context, *MoveSavedPostsRequest) (*MoveSavedPostsResponse, error)
 	GetPost(context.Context, *GetPostRequest) (*Post, error)
 	GetPosts(context.Context, *GetPostsRequest) (*GetPostsResponse, error)
 	DeletePost(context.Context, *DeletePostRequest) (*DeletePostResponse, error)
+	RestorePost(context.Context, *RestorePostRequest) (*RestorePostResponse, error)
+	GetRecentlyDeleted(context.Context, *GetRecentlyDeletedRequest) (*GetRecentlyDeletedResponse, error)
+	ArchivePost(context.Context, *ArchivePostRequest) (*ArchivePostResponse, error)
+	UnarchivePost(context.Context, *ArchivePostRequest) (*UnarchivePostResponse, error)
+	GetArchivedPosts(context.Context, *GetArchivedPostsRequest) (*GetArchivedPostsResponse, error)
 	SharePost(context.Context, *SharePostRequest) (*SharePostResponse, error)
+	SendPostToDirect(context.Context, *SendPostToDirectRequest) (*SendPostToDirectResponse, error)
 	UnsharePost(context.Context, *UnsharePostRequest) (*UnsharePostResponse, error)
 	GetSharedPosts(context.Context, *GetSharedPostsRequest) (*GetSharedPostsResponse, error)
 	GetUserTaggedPosts(context.Context, *GetUserContentRequest) (*GetHomeFeedResponse, error)
+	// Creator insights
+	RecordImpressions(context.Context, *RecordImpressionsRequest) (*RecordImpressionsResponse, error)
+	RecordProfileVisit(context.Context, *RecordProfileVisitRequest) (*RecordProfileVisitResponse, error)
+	GetPostInsights(context.Context, *GetPostInsightsRequest) (*PostInsights, error)
+	GetAccountInsights(context.Context, *GetAccountInsightsRequest) (*AccountInsights, error)
 	mustEmbedUnimplementedPostServiceServer()
 }
 
@@ -435,15 +881,36 @@ func (UnimplementedPostServiceServer) LikePost(context.Context, *LikePostRequest
 func (UnimplementedPostServiceServer) UnlikePost(context.Context, *LikePostRequest) (*UnlikePostResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UnlikePost not implemented")
 }
+func (UnimplementedPostServiceServer) GetPostLikers(context.Context, *GetPostLikersRequest) (*GetPostLikersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPostLikers not implemented")
+}
+func (UnimplementedPostServiceServer) SetHideLikeCount(context.Context, *SetHideLikeCountRequest) (*SetHideLikeCountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetHideLikeCount not implemented")
+}
 func (UnimplementedPostServiceServer) CommentOnPost(context.Context, *CommentOnPostRequest) (*CommentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CommentOnPost not implemented")
 }
 func (UnimplementedPostServiceServer) GetCommentsByPost(context.Context, *GetCommentsByPostRequest) (*GetCommentsByPostResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCommentsByPost not implemented")
 }
+func (UnimplementedPostServiceServer) GetCommentReplies(context.Context, *GetCommentRepliesRequest) (*GetCommentsByPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCommentReplies not implemented")
+}
 func (UnimplementedPostServiceServer) DeleteComment(context.Context, *DeleteCommentRequest) (*DeleteCommentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteComment not implemented")
 }
+func (UnimplementedPostServiceServer) UpdateCommentAudience(context.Context, *UpdateCommentAudienceRequest) (*UpdateCommentAudienceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCommentAudience not implemented")
+}
+func (UnimplementedPostServiceServer) EditComment(context.Context, *EditCommentRequest) (*CommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditComment not implemented")
+}
+func (UnimplementedPostServiceServer) PinComment(context.Context, *PinCommentRequest) (*PinCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PinComment not implemented")
+}
+func (UnimplementedPostServiceServer) HideComment(context.Context, *HideCommentRequest) (*HideCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HideComment not implemented")
+}
 func (UnimplementedPostServiceServer) LikeComment(context.Context, *LikeCommentRequest) (*LikeCommentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LikeComment not implemented")
 }
@@ -453,16 +920,37 @@ func (UnimplementedPostServiceServer) UnlikeComment(context.Context, *LikeCommen
 func (UnimplementedPostServiceServer) GetHomeFeed(context.Context, *GetHomeFeedRequest) (*GetHomeFeedResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetHomeFeed not implemented")
 }
+func (UnimplementedPostServiceServer) FanOutPost(context.Context, *FanOutPostRequest) (*TimelineUpdateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FanOutPost not implemented")
+}
+func (UnimplementedPostServiceServer) RetractPost(context.Context, *RetractPostRequest) (*TimelineUpdateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetractPost not implemented")
+}
+func (UnimplementedPostServiceServer) SyncTimelineAuthor(context.Context, *SyncTimelineAuthorRequest) (*TimelineUpdateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SyncTimelineAuthor not implemented")
+}
 func (UnimplementedPostServiceServer) GetExploreFeed(context.Context, *GetHomeFeedRequest) (*GetHomeFeedResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetExploreFeed not implemented")
 }
 func (UnimplementedPostServiceServer) GetReelsFeed(context.Context, *GetHomeFeedRequest) (*GetHomeFeedResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetReelsFeed not implemented")
 }
-func (UnimplementedPostServiceServer) GetUserPosts(context.Context, *GetUserContentRequest) (*GetHomeFeedResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetUserPosts not implemented")
+func (UnimplementedPostServiceServer) RecordReelWatch(context.Context, *RecordReelWatchRequest) (*RecordReelWatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordReelWatch not implemented")
 }
-func (UnimplementedPostServiceServer) GetUserReels(context.Context, *GetUserContentRequest) (*GetHomeFeedResponse, error) {
+func (UnimplementedPostServiceServer) MarkNotInterested(context.Context, *MarkNotInterestedRequest) (*NotInterestedSignal, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkNotInterested not implemented")
+}
+func (UnimplementedPostServiceServer) GetNotInterested(context.Context, *GetNotInterestedRequest) (*GetNotInterestedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNotInterested not implemented")
+}
+func (UnimplementedPostServiceServer) UndoNotInterested(context.Context, *UndoNotInterestedRequest) (*UndoNotInterestedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndoNotInterested not implemented")
+}
+func (UnimplementedPostServiceServer) GetUserPosts(context.Context, *GetUserContentRequest) (*GetHomeFeedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserPosts not implemented")
+}
+func (UnimplementedPostServiceServer) GetUserReels(context.Context, *GetUserContentRequest) (*GetHomeFeedResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUserReels not implemented")
 }
 func (UnimplementedPostServiceServer) GetUserContentCount(context.Context, *GetUserContentCountRequest) (*GetUserContentCountResponse, error) {
@@ -492,6 +980,36 @@ func (UnimplementedPostServiceServer) DeleteCollection(context.Context, *DeleteC
 func (UnimplementedPostServiceServer) RenameCollection(context.Context, *RenameCollectionRequest) (*Collection, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RenameCollection not implemented")
 }
+func (UnimplementedPostServiceServer) GetCollection(context.Context, *GetCollectionRequest) (*Collection, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCollection not implemented")
+}
+func (UnimplementedPostServiceServer) SetCollectionPrivacy(context.Context, *SetCollectionPrivacyRequest) (*Collection, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCollectionPrivacy not implemented")
+}
+func (UnimplementedPostServiceServer) InviteCollectionMember(context.Context, *InviteCollectionMemberRequest) (*CollectionMember, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InviteCollectionMember not implemented")
+}
+func (UnimplementedPostServiceServer) RespondToCollectionInvite(context.Context, *RespondToCollectionInviteRequest) (*RespondToCollectionInviteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RespondToCollectionInvite not implemented")
+}
+func (UnimplementedPostServiceServer) GetCollectionInvites(context.Context, *GetCollectionInvitesRequest) (*GetCollectionInvitesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCollectionInvites not implemented")
+}
+func (UnimplementedPostServiceServer) GetCollectionMembers(context.Context, *GetCollectionMembersRequest) (*GetCollectionMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCollectionMembers not implemented")
+}
+func (UnimplementedPostServiceServer) RemoveCollectionMember(context.Context, *RemoveCollectionMemberRequest) (*RemoveCollectionMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveCollectionMember not implemented")
+}
+func (UnimplementedPostServiceServer) SetCollectionCover(context.Context, *SetCollectionCoverRequest) (*Collection, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCollectionCover not implemented")
+}
+func (UnimplementedPostServiceServer) ReorderCollectionPosts(context.Context, *ReorderCollectionPostsRequest) (*ReorderCollectionPostsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReorderCollectionPosts not implemented")
+}
+func (UnimplementedPostServiceServer) MoveSavedPosts(context.Context, *MoveSavedPostsRequest) (*MoveSavedPostsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveSavedPosts not implemented")
+}
 func (UnimplementedPostServiceServer) GetPost(context.Context, *GetPostRequest) (*Post, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPost not implemented")
 }
@@ -501,9 +1019,27 @@ func (UnimplementedPostServiceServer) GetPosts(context.Context, *GetPostsRequest
 func (UnimplementedPostServiceServer) DeletePost(context.Context, *DeletePostRequest) (*DeletePostResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeletePost not implemented")
 }
+func (UnimplementedPostServiceServer) RestorePost(context.Context, *RestorePostRequest) (*RestorePostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestorePost not implemented")
+}
+func (UnimplementedPostServiceServer) GetRecentlyDeleted(context.Context, *GetRecentlyDeletedRequest) (*GetRecentlyDeletedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecentlyDeleted not implemented")
+}
+func (UnimplementedPostServiceServer) ArchivePost(context.Context, *ArchivePostRequest) (*ArchivePostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchivePost not implemented")
+}
+func (UnimplementedPostServiceServer) UnarchivePost(context.Context, *ArchivePostRequest) (*UnarchivePostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnarchivePost not implemented")
+}
+func (UnimplementedPostServiceServer) GetArchivedPosts(context.Context, *GetArchivedPostsRequest) (*GetArchivedPostsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetArchivedPosts not implemented")
+}
 func (UnimplementedPostServiceServer) SharePost(context.Context, *SharePostRequest) (*SharePostResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SharePost not implemented")
 }
+func (UnimplementedPostServiceServer) SendPostToDirect(context.Context, *SendPostToDirectRequest) (*SendPostToDirectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendPostToDirect not implemented")
+}
 func (UnimplementedPostServiceServer) UnsharePost(context.Context, *UnsharePostRequest) (*UnsharePostResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UnsharePost not implemented")
 }
@@ -513,6 +1049,18 @@ func (UnimplementedPostServiceServer) GetSharedPosts(context.Context, *GetShared
 func (UnimplementedPostServiceServer) GetUserTaggedPosts(context.Context, *GetUserContentRequest) (*GetHomeFeedResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUserTaggedPosts not implemented")
 }
+func (UnimplementedPostServiceServer) RecordImpressions(context.Context, *RecordImpressionsRequest) (*RecordImpressionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordImpressions not implemented")
+}
+func (UnimplementedPostServiceServer) RecordProfileVisit(context.Context, *RecordProfileVisitRequest) (*RecordProfileVisitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordProfileVisit not implemented")
+}
+func (UnimplementedPostServiceServer) GetPostInsights(context.Context, *GetPostInsightsRequest) (*PostInsights, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPostInsights not implemented")
+}
+func (UnimplementedPostServiceServer) GetAccountInsights(context.Context, *GetAccountInsightsRequest) (*AccountInsights, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountInsights not implemented")
+}
 func (UnimplementedPostServiceServer) mustEmbedUnimplementedPostServiceServer() {}
 func (UnimplementedPostServiceServer) testEmbeddedByValue()                     {}
 
@@ -540,518 +1088,1130 @@ func _PostService_CreatePost_Handler(srv interface{}, ctx context.Context, dec f
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PostServiceServer).CreatePost(ctx, in)
+		return srv.(PostServiceServer).CreatePost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_CreatePost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).CreatePost(ctx, req.(*CreatePostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_LikePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LikePostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).LikePost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_LikePost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).LikePost(ctx, req.(*LikePostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_UnlikePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LikePostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).UnlikePost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_UnlikePost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).UnlikePost(ctx, req.(*LikePostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_GetPostLikers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPostLikersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).GetPostLikers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_GetPostLikers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).GetPostLikers(ctx, req.(*GetPostLikersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_SetHideLikeCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetHideLikeCountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).SetHideLikeCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_SetHideLikeCount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).SetHideLikeCount(ctx, req.(*SetHideLikeCountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_CommentOnPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommentOnPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).CommentOnPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_CommentOnPost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).CommentOnPost(ctx, req.(*CommentOnPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_GetCommentsByPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCommentsByPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).GetCommentsByPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_GetCommentsByPost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).GetCommentsByPost(ctx, req.(*GetCommentsByPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_GetCommentReplies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCommentRepliesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).GetCommentReplies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_GetCommentReplies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).GetCommentReplies(ctx, req.(*GetCommentRepliesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_DeleteComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).DeleteComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_DeleteComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).DeleteComment(ctx, req.(*DeleteCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_UpdateCommentAudience_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCommentAudienceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).UpdateCommentAudience(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_UpdateCommentAudience_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).UpdateCommentAudience(ctx, req.(*UpdateCommentAudienceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_EditComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).EditComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_EditComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).EditComment(ctx, req.(*EditCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_PinComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PinCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).PinComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_PinComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).PinComment(ctx, req.(*PinCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_HideComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HideCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).HideComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_HideComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).HideComment(ctx, req.(*HideCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_LikeComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LikeCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).LikeComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_LikeComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).LikeComment(ctx, req.(*LikeCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_UnlikeComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LikeCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).UnlikeComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_UnlikeComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).UnlikeComment(ctx, req.(*LikeCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_GetHomeFeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHomeFeedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).GetHomeFeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_GetHomeFeed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).GetHomeFeed(ctx, req.(*GetHomeFeedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_FanOutPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FanOutPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).FanOutPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_FanOutPost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).FanOutPost(ctx, req.(*FanOutPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_RetractPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetractPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).RetractPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_RetractPost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).RetractPost(ctx, req.(*RetractPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_SyncTimelineAuthor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SyncTimelineAuthorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).SyncTimelineAuthor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_SyncTimelineAuthor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).SyncTimelineAuthor(ctx, req.(*SyncTimelineAuthorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_GetExploreFeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHomeFeedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).GetExploreFeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_GetExploreFeed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).GetExploreFeed(ctx, req.(*GetHomeFeedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_GetReelsFeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHomeFeedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).GetReelsFeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_GetReelsFeed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).GetReelsFeed(ctx, req.(*GetHomeFeedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_RecordReelWatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordReelWatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).RecordReelWatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_RecordReelWatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).RecordReelWatch(ctx, req.(*RecordReelWatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_MarkNotInterested_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkNotInterestedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).MarkNotInterested(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_MarkNotInterested_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).MarkNotInterested(ctx, req.(*MarkNotInterestedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_GetNotInterested_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNotInterestedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).GetNotInterested(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_GetNotInterested_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).GetNotInterested(ctx, req.(*GetNotInterestedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_UndoNotInterested_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UndoNotInterestedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).UndoNotInterested(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_UndoNotInterested_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).UndoNotInterested(ctx, req.(*UndoNotInterestedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_GetUserPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserContentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).GetUserPosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_GetUserPosts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).GetUserPosts(ctx, req.(*GetUserContentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_GetUserReels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserContentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).GetUserReels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_GetUserReels_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).GetUserReels(ctx, req.(*GetUserContentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_GetUserContentCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserContentCountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).GetUserContentCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_GetUserContentCount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).GetUserContentCount(ctx, req.(*GetUserContentCountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_CreateCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).CreateCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_CreateCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).CreateCollection(ctx, req.(*CreateCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_GetUserCollections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserCollectionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).GetUserCollections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_GetUserCollections_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).GetUserCollections(ctx, req.(*GetUserCollectionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_GetPostsInCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPostsInCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).GetPostsInCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_GetPostsInCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).GetPostsInCollection(ctx, req.(*GetPostsInCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_GetCollectionsForPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCollectionsForPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).GetCollectionsForPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_GetCollectionsForPost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).GetCollectionsForPost(ctx, req.(*GetCollectionsForPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_SavePostToCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SavePostToCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).SavePostToCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_SavePostToCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).SavePostToCollection(ctx, req.(*SavePostToCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_UnsavePostFromCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnsavePostFromCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).UnsavePostFromCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_UnsavePostFromCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).UnsavePostFromCollection(ctx, req.(*UnsavePostFromCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_DeleteCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).DeleteCollection(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PostService_CreatePost_FullMethodName,
+		FullMethod: PostService_DeleteCollection_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PostServiceServer).CreatePost(ctx, req.(*CreatePostRequest))
+		return srv.(PostServiceServer).DeleteCollection(ctx, req.(*DeleteCollectionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PostService_LikePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LikePostRequest)
+func _PostService_RenameCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameCollectionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PostServiceServer).LikePost(ctx, in)
+		return srv.(PostServiceServer).RenameCollection(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PostService_LikePost_FullMethodName,
+		FullMethod: PostService_RenameCollection_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PostServiceServer).LikePost(ctx, req.(*LikePostRequest))
+		return srv.(PostServiceServer).RenameCollection(ctx, req.(*RenameCollectionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PostService_UnlikePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LikePostRequest)
+func _PostService_GetCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCollectionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PostServiceServer).UnlikePost(ctx, in)
+		return srv.(PostServiceServer).GetCollection(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PostService_UnlikePost_FullMethodName,
+		FullMethod: PostService_GetCollection_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PostServiceServer).UnlikePost(ctx, req.(*LikePostRequest))
+		return srv.(PostServiceServer).GetCollection(ctx, req.(*GetCollectionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PostService_CommentOnPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CommentOnPostRequest)
+func _PostService_SetCollectionPrivacy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCollectionPrivacyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PostServiceServer).CommentOnPost(ctx, in)
+		return srv.(PostServiceServer).SetCollectionPrivacy(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PostService_CommentOnPost_FullMethodName,
+		FullMethod: PostService_SetCollectionPrivacy_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PostServiceServer).CommentOnPost(ctx, req.(*CommentOnPostRequest))
+		return srv.(PostServiceServer).SetCollectionPrivacy(ctx, req.(*SetCollectionPrivacyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PostService_GetCommentsByPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCommentsByPostRequest)
+func _PostService_InviteCollectionMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InviteCollectionMemberRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PostServiceServer).GetCommentsByPost(ctx, in)
+		return srv.(PostServiceServer).InviteCollectionMember(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PostService_GetCommentsByPost_FullMethodName,
+		FullMethod: PostService_InviteCollectionMember_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PostServiceServer).GetCommentsByPost(ctx, req.(*GetCommentsByPostRequest))
+		return srv.(PostServiceServer).InviteCollectionMember(ctx, req.(*InviteCollectionMemberRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PostService_DeleteComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteCommentRequest)
+func _PostService_RespondToCollectionInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RespondToCollectionInviteRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PostServiceServer).DeleteComment(ctx, in)
+		return srv.(PostServiceServer).RespondToCollectionInvite(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PostService_DeleteComment_FullMethodName,
+		FullMethod: PostService_RespondToCollectionInvite_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PostServiceServer).DeleteComment(ctx, req.(*DeleteCommentRequest))
+		return srv.(PostServiceServer).RespondToCollectionInvite(ctx, req.(*RespondToCollectionInviteRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PostService_LikeComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LikeCommentRequest)
+func _PostService_GetCollectionInvites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCollectionInvitesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PostServiceServer).LikeComment(ctx, in)
+		return srv.(PostServiceServer).GetCollectionInvites(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PostService_LikeComment_FullMethodName,
+		FullMethod: PostService_GetCollectionInvites_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PostServiceServer).LikeComment(ctx, req.(*LikeCommentRequest))
+		return srv.(PostServiceServer).GetCollectionInvites(ctx, req.(*GetCollectionInvitesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PostService_UnlikeComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LikeCommentRequest)
+func _PostService_GetCollectionMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCollectionMembersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PostServiceServer).UnlikeComment(ctx, in)
+		return srv.(PostServiceServer).GetCollectionMembers(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PostService_UnlikeComment_FullMethodName,
+		FullMethod: PostService_GetCollectionMembers_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PostServiceServer).UnlikeComment(ctx, req.(*LikeCommentRequest))
+		return srv.(PostServiceServer).GetCollectionMembers(ctx, req.(*GetCollectionMembersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PostService_GetHomeFeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetHomeFeedRequest)
+func _PostService_RemoveCollectionMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveCollectionMemberRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PostServiceServer).GetHomeFeed(ctx, in)
+		return srv.(PostServiceServer).RemoveCollectionMember(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PostService_GetHomeFeed_FullMethodName,
+		FullMethod: PostService_RemoveCollectionMember_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PostServiceServer).GetHomeFeed(ctx, req.(*GetHomeFeedRequest))
+		return srv.(PostServiceServer).RemoveCollectionMember(ctx, req.(*RemoveCollectionMemberRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PostService_GetExploreFeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetHomeFeedRequest)
+func _PostService_SetCollectionCover_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCollectionCoverRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PostServiceServer).GetExploreFeed(ctx, in)
+		return srv.(PostServiceServer).SetCollectionCover(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PostService_GetExploreFeed_FullMethodName,
+		FullMethod: PostService_SetCollectionCover_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PostServiceServer).GetExploreFeed(ctx, req.(*GetHomeFeedRequest))
+		return srv.(PostServiceServer).SetCollectionCover(ctx, req.(*SetCollectionCoverRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PostService_GetReelsFeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetHomeFeedRequest)
+func _PostService_ReorderCollectionPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderCollectionPostsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PostServiceServer).GetReelsFeed(ctx, in)
+		return srv.(PostServiceServer).ReorderCollectionPosts(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PostService_GetReelsFeed_FullMethodName,
+		FullMethod: PostService_ReorderCollectionPosts_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PostServiceServer).GetReelsFeed(ctx, req.(*GetHomeFeedRequest))
+		return srv.(PostServiceServer).ReorderCollectionPosts(ctx, req.(*ReorderCollectionPostsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PostService_GetUserPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUserContentRequest)
+func _PostService_MoveSavedPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveSavedPostsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PostServiceServer).GetUserPosts(ctx, in)
+		return srv.(PostServiceServer).MoveSavedPosts(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PostService_GetUserPosts_FullMethodName,
+		FullMethod: PostService_MoveSavedPosts_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PostServiceServer).GetUserPosts(ctx, req.(*GetUserContentRequest))
+		return srv.(PostServiceServer).MoveSavedPosts(ctx, req.(*MoveSavedPostsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PostService_GetUserReels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUserContentRequest)
+func _PostService_GetPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPostRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PostServiceServer).GetUserReels(ctx, in)
+		return srv.(PostServiceServer).GetPost(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PostService_GetUserReels_FullMethodName,
+		FullMethod: PostService_GetPost_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PostServiceServer).GetUserReels(ctx, req.(*GetUserContentRequest))
+		return srv.(PostServiceServer).GetPost(ctx, req.(*GetPostRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PostService_GetUserContentCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUserContentCountRequest)
+func _PostService_GetPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPostsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PostServiceServer).GetUserContentCount(ctx, in)
+		return srv.(PostServiceServer).GetPosts(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PostService_GetUserContentCount_FullMethodName,
+		FullMethod: PostService_GetPosts_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PostServiceServer).GetUserContentCount(ctx, req.(*GetUserContentCountRequest))
+		return srv.(PostServiceServer).GetPosts(ctx, req.(*GetPostsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PostService_CreateCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateCollectionRequest)
+func _PostService_DeletePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePostRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PostServiceServer).CreateCollection(ctx, in)
+		return srv.(PostServiceServer).DeletePost(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PostService_CreateCollection_FullMethodName,
+		FullMethod: PostService_DeletePost_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PostServiceServer).CreateCollection(ctx, req.(*CreateCollectionRequest))
+		return srv.(PostServiceServer).DeletePost(ctx, req.(*DeletePostRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PostService_GetUserCollections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUserCollectionsRequest)
+func _PostService_RestorePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestorePostRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PostServiceServer).GetUserCollections(ctx, in)
+		return srv.(PostServiceServer).RestorePost(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PostService_GetUserCollections_FullMethodName,
+		FullMethod: PostService_RestorePost_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PostServiceServer).GetUserCollections(ctx, req.(*GetUserCollectionsRequest))
+		return srv.(PostServiceServer).RestorePost(ctx, req.(*RestorePostRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PostService_GetPostsInCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetPostsInCollectionRequest)
+func _PostService_GetRecentlyDeleted_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRecentlyDeletedRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PostServiceServer).GetPostsInCollection(ctx, in)
+		return srv.(PostServiceServer).GetRecentlyDeleted(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PostService_GetPostsInCollection_FullMethodName,
+		FullMethod: PostService_GetRecentlyDeleted_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PostServiceServer).GetPostsInCollection(ctx, req.(*GetPostsInCollectionRequest))
+		return srv.(PostServiceServer).GetRecentlyDeleted(ctx, req.(*GetRecentlyDeletedRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PostService_GetCollectionsForPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCollectionsForPostRequest)
+func _PostService_ArchivePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchivePostRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PostServiceServer).GetCollectionsForPost(ctx, in)
+		return srv.(PostServiceServer).ArchivePost(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PostService_GetCollectionsForPost_FullMethodName,
+		FullMethod: PostService_ArchivePost_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PostServiceServer).GetCollectionsForPost(ctx, req.(*GetCollectionsForPostRequest))
+		return srv.(PostServiceServer).ArchivePost(ctx, req.(*ArchivePostRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PostService_SavePostToCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SavePostToCollectionRequest)
+func _PostService_UnarchivePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchivePostRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PostServiceServer).SavePostToCollection(ctx, in)
+		return srv.(PostServiceServer).UnarchivePost(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PostService_SavePostToCollection_FullMethodName,
+		FullMethod: PostService_UnarchivePost_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PostServiceServer).SavePostToCollection(ctx, req.(*SavePostToCollectionRequest))
+		return srv.(PostServiceServer).UnarchivePost(ctx, req.(*ArchivePostRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PostService_UnsavePostFromCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UnsavePostFromCollectionRequest)
+func _PostService_GetArchivedPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetArchivedPostsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PostServiceServer).UnsavePostFromCollection(ctx, in)
+		return srv.(PostServiceServer).GetArchivedPosts(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PostService_UnsavePostFromCollection_FullMethodName,
+		FullMethod: PostService_GetArchivedPosts_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PostServiceServer).UnsavePostFromCollection(ctx, req.(*UnsavePostFromCollectionRequest))
+		return srv.(PostServiceServer).GetArchivedPosts(ctx, req.(*GetArchivedPostsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PostService_DeleteCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteCollectionRequest)
+func _PostService_SharePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SharePostRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PostServiceServer).DeleteCollection(ctx, in)
+		return srv.(PostServiceServer).SharePost(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PostService_DeleteCollection_FullMethodName,
+		FullMethod: PostService_SharePost_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PostServiceServer).DeleteCollection(ctx, req.(*DeleteCollectionRequest))
+		return srv.(PostServiceServer).SharePost(ctx, req.(*SharePostRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PostService_RenameCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RenameCollectionRequest)
+func _PostService_SendPostToDirect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendPostToDirectRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PostServiceServer).RenameCollection(ctx, in)
+		return srv.(PostServiceServer).SendPostToDirect(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PostService_RenameCollection_FullMethodName,
+		FullMethod: PostService_SendPostToDirect_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PostServiceServer).RenameCollection(ctx, req.(*RenameCollectionRequest))
+		return srv.(PostServiceServer).SendPostToDirect(ctx, req.(*SendPostToDirectRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PostService_GetPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetPostRequest)
+func _PostService_UnsharePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnsharePostRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PostServiceServer).GetPost(ctx, in)
+		return srv.(PostServiceServer).UnsharePost(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PostService_GetPost_FullMethodName,
+		FullMethod: PostService_UnsharePost_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PostServiceServer).GetPost(ctx, req.(*GetPostRequest))
+		return srv.(PostServiceServer).UnsharePost(ctx, req.(*UnsharePostRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PostService_GetPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetPostsRequest)
+func _PostService_GetSharedPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSharedPostsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PostServiceServer).GetPosts(ctx, in)
+		return srv.(PostServiceServer).GetSharedPosts(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PostService_GetPosts_FullMethodName,
+		FullMethod: PostService_GetSharedPosts_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PostServiceServer).GetPosts(ctx, req.(*GetPostsRequest))
+		return srv.(PostServiceServer).GetSharedPosts(ctx, req.(*GetSharedPostsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PostService_DeletePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeletePostRequest)
+func _PostService_GetUserTaggedPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserContentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PostServiceServer).DeletePost(ctx, in)
+		return srv.(PostServiceServer).GetUserTaggedPosts(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PostService_DeletePost_FullMethodName,
+		FullMethod: PostService_GetUserTaggedPosts_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PostServiceServer).DeletePost(ctx, req.(*DeletePostRequest))
+		return srv.(PostServiceServer).GetUserTaggedPosts(ctx, req.(*GetUserContentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PostService_SharePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SharePostRequest)
+func _PostService_RecordImpressions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordImpressionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PostServiceServer).SharePost(ctx, in)
+		return srv.(PostServiceServer).RecordImpressions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PostService_SharePost_FullMethodName,
+		FullMethod: PostService_RecordImpressions_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PostServiceServer).SharePost(ctx, req.(*SharePostRequest))
+		return srv.(PostServiceServer).RecordImpressions(ctx, req.(*RecordImpressionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PostService_UnsharePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UnsharePostRequest)
+func _PostService_RecordProfileVisit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordProfileVisitRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PostServiceServer).UnsharePost(ctx, in)
+		return srv.(PostServiceServer).RecordProfileVisit(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PostService_UnsharePost_FullMethodName,
+		FullMethod: PostService_RecordProfileVisit_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PostServiceServer).UnsharePost(ctx, req.(*UnsharePostRequest))
+		return srv.(PostServiceServer).RecordProfileVisit(ctx, req.(*RecordProfileVisitRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PostService_GetSharedPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetSharedPostsRequest)
+func _PostService_GetPostInsights_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPostInsightsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PostServiceServer).GetSharedPosts(ctx, in)
+		return srv.(PostServiceServer).GetPostInsights(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PostService_GetSharedPosts_FullMethodName,
+		FullMethod: PostService_GetPostInsights_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PostServiceServer).GetSharedPosts(ctx, req.(*GetSharedPostsRequest))
+		return srv.(PostServiceServer).GetPostInsights(ctx, req.(*GetPostInsightsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PostService_GetUserTaggedPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUserContentRequest)
+func _PostService_GetAccountInsights_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountInsightsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PostServiceServer).GetUserTaggedPosts(ctx, in)
+		return srv.(PostServiceServer).GetAccountInsights(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PostService_GetUserTaggedPosts_FullMethodName,
+		FullMethod: PostService_GetAccountInsights_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PostServiceServer).GetUserTaggedPosts(ctx, req.(*GetUserContentRequest))
+		return srv.(PostServiceServer).GetAccountInsights(ctx, req.(*GetAccountInsightsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -1075,6 +2235,14 @@ var PostService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UnlikePost",
 			Handler:    _PostService_UnlikePost_Handler,
 		},
+		{
+			MethodName: "GetPostLikers",
+			Handler:    _PostService_GetPostLikers_Handler,
+		},
+		{
+			MethodName: "SetHideLikeCount",
+			Handler:    _PostService_SetHideLikeCount_Handler,
+		},
 		{
 			MethodName: "CommentOnPost",
 			Handler:    _PostService_CommentOnPost_Handler,
@@ -1083,10 +2251,30 @@ var PostService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetCommentsByPost",
 			Handler:    _PostService_GetCommentsByPost_Handler,
 		},
+		{
+			MethodName: "GetCommentReplies",
+			Handler:    _PostService_GetCommentReplies_Handler,
+		},
 		{
 			MethodName: "DeleteComment",
 			Handler:    _PostService_DeleteComment_Handler,
 		},
+		{
+			MethodName: "UpdateCommentAudience",
+			Handler:    _PostService_UpdateCommentAudience_Handler,
+		},
+		{
+			MethodName: "EditComment",
+			Handler:    _PostService_EditComment_Handler,
+		},
+		{
+			MethodName: "PinComment",
+			Handler:    _PostService_PinComment_Handler,
+		},
+		{
+			MethodName: "HideComment",
+			Handler:    _PostService_HideComment_Handler,
+		},
 		{
 			MethodName: "LikeComment",
 			Handler:    _PostService_LikeComment_Handler,
@@ -1099,6 +2287,18 @@ var PostService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetHomeFeed",
 			Handler:    _PostService_GetHomeFeed_Handler,
 		},
+		{
+			MethodName: "FanOutPost",
+			Handler:    _PostService_FanOutPost_Handler,
+		},
+		{
+			MethodName: "RetractPost",
+			Handler:    _PostService_RetractPost_Handler,
+		},
+		{
+			MethodName: "SyncTimelineAuthor",
+			Handler:    _PostService_SyncTimelineAuthor_Handler,
+		},
 		{
 			MethodName: "GetExploreFeed",
 			Handler:    _PostService_GetExploreFeed_Handler,
@@ -1107,6 +2307,22 @@ var PostService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetReelsFeed",
 			Handler:    _PostService_GetReelsFeed_Handler,
 		},
+		{
+			MethodName: "RecordReelWatch",
+			Handler:    _PostService_RecordReelWatch_Handler,
+		},
+		{
+			MethodName: "MarkNotInterested",
+			Handler:    _PostService_MarkNotInterested_Handler,
+		},
+		{
+			MethodName: "GetNotInterested",
+			Handler:    _PostService_GetNotInterested_Handler,
+		},
+		{
+			MethodName: "UndoNotInterested",
+			Handler:    _PostService_UndoNotInterested_Handler,
+		},
 		{
 			MethodName: "GetUserPosts",
 			Handler:    _PostService_GetUserPosts_Handler,
@@ -1151,6 +2367,46 @@ var PostService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RenameCollection",
 			Handler:    _PostService_RenameCollection_Handler,
 		},
+		{
+			MethodName: "GetCollection",
+			Handler:    _PostService_GetCollection_Handler,
+		},
+		{
+			MethodName: "SetCollectionPrivacy",
+			Handler:    _PostService_SetCollectionPrivacy_Handler,
+		},
+		{
+			MethodName: "InviteCollectionMember",
+			Handler:    _PostService_InviteCollectionMember_Handler,
+		},
+		{
+			MethodName: "RespondToCollectionInvite",
+			Handler:    _PostService_RespondToCollectionInvite_Handler,
+		},
+		{
+			MethodName: "GetCollectionInvites",
+			Handler:    _PostService_GetCollectionInvites_Handler,
+		},
+		{
+			MethodName: "GetCollectionMembers",
+			Handler:    _PostService_GetCollectionMembers_Handler,
+		},
+		{
+			MethodName: "RemoveCollectionMember",
+			Handler:    _PostService_RemoveCollectionMember_Handler,
+		},
+		{
+			MethodName: "SetCollectionCover",
+			Handler:    _PostService_SetCollectionCover_Handler,
+		},
+		{
+			MethodName: "ReorderCollectionPosts",
+			Handler:    _PostService_ReorderCollectionPosts_Handler,
+		},
+		{
+			MethodName: "MoveSavedPosts",
+			Handler:    _PostService_MoveSavedPosts_Handler,
+		},
 		{
 			MethodName: "GetPost",
 			Handler:    _PostService_GetPost_Handler,
@@ -1163,10 +2419,34 @@ var PostService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeletePost",
 			Handler:    _PostService_DeletePost_Handler,
 		},
+		{
+			MethodName: "RestorePost",
+			Handler:    _PostService_RestorePost_Handler,
+		},
+		{
+			MethodName: "GetRecentlyDeleted",
+			Handler:    _PostService_GetRecentlyDeleted_Handler,
+		},
+		{
+			MethodName: "ArchivePost",
+			Handler:    _PostService_ArchivePost_Handler,
+		},
+		{
+			MethodName: "UnarchivePost",
+			Handler:    _PostService_UnarchivePost_Handler,
+		},
+		{
+			MethodName: "GetArchivedPosts",
+			Handler:    _PostService_GetArchivedPosts_Handler,
+		},
 		{
 			MethodName: "SharePost",
 			Handler:    _PostService_SharePost_Handler,
 		},
+		{
+			MethodName: "SendPostToDirect",
+			Handler:    _PostService_SendPostToDirect_Handler,
+		},
 		{
 			MethodName: "UnsharePost",
 			Handler:    _PostService_UnsharePost_Handler,
@@ -1179,6 +2459,22 @@ var PostService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetUserTaggedPosts",
 			Handler:    _PostService_GetUserTaggedPosts_Handler,
 		},
+		{
+			MethodName: "RecordImpressions",
+			Handler:    _PostService_RecordImpressions_Handler,
+		},
+		{
+			MethodName: "RecordProfileVisit",
+			Handler:    _PostService_RecordProfileVisit_Handler,
+		},
+		{
+			MethodName: "GetPostInsights",
+			Handler:    _PostService_GetPostInsights_Handler,
+		},
+		{
+			MethodName: "GetAccountInsights",
+			Handler:    _PostService_GetAccountInsights_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "post.proto",
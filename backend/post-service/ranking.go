@@ -0,0 +1,249 @@
+package main
+
+import (
+	"context"
+	"log"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	messagePb "github.com/hoshibmatchi/message-service/proto"
+)
+
+// Sort orders accepted by GetHomeFeed
+const (
+	feedSortChronological = "chronological"
+	feedSortRanked        = "ranked"
+)
+
+const (
+	// rankCandidateLimit bounds how many of the newest feed posts are scored for a
+	// ranked feed; the ranked feed ends once all of them have been served
+	rankCandidateLimit = 300
+
+	// affinityWindowDays is how far back likes, comments and DMs count toward affinity
+	affinityWindowDays = 30
+
+	// rankScoreScale turns float scores into the integer stored in page cursors
+	rankScoreScale = 1e9
+)
+
+// authorAffinity is the viewer's recent interactions with one author
+type authorAffinity struct {
+	Likes    int64
+	Comments int64
+	Messages int64
+}
+
+// rankSignals carries the per-viewer inputs a Ranker can't read off the post itself
+type rankSignals struct {
+	Now      time.Time
+	Affinity map[int64]authorAffinity // author ID -> interactions
+
+	// Explore only: why each candidate was picked, keyed by post ID
+	HashtagMatches map[uint]int32
+	FolloweeLikes  map[uint]int64
+
+	// Reels only: watch aggregates keyed by post ID
+	ReelStats map[uint]ReelStat
+}
+
+// Ranker scores feed candidates; higher scores are shown first
+type Ranker interface {
+	Score(post *Post, signals *rankSignals) float64
+}
+
+// defaultRanker multiplies recency decay, author affinity, engagement velocity
+// and a media-type weight
+type defaultRanker struct {
+	RecencyHalfLife time.Duration
+	AffinityWeight  float64
+	VelocityWeight  float64
+	MediaWeights    map[string]float64 // keyed by postMediaType
+}
+
+func newDefaultRanker() *defaultRanker {
+	return &defaultRanker{
+		RecencyHalfLife: 12 * time.Hour,
+		AffinityWeight:  0.5,
+		VelocityWeight:  0.3,
+		MediaWeights: map[string]float64{
+			"video":    1.2,
+			"carousel": 1.1,
+			"image":    1.0,
+			"text":     0.9,
+		},
+	}
+}
+
+func (r *defaultRanker) Score(post *Post, signals *rankSignals) float64 {
+	age := signals.Now.Sub(post.CreatedAt)
+	if age < 0 {
+		age = 0
+	}
+	recency := math.Exp2(-age.Hours() / r.RecencyHalfLife.Hours())
+
+	a := signals.Affinity[post.AuthorID]
+	affinity := float64(a.Likes) + 2*float64(a.Comments) + 3*float64(a.Messages)
+
+	// Engagement per hour since posting, with a one-hour floor so a brand new
+	// post with a single like doesn't look viral
+	hours := math.Max(age.Hours(), 1)
+	velocity := (float64(post.LikeCount) + 2*float64(post.CommentCount)) / hours
+
+	mediaWeight, ok := r.MediaWeights[postMediaType(post)]
+	if !ok {
+		mediaWeight = 1
+	}
+
+	return recency *
+		(1 + r.AffinityWeight*math.Log1p(affinity)) *
+		(1 + r.VelocityWeight*math.Log1p(velocity)) *
+		mediaWeight
+}
+
+// postMediaType classifies a post as video, carousel, image or text
+func postMediaType(post *Post) string {
+	if post.IsReel {
+		return "video"
+	}
+	for _, url := range post.MediaURLs {
+		if strings.HasSuffix(url, ".mp4") || strings.HasSuffix(url, ".mov") {
+			return "video"
+		}
+	}
+	switch {
+	case len(post.MediaURLs) > 1:
+		return "carousel"
+	case len(post.MediaURLs) == 1:
+		return "image"
+	default:
+		return "text"
+	}
+}
+
+// rankPage scores the candidates and returns the page after the cursor.
+// Ranked cursors carry the scoring clock in CreatedAt so later pages are scored
+// against the same moment, and the last item's (score, id) in Score and ID.
+func rankPage(ranker Ranker, candidates []Post, signals *rankSignals, cursor *pageCursor, pageSize int) ([]Post, *pageCursor) {
+	type scoredPost struct {
+		post  Post
+		score int64
+	}
+	scored := make([]scoredPost, 0, len(candidates))
+	for i := range candidates {
+		score := int64(ranker.Score(&candidates[i], signals) * rankScoreScale)
+		if cursor != nil && (score > cursor.Score || (score == cursor.Score && candidates[i].ID >= cursor.ID)) {
+			continue
+		}
+		scored = append(scored, scoredPost{post: candidates[i], score: score})
+	}
+
+	sort.Slice(scored, func(i, j int) bool {
+		if scored[i].score != scored[j].score {
+			return scored[i].score > scored[j].score
+		}
+		return scored[i].post.ID > scored[j].post.ID
+	})
+
+	var next *pageCursor
+	if len(scored) > pageSize {
+		scored = scored[:pageSize]
+		last := scored[len(scored)-1]
+		next = &pageCursor{CreatedAt: signals.Now, ID: last.post.ID, Score: last.score}
+	}
+
+	posts := make([]Post, len(scored))
+	for i := range scored {
+		posts[i] = scored[i].post
+	}
+	return posts, next
+}
+
+// rankedHomePage ranks the newest rankCandidateLimit feed posts and returns one page
+func (s *server) rankedHomePage(ctx context.Context, userID int64, cursor *pageCursor, pageSize int) ([]Post, *pageCursor, error) {
+	now := time.Now()
+	if cursor != nil {
+		now = cursor.CreatedAt
+	}
+
+	timelinePosts, pulledPosts, floor, err := s.readHomeCandidates(ctx, userID, nil, rankCandidateLimit, rankCandidateLimit)
+	if err != nil {
+		return nil, nil, err
+	}
+	candidates, _ := mergeTimelinePage(timelinePosts, pulledPosts, nil, rankCandidateLimit, floor)
+
+	seen := make(map[int64]bool)
+	var authorIDs []int64
+	for _, post := range candidates {
+		if post.AuthorID != userID && !seen[post.AuthorID] {
+			seen[post.AuthorID] = true
+			authorIDs = append(authorIDs, post.AuthorID)
+		}
+	}
+
+	signals := &rankSignals{Now: now, Affinity: s.loadAuthorAffinity(ctx, userID, authorIDs)}
+	posts, next := rankPage(s.ranker, candidates, signals, cursor, pageSize)
+	return posts, next, nil
+}
+
+// loadAuthorAffinity counts the viewer's recent likes and comments on each author's
+// posts and the DMs they exchanged. A failing source just contributes nothing.
+func (s *server) loadAuthorAffinity(ctx context.Context, viewerID int64, authorIDs []int64) map[int64]authorAffinity {
+	affinity := make(map[int64]authorAffinity, len(authorIDs))
+	if len(authorIDs) == 0 {
+		return affinity
+	}
+	since := time.Now().AddDate(0, 0, -affinityWindowDays)
+
+	var rows []struct {
+		AuthorID int64
+		Count    int64
+	}
+	if err := s.db.Table("post_likes").
+		Select("posts.author_id, COUNT(*) AS count").
+		Joins("JOIN posts ON posts.id = post_likes.post_id").
+		Where("post_likes.user_id = ? AND posts.author_id IN ? AND post_likes.created_at > ?", viewerID, authorIDs, since).
+		Group("posts.author_id").
+		Scan(&rows).Error; err != nil {
+		log.Printf("Failed to load like affinity for user %d: %v", viewerID, err)
+	}
+	for _, row := range rows {
+		a := affinity[row.AuthorID]
+		a.Likes = row.Count
+		affinity[row.AuthorID] = a
+	}
+
+	rows = nil
+	if err := s.db.Table("comments").
+		Select("posts.author_id, COUNT(*) AS count").
+		Joins("JOIN posts ON posts.id = comments.post_id").
+		Where("comments.user_id = ? AND posts.author_id IN ? AND comments.created_at > ? AND comments.deleted_at IS NULL", viewerID, authorIDs, since).
+		Group("posts.author_id").
+		Scan(&rows).Error; err != nil {
+		log.Printf("Failed to load comment affinity for user %d: %v", viewerID, err)
+	}
+	for _, row := range rows {
+		a := affinity[row.AuthorID]
+		a.Comments = row.Count
+		affinity[row.AuthorID] = a
+	}
+
+	dmRes, err := s.messageClient.GetDirectMessageCounts(ctx, &messagePb.GetDirectMessageCountsRequest{
+		UserId:    viewerID,
+		PeerIds:   authorIDs,
+		SinceDays: affinityWindowDays,
+	})
+	if err != nil {
+		log.Printf("Failed to load DM affinity for user %d: %v", viewerID, err)
+		return affinity
+	}
+	for authorID, count := range dmRes.Counts {
+		a := affinity[authorID]
+		a.Messages = int64(count)
+		affinity[authorID] = a
+	}
+
+	return affinity
+}
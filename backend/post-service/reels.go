@@ -0,0 +1,234 @@
+package main
+
+import (
+	"context"
+	"log"
+	"math"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	pb "github.com/hoshibmatchi/post-service/proto"
+)
+
+// The reels feed ranks recent reels by how often they are watched to the end,
+// penalizing skips, with a freshness decay. Reels the viewer has already
+// watched are left out.
+const (
+	reelWindowDays = 30 // Only reels this recent are candidates
+	reelHalfLife   = 72 * time.Hour
+
+	// Completion and skip rates are smoothed toward a prior so a reel with one
+	// completed play doesn't outrank everything else
+	reelPriorPlays      = 10
+	reelPriorCompletion = 0.3
+)
+
+// ReelWatch is one viewer's accumulated watching of one reel
+type ReelWatch struct {
+	UserID        int64 `gorm:"primaryKey"`
+	PostID        int64 `gorm:"primaryKey;index"`
+	WatchMs       int64
+	Plays         int64
+	Replays       int64
+	Completed     bool // Finished at least once
+	Skipped       bool // The latest viewing was a skip
+	LastWatchedAt time.Time
+}
+
+// ReelStat aggregates every viewer's watching of one reel
+type ReelStat struct {
+	PostID      int64 `gorm:"primaryKey"`
+	Viewers     int64 // Unique viewers
+	Plays       int64
+	Completions int64
+	Replays     int64
+	Skips       int64
+	WatchMs     int64
+	UpdatedAt   time.Time
+}
+
+// reelRanker scores reels by smoothed completion rate, rewatches and skips,
+// decayed by age
+type reelRanker struct{}
+
+func (reelRanker) Score(post *Post, signals *rankSignals) float64 {
+	age := signals.Now.Sub(post.CreatedAt)
+	if age < 0 {
+		age = 0
+	}
+	stat := signals.ReelStats[post.ID]
+	plays := float64(stat.Plays) + reelPriorPlays
+	completion := (float64(stat.Completions) + reelPriorPlays*reelPriorCompletion) / plays
+	skipRate := float64(stat.Skips) / plays
+	rewatch := math.Log1p(float64(stat.Replays) / plays)
+
+	return (completion + 0.5*rewatch) * (1 - 0.5*skipRate) * math.Exp2(-age.Hours()/reelHalfLife.Hours())
+}
+
+// --- Implement GetReelsFeed ---
+func (s *server) GetReelsFeed(ctx context.Context, req *pb.GetHomeFeedRequest) (*pb.GetHomeFeedResponse, error) {
+	log.Printf("GetReelsFeed request received for user %d", req.UserId)
+
+	cursor, err := decodeCursor(req.Cursor)
+	if err != nil {
+		return nil, err
+	}
+	pageSize := normalizePageSize(req.PageSize)
+	now := time.Now()
+	if cursor != nil {
+		now = cursor.CreatedAt // Score later pages against the same clock
+	}
+
+	// Later pages keep to the candidate set the first page came from
+	rewatch := cursor != nil && cursor.Rewatch
+	candidates, err := s.reelCandidates(ctx, req.UserId, !rewatch)
+	if err != nil {
+		return nil, err
+	}
+	if len(candidates) == 0 && cursor == nil {
+		// Everything recent has been watched; rewatching beats an empty feed
+		rewatch = true
+		if candidates, err = s.reelCandidates(ctx, req.UserId, false); err != nil {
+			return nil, err
+		}
+	}
+
+	signals := &rankSignals{Now: now, ReelStats: s.loadReelStats(candidates)}
+	posts, next := rankPage(reelRanker{}, candidates, signals, cursor, pageSize)
+
+	response := &pb.GetHomeFeedResponse{Posts: s.enrichPosts(ctx, posts, req.UserId)}
+	if next != nil {
+		next.Rewatch = rewatch
+		response.NextCursor = encodeCursor(*next)
+	}
+	return response, nil
+}
+
+// reelCandidates loads the newest reels the viewer may see and hasn't marked as
+// not interested, optionally leaving out the ones they have already watched
+func (s *server) reelCandidates(ctx context.Context, viewerID int64, unwatchedOnly bool) ([]Post, error) {
+	query := s.db.Scopes(notArchived, excludeNotInterested(s.db, viewerID)).
+		Where("is_reel = ? AND author_id != ? AND created_at > ?", true, viewerID, time.Now().AddDate(0, 0, -reelWindowDays))
+	if unwatchedOnly {
+		query = query.Where("id NOT IN (?)", s.db.Model(&ReelWatch{}).Select("post_id").Where("user_id = ?", viewerID))
+	}
+
+	var posts []Post
+	if err := query.Order("created_at DESC").Limit(rankCandidateLimit).Find(&posts).Error; err != nil {
+		return nil, status.Error(codes.Internal, "Failed to retrieve posts")
+	}
+	posts, err := s.filterPostsByPrivacy(ctx, posts, viewerID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to retrieve posts")
+	}
+	return s.filterNotInterestedHashtags(posts, viewerID), nil
+}
+
+// loadReelStats returns the watch aggregates for each post, keyed by post ID.
+// Reels nobody has watched yet are simply missing.
+func (s *server) loadReelStats(posts []Post) map[uint]ReelStat {
+	stats := make(map[uint]ReelStat, len(posts))
+	if len(posts) == 0 {
+		return stats
+	}
+	ids := make([]int64, len(posts))
+	for i := range posts {
+		ids[i] = int64(posts[i].ID)
+	}
+
+	var rows []ReelStat
+	if err := s.db.Where("post_id IN ?", ids).Find(&rows).Error; err != nil {
+		log.Printf("Failed to load reel stats: %v", err)
+		return stats
+	}
+	for _, row := range rows {
+		stats[uint(row.PostID)] = row
+	}
+	return stats
+}
+
+// --- GRPC: RecordReelWatch ---
+// RecordReelWatch stores one viewing of a reel, updating the viewer's watch record
+// and the reel's aggregates together
+func (s *server) RecordReelWatch(ctx context.Context, req *pb.RecordReelWatchRequest) (*pb.RecordReelWatchResponse, error) {
+	if req.WatchMs < 0 || req.DurationMs < 0 || req.Replays < 0 {
+		return nil, status.Error(codes.InvalidArgument, "Watch time, duration and replays can't be negative")
+	}
+
+	var post Post
+	if err := s.db.Scopes(notArchived).Select("id", "author_id", "is_reel").First(&post, req.PostId).Error; err == gorm.ErrRecordNotFound {
+		return nil, status.Error(codes.NotFound, "Post not found")
+	} else if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to retrieve post")
+	}
+	if !post.IsReel {
+		return nil, status.Error(codes.InvalidArgument, "Post is not a reel")
+	}
+	if !s.canViewPost(ctx, &post, req.UserId) {
+		return nil, status.Error(codes.PermissionDenied, "You don't have permission to view this post")
+	}
+
+	// Watching at least the full length counts as completing it even if the client didn't say so
+	completed := req.Completed || (req.DurationMs > 0 && req.WatchMs >= req.DurationMs)
+	skipped := req.Skipped && !completed
+	now := time.Now()
+
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		var watch ReelWatch
+		err := tx.Where("user_id = ? AND post_id = ?", req.UserId, req.PostId).First(&watch).Error
+		firstView := err == gorm.ErrRecordNotFound
+		if err != nil && !firstView {
+			return err
+		}
+
+		watch.UserID = req.UserId
+		watch.PostID = req.PostId
+		watch.WatchMs += req.WatchMs
+		watch.Plays++
+		watch.Replays += int64(req.Replays)
+		watch.Completed = watch.Completed || completed
+		watch.Skipped = skipped
+		watch.LastWatchedAt = now
+		if firstView {
+			err = tx.Create(&watch).Error
+		} else {
+			err = tx.Save(&watch).Error
+		}
+		if err != nil {
+			return err
+		}
+
+		stat := ReelStat{PostID: req.PostId, Plays: 1, Replays: int64(req.Replays), WatchMs: req.WatchMs, UpdatedAt: now}
+		if firstView {
+			stat.Viewers = 1
+		}
+		if completed {
+			stat.Completions = 1
+		}
+		if skipped {
+			stat.Skips = 1
+		}
+		return tx.Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "post_id"}},
+			DoUpdates: clause.Assignments(map[string]interface{}{
+				"viewers":     gorm.Expr("reel_stats.viewers + ?", stat.Viewers),
+				"plays":       gorm.Expr("reel_stats.plays + ?", stat.Plays),
+				"completions": gorm.Expr("reel_stats.completions + ?", stat.Completions),
+				"replays":     gorm.Expr("reel_stats.replays + ?", stat.Replays),
+				"skips":       gorm.Expr("reel_stats.skips + ?", stat.Skips),
+				"watch_ms":    gorm.Expr("reel_stats.watch_ms + ?", stat.WatchMs),
+				"updated_at":  now,
+			}),
+		}).Create(&stat).Error
+	})
+	if err != nil {
+		log.Printf("Failed to record watch of reel %d by user %d: %v", req.PostId, req.UserId, err)
+		return nil, status.Error(codes.Internal, "Failed to record reel watch")
+	}
+
+	return &pb.RecordReelWatchResponse{Message: "Reel watch recorded"}, nil
+}
@@ -0,0 +1,452 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/hoshibmatchi/post-service/proto"
+	userPb "github.com/hoshibmatchi/user-service/proto"
+)
+
+// Home timelines are Redis sorted sets of post IDs scored by created_at (unix ms).
+// worker-service pushes new posts into followers' timelines (fan-out-on-write);
+// authors with more than fanOutFollowerLimit followers are skipped and their posts
+// are merged in at read time instead (fan-out-on-read).
+const (
+	timelineMaxLen      = 800
+	timelineTTL         = 7 * 24 * time.Hour
+	fanOutFollowerLimit = 5000
+
+	// celebritiesKey holds the authors whose posts are read on demand
+	celebritiesKey = "timeline:celebrities"
+
+	// timelineBuiltMember marks a timeline as complete. It scores 0 so it
+	// always sorts last and is never returned by a ranged read.
+	timelineBuiltMember = "0"
+)
+
+func timelineKey(userID int64) string {
+	return fmt.Sprintf("timeline:%d", userID)
+}
+
+func timelineScore(t time.Time) float64 {
+	return float64(t.UnixMilli())
+}
+
+// addToTimelines pushes one post into each user's timeline and trims them
+func (s *server) addToTimelines(ctx context.Context, post *Post, userIDs []int64) error {
+	pipe := s.rdb.Pipeline()
+	member := &redis.Z{Score: timelineScore(post.CreatedAt), Member: strconv.FormatUint(uint64(post.ID), 10)}
+	for _, userID := range userIDs {
+		key := timelineKey(userID)
+		pipe.ZAdd(ctx, key, member)
+		// Rank 0 is the built marker, so trimming starts at 1
+		pipe.ZRemRangeByRank(ctx, key, 1, -(timelineMaxLen + 1))
+		pipe.Expire(ctx, key, timelineTTL)
+	}
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// ensureTimeline rebuilds a user's timeline from the database when it is missing
+// (new user, expired after inactivity, or never built)
+func (s *server) ensureTimeline(ctx context.Context, userID int64) error {
+	key := timelineKey(userID)
+	if _, err := s.rdb.ZScore(ctx, key, timelineBuiltMember).Result(); err == nil {
+		return nil
+	} else if err != redis.Nil {
+		return err
+	}
+
+	followingRes, err := s.userClient.GetFollowingList(ctx, &userPb.GetFollowingListRequest{UserId: userID})
+	if err != nil {
+		return err
+	}
+	celebrities, err := s.rdb.SMembers(ctx, celebritiesKey).Result()
+	if err != nil {
+		return err
+	}
+	isCelebrity := make(map[string]bool, len(celebrities))
+	for _, id := range celebrities {
+		isCelebrity[id] = true
+	}
+	var authorIDs []int64
+	for _, id := range followingRes.FollowingUserIds {
+		if !isCelebrity[strconv.FormatInt(id, 10)] {
+			authorIDs = append(authorIDs, id)
+		}
+	}
+
+	// Posts the user collaborated on show up even if they don't follow the author
+	var collaboratorPostIDs []int64
+	s.db.Model(&PostCollaborator{}).Where("user_id = ?", userID).Pluck("post_id", &collaboratorPostIDs)
+
+	var posts []Post
+	if len(authorIDs) > 0 || len(collaboratorPostIDs) > 0 {
+		query := s.db.Scopes(notArchived).Select("id", "created_at")
+		if len(authorIDs) > 0 && len(collaboratorPostIDs) > 0 {
+			query = query.Where("author_id IN ? OR id IN ?", authorIDs, collaboratorPostIDs)
+		} else if len(authorIDs) > 0 {
+			query = query.Where("author_id IN ?", authorIDs)
+		} else {
+			query = query.Where("id IN ?", collaboratorPostIDs)
+		}
+		if err := query.Order("created_at DESC").Limit(timelineMaxLen).Find(&posts).Error; err != nil {
+			return err
+		}
+	}
+
+	members := make([]*redis.Z, 0, len(posts)+1)
+	members = append(members, &redis.Z{Score: 0, Member: timelineBuiltMember})
+	for _, post := range posts {
+		members = append(members, &redis.Z{Score: timelineScore(post.CreatedAt), Member: strconv.FormatUint(uint64(post.ID), 10)})
+	}
+
+	pipe := s.rdb.TxPipeline()
+	pipe.Del(ctx, key)
+	pipe.ZAdd(ctx, key, members...)
+	pipe.Expire(ctx, key, timelineTTL)
+	_, err = pipe.Exec(ctx)
+	return err
+}
+
+// followedCelebrities returns the high-follower authors this user follows
+func (s *server) followedCelebrities(ctx context.Context, userID int64) ([]int64, error) {
+	celebrities, err := s.rdb.SMembers(ctx, celebritiesKey).Result()
+	if err != nil || len(celebrities) == 0 {
+		return nil, err
+	}
+
+	followingRes, err := s.userClient.GetFollowingList(ctx, &userPb.GetFollowingListRequest{UserId: userID})
+	if err != nil {
+		return nil, err
+	}
+	following := make(map[string]bool, len(followingRes.FollowingUserIds))
+	for _, id := range followingRes.FollowingUserIds {
+		following[strconv.FormatInt(id, 10)] = true
+	}
+
+	var ids []int64
+	for _, id := range celebrities {
+		if following[id] {
+			authorID, _ := strconv.ParseInt(id, 10, 64)
+			ids = append(ids, authorID)
+		}
+	}
+	return ids, nil
+}
+
+// olderThanCursor reports whether a post sorts after the cursor (created_at DESC, id DESC)
+func olderThanCursor(post *Post, cursor *pageCursor) bool {
+	if cursor == nil {
+		return true
+	}
+	return post.CreatedAt.Before(cursor.CreatedAt) || (post.CreatedAt.Equal(cursor.CreatedAt) && post.ID < cursor.ID)
+}
+
+// mergeTimelinePage merges timeline and fan-out-on-read candidates into one page.
+// floor is the score of the oldest timeline candidate when the timeline has more
+// entries than were read; anything older is dropped so nothing in between is skipped.
+func mergeTimelinePage(timelinePosts, pulledPosts []Post, cursor *pageCursor, pageSize int, floor *time.Time) ([]Post, *pageCursor) {
+	seen := make(map[uint]bool, len(timelinePosts)+len(pulledPosts))
+	merged := make([]Post, 0, len(timelinePosts)+len(pulledPosts))
+	for _, batch := range [][]Post{timelinePosts, pulledPosts} {
+		for _, post := range batch {
+			if seen[post.ID] || !olderThanCursor(&post, cursor) {
+				continue
+			}
+			if floor != nil && timelineScore(post.CreatedAt) < timelineScore(*floor) {
+				continue
+			}
+			seen[post.ID] = true
+			merged = append(merged, post)
+		}
+	}
+
+	sort.Slice(merged, func(i, j int) bool {
+		if !merged[i].CreatedAt.Equal(merged[j].CreatedAt) {
+			return merged[i].CreatedAt.After(merged[j].CreatedAt)
+		}
+		return merged[i].ID > merged[j].ID
+	})
+
+	hasMore := floor != nil
+	if len(merged) > pageSize {
+		merged = merged[:pageSize]
+		hasMore = true
+	}
+	if !hasMore || len(merged) == 0 {
+		if hasMore && floor != nil {
+			// Everything read was filtered out; resume below the floor
+			return merged, &pageCursor{CreatedAt: *floor}
+		}
+		return merged, nil
+	}
+	last := merged[len(merged)-1]
+	return merged, &pageCursor{CreatedAt: last.CreatedAt, ID: last.ID}
+}
+
+// --- GRPC: GetHomeFeed ---
+func (s *server) GetHomeFeed(ctx context.Context, req *pb.GetHomeFeedRequest) (*pb.GetHomeFeedResponse, error) {
+	log.Printf("GetHomeFeed request received for user %d", req.UserId)
+
+	if req.Sort != "" && req.Sort != feedSortChronological && req.Sort != feedSortRanked {
+		return nil, status.Error(codes.InvalidArgument, "Invalid sort, expected chronological or ranked")
+	}
+	cursor, err := decodeCursor(req.Cursor)
+	if err != nil {
+		return nil, err
+	}
+	pageSize := normalizePageSize(req.PageSize)
+
+	if err := s.ensureTimeline(ctx, req.UserId); err != nil {
+		log.Printf("Failed to build timeline for user %d: %v", req.UserId, err)
+		return nil, status.Error(codes.Internal, "Failed to retrieve user feed")
+	}
+
+	// Timeline entries only ever come from approved follows and collaborations,
+	// and unfollow/block prune them, but pruning is asynchronous
+	var posts []Post
+	var next *pageCursor
+	if req.Sort == feedSortRanked {
+		posts, next, err = s.rankedHomePage(ctx, req.UserId, cursor, pageSize)
+	} else {
+		// Over-read the timeline so deleted or archived posts don't leave the page short
+		var timelinePosts, pulledPosts []Post
+		var floor *time.Time
+		timelinePosts, pulledPosts, floor, err = s.readHomeCandidates(ctx, req.UserId, cursor, pageSize*2+1, pageSize+1)
+		if err == nil {
+			posts, next = mergeTimelinePage(timelinePosts, pulledPosts, cursor, pageSize, floor)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// So the page is checked too, with one lookup for all of its authors. The
+	// cursor was taken before filtering, so nothing is skipped.
+	posts, err = s.filterPostsByPrivacy(ctx, posts, req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to retrieve user feed")
+	}
+
+	grpcPosts := s.enrichPosts(ctx, posts, req.UserId)
+
+	response := &pb.GetHomeFeedResponse{Posts: grpcPosts}
+	if next != nil {
+		response.NextCursor = encodeCursor(*next)
+	}
+	return response, nil
+}
+
+// readHomeCandidates reads up to fetch posts from the user's timeline below the cursor,
+// plus up to pullLimit recent posts from followed high-follower authors. floor is set
+// when the timeline holds more entries than were read (see mergeTimelinePage).
+func (s *server) readHomeCandidates(ctx context.Context, userID int64, cursor *pageCursor, fetch, pullLimit int) (timelinePosts, pulledPosts []Post, floor *time.Time, err error) {
+	// --- Step 1: Read candidates from the timeline ---
+	maxScore := "+inf"
+	if cursor != nil {
+		maxScore = strconv.FormatInt(cursor.CreatedAt.UnixMilli(), 10)
+	}
+	entries, err := s.rdb.ZRevRangeByScoreWithScores(ctx, timelineKey(userID), &redis.ZRangeBy{
+		Max:   maxScore,
+		Min:   "(0", // Skip the built marker
+		Count: int64(fetch),
+	}).Result()
+	if err != nil {
+		log.Printf("Failed to read timeline for user %d: %v", userID, err)
+		return nil, nil, nil, status.Error(codes.Internal, "Failed to retrieve user feed")
+	}
+
+	postIDs := make([]int64, 0, len(entries))
+	for _, entry := range entries {
+		id, _ := strconv.ParseInt(entry.Member.(string), 10, 64)
+		postIDs = append(postIDs, id)
+	}
+	if len(entries) == fetch {
+		oldest := time.UnixMilli(int64(entries[len(entries)-1].Score))
+		floor = &oldest
+	}
+
+	if len(postIDs) > 0 {
+		if err := s.db.Scopes(notArchived).Where("id IN ?", postIDs).Find(&timelinePosts).Error; err != nil {
+			return nil, nil, nil, status.Error(codes.Internal, "Failed to retrieve posts")
+		}
+	}
+
+	// --- Step 2: Pull recent posts from followed high-follower authors ---
+	celebrities, err := s.followedCelebrities(ctx, userID)
+	if err != nil {
+		// Degrade to the pushed timeline rather than failing the feed
+		log.Printf("Failed to resolve followed celebrities for user %d: %v", userID, err)
+	}
+	if len(celebrities) > 0 {
+		query := s.db.Scopes(notArchived).Where("author_id IN ?", celebrities)
+		if cursor != nil {
+			query = query.Where("created_at < ? OR (created_at = ? AND id < ?)", cursor.CreatedAt, cursor.CreatedAt, cursor.ID)
+		}
+		if err := query.Order("created_at DESC, id DESC").Limit(pullLimit).Find(&pulledPosts).Error; err != nil {
+			return nil, nil, nil, status.Error(codes.Internal, "Failed to retrieve posts")
+		}
+	}
+
+	return timelinePosts, pulledPosts, floor, nil
+}
+
+// timelineAudience splits everyone whose home timeline should carry the post into
+// the author's approved followers and the author plus any collaborators
+func (s *server) timelineAudience(ctx context.Context, post *Post) (followers, owners []int64, err error) {
+	followersRes, err := s.userClient.GetFollowersList(ctx, &userPb.GetFollowersListRequest{UserId: post.AuthorID})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	owners = []int64{post.AuthorID}
+	var collaboratorIDs []int64
+	s.db.Model(&PostCollaborator{}).Where("post_id = ? AND user_id != ?", post.ID, post.AuthorID).Pluck("user_id", &collaboratorIDs)
+	owners = append(owners, collaboratorIDs...)
+
+	return followersRes.FollowerUserIds, owners, nil
+}
+
+// --- GRPC: FanOutPost (internal) ---
+func (s *server) FanOutPost(ctx context.Context, req *pb.FanOutPostRequest) (*pb.TimelineUpdateResponse, error) {
+	var post Post
+	if err := s.db.Scopes(notArchived).First(&post, req.PostId).Error; err != nil {
+		// Deleted or archived before the job ran; nothing to push
+		return &pb.TimelineUpdateResponse{}, nil
+	}
+
+	followers, audience, err := s.timelineAudience(ctx, &post)
+	if err != nil {
+		log.Printf("Failed to get audience for post %d: %v", post.ID, err)
+		return nil, status.Error(codes.Internal, "Failed to get followers")
+	}
+
+	authorKey := strconv.FormatInt(post.AuthorID, 10)
+	if len(followers) > fanOutFollowerLimit {
+		// Followers read this author's posts on demand; only the author and collaborators get a push
+		s.rdb.SAdd(ctx, celebritiesKey, authorKey)
+	} else {
+		s.rdb.SRem(ctx, celebritiesKey, authorKey)
+		audience = append(audience, followers...)
+	}
+
+	if err := s.addToTimelines(ctx, &post, audience); err != nil {
+		log.Printf("Failed to fan out post %d: %v", post.ID, err)
+		return nil, status.Error(codes.Internal, "Failed to update timelines")
+	}
+
+	log.Printf("Fanned out post %d to %d timelines", post.ID, len(audience))
+	return &pb.TimelineUpdateResponse{TimelinesUpdated: int32(len(audience))}, nil
+}
+
+// --- GRPC: RetractPost (internal) ---
+func (s *server) RetractPost(ctx context.Context, req *pb.RetractPostRequest) (*pb.TimelineUpdateResponse, error) {
+	var post Post
+	if err := s.db.Unscoped().Select("id", "author_id").First(&post, req.PostId).Error; err != nil {
+		return &pb.TimelineUpdateResponse{}, nil
+	}
+
+	followers, audience, err := s.timelineAudience(ctx, &post)
+	if err != nil {
+		log.Printf("Failed to get audience for post %d: %v", post.ID, err)
+		return nil, status.Error(codes.Internal, "Failed to get followers")
+	}
+	audience = append(audience, followers...)
+
+	member := strconv.FormatUint(uint64(post.ID), 10)
+	pipe := s.rdb.Pipeline()
+	for _, userID := range audience {
+		pipe.ZRem(ctx, timelineKey(userID), member)
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		log.Printf("Failed to retract post %d: %v", post.ID, err)
+		return nil, status.Error(codes.Internal, "Failed to update timelines")
+	}
+
+	return &pb.TimelineUpdateResponse{TimelinesUpdated: int32(len(audience))}, nil
+}
+
+// --- GRPC: SyncTimelineAuthor (internal) ---
+// Called on follow (following=true) and on unfollow or block (following=false)
+func (s *server) SyncTimelineAuthor(ctx context.Context, req *pb.SyncTimelineAuthorRequest) (*pb.TimelineUpdateResponse, error) {
+	key := timelineKey(req.UserId)
+	if _, err := s.rdb.ZScore(ctx, key, timelineBuiltMember).Result(); err == redis.Nil {
+		// Not built yet; the next read rebuilds it from the follow graph
+		return &pb.TimelineUpdateResponse{}, nil
+	} else if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to read timeline")
+	}
+
+	if req.Following {
+		isCelebrity, _ := s.rdb.SIsMember(ctx, celebritiesKey, strconv.FormatInt(req.AuthorId, 10)).Result()
+		if isCelebrity {
+			return &pb.TimelineUpdateResponse{}, nil // Read on demand
+		}
+
+		var posts []Post
+		if err := s.db.Scopes(notArchived).Select("id", "created_at").
+			Where("author_id = ?", req.AuthorId).
+			Order("created_at DESC").Limit(timelineMaxLen).
+			Find(&posts).Error; err != nil {
+			return nil, status.Error(codes.Internal, "Failed to retrieve posts")
+		}
+		if len(posts) == 0 {
+			return &pb.TimelineUpdateResponse{}, nil
+		}
+		members := make([]*redis.Z, 0, len(posts))
+		for _, post := range posts {
+			members = append(members, &redis.Z{Score: timelineScore(post.CreatedAt), Member: strconv.FormatUint(uint64(post.ID), 10)})
+		}
+		pipe := s.rdb.Pipeline()
+		pipe.ZAdd(ctx, key, members...)
+		pipe.ZRemRangeByRank(ctx, key, 1, -(timelineMaxLen + 1))
+		if _, err := pipe.Exec(ctx); err != nil {
+			return nil, status.Error(codes.Internal, "Failed to update timeline")
+		}
+		return &pb.TimelineUpdateResponse{TimelinesUpdated: 1}, nil
+	}
+
+	// Only the timeline's own entries can need removing, so look those up
+	// instead of scanning every post the author ever made
+	members, err := s.rdb.ZRange(ctx, key, 0, -1).Result()
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to read timeline")
+	}
+	var postIDs []int64
+	for _, member := range members {
+		if id, err := strconv.ParseInt(member, 10, 64); err == nil && id != 0 {
+			postIDs = append(postIDs, id)
+		}
+	}
+	if len(postIDs) == 0 {
+		return &pb.TimelineUpdateResponse{}, nil
+	}
+
+	var authored []int64
+	if err := s.db.Unscoped().Model(&Post{}).
+		Where("id IN ? AND author_id = ?", postIDs, req.AuthorId).
+		Pluck("id", &authored).Error; err != nil {
+		return nil, status.Error(codes.Internal, "Failed to retrieve posts")
+	}
+	if len(authored) == 0 {
+		return &pb.TimelineUpdateResponse{}, nil
+	}
+	remove := make([]interface{}, len(authored))
+	for i, id := range authored {
+		remove[i] = strconv.FormatInt(id, 10)
+	}
+	if err := s.rdb.ZRem(ctx, key, remove...).Err(); err != nil {
+		return nil, status.Error(codes.Internal, "Failed to update timeline")
+	}
+	return &pb.TimelineUpdateResponse{TimelinesUpdated: 1}, nil
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// Report queues are paged newest first. Moderators work through them in
+// larger pages than the app's feeds use.
+
+const (
+	defaultPageSize = 50
+	maxPageSize     = 100
+)
+
+// reportCursor is the last report of a page
+type reportCursor struct {
+	CreatedAt time.Time `json:"t"`
+	ID        uint      `json:"id"`
+}
+
+func encodeCursor(c reportCursor) string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// decodeCursor returns nil for an empty token, i.e. the newest reports
+func decodeCursor(token string) (*reportCursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "Invalid cursor")
+	}
+	var c reportCursor
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "Invalid cursor")
+	}
+	return &c, nil
+}
+
+func normalizePageSize(size int32) int {
+	if size <= 0 {
+		return defaultPageSize
+	}
+	if size > maxPageSize {
+		return maxPageSize
+	}
+	return int(size)
+}
+
+// newestFirst orders a report query by (created_at, id) descending and starts it
+// after the cursor, fetching one extra row to detect a following page
+func newestFirst(cursor *reportCursor, pageSize int) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if cursor != nil {
+			db = db.Where("created_at < ? OR (created_at = ? AND id < ?)", cursor.CreatedAt, cursor.CreatedAt, cursor.ID)
+		}
+		return db.Order("created_at DESC, id DESC").Limit(pageSize + 1)
+	}
+}
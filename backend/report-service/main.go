@@ -247,8 +247,14 @@ func gormToGrpcUserReport(report *UserReport) *pb.UserReport {
 func (s *server) GetPostReports(ctx context.Context, req *pb.GetReportsRequest) (*pb.GetPostReportsResponse, error) {
 	log.Printf("Admin action: GetPostReports request")
 
+	cursor, err := decodeCursor(req.Cursor)
+	if err != nil {
+		return nil, err
+	}
+	pageSize := normalizePageSize(req.PageSize)
+
 	var reports []PostReport
-	query := s.db.Order("created_at DESC").Limit(int(req.PageSize)).Offset(int(req.PageOffset))
+	query := s.db.Scopes(newestFirst(cursor, pageSize))
 
 	if req.UnresolvedOnly {
 		query = query.Where("is_resolved = ?", false)
@@ -259,6 +265,13 @@ func (s *server) GetPostReports(ctx context.Context, req *pb.GetReportsRequest)
 		return nil, status.Error(codes.Internal, "Failed to retrieve reports")
 	}
 
+	nextCursor := ""
+	if len(reports) > pageSize {
+		reports = reports[:pageSize]
+		last := reports[len(reports)-1]
+		nextCursor = encodeCursor(reportCursor{CreatedAt: last.CreatedAt, ID: last.ID})
+	}
+
 	var grpcReports []*pb.PostReport
 	for _, report := range reports {
 		// Fetch reporter username
@@ -280,14 +293,20 @@ func (s *server) GetPostReports(ctx context.Context, req *pb.GetReportsRequest)
 		})
 	}
 
-	return &pb.GetPostReportsResponse{Reports: grpcReports}, nil
+	return &pb.GetPostReportsResponse{Reports: grpcReports, NextCursor: nextCursor}, nil
 }
 
 func (s *server) GetUserReports(ctx context.Context, req *pb.GetReportsRequest) (*pb.GetUserReportsResponse, error) {
 	log.Printf("Admin action: GetUserReports request")
 
+	cursor, err := decodeCursor(req.Cursor)
+	if err != nil {
+		return nil, err
+	}
+	pageSize := normalizePageSize(req.PageSize)
+
 	var reports []UserReport
-	query := s.db.Order("created_at DESC").Limit(int(req.PageSize)).Offset(int(req.PageOffset))
+	query := s.db.Scopes(newestFirst(cursor, pageSize))
 
 	if req.UnresolvedOnly {
 		query = query.Where("is_resolved = ?", false)
@@ -298,6 +317,13 @@ func (s *server) GetUserReports(ctx context.Context, req *pb.GetReportsRequest)
 		return nil, status.Error(codes.Internal, "Failed to retrieve reports")
 	}
 
+	nextCursor := ""
+	if len(reports) > pageSize {
+		reports = reports[:pageSize]
+		last := reports[len(reports)-1]
+		nextCursor = encodeCursor(reportCursor{CreatedAt: last.CreatedAt, ID: last.ID})
+	}
+
 	var grpcReports []*pb.UserReport
 	for _, report := range reports {
 		// Fetch reporter and reported usernames
@@ -324,7 +350,7 @@ func (s *server) GetUserReports(ctx context.Context, req *pb.GetReportsRequest)
 		})
 	}
 
-	return &pb.GetUserReportsResponse{Reports: grpcReports}, nil
+	return &pb.GetUserReportsResponse{Reports: grpcReports, NextCursor: nextCursor}, nil
 }
 
 func (s *server) ResolvePostReport(ctx context.Context, req *pb.ResolveReportRequest) (*pb.ReportResponse, error) {
@@ -391,8 +391,9 @@ func (x *ReportResponse) GetMessage() string {
 type GetReportsRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	PageSize       int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageOffset     int32                  `protobuf:"varint,2,opt,name=page_offset,json=pageOffset,proto3" json:"page_offset,omitempty"`
+	PageOffset     int32                  `protobuf:"varint,2,opt,name=page_offset,json=pageOffset,proto3" json:"page_offset,omitempty"` // Deprecated: ignored, use cursor
 	UnresolvedOnly bool                   `protobuf:"varint,3,opt,name=unresolved_only,json=unresolvedOnly,proto3" json:"unresolved_only,omitempty"`
+	Cursor         string                 `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"` // next_cursor from the previous page
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -448,9 +449,17 @@ func (x *GetReportsRequest) GetUnresolvedOnly() bool {
 	return false
 }
 
+func (x *GetReportsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
 type GetPostReportsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Reports       []*PostReport          `protobuf:"bytes,1,rep,name=reports,proto3" json:"reports,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"` // Empty on the last page
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -492,9 +501,17 @@ func (x *GetPostReportsResponse) GetReports() []*PostReport {
 	return nil
 }
 
+func (x *GetPostReportsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
 type GetUserReportsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Reports       []*UserReport          `protobuf:"bytes,1,rep,name=reports,proto3" json:"reports,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"` // Empty on the last page
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -536,6 +553,13 @@ func (x *GetUserReportsResponse) GetReports() []*UserReport {
 	return nil
 }
 
+func (x *GetUserReportsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
 type ResolveReportRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	AdminUserId   int64                  `protobuf:"varint,1,opt,name=admin_user_id,json=adminUserId,proto3" json:"admin_user_id,omitempty"` // From JWT
@@ -638,16 +662,21 @@ const file_report_proto_rawDesc = "" +
 	"\x10reported_user_id\x18\x02 \x01(\x03R\x0ereportedUserId\x12\x16\n" +
 	"\x06reason\x18\x03 \x01(\tR\x06reason\"*\n" +
 	"\x0eReportResponse\x12\x18\n" +
-	"\amessage\x18\x01 \x01(\tR\amessage\"z\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"\x92\x01\n" +
 	"\x11GetReportsRequest\x12\x1b\n" +
 	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1f\n" +
 	"\vpage_offset\x18\x02 \x01(\x05R\n" +
 	"pageOffset\x12'\n" +
-	"\x0funresolved_only\x18\x03 \x01(\bR\x0eunresolvedOnly\"F\n" +
+	"\x0funresolved_only\x18\x03 \x01(\bR\x0eunresolvedOnly\x12\x16\n" +
+	"\x06cursor\x18\x04 \x01(\tR\x06cursor\"g\n" +
 	"\x16GetPostReportsResponse\x12,\n" +
-	"\areports\x18\x01 \x03(\v2\x12.report.PostReportR\areports\"F\n" +
+	"\areports\x18\x01 \x03(\v2\x12.report.PostReportR\areports\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"g\n" +
 	"\x16GetUserReportsResponse\x12,\n" +
-	"\areports\x18\x01 \x03(\v2\x12.report.UserReportR\areports\"o\n" +
+	"\areports\x18\x01 \x03(\v2\x12.report.UserReportR\areports\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"o\n" +
 	"\x14ResolveReportRequest\x12\"\n" +
 	"\radmin_user_id\x18\x01 \x01(\x03R\vadminUserId\x12\x1b\n" +
 	"\treport_id\x18\x02 \x01(\x03R\breportId\x12\x16\n" +
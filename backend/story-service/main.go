@@ -285,6 +285,43 @@ func (s *server) CreateStory(ctx context.Context, req *pb.CreateStoryRequest) (*
 	}, nil
 }
 
+// getRelationships looks up the viewer's relationship with each user, in batches
+// of the user-service GetRelationships limit
+func (s *server) getRelationships(ctx context.Context, viewerID int64, userIDs []int64) (map[int64]*userPb.Relationship, error) {
+	rels := make(map[int64]*userPb.Relationship, len(userIDs))
+	for start := 0; start < len(userIDs); start += 500 {
+		end := start + 500
+		if end > len(userIDs) {
+			end = len(userIDs)
+		}
+		res, err := s.userClient.GetRelationships(ctx, &userPb.GetRelationshipsRequest{
+			ViewerId:  viewerID,
+			TargetIds: userIDs[start:end],
+		})
+		if err != nil {
+			return nil, err
+		}
+		for id, rel := range res.Relationships {
+			rels[id] = rel
+		}
+	}
+	return rels, nil
+}
+
+// storyVisibleTo applies the author's block, hidden story and close friends
+// settings to a viewer who isn't the author
+func storyVisibleTo(story *Story, rel *userPb.Relationship) bool {
+	if rel == nil || !rel.Exists || rel.Blocked {
+		return false
+	}
+	// The author may have hidden their stories from this user
+	if rel.HidesStoryFromViewer {
+		return false
+	}
+	// Close friends only stories need the author to list the viewer as a close friend
+	return !story.CloseFriendsOnly || rel.ViewerIsCloseFriend
+}
+
 // --- 2. Get Story Feed (Grouped by User) ---
 func (s *server) GetStoryFeed(ctx context.Context, req *pb.GetStoryFeedRequest) (*pb.GetStoryFeedResponse, error) {
 	// 1. Get Following List
@@ -295,28 +332,12 @@ func (s *server) GetStoryFeed(ctx context.Context, req *pb.GetStoryFeedRequest)
 	// Include Self so user sees their own story
 	targetIDs := append(followingRes.FollowingUserIds, req.UserId)
 
-	// Get close friends list
-	closeFriendsRes, err := s.userClient.GetCloseFriends(ctx, &userPb.GetCloseFriendsRequest{UserId: req.UserId})
+	// One batched lookup covers blocks, close friends and hidden-story settings
+	// for every author, instead of several user-service calls per story
+	rels, err := s.getRelationships(ctx, req.UserId, followingRes.FollowingUserIds)
 	if err != nil {
-		log.Printf("Failed to get close friends: %v", err)
-	}
-	closeFriendsMap := make(map[int64]bool)
-	if closeFriendsRes != nil {
-		for _, friend := range closeFriendsRes.Friends {
-			closeFriendsMap[friend.UserId] = true
-		}
-	}
-
-	// Get hidden story users (users who have hidden their stories from viewer)
-	hiddenUsersRes, err := s.userClient.GetHiddenStoryUsers(ctx, &userPb.GetHiddenStoryUsersRequest{UserId: req.UserId})
-	if err != nil {
-		log.Printf("Failed to get hidden story users: %v", err)
-	}
-	hiddenUsersMap := make(map[int64]bool)
-	if hiddenUsersRes != nil {
-		for _, user := range hiddenUsersRes.HiddenUsers {
-			hiddenUsersMap[user.UserId] = true
-		}
+		log.Printf("Failed to get relationships for user %d: %v", req.UserId, err)
+		return nil, status.Error(codes.Internal, "Failed to get story feed")
 	}
 
 	// 2. Fetch Active Stories (ExpiresAt > Now)
@@ -330,32 +351,7 @@ func (s *server) GetStoryFeed(ctx context.Context, req *pb.GetStoryFeedRequest)
 	// Filter stories based on close friends, hidden story settings, and blocks
 	var filteredStories []Story
 	for _, story := range stories {
-		// Skip if author has hidden stories from this user
-		if hiddenUsersMap[story.AuthorID] {
-			continue
-		}
-
-		// Check if there's a block relationship (either direction)
-		if story.AuthorID != req.UserId {
-			blockCheckAuthorToViewer, err := s.userClient.IsBlocked(ctx, &userPb.IsBlockedRequest{
-				BlockerId: story.AuthorID,
-				BlockedId: req.UserId,
-			})
-			if err == nil && blockCheckAuthorToViewer.IsBlocked {
-				continue // Author blocked the viewer
-			}
-
-			blockCheckViewerToAuthor, err := s.userClient.IsBlocked(ctx, &userPb.IsBlockedRequest{
-				BlockerId: req.UserId,
-				BlockedId: story.AuthorID,
-			})
-			if err == nil && blockCheckViewerToAuthor.IsBlocked {
-				continue // Viewer blocked the author
-			}
-		}
-
-		// Skip close friends only stories if viewer is not a close friend (unless it's their own story)
-		if story.CloseFriendsOnly && story.AuthorID != req.UserId && !closeFriendsMap[story.AuthorID] {
+		if story.AuthorID != req.UserId && !storyVisibleTo(&story, rels[story.AuthorID]) {
 			continue
 		}
 
@@ -538,6 +534,46 @@ func (s *server) GetUserArchive(ctx context.Context, req *pb.GetUserArchiveReque
 	return &pb.GetUserArchiveResponse{Stories: pbStories}, nil
 }
 
+// --- 7. Get Story (a single active story) ---
+func (s *server) GetStory(ctx context.Context, req *pb.GetStoryRequest) (*pb.GetStoryResponse, error) {
+	var story Story
+	if err := s.db.Where("id = ? AND expires_at > ?", req.StoryId, time.Now()).First(&story).Error; err == gorm.ErrRecordNotFound {
+		return nil, status.Error(codes.NotFound, "Story not found")
+	} else if err != nil {
+		log.Printf("Failed to get story %d: %v", req.StoryId, err)
+		return nil, status.Error(codes.Internal, "Failed to get story")
+	}
+
+	// Others see it as they would in their story feed, which only has people they follow.
+	// A story they can't see is reported as not found.
+	if story.AuthorID != req.ViewerId {
+		rels, err := s.getRelationships(ctx, req.ViewerId, []int64{story.AuthorID})
+		if err != nil {
+			log.Printf("Failed to get relationship of user %d with %d: %v", req.ViewerId, story.AuthorID, err)
+			return nil, status.Error(codes.Internal, "Failed to get story")
+		}
+		rel := rels[story.AuthorID]
+		if rel == nil || !rel.ViewerFollows || !storyVisibleTo(&story, rel) {
+			return nil, status.Error(codes.NotFound, "Story not found")
+		}
+	}
+
+	return &pb.GetStoryResponse{Story: &pb.Story{
+		Id:               strconv.FormatUint(uint64(story.ID), 10),
+		AuthorId:         story.AuthorID,
+		MediaUrl:         story.MediaURL,
+		MediaType:        story.MediaType,
+		Caption:          story.Caption,
+		AuthorUsername:   story.AuthorUsername,
+		AuthorProfileUrl: story.AuthorProfileURL,
+		CreatedAt:        story.CreatedAt.Format(time.RFC3339),
+		ExpiresAt:        story.ExpiresAt.Format(time.RFC3339),
+		FilterName:       story.FilterName,
+		StickersJson:     story.StickersJSON,
+		CloseFriendsOnly: story.CloseFriendsOnly,
+	}}, nil
+}
+
 // --- Helper Function: Check if URL is a video file ---
 func isVideoFile(url string) bool {
 	if url == "" {
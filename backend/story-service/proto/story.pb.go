@@ -130,6 +130,7 @@ type Story struct {
 	FilterName       string                 `protobuf:"bytes,10,opt,name=filter_name,json=filterName,proto3" json:"filter_name,omitempty"`
 	StickersJson     string                 `protobuf:"bytes,11,opt,name=stickers_json,json=stickersJson,proto3" json:"stickers_json,omitempty"`
 	CloseFriendsOnly bool                   `protobuf:"varint,12,opt,name=close_friends_only,json=closeFriendsOnly,proto3" json:"close_friends_only,omitempty"`
+	AuthorId         int64                  `protobuf:"varint,13,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -248,6 +249,13 @@ func (x *Story) GetCloseFriendsOnly() bool {
 	return false
 }
 
+func (x *Story) GetAuthorId() int64 {
+	if x != nil {
+		return x.AuthorId
+	}
+	return 0
+}
+
 type CreateStoryResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Story         *Story                 `protobuf:"bytes,1,opt,name=story,proto3" json:"story,omitempty"`
@@ -929,6 +937,102 @@ func (x *GetUserArchiveResponse) GetStories() []*Story {
 	return nil
 }
 
+type GetStoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ViewerId      int64                  `protobuf:"varint,1,opt,name=viewer_id,json=viewerId,proto3" json:"viewer_id,omitempty"`
+	StoryId       int64                  `protobuf:"varint,2,opt,name=story_id,json=storyId,proto3" json:"story_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStoryRequest) Reset() {
+	*x = GetStoryRequest{}
+	mi := &file_story_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStoryRequest) ProtoMessage() {}
+
+func (x *GetStoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_story_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStoryRequest.ProtoReflect.Descriptor instead.
+func (*GetStoryRequest) Descriptor() ([]byte, []int) {
+	return file_story_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetStoryRequest) GetViewerId() int64 {
+	if x != nil {
+		return x.ViewerId
+	}
+	return 0
+}
+
+func (x *GetStoryRequest) GetStoryId() int64 {
+	if x != nil {
+		return x.StoryId
+	}
+	return 0
+}
+
+type GetStoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Story         *Story                 `protobuf:"bytes,1,opt,name=story,proto3" json:"story,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStoryResponse) Reset() {
+	*x = GetStoryResponse{}
+	mi := &file_story_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStoryResponse) ProtoMessage() {}
+
+func (x *GetStoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_story_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStoryResponse.ProtoReflect.Descriptor instead.
+func (*GetStoryResponse) Descriptor() ([]byte, []int) {
+	return file_story_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetStoryResponse) GetStory() *Story {
+	if x != nil {
+		return x.Story
+	}
+	return nil
+}
+
 var File_story_proto protoreflect.FileDescriptor
 
 const file_story_proto_rawDesc = "" +
@@ -944,7 +1048,7 @@ const file_story_proto_rawDesc = "" +
 	"\vfilter_name\x18\x05 \x01(\tR\n" +
 	"filterName\x12#\n" +
 	"\rstickers_json\x18\x06 \x01(\tR\fstickersJson\x12,\n" +
-	"\x12close_friends_only\x18\a \x01(\bR\x10closeFriendsOnly\"\x91\x03\n" +
+	"\x12close_friends_only\x18\a \x01(\bR\x10closeFriendsOnly\"\xae\x03\n" +
 	"\x05Story\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
 	"\tmedia_url\x18\x02 \x01(\tR\bmediaUrl\x12\x1d\n" +
@@ -962,7 +1066,8 @@ const file_story_proto_rawDesc = "" +
 	" \x01(\tR\n" +
 	"filterName\x12#\n" +
 	"\rstickers_json\x18\v \x01(\tR\fstickersJson\x12,\n" +
-	"\x12close_friends_only\x18\f \x01(\bR\x10closeFriendsOnly\"9\n" +
+	"\x12close_friends_only\x18\f \x01(\bR\x10closeFriendsOnly\x12\x1b\n" +
+	"\tauthor_id\x18\r \x01(\x03R\bauthorId\"9\n" +
 	"\x13CreateStoryResponse\x12\"\n" +
 	"\x05story\x18\x01 \x01(\v2\f.story.StoryR\x05story\".\n" +
 	"\x13GetStoryFeedRequest\x12\x17\n" +
@@ -998,7 +1103,12 @@ const file_story_proto_rawDesc = "" +
 	"\x15GetUserArchiveRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\"@\n" +
 	"\x16GetUserArchiveResponse\x12&\n" +
-	"\astories\x18\x01 \x03(\v2\f.story.StoryR\astories2\xf8\x03\n" +
+	"\astories\x18\x01 \x03(\v2\f.story.StoryR\astories\"I\n" +
+	"\x0fGetStoryRequest\x12\x1b\n" +
+	"\tviewer_id\x18\x01 \x01(\x03R\bviewerId\x12\x19\n" +
+	"\bstory_id\x18\x02 \x01(\x03R\astoryId\"6\n" +
+	"\x10GetStoryResponse\x12\"\n" +
+	"\x05story\x18\x01 \x01(\v2\f.story.StoryR\x05story2\xb5\x04\n" +
 	"\fStoryService\x12D\n" +
 	"\vCreateStory\x12\x19.story.CreateStoryRequest\x1a\x1a.story.CreateStoryResponse\x12>\n" +
 	"\tLikeStory\x12\x17.story.LikeStoryRequest\x1a\x18.story.LikeStoryResponse\x12D\n" +
@@ -1006,7 +1116,8 @@ const file_story_proto_rawDesc = "" +
 	"\tViewStory\x12\x17.story.ViewStoryRequest\x1a\x18.story.ViewStoryResponse\x12G\n" +
 	"\fGetStoryFeed\x12\x1a.story.GetStoryFeedRequest\x1a\x1b.story.GetStoryFeedResponse\x12D\n" +
 	"\vDeleteStory\x12\x19.story.DeleteStoryRequest\x1a\x1a.story.DeleteStoryResponse\x12M\n" +
-	"\x0eGetUserArchive\x12\x1c.story.GetUserArchiveRequest\x1a\x1d.story.GetUserArchiveResponseB-Z+github.com/hoshibmatchi/story-service/protob\x06proto3"
+	"\x0eGetUserArchive\x12\x1c.story.GetUserArchiveRequest\x1a\x1d.story.GetUserArchiveResponse\x12;\n" +
+	"\bGetStory\x12\x16.story.GetStoryRequest\x1a\x17.story.GetStoryResponseB-Z+github.com/hoshibmatchi/story-service/protob\x06proto3"
 
 var (
 	file_story_proto_rawDescOnce sync.Once
@@ -1020,7 +1131,7 @@ func file_story_proto_rawDescGZIP() []byte {
 	return file_story_proto_rawDescData
 }
 
-var file_story_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_story_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
 var file_story_proto_goTypes = []any{
 	(*CreateStoryRequest)(nil),     // 0: story.CreateStoryRequest
 	(*Story)(nil),                  // 1: story.Story
@@ -1038,31 +1149,36 @@ var file_story_proto_goTypes = []any{
 	(*DeleteStoryResponse)(nil),    // 13: story.DeleteStoryResponse
 	(*GetUserArchiveRequest)(nil),  // 14: story.GetUserArchiveRequest
 	(*GetUserArchiveResponse)(nil), // 15: story.GetUserArchiveResponse
+	(*GetStoryRequest)(nil),        // 16: story.GetStoryRequest
+	(*GetStoryResponse)(nil),       // 17: story.GetStoryResponse
 }
 var file_story_proto_depIdxs = []int32{
 	1,  // 0: story.CreateStoryResponse.story:type_name -> story.Story
 	1,  // 1: story.UserStoryGroup.stories:type_name -> story.Story
 	4,  // 2: story.GetStoryFeedResponse.story_groups:type_name -> story.UserStoryGroup
 	1,  // 3: story.GetUserArchiveResponse.stories:type_name -> story.Story
-	0,  // 4: story.StoryService.CreateStory:input_type -> story.CreateStoryRequest
-	6,  // 5: story.StoryService.LikeStory:input_type -> story.LikeStoryRequest
-	8,  // 6: story.StoryService.UnlikeStory:input_type -> story.UnlikeStoryRequest
-	10, // 7: story.StoryService.ViewStory:input_type -> story.ViewStoryRequest
-	3,  // 8: story.StoryService.GetStoryFeed:input_type -> story.GetStoryFeedRequest
-	12, // 9: story.StoryService.DeleteStory:input_type -> story.DeleteStoryRequest
-	14, // 10: story.StoryService.GetUserArchive:input_type -> story.GetUserArchiveRequest
-	2,  // 11: story.StoryService.CreateStory:output_type -> story.CreateStoryResponse
-	7,  // 12: story.StoryService.LikeStory:output_type -> story.LikeStoryResponse
-	9,  // 13: story.StoryService.UnlikeStory:output_type -> story.UnlikeStoryResponse
-	11, // 14: story.StoryService.ViewStory:output_type -> story.ViewStoryResponse
-	5,  // 15: story.StoryService.GetStoryFeed:output_type -> story.GetStoryFeedResponse
-	13, // 16: story.StoryService.DeleteStory:output_type -> story.DeleteStoryResponse
-	15, // 17: story.StoryService.GetUserArchive:output_type -> story.GetUserArchiveResponse
-	11, // [11:18] is the sub-list for method output_type
-	4,  // [4:11] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+	1,  // 4: story.GetStoryResponse.story:type_name -> story.Story
+	0,  // 5: story.StoryService.CreateStory:input_type -> story.CreateStoryRequest
+	6,  // 6: story.StoryService.LikeStory:input_type -> story.LikeStoryRequest
+	8,  // 7: story.StoryService.UnlikeStory:input_type -> story.UnlikeStoryRequest
+	10, // 8: story.StoryService.ViewStory:input_type -> story.ViewStoryRequest
+	3,  // 9: story.StoryService.GetStoryFeed:input_type -> story.GetStoryFeedRequest
+	12, // 10: story.StoryService.DeleteStory:input_type -> story.DeleteStoryRequest
+	14, // 11: story.StoryService.GetUserArchive:input_type -> story.GetUserArchiveRequest
+	16, // 12: story.StoryService.GetStory:input_type -> story.GetStoryRequest
+	2,  // 13: story.StoryService.CreateStory:output_type -> story.CreateStoryResponse
+	7,  // 14: story.StoryService.LikeStory:output_type -> story.LikeStoryResponse
+	9,  // 15: story.StoryService.UnlikeStory:output_type -> story.UnlikeStoryResponse
+	11, // 16: story.StoryService.ViewStory:output_type -> story.ViewStoryResponse
+	5,  // 17: story.StoryService.GetStoryFeed:output_type -> story.GetStoryFeedResponse
+	13, // 18: story.StoryService.DeleteStory:output_type -> story.DeleteStoryResponse
+	15, // 19: story.StoryService.GetUserArchive:output_type -> story.GetUserArchiveResponse
+	17, // 20: story.StoryService.GetStory:output_type -> story.GetStoryResponse
+	13, // [13:21] is the sub-list for method output_type
+	5,  // [5:13] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_story_proto_init() }
@@ -1076,7 +1192,7 @@ func file_story_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_story_proto_rawDesc), len(file_story_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   16,
+			NumMessages:   18,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
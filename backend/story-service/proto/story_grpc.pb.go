@@ -26,6 +26,7 @@ const (
 	StoryService_GetStoryFeed_FullMethodName   = "/story.StoryService/GetStoryFeed"
 	StoryService_DeleteStory_FullMethodName    = "/story.StoryService/DeleteStory"
 	StoryService_GetUserArchive_FullMethodName = "/story.StoryService/GetUserArchive"
+	StoryService_GetStory_FullMethodName       = "/story.StoryService/GetStory"
 )
 
 // StoryServiceClient is the client API for StoryService service.
@@ -42,6 +43,8 @@ type StoryServiceClient interface {
 	DeleteStory(ctx context.Context, in *DeleteStoryRequest, opts ...grpc.CallOption) (*DeleteStoryResponse, error)
 	// NEW: Get user's archive (all their stories)
 	GetUserArchive(ctx context.Context, in *GetUserArchiveRequest, opts ...grpc.CallOption) (*GetUserArchiveResponse, error)
+	// A single active story, if the viewer can see it
+	GetStory(ctx context.Context, in *GetStoryRequest, opts ...grpc.CallOption) (*GetStoryResponse, error)
 }
 
 type storyServiceClient struct {
@@ -122,6 +125,16 @@ func (c *storyServiceClient) GetUserArchive(ctx context.Context, in *GetUserArch
 	return out, nil
 }
 
+func (c *storyServiceClient) GetStory(ctx context.Context, in *GetStoryRequest, opts ...grpc.CallOption) (*GetStoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetStoryResponse)
+	err := c.cc.Invoke(ctx, StoryService_GetStory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // StoryServiceServer is the server API for StoryService service.
 // All implementations must embed UnimplementedStoryServiceServer
 // for forward compatibility.
@@ -136,6 +149,8 @@ type StoryServiceServer interface {
 	DeleteStory(context.Context, *DeleteStoryRequest) (*DeleteStoryResponse, error)
 	// NEW: Get user's archive (all their stories)
 	GetUserArchive(context.Context, *GetUserArchiveRequest) (*GetUserArchiveResponse, error)
+	// A single active story, if the viewer can see it
+	GetStory(context.Context, *GetStoryRequest) (*GetStoryResponse, error)
 	mustEmbedUnimplementedStoryServiceServer()
 }
 
@@ -167,6 +182,9 @@ func (UnimplementedStoryServiceServer) DeleteStory(context.Context, *DeleteStory
 func (UnimplementedStoryServiceServer) GetUserArchive(context.Context, *GetUserArchiveRequest) (*GetUserArchiveResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUserArchive not implemented")
 }
+func (UnimplementedStoryServiceServer) GetStory(context.Context, *GetStoryRequest) (*GetStoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStory not implemented")
+}
 func (UnimplementedStoryServiceServer) mustEmbedUnimplementedStoryServiceServer() {}
 func (UnimplementedStoryServiceServer) testEmbeddedByValue()                      {}
 
@@ -314,6 +332,24 @@ func _StoryService_GetUserArchive_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StoryService_GetStory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoryServiceServer).GetStory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StoryService_GetStory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoryServiceServer).GetStory(ctx, req.(*GetStoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // StoryService_ServiceDesc is the grpc.ServiceDesc for StoryService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -349,6 +385,10 @@ var StoryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetUserArchive",
 			Handler:    _StoryService_GetUserArchive_Handler,
 		},
+		{
+			MethodName: "GetStory",
+			Handler:    _StoryService_GetStory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "story.proto",
@@ -61,8 +61,19 @@ type User struct {
 	IsVerified   bool   `gorm:"default:false"` // For verified checkmark
 	Provider     string `gorm:"type:varchar(20);default:'local'"`
 	ProviderID   string `gorm:"type:varchar(255);index"`
+
+	DefaultCommentAudience string `gorm:"type:varchar(20);default:'everyone'"` // Who can comment on new posts
+	ShowActivityStatus     bool   `gorm:"default:true"`                        // Online and last seen in messages
 }
 
+// Comment audiences, shared with post-service
+const (
+	commentAudienceEveryone  = "everyone"
+	commentAudienceFollowing = "following" // People the author follows
+	commentAudienceFollowers = "followers" // People who follow the author
+	commentAudienceOff       = "off"
+)
+
 // Follow defines the relationship between two users
 type Follow struct {
 	// Composite primary key (follower_id, following_id)
@@ -121,6 +132,13 @@ type NotificationSetting struct {
 	UpdatedAt    time.Time
 }
 
+// CommentFilterKeyword is a word or phrase a user doesn't want to see in comments on their posts
+type CommentFilterKeyword struct {
+	UserID    int64  `gorm:"primaryKey"`
+	Keyword   string `gorm:"primaryKey;type:varchar(100)"`
+	CreatedAt time.Time
+}
+
 // searchResult is a helper struct for sorting
 type searchResult struct {
 	user       User
@@ -297,6 +315,7 @@ func main() {
 	db.AutoMigrate(&CloseFriend{})
 	db.AutoMigrate(&HiddenStoryUser{})
 	db.AutoMigrate(&NotificationSetting{})
+	db.AutoMigrate(&CommentFilterKeyword{})
 	appLogger.Info("Database migrations completed")
 
 	// --- Step 2: Connect to Redis ---
@@ -354,6 +373,19 @@ func main() {
 	}
 	log.Println("RabbitMQ email_queue declared")
 
+	_, err = amqpCh.QueueDeclare(
+		"feed_fanout_queue",
+		true,  // durable
+		false, // delete when unused
+		false, // exclusive
+		false, // no-wait
+		nil,   // arguments
+	)
+	if err != nil {
+		log.Fatalf("Failed to declare feed_fanout_queue: %v", err)
+	}
+	log.Println("RabbitMQ feed_fanout_queue declared")
+
 	// --- Step 4: Set up and start the gRPC server ---
 	lis, err := net.Listen("tcp", ":9000")
 	if err != nil {
@@ -709,6 +741,19 @@ func (s *server) publishToQueue(ctx context.Context, queueName string, body []by
 	)
 }
 
+// publishFeedEvent asks worker-service to add or prune an author's posts in a
+// user's home timeline after the follow graph changes
+func (s *server) publishFeedEvent(ctx context.Context, eventType string, userID, authorID int64) {
+	msgBody, _ := json.Marshal(map[string]interface{}{
+		"type":      eventType,
+		"user_id":   userID,
+		"author_id": authorID,
+	})
+	if err := s.publishToQueue(ctx, "feed_fanout_queue", msgBody); err != nil {
+		log.Printf("Failed to publish %s for user %d: %v", eventType, userID, err)
+	}
+}
+
 // --- ADD GPRC FUNCTION 1: SendPasswordReset ---
 func (s *server) SendPasswordReset(ctx context.Context, req *pb.SendPasswordResetRequest) (*pb.SendPasswordResetResponse, error) {
 	var user User
@@ -820,6 +865,9 @@ func (s *server) GetUserData(ctx context.Context, req *pb.GetUserDataRequest) (*
 		Username:          user.Username,
 		ProfilePictureUrl: user.ProfilePictureURL,
 		IsVerified:        user.IsVerified,
+
+		DefaultCommentAudience: user.DefaultCommentAudience,
+		ActivityStatusHidden:   !user.ShowActivityStatus,
 	}
 
 	// Store in cache with 15 minute TTL
@@ -877,6 +925,10 @@ func (s *server) FollowUser(ctx context.Context, req *pb.FollowUserRequest) (*pb
 	})
 	s.publishToQueue(ctx, "notification_queue", msgBody)
 
+	if followStatus == "approved" {
+		s.publishFeedEvent(ctx, "user.followed", req.FollowerId, req.FollowingId)
+	}
+
 	log.Printf("User %d follow status: %s for User %d", req.FollowerId, followStatus, req.FollowingId)
 
 	return &pb.FollowUserResponse{Message: message}, nil
@@ -895,12 +947,8 @@ func (s *server) UnfollowUser(ctx context.Context, req *pb.UnfollowUserRequest)
 		return nil, status.Error(codes.NotFound, "You are not following this user")
 	}
 
-	// Invalidate follower's home feed cache (they should no longer see posts from this user)
-	pattern := "feed:home:" + strconv.FormatInt(req.FollowerId, 10) + ":*"
-	iter := s.rdb.Scan(ctx, 0, pattern, 0).Iterator()
-	for iter.Next(ctx) {
-		s.rdb.Del(ctx, iter.Val())
-	}
+	// Drop this user's posts from the follower's home timeline
+	s.publishFeedEvent(ctx, "user.unfollowed", req.FollowerId, req.FollowingId)
 
 	log.Printf("User %d has unfollowed User %d", req.FollowerId, req.FollowingId)
 
@@ -920,12 +968,8 @@ func (s *server) ApproveFollowRequest(ctx context.Context, req *pb.ApproveFollow
 		return nil, status.Error(codes.NotFound, "No pending follow request found")
 	}
 
-	// Invalidate follower's home feed cache (they should now see posts from the followed user)
-	pattern := "feed:home:" + strconv.FormatInt(req.FollowerId, 10) + ":*"
-	iter := s.rdb.Scan(ctx, 0, pattern, 0).Iterator()
-	for iter.Next(ctx) {
-		s.rdb.Del(ctx, iter.Val())
-	}
+	// The follower should now see this user's posts in their home timeline
+	s.publishFeedEvent(ctx, "user.followed", req.FollowerId, req.UserId)
 
 	// Send notification to requester
 	msgBody, _ := json.Marshal(map[string]interface{}{
@@ -1009,10 +1053,11 @@ func (s *server) IsFollowing(ctx context.Context, req *pb.IsFollowingRequest) (*
 func (s *server) GetFollowingList(ctx context.Context, req *pb.GetFollowingListRequest) (*pb.GetFollowingListResponse, error) {
 	var followingIDs []int64
 
-	// Find all 'Follow' records where the follower_id is our user
-	// Then, select only the 'following_id' column
+	// Find all approved 'Follow' records where the follower_id is our user
+	// Then, select only the 'following_id' column. Pending requests don't
+	// grant access to a private account's content, so they are left out.
 	err := s.db.Model(&Follow{}).
-		Where("follower_id = ?", req.UserId).
+		Where("follower_id = ? AND status = ?", req.UserId, "approved").
 		Pluck("following_id", &followingIDs).Error
 
 	if err != nil {
@@ -1032,9 +1077,10 @@ func (s *server) GetFollowingList(ctx context.Context, req *pb.GetFollowingListR
 func (s *server) GetFollowersList(ctx context.Context, req *pb.GetFollowersListRequest) (*pb.GetFollowersListResponse, error) {
 	var followerIDs []int64
 
-	// Find all 'Follow' records where following_id is the target user
+	// Find all approved 'Follow' records where following_id is the target user.
+	// Home timelines fan out to these followers, so pending requests must not count.
 	err := s.db.Model(&Follow{}).
-		Where("following_id = ?", req.UserId).
+		Where("following_id = ? AND status = ?", req.UserId, "approved").
 		Pluck("follower_id", &followerIDs).Error
 
 	if err != nil {
@@ -1095,6 +1141,9 @@ func (s *server) GetUserProfile(ctx context.Context, req *pb.GetUserProfileReque
 
 	mutualFollowerCount = 0
 
+	// Only you need to know whether your activity status is hidden
+	activityStatusHidden := req.SelfUserId == int64(user.ID) && !user.ShowActivityStatus
+
 	return &pb.GetUserProfileResponse{
 		UserId:              int64(user.ID),
 		Name:                user.Name,
@@ -1110,6 +1159,8 @@ func (s *server) GetUserProfile(ctx context.Context, req *pb.GetUserProfileReque
 		IsPrivate:           user.IsPrivate,
 		FollowStatus:        followStatus,
 		IsBlocked:           isBlocked,
+
+		ActivityStatusHidden: activityStatusHidden,
 	}, nil
 }
 
@@ -1257,6 +1308,42 @@ func (s *server) SetAccountPrivacy(ctx context.Context, req *pb.SetAccountPrivac
 	return &pb.SetAccountPrivacyResponse{Message: "Account privacy updated successfully"}, nil
 }
 
+// --- GPRC: SetDefaultCommentAudience ---
+func (s *server) SetDefaultCommentAudience(ctx context.Context, req *pb.SetDefaultCommentAudienceRequest) (*pb.SetDefaultCommentAudienceResponse, error) {
+	switch req.Audience {
+	case commentAudienceEveryone, commentAudienceFollowing, commentAudienceFollowers, commentAudienceOff:
+	default:
+		return nil, status.Error(codes.InvalidArgument, "Audience must be one of everyone, following, followers or off")
+	}
+
+	if err := s.db.Model(&User{}).Where("id = ?", req.UserId).Update("default_comment_audience", req.Audience).Error; err != nil {
+		return nil, status.Error(codes.Internal, "Failed to update comment audience")
+	}
+
+	// GetUserData caches the default, so drop it
+	cacheKey := fmt.Sprintf("user:profile:%d", req.UserId)
+	s.rdb.Del(ctx, cacheKey)
+
+	log.Printf("Default comment audience set to %s for user_id: %d", req.Audience, req.UserId)
+
+	return &pb.SetDefaultCommentAudienceResponse{Message: "Default comment audience updated successfully"}, nil
+}
+
+// --- GPRC: SetActivityStatus ---
+func (s *server) SetActivityStatus(ctx context.Context, req *pb.SetActivityStatusRequest) (*pb.SetActivityStatusResponse, error) {
+	if err := s.db.Model(&User{}).Where("id = ?", req.UserId).Update("show_activity_status", req.Show).Error; err != nil {
+		return nil, status.Error(codes.Internal, "Failed to update activity status")
+	}
+
+	// GetUserData caches the setting and message-service reads it from there
+	cacheKey := fmt.Sprintf("user:profile:%d", req.UserId)
+	s.rdb.Del(ctx, cacheKey)
+
+	log.Printf("Activity status shown set to %t for user_id: %d", req.Show, req.UserId)
+
+	return &pb.SetActivityStatusResponse{Message: "Activity status updated successfully"}, nil
+}
+
 // --- GPRC: BlockUser ---
 func (s *server) BlockUser(ctx context.Context, req *pb.BlockUserRequest) (*pb.BlockUserResponse, error) {
 	if req.BlockerId == req.BlockedId {
@@ -1299,6 +1386,9 @@ func (s *server) BlockUser(ctx context.Context, req *pb.BlockUserRequest) (*pb.B
 		return nil, err
 	}
 
+	// Both follows are gone, so prune each user's posts from the other's timeline
+	s.publishFeedEvent(ctx, "user.blocked", req.BlockerId, req.BlockedId)
+
 	log.Printf("User %d is now blocking User %d", req.BlockerId, req.BlockedId)
 
 	return &pb.BlockUserResponse{Message: "Successfully blocked user"}, nil
@@ -1355,6 +1445,189 @@ func (s *server) GetBlockedUsers(ctx context.Context, req *pb.GetBlockedUsersReq
 	return &pb.GetBlockedUsersResponse{BlockedUsers: blockedUsers}, nil
 }
 
+// --- GRPC: GetUserSummaries ---
+// Batched lookup used by other services to render user lists without an RPC per user
+func (s *server) GetUserSummaries(ctx context.Context, req *pb.GetUserSummariesRequest) (*pb.GetUserSummariesResponse, error) {
+	if len(req.UserIds) == 0 {
+		return &pb.GetUserSummariesResponse{Users: []*pb.UserSummary{}}, nil
+	}
+	if len(req.UserIds) > 500 {
+		return nil, status.Error(codes.InvalidArgument, "Cannot look up more than 500 users at once")
+	}
+
+	var users []User
+	if err := s.db.Where("id IN ? AND is_banned = ?", req.UserIds, false).Find(&users).Error; err != nil {
+		return nil, status.Error(codes.Internal, "Failed to retrieve users")
+	}
+	usersByID := make(map[int64]User, len(users))
+	for _, user := range users {
+		usersByID[int64(user.ID)] = user
+	}
+
+	// Relationship state relative to the viewer, one query each
+	followStatus := make(map[int64]string)
+	blocked := make(map[int64]bool)
+	if req.ViewerId != 0 {
+		var follows []Follow
+		if err := s.db.Where("follower_id = ? AND following_id IN ?", req.ViewerId, req.UserIds).Find(&follows).Error; err != nil {
+			return nil, status.Error(codes.Internal, "Failed to check follow status")
+		}
+		for _, follow := range follows {
+			followStatus[follow.FollowingID] = follow.Status
+		}
+
+		var blocks []Block
+		if err := s.db.Where("(blocker_id = ? AND blocked_id IN ?) OR (blocked_id = ? AND blocker_id IN ?)",
+			req.ViewerId, req.UserIds, req.ViewerId, req.UserIds).Find(&blocks).Error; err != nil {
+			return nil, status.Error(codes.Internal, "Failed to check block status")
+		}
+		for _, block := range blocks {
+			if block.BlockerID == req.ViewerId {
+				blocked[block.BlockedID] = true
+			} else {
+				blocked[block.BlockerID] = true
+			}
+		}
+	}
+
+	summaries := make([]*pb.UserSummary, 0, len(req.UserIds))
+	seen := make(map[int64]bool, len(req.UserIds))
+	for _, id := range req.UserIds {
+		user, ok := usersByID[id]
+		if !ok || blocked[id] || seen[id] {
+			continue
+		}
+		seen[id] = true
+		summaries = append(summaries, &pb.UserSummary{
+			User: &pb.UserInfo{
+				UserId:            id,
+				Username:          user.Username,
+				Name:              user.Name,
+				ProfilePictureUrl: user.ProfilePictureURL,
+				IsVerified:        user.IsVerified,
+			},
+			IsFollowedByViewer:   followStatus[id] == "approved",
+			FollowStatus:         followStatus[id],
+			IsPrivate:            user.IsPrivate,
+			ActivityStatusHidden: !user.ShowActivityStatus,
+		})
+	}
+
+	return &pb.GetUserSummariesResponse{Users: summaries}, nil
+}
+
+// --- GPRC: GetRelationships ---
+// GetRelationships answers every privacy question post-service and story-service
+// ask about a viewer and a set of users with a fixed number of queries.
+func (s *server) GetRelationships(ctx context.Context, req *pb.GetRelationshipsRequest) (*pb.GetRelationshipsResponse, error) {
+	if len(req.TargetIds) == 0 {
+		return &pb.GetRelationshipsResponse{Relationships: map[int64]*pb.Relationship{}}, nil
+	}
+	if len(req.TargetIds) > 500 {
+		return nil, status.Error(codes.InvalidArgument, "Cannot look up more than 500 users at once")
+	}
+
+	rels := make(map[int64]*pb.Relationship, len(req.TargetIds))
+	for _, id := range req.TargetIds {
+		rels[id] = &pb.Relationship{TargetId: id}
+	}
+
+	var users []User
+	if err := s.db.Select("id", "is_private").Where("id IN ? AND is_banned = ?", req.TargetIds, false).Find(&users).Error; err != nil {
+		return nil, status.Error(codes.Internal, "Failed to retrieve users")
+	}
+	for _, user := range users {
+		rel := rels[int64(user.ID)]
+		rel.Exists = true
+		rel.IsPrivate = user.IsPrivate
+	}
+
+	var follows []Follow
+	if err := s.db.Where("(follower_id = ? AND following_id IN ?) OR (following_id = ? AND follower_id IN ? AND status = ?)",
+		req.ViewerId, req.TargetIds, req.ViewerId, req.TargetIds, "approved").Find(&follows).Error; err != nil {
+		return nil, status.Error(codes.Internal, "Failed to check follow status")
+	}
+	for _, follow := range follows {
+		if follow.FollowerID == req.ViewerId {
+			rels[follow.FollowingID].ViewerFollows = follow.Status == "approved"
+			rels[follow.FollowingID].FollowPending = follow.Status == "pending"
+		} else {
+			rels[follow.FollowerID].FollowsViewer = true
+		}
+	}
+
+	var blocks []Block
+	if err := s.db.Where("(blocker_id = ? AND blocked_id IN ?) OR (blocked_id = ? AND blocker_id IN ?)",
+		req.ViewerId, req.TargetIds, req.ViewerId, req.TargetIds).Find(&blocks).Error; err != nil {
+		return nil, status.Error(codes.Internal, "Failed to check block status")
+	}
+	for _, block := range blocks {
+		if block.BlockerID == req.ViewerId {
+			rels[block.BlockedID].Blocked = true
+		} else {
+			rels[block.BlockerID].Blocked = true
+		}
+	}
+
+	var closeFriendOf []int64
+	if err := s.db.Model(&CloseFriend{}).Where("friend_id = ? AND user_id IN ?", req.ViewerId, req.TargetIds).
+		Pluck("user_id", &closeFriendOf).Error; err != nil {
+		return nil, status.Error(codes.Internal, "Failed to check close friends")
+	}
+	for _, id := range closeFriendOf {
+		rels[id].ViewerIsCloseFriend = true
+	}
+
+	var hiddenBy []int64
+	if err := s.db.Model(&HiddenStoryUser{}).Where("hidden_user_id = ? AND user_id IN ?", req.ViewerId, req.TargetIds).
+		Pluck("user_id", &hiddenBy).Error; err != nil {
+		return nil, status.Error(codes.Internal, "Failed to check hidden stories")
+	}
+	for _, id := range hiddenBy {
+		rels[id].HidesStoryFromViewer = true
+	}
+
+	return &pb.GetRelationshipsResponse{Relationships: rels}, nil
+}
+
+// --- GPRC: GetFollowerGrowth ---
+// GetFollowerGrowth counts approved followers by the day they followed, for account insights.
+// Unfollows delete the row, so this is followers gained rather than net growth.
+func (s *server) GetFollowerGrowth(ctx context.Context, req *pb.GetFollowerGrowthRequest) (*pb.GetFollowerGrowthResponse, error) {
+	since, err := time.Parse("2006-01-02", req.Since)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "since must be a YYYY-MM-DD date")
+	}
+
+	var followerCount int64
+	if err := s.db.Model(&Follow{}).Where("following_id = ? AND status = ?", req.UserId, "approved").Count(&followerCount).Error; err != nil {
+		return nil, status.Error(codes.Internal, "Failed to count followers")
+	}
+
+	var rows []struct {
+		Day   string
+		Count int64
+	}
+	if err := s.db.Model(&Follow{}).
+		Select("DATE(created_at) AS day, COUNT(*) AS count").
+		Where("following_id = ? AND status = ? AND created_at >= ?", req.UserId, "approved", since).
+		Group("DATE(created_at)").
+		Order("day").
+		Scan(&rows).Error; err != nil {
+		return nil, status.Error(codes.Internal, "Failed to retrieve follower growth")
+	}
+
+	days := make([]*pb.DailyCount, 0, len(rows))
+	for _, row := range rows {
+		// Postgres hands DATE back as a timestamp string, SQLite as YYYY-MM-DD
+		if len(row.Day) > 10 {
+			row.Day = row.Day[:10]
+		}
+		days = append(days, &pb.DailyCount{Date: row.Day, Count: row.Count})
+	}
+	return &pb.GetFollowerGrowthResponse{FollowerCount: followerCount, Days: days}, nil
+}
+
 // --- ADD NEW GRPC FUNCTION: VerifyRegistrationOtp ---
 func (s *server) VerifyRegistrationOtp(ctx context.Context, req *pb.VerifyRegistrationOtpRequest) (*pb.VerifyRegistrationOtpResponse, error) {
 	log.Printf("VerifyRegistrationOtp request received for: %s", req.Email)
@@ -1975,3 +2248,62 @@ func (s *server) GetNotificationSettings(ctx context.Context, req *pb.GetNotific
 		EmailEnabled: settings.EmailEnabled,
 	}, nil
 }
+
+// --- GPRC: SetCommentFilterKeywords ---
+func (s *server) SetCommentFilterKeywords(ctx context.Context, req *pb.SetCommentFilterKeywordsRequest) (*pb.SetCommentFilterKeywordsResponse, error) {
+	if len(req.Keywords) > 100 {
+		return nil, status.Error(codes.InvalidArgument, "You can filter at most 100 keywords")
+	}
+
+	// Normalize: trimmed, lowercased, unique
+	keywords := []string{}
+	seen := make(map[string]bool)
+	for _, keyword := range req.Keywords {
+		keyword = strings.ToLower(strings.TrimSpace(keyword))
+		if keyword == "" || seen[keyword] {
+			continue
+		}
+		if len(keyword) > 100 {
+			return nil, status.Error(codes.InvalidArgument, "Keywords must not exceed 100 characters")
+		}
+		seen[keyword] = true
+		keywords = append(keywords, keyword)
+	}
+
+	// Replace the whole list in one transaction
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("user_id = ?", req.UserId).Delete(&CommentFilterKeyword{}).Error; err != nil {
+			return err
+		}
+		if len(keywords) == 0 {
+			return nil
+		}
+		rows := make([]CommentFilterKeyword, len(keywords))
+		for i, keyword := range keywords {
+			rows[i] = CommentFilterKeyword{UserID: req.UserId, Keyword: keyword, CreatedAt: time.Now()}
+		}
+		return tx.Create(&rows).Error
+	})
+	if err != nil {
+		log.Printf("Failed to save comment filter for user %d: %v", req.UserId, err)
+		return nil, status.Error(codes.Internal, "Failed to update comment filter")
+	}
+
+	return &pb.SetCommentFilterKeywordsResponse{
+		Message:  "Comment filter updated successfully",
+		Keywords: keywords,
+	}, nil
+}
+
+// --- GPRC: GetCommentFilterKeywords ---
+func (s *server) GetCommentFilterKeywords(ctx context.Context, req *pb.GetCommentFilterKeywordsRequest) (*pb.GetCommentFilterKeywordsResponse, error) {
+	keywords := []string{}
+	if err := s.db.Model(&CommentFilterKeyword{}).
+		Where("user_id = ?", req.UserId).
+		Order("keyword ASC").
+		Pluck("keyword", &keywords).Error; err != nil {
+		return nil, status.Error(codes.Internal, "Failed to get comment filter")
+	}
+
+	return &pb.GetCommentFilterKeywordsResponse{Keywords: keywords}, nil
+}
@@ -1,11 +1,14 @@
 package main
 
 import (
+	"context"
 	"testing"
 	"time"
 
 	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
+
+	pb "github.com/hoshibmatchi/user-service/proto"
 )
 
 // setupTestDB creates an in-memory SQLite database for testing
@@ -23,6 +26,7 @@ func setupTestDB() (*gorm.DB, error) {
 	db.AutoMigrate(&CloseFriend{})
 	db.AutoMigrate(&HiddenStoryUser{})
 	db.AutoMigrate(&NotificationSetting{})
+	db.AutoMigrate(&CommentFilterKeyword{})
 
 	return db, nil
 }
@@ -294,3 +298,218 @@ func TestCloseFriendRelationship(t *testing.T) {
 		t.Errorf("Expected friend_id %d, got %d", user2.ID, found.FriendID)
 	}
 }
+
+func TestSetCommentFilterKeywords(t *testing.T) {
+	db, err := setupTestDB()
+	if err != nil {
+		t.Fatalf("Failed to setup test database: %v", err)
+	}
+	s := &server{db: db}
+	ctx := context.Background()
+
+	res, err := s.SetCommentFilterKeywords(ctx, &pb.SetCommentFilterKeywordsRequest{
+		UserId:   1,
+		Keywords: []string{"  Spam ", "spam", "", "Buy Followers"},
+	})
+	if err != nil {
+		t.Fatalf("Failed to set comment filter: %v", err)
+	}
+	if len(res.Keywords) != 2 || res.Keywords[0] != "spam" || res.Keywords[1] != "buy followers" {
+		t.Errorf("Expected normalized keywords [spam buy followers], got %v", res.Keywords)
+	}
+
+	// Setting again replaces the list
+	if _, err := s.SetCommentFilterKeywords(ctx, &pb.SetCommentFilterKeywordsRequest{UserId: 1, Keywords: []string{"scam"}}); err != nil {
+		t.Fatalf("Failed to replace comment filter: %v", err)
+	}
+	got, err := s.GetCommentFilterKeywords(ctx, &pb.GetCommentFilterKeywordsRequest{UserId: 1})
+	if err != nil {
+		t.Fatalf("Failed to get comment filter: %v", err)
+	}
+	if len(got.Keywords) != 1 || got.Keywords[0] != "scam" {
+		t.Errorf("Expected [scam], got %v", got.Keywords)
+	}
+}
+
+func TestGetUserSummaries(t *testing.T) {
+	db, err := setupTestDB()
+	if err != nil {
+		t.Fatalf("Failed to setup test database: %v", err)
+	}
+	s := &server{db: db}
+	ctx := context.Background()
+
+	var ids []int64
+	for _, name := range []string{"viewer", "friend", "stranger", "blocker", "banned"} {
+		user := User{
+			Name:        name,
+			Username:    name,
+			Email:       name + "@example.com",
+			Password:    "hashedpassword",
+			DateOfBirth: time.Now().AddDate(-20, 0, 0),
+			Gender:      "male",
+			IsBanned:    name == "banned",
+		}
+		if err := db.Create(&user).Error; err != nil {
+			t.Fatalf("Failed to create user: %v", err)
+		}
+		ids = append(ids, int64(user.ID))
+	}
+	viewer, friend, stranger, blocker, banned := ids[0], ids[1], ids[2], ids[3], ids[4]
+
+	db.Create(&Follow{FollowerID: viewer, FollowingID: friend, Status: "approved"})
+	db.Create(&Follow{FollowerID: viewer, FollowingID: stranger, Status: "pending"})
+	db.Create(&Block{BlockerID: blocker, BlockedID: viewer})
+	db.Model(&User{}).Where("id = ?", stranger).Update("show_activity_status", false)
+
+	res, err := s.GetUserSummaries(ctx, &pb.GetUserSummariesRequest{
+		UserIds:  []int64{stranger, banned, blocker, friend, 999},
+		ViewerId: viewer,
+	})
+	if err != nil {
+		t.Fatalf("GetUserSummaries failed: %v", err)
+	}
+	if len(res.Users) != 2 {
+		t.Fatalf("Expected 2 users after filtering, got %d", len(res.Users))
+	}
+	if res.Users[0].User.UserId != stranger || res.Users[0].IsFollowedByViewer || res.Users[0].FollowStatus != "pending" {
+		t.Errorf("Expected pending stranger first, got %+v", res.Users[0])
+	}
+	if res.Users[1].User.UserId != friend || !res.Users[1].IsFollowedByViewer {
+		t.Errorf("Expected followed friend second, got %+v", res.Users[1])
+	}
+	if !res.Users[0].ActivityStatusHidden || res.Users[1].ActivityStatusHidden {
+		t.Errorf("Expected only the stranger's activity status hidden, got %t and %t", res.Users[0].ActivityStatusHidden, res.Users[1].ActivityStatusHidden)
+	}
+}
+
+func TestGetRelationships(t *testing.T) {
+	db, err := setupTestDB()
+	if err != nil {
+		t.Fatalf("Failed to setup test database: %v", err)
+	}
+	s := &server{db: db}
+	ctx := context.Background()
+
+	var ids []int64
+	for _, name := range []string{"viewer", "friend", "private", "blocker", "banned"} {
+		user := User{
+			Name:        name,
+			Username:    name,
+			Email:       name + "@example.com",
+			Password:    "hashedpassword",
+			DateOfBirth: time.Now().AddDate(-20, 0, 0),
+			Gender:      "female",
+			IsPrivate:   name == "private",
+			IsBanned:    name == "banned",
+		}
+		if err := db.Create(&user).Error; err != nil {
+			t.Fatalf("Failed to create user: %v", err)
+		}
+		ids = append(ids, int64(user.ID))
+	}
+	viewer, friend, private, blocker, banned := ids[0], ids[1], ids[2], ids[3], ids[4]
+
+	db.Create(&Follow{FollowerID: viewer, FollowingID: friend, Status: "approved"})
+	db.Create(&Follow{FollowerID: friend, FollowingID: viewer, Status: "approved"})
+	db.Create(&Follow{FollowerID: viewer, FollowingID: private, Status: "pending"})
+	db.Create(&Follow{FollowerID: private, FollowingID: viewer, Status: "pending"}) // Not approved, doesn't count
+	db.Create(&Block{BlockerID: blocker, BlockedID: viewer})
+	db.Create(&CloseFriend{UserID: friend, FriendID: viewer})
+	db.Create(&HiddenStoryUser{UserID: private, HiddenUserID: viewer})
+
+	res, err := s.GetRelationships(ctx, &pb.GetRelationshipsRequest{
+		ViewerId:  viewer,
+		TargetIds: []int64{friend, private, blocker, banned, 999},
+	})
+	if err != nil {
+		t.Fatalf("GetRelationships failed: %v", err)
+	}
+	if len(res.Relationships) != 5 {
+		t.Fatalf("Expected an entry per target, got %d", len(res.Relationships))
+	}
+
+	if rel := res.Relationships[friend]; !rel.Exists || !rel.ViewerFollows || !rel.FollowsViewer || !rel.ViewerIsCloseFriend || rel.Blocked {
+		t.Errorf("Unexpected friend relationship: %+v", rel)
+	}
+	if rel := res.Relationships[private]; !rel.IsPrivate || rel.ViewerFollows || !rel.FollowPending || rel.FollowsViewer || !rel.HidesStoryFromViewer {
+		t.Errorf("Unexpected private relationship: %+v", rel)
+	}
+	if rel := res.Relationships[blocker]; !rel.Blocked {
+		t.Errorf("Expected blocker to be blocked, got %+v", rel)
+	}
+	if res.Relationships[banned].Exists || res.Relationships[999].Exists {
+		t.Error("Expected banned and unknown users to not exist")
+	}
+}
+
+func TestFollowListsOnlyApproved(t *testing.T) {
+	db, err := setupTestDB()
+	if err != nil {
+		t.Fatalf("Failed to setup test database: %v", err)
+	}
+	s := &server{db: db}
+	ctx := context.Background()
+
+	// 1 follows 2, asked to follow 3 and was turned down by 4
+	db.Create(&Follow{FollowerID: 1, FollowingID: 2, Status: "approved"})
+	db.Create(&Follow{FollowerID: 1, FollowingID: 3, Status: "pending"})
+	db.Create(&Follow{FollowerID: 1, FollowingID: 4, Status: "rejected"})
+
+	following, err := s.GetFollowingList(ctx, &pb.GetFollowingListRequest{UserId: 1})
+	if err != nil {
+		t.Fatalf("GetFollowingList failed: %v", err)
+	}
+	// The user's own ID is always included
+	if len(following.FollowingUserIds) != 2 || following.FollowingUserIds[0] != 2 || following.FollowingUserIds[1] != 1 {
+		t.Errorf("Expected only the approved follow and self, got %v", following.FollowingUserIds)
+	}
+
+	for _, target := range []int64{2, 3, 4} {
+		followers, err := s.GetFollowersList(ctx, &pb.GetFollowersListRequest{UserId: target})
+		if err != nil {
+			t.Fatalf("GetFollowersList failed: %v", err)
+		}
+		approved := target == 2
+		if got := len(followers.FollowerUserIds) == 1; got != approved {
+			t.Errorf("Expected user %d to have approved followers %v, got %v", target, approved, followers.FollowerUserIds)
+		}
+	}
+}
+
+func TestGetFollowerGrowth(t *testing.T) {
+	db, err := setupTestDB()
+	if err != nil {
+		t.Fatalf("Failed to setup test database: %v", err)
+	}
+	s := &server{db: db}
+	ctx := context.Background()
+
+	today := time.Now().UTC().Truncate(24 * time.Hour).Add(12 * time.Hour)
+	db.Create(&Follow{FollowerID: 2, FollowingID: 1, Status: "approved", CreatedAt: today})
+	db.Create(&Follow{FollowerID: 3, FollowingID: 1, Status: "approved", CreatedAt: today})
+	db.Create(&Follow{FollowerID: 4, FollowingID: 1, Status: "approved", CreatedAt: today.AddDate(0, 0, -2)})
+	db.Create(&Follow{FollowerID: 5, FollowingID: 1, Status: "approved", CreatedAt: today.AddDate(0, 0, -40)})
+	db.Create(&Follow{FollowerID: 6, FollowingID: 1, Status: "pending", CreatedAt: today})
+
+	res, err := s.GetFollowerGrowth(ctx, &pb.GetFollowerGrowthRequest{UserId: 1, Since: today.AddDate(0, 0, -6).Format("2006-01-02")})
+	if err != nil {
+		t.Fatalf("GetFollowerGrowth failed: %v", err)
+	}
+	if res.FollowerCount != 4 {
+		t.Errorf("Expected 4 approved followers, got %d", res.FollowerCount)
+	}
+	if len(res.Days) != 2 {
+		t.Fatalf("Expected 2 days with new followers, got %+v", res.Days)
+	}
+	if res.Days[0].Date != today.AddDate(0, 0, -2).Format("2006-01-02") || res.Days[0].Count != 1 {
+		t.Errorf("Unexpected first day %+v", res.Days[0])
+	}
+	if res.Days[1].Date != today.Format("2006-01-02") || res.Days[1].Count != 2 {
+		t.Errorf("Unexpected second day %+v", res.Days[1])
+	}
+
+	if _, err := s.GetFollowerGrowth(ctx, &pb.GetFollowerGrowthRequest{UserId: 1, Since: "last week"}); err == nil {
+		t.Error("Expected an error for a malformed date")
+	}
+}
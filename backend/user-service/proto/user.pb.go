@@ -968,13 +968,15 @@ func (x *GetUserDataRequest) GetUserId() int64 {
 }
 
 type GetUserDataResponse struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	Id                int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // User ID
-	Username          string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
-	ProfilePictureUrl string                 `protobuf:"bytes,3,opt,name=profile_picture_url,json=profilePictureUrl,proto3" json:"profile_picture_url,omitempty"`
-	IsVerified        bool                   `protobuf:"varint,4,opt,name=is_verified,json=isVerified,proto3" json:"is_verified,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	Id                     int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // User ID
+	Username               string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	ProfilePictureUrl      string                 `protobuf:"bytes,3,opt,name=profile_picture_url,json=profilePictureUrl,proto3" json:"profile_picture_url,omitempty"`
+	IsVerified             bool                   `protobuf:"varint,4,opt,name=is_verified,json=isVerified,proto3" json:"is_verified,omitempty"`
+	DefaultCommentAudience string                 `protobuf:"bytes,5,opt,name=default_comment_audience,json=defaultCommentAudience,proto3" json:"default_comment_audience,omitempty"` // Applied to new posts that don't set one
+	ActivityStatusHidden   bool                   `protobuf:"varint,6,opt,name=activity_status_hidden,json=activityStatusHidden,proto3" json:"activity_status_hidden,omitempty"`      // Don't show when this user is online or was last active
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
 }
 
 func (x *GetUserDataResponse) Reset() {
@@ -1035,6 +1037,20 @@ func (x *GetUserDataResponse) GetIsVerified() bool {
 	return false
 }
 
+func (x *GetUserDataResponse) GetDefaultCommentAudience() string {
+	if x != nil {
+		return x.DefaultCommentAudience
+	}
+	return ""
+}
+
+func (x *GetUserDataResponse) GetActivityStatusHidden() bool {
+	if x != nil {
+		return x.ActivityStatusHidden
+	}
+	return false
+}
+
 // --- Follow / Unfollow User ---
 type FollowUserRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -1563,23 +1579,24 @@ func (x *GetUserProfileRequest) GetSelfUserId() int64 {
 }
 
 type GetUserProfileResponse struct {
-	state               protoimpl.MessageState `protogen:"open.v1"`
-	UserId              int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Name                string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Username            string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
-	Bio                 string                 `protobuf:"bytes,4,opt,name=bio,proto3" json:"bio,omitempty"` // We need to add this to our GORM model
-	ProfilePictureUrl   string                 `protobuf:"bytes,5,opt,name=profile_picture_url,json=profilePictureUrl,proto3" json:"profile_picture_url,omitempty"`
-	IsVerified          bool                   `protobuf:"varint,6,opt,name=is_verified,json=isVerified,proto3" json:"is_verified,omitempty"`
-	FollowerCount       int64                  `protobuf:"varint,7,opt,name=follower_count,json=followerCount,proto3" json:"follower_count,omitempty"`
-	FollowingCount      int64                  `protobuf:"varint,8,opt,name=following_count,json=followingCount,proto3" json:"following_count,omitempty"`
-	IsFollowedBySelf    bool                   `protobuf:"varint,9,opt,name=is_followed_by_self,json=isFollowedBySelf,proto3" json:"is_followed_by_self,omitempty"` // Does the person viewing follow this profile?
-	MutualFollowerCount int64                  `protobuf:"varint,10,opt,name=mutual_follower_count,json=mutualFollowerCount,proto3" json:"mutual_follower_count,omitempty"`
-	Gender              string                 `protobuf:"bytes,11,opt,name=gender,proto3" json:"gender,omitempty"`
-	IsPrivate           bool                   `protobuf:"varint,12,opt,name=is_private,json=isPrivate,proto3" json:"is_private,omitempty"`
-	FollowStatus        string                 `protobuf:"bytes,13,opt,name=follow_status,json=followStatus,proto3" json:"follow_status,omitempty"` // pending, approved, or empty if not following
-	IsBlocked           bool                   `protobuf:"varint,14,opt,name=is_blocked,json=isBlocked,proto3" json:"is_blocked,omitempty"`         // if current user has blocked this profile
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	UserId               int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name                 string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Username             string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	Bio                  string                 `protobuf:"bytes,4,opt,name=bio,proto3" json:"bio,omitempty"` // We need to add this to our GORM model
+	ProfilePictureUrl    string                 `protobuf:"bytes,5,opt,name=profile_picture_url,json=profilePictureUrl,proto3" json:"profile_picture_url,omitempty"`
+	IsVerified           bool                   `protobuf:"varint,6,opt,name=is_verified,json=isVerified,proto3" json:"is_verified,omitempty"`
+	FollowerCount        int64                  `protobuf:"varint,7,opt,name=follower_count,json=followerCount,proto3" json:"follower_count,omitempty"`
+	FollowingCount       int64                  `protobuf:"varint,8,opt,name=following_count,json=followingCount,proto3" json:"following_count,omitempty"`
+	IsFollowedBySelf     bool                   `protobuf:"varint,9,opt,name=is_followed_by_self,json=isFollowedBySelf,proto3" json:"is_followed_by_self,omitempty"` // Does the person viewing follow this profile?
+	MutualFollowerCount  int64                  `protobuf:"varint,10,opt,name=mutual_follower_count,json=mutualFollowerCount,proto3" json:"mutual_follower_count,omitempty"`
+	Gender               string                 `protobuf:"bytes,11,opt,name=gender,proto3" json:"gender,omitempty"`
+	IsPrivate            bool                   `protobuf:"varint,12,opt,name=is_private,json=isPrivate,proto3" json:"is_private,omitempty"`
+	FollowStatus         string                 `protobuf:"bytes,13,opt,name=follow_status,json=followStatus,proto3" json:"follow_status,omitempty"`                            // pending, approved, or empty if not following
+	IsBlocked            bool                   `protobuf:"varint,14,opt,name=is_blocked,json=isBlocked,proto3" json:"is_blocked,omitempty"`                                    // if current user has blocked this profile
+	ActivityStatusHidden bool                   `protobuf:"varint,15,opt,name=activity_status_hidden,json=activityStatusHidden,proto3" json:"activity_status_hidden,omitempty"` // Only set on your own profile
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
 func (x *GetUserProfileResponse) Reset() {
@@ -1710,6 +1727,13 @@ func (x *GetUserProfileResponse) GetIsBlocked() bool {
 	return false
 }
 
+func (x *GetUserProfileResponse) GetActivityStatusHidden() bool {
+	if x != nil {
+		return x.ActivityStatusHidden
+	}
+	return false
+}
+
 // --- Edit User Profile ---
 type UpdateUserProfileRequest struct {
 	state             protoimpl.MessageState `protogen:"open.v1"`
@@ -1997,6 +2021,200 @@ func (x *SetAccountPrivacyResponse) GetMessage() string {
 	return ""
 }
 
+// --- Default Comment Audience ---
+type SetDefaultCommentAudienceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // From JWT
+	Audience      string                 `protobuf:"bytes,2,opt,name=audience,proto3" json:"audience,omitempty"`            // "everyone", "following", "followers" or "off"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetDefaultCommentAudienceRequest) Reset() {
+	*x = SetDefaultCommentAudienceRequest{}
+	mi := &file_user_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetDefaultCommentAudienceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDefaultCommentAudienceRequest) ProtoMessage() {}
+
+func (x *SetDefaultCommentAudienceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDefaultCommentAudienceRequest.ProtoReflect.Descriptor instead.
+func (*SetDefaultCommentAudienceRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *SetDefaultCommentAudienceRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SetDefaultCommentAudienceRequest) GetAudience() string {
+	if x != nil {
+		return x.Audience
+	}
+	return ""
+}
+
+type SetDefaultCommentAudienceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetDefaultCommentAudienceResponse) Reset() {
+	*x = SetDefaultCommentAudienceResponse{}
+	mi := &file_user_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetDefaultCommentAudienceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDefaultCommentAudienceResponse) ProtoMessage() {}
+
+func (x *SetDefaultCommentAudienceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDefaultCommentAudienceResponse.ProtoReflect.Descriptor instead.
+func (*SetDefaultCommentAudienceResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *SetDefaultCommentAudienceResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// --- Activity Status ---
+type SetActivityStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // From JWT
+	Show          bool                   `protobuf:"varint,2,opt,name=show,proto3" json:"show,omitempty"`                   // False hides your online status and last seen time from everyone
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetActivityStatusRequest) Reset() {
+	*x = SetActivityStatusRequest{}
+	mi := &file_user_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetActivityStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetActivityStatusRequest) ProtoMessage() {}
+
+func (x *SetActivityStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetActivityStatusRequest.ProtoReflect.Descriptor instead.
+func (*SetActivityStatusRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *SetActivityStatusRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SetActivityStatusRequest) GetShow() bool {
+	if x != nil {
+		return x.Show
+	}
+	return false
+}
+
+type SetActivityStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetActivityStatusResponse) Reset() {
+	*x = SetActivityStatusResponse{}
+	mi := &file_user_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetActivityStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetActivityStatusResponse) ProtoMessage() {}
+
+func (x *SetActivityStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetActivityStatusResponse.ProtoReflect.Descriptor instead.
+func (*SetActivityStatusResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *SetActivityStatusResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
 // --- Block / Unblock User ---
 type BlockUserRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -2008,7 +2226,7 @@ type BlockUserRequest struct {
 
 func (x *BlockUserRequest) Reset() {
 	*x = BlockUserRequest{}
-	mi := &file_user_proto_msgTypes[34]
+	mi := &file_user_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2020,7 +2238,7 @@ func (x *BlockUserRequest) String() string {
 func (*BlockUserRequest) ProtoMessage() {}
 
 func (x *BlockUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[34]
+	mi := &file_user_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2033,7 +2251,7 @@ func (x *BlockUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BlockUserRequest.ProtoReflect.Descriptor instead.
 func (*BlockUserRequest) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{34}
+	return file_user_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *BlockUserRequest) GetBlockerId() int64 {
@@ -2059,7 +2277,7 @@ type BlockUserResponse struct {
 
 func (x *BlockUserResponse) Reset() {
 	*x = BlockUserResponse{}
-	mi := &file_user_proto_msgTypes[35]
+	mi := &file_user_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2071,7 +2289,7 @@ func (x *BlockUserResponse) String() string {
 func (*BlockUserResponse) ProtoMessage() {}
 
 func (x *BlockUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[35]
+	mi := &file_user_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2084,7 +2302,7 @@ func (x *BlockUserResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BlockUserResponse.ProtoReflect.Descriptor instead.
 func (*BlockUserResponse) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{35}
+	return file_user_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *BlockUserResponse) GetMessage() string {
@@ -2104,7 +2322,7 @@ type UnblockUserRequest struct {
 
 func (x *UnblockUserRequest) Reset() {
 	*x = UnblockUserRequest{}
-	mi := &file_user_proto_msgTypes[36]
+	mi := &file_user_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2116,7 +2334,7 @@ func (x *UnblockUserRequest) String() string {
 func (*UnblockUserRequest) ProtoMessage() {}
 
 func (x *UnblockUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[36]
+	mi := &file_user_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2129,7 +2347,7 @@ func (x *UnblockUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UnblockUserRequest.ProtoReflect.Descriptor instead.
 func (*UnblockUserRequest) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{36}
+	return file_user_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *UnblockUserRequest) GetBlockerId() int64 {
@@ -2155,7 +2373,7 @@ type UnblockUserResponse struct {
 
 func (x *UnblockUserResponse) Reset() {
 	*x = UnblockUserResponse{}
-	mi := &file_user_proto_msgTypes[37]
+	mi := &file_user_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2167,7 +2385,7 @@ func (x *UnblockUserResponse) String() string {
 func (*UnblockUserResponse) ProtoMessage() {}
 
 func (x *UnblockUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[37]
+	mi := &file_user_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2180,7 +2398,7 @@ func (x *UnblockUserResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UnblockUserResponse.ProtoReflect.Descriptor instead.
 func (*UnblockUserResponse) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{37}
+	return file_user_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *UnblockUserResponse) GetMessage() string {
@@ -2200,7 +2418,7 @@ type IsBlockedRequest struct {
 
 func (x *IsBlockedRequest) Reset() {
 	*x = IsBlockedRequest{}
-	mi := &file_user_proto_msgTypes[38]
+	mi := &file_user_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2212,7 +2430,7 @@ func (x *IsBlockedRequest) String() string {
 func (*IsBlockedRequest) ProtoMessage() {}
 
 func (x *IsBlockedRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[38]
+	mi := &file_user_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2225,7 +2443,7 @@ func (x *IsBlockedRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IsBlockedRequest.ProtoReflect.Descriptor instead.
 func (*IsBlockedRequest) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{38}
+	return file_user_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *IsBlockedRequest) GetBlockerId() int64 {
@@ -2251,7 +2469,7 @@ type IsBlockedResponse struct {
 
 func (x *IsBlockedResponse) Reset() {
 	*x = IsBlockedResponse{}
-	mi := &file_user_proto_msgTypes[39]
+	mi := &file_user_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2263,7 +2481,7 @@ func (x *IsBlockedResponse) String() string {
 func (*IsBlockedResponse) ProtoMessage() {}
 
 func (x *IsBlockedResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[39]
+	mi := &file_user_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2276,7 +2494,7 @@ func (x *IsBlockedResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IsBlockedResponse.ProtoReflect.Descriptor instead.
 func (*IsBlockedResponse) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{39}
+	return file_user_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *IsBlockedResponse) GetIsBlocked() bool {
@@ -2295,7 +2513,7 @@ type GetBlockedUsersRequest struct {
 
 func (x *GetBlockedUsersRequest) Reset() {
 	*x = GetBlockedUsersRequest{}
-	mi := &file_user_proto_msgTypes[40]
+	mi := &file_user_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2307,7 +2525,7 @@ func (x *GetBlockedUsersRequest) String() string {
 func (*GetBlockedUsersRequest) ProtoMessage() {}
 
 func (x *GetBlockedUsersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[40]
+	mi := &file_user_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2320,7 +2538,7 @@ func (x *GetBlockedUsersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBlockedUsersRequest.ProtoReflect.Descriptor instead.
 func (*GetBlockedUsersRequest) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{40}
+	return file_user_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *GetBlockedUsersRequest) GetUserId() int64 {
@@ -2339,7 +2557,7 @@ type GetBlockedUsersResponse struct {
 
 func (x *GetBlockedUsersResponse) Reset() {
 	*x = GetBlockedUsersResponse{}
-	mi := &file_user_proto_msgTypes[41]
+	mi := &file_user_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2351,7 +2569,7 @@ func (x *GetBlockedUsersResponse) String() string {
 func (*GetBlockedUsersResponse) ProtoMessage() {}
 
 func (x *GetBlockedUsersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[41]
+	mi := &file_user_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2364,7 +2582,7 @@ func (x *GetBlockedUsersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBlockedUsersResponse.ProtoReflect.Descriptor instead.
 func (*GetBlockedUsersResponse) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{41}
+	return file_user_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *GetBlockedUsersResponse) GetBlockedUsers() []*UserInfo {
@@ -2385,7 +2603,7 @@ type SearchUsersRequest struct {
 
 func (x *SearchUsersRequest) Reset() {
 	*x = SearchUsersRequest{}
-	mi := &file_user_proto_msgTypes[42]
+	mi := &file_user_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2397,7 +2615,7 @@ func (x *SearchUsersRequest) String() string {
 func (*SearchUsersRequest) ProtoMessage() {}
 
 func (x *SearchUsersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[42]
+	mi := &file_user_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2410,7 +2628,7 @@ func (x *SearchUsersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchUsersRequest.ProtoReflect.Descriptor instead.
 func (*SearchUsersRequest) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{42}
+	return file_user_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *SearchUsersRequest) GetQuery() string {
@@ -2437,7 +2655,7 @@ type SearchUsersResponse struct {
 
 func (x *SearchUsersResponse) Reset() {
 	*x = SearchUsersResponse{}
-	mi := &file_user_proto_msgTypes[43]
+	mi := &file_user_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2449,7 +2667,7 @@ func (x *SearchUsersResponse) String() string {
 func (*SearchUsersResponse) ProtoMessage() {}
 
 func (x *SearchUsersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[43]
+	mi := &file_user_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2462,7 +2680,7 @@ func (x *SearchUsersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchUsersResponse.ProtoReflect.Descriptor instead.
 func (*SearchUsersResponse) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{43}
+	return file_user_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *SearchUsersResponse) GetUsers() []*GetUserProfileResponse {
@@ -2484,7 +2702,7 @@ type BanUserRequest struct {
 
 func (x *BanUserRequest) Reset() {
 	*x = BanUserRequest{}
-	mi := &file_user_proto_msgTypes[44]
+	mi := &file_user_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2496,7 +2714,7 @@ func (x *BanUserRequest) String() string {
 func (*BanUserRequest) ProtoMessage() {}
 
 func (x *BanUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[44]
+	mi := &file_user_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2509,7 +2727,7 @@ func (x *BanUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BanUserRequest.ProtoReflect.Descriptor instead.
 func (*BanUserRequest) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{44}
+	return file_user_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *BanUserRequest) GetAdminUserId() int64 {
@@ -2535,7 +2753,7 @@ type BanUserResponse struct {
 
 func (x *BanUserResponse) Reset() {
 	*x = BanUserResponse{}
-	mi := &file_user_proto_msgTypes[45]
+	mi := &file_user_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2547,7 +2765,7 @@ func (x *BanUserResponse) String() string {
 func (*BanUserResponse) ProtoMessage() {}
 
 func (x *BanUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[45]
+	mi := &file_user_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2560,7 +2778,7 @@ func (x *BanUserResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BanUserResponse.ProtoReflect.Descriptor instead.
 func (*BanUserResponse) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{45}
+	return file_user_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *BanUserResponse) GetMessage() string {
@@ -2580,7 +2798,7 @@ type UnbanUserRequest struct {
 
 func (x *UnbanUserRequest) Reset() {
 	*x = UnbanUserRequest{}
-	mi := &file_user_proto_msgTypes[46]
+	mi := &file_user_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2592,7 +2810,7 @@ func (x *UnbanUserRequest) String() string {
 func (*UnbanUserRequest) ProtoMessage() {}
 
 func (x *UnbanUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[46]
+	mi := &file_user_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2605,7 +2823,7 @@ func (x *UnbanUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UnbanUserRequest.ProtoReflect.Descriptor instead.
 func (*UnbanUserRequest) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{46}
+	return file_user_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *UnbanUserRequest) GetAdminUserId() int64 {
@@ -2631,7 +2849,7 @@ type UnbanUserResponse struct {
 
 func (x *UnbanUserResponse) Reset() {
 	*x = UnbanUserResponse{}
-	mi := &file_user_proto_msgTypes[47]
+	mi := &file_user_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2643,7 +2861,7 @@ func (x *UnbanUserResponse) String() string {
 func (*UnbanUserResponse) ProtoMessage() {}
 
 func (x *UnbanUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[47]
+	mi := &file_user_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2656,7 +2874,7 @@ func (x *UnbanUserResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UnbanUserResponse.ProtoReflect.Descriptor instead.
 func (*UnbanUserResponse) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{47}
+	return file_user_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *UnbanUserResponse) GetMessage() string {
@@ -2678,7 +2896,7 @@ type SendNewsletterRequest struct {
 
 func (x *SendNewsletterRequest) Reset() {
 	*x = SendNewsletterRequest{}
-	mi := &file_user_proto_msgTypes[48]
+	mi := &file_user_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2690,7 +2908,7 @@ func (x *SendNewsletterRequest) String() string {
 func (*SendNewsletterRequest) ProtoMessage() {}
 
 func (x *SendNewsletterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[48]
+	mi := &file_user_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2703,7 +2921,7 @@ func (x *SendNewsletterRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SendNewsletterRequest.ProtoReflect.Descriptor instead.
 func (*SendNewsletterRequest) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{48}
+	return file_user_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *SendNewsletterRequest) GetAdminUserId() int64 {
@@ -2737,7 +2955,7 @@ type SendNewsletterResponse struct {
 
 func (x *SendNewsletterResponse) Reset() {
 	*x = SendNewsletterResponse{}
-	mi := &file_user_proto_msgTypes[49]
+	mi := &file_user_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2749,7 +2967,7 @@ func (x *SendNewsletterResponse) String() string {
 func (*SendNewsletterResponse) ProtoMessage() {}
 
 func (x *SendNewsletterResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[49]
+	mi := &file_user_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2762,7 +2980,7 @@ func (x *SendNewsletterResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SendNewsletterResponse.ProtoReflect.Descriptor instead.
 func (*SendNewsletterResponse) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{49}
+	return file_user_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *SendNewsletterResponse) GetMessage() string {
@@ -2796,7 +3014,7 @@ type VerificationRequest struct {
 
 func (x *VerificationRequest) Reset() {
 	*x = VerificationRequest{}
-	mi := &file_user_proto_msgTypes[50]
+	mi := &file_user_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2808,7 +3026,7 @@ func (x *VerificationRequest) String() string {
 func (*VerificationRequest) ProtoMessage() {}
 
 func (x *VerificationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[50]
+	mi := &file_user_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2821,7 +3039,7 @@ func (x *VerificationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VerificationRequest.ProtoReflect.Descriptor instead.
 func (*VerificationRequest) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{50}
+	return file_user_proto_rawDescGZIP(), []int{54}
 }
 
 func (x *VerificationRequest) GetId() string {
@@ -2892,7 +3110,7 @@ type SubmitVerificationRequestRequest struct {
 
 func (x *SubmitVerificationRequestRequest) Reset() {
 	*x = SubmitVerificationRequestRequest{}
-	mi := &file_user_proto_msgTypes[51]
+	mi := &file_user_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2904,7 +3122,7 @@ func (x *SubmitVerificationRequestRequest) String() string {
 func (*SubmitVerificationRequestRequest) ProtoMessage() {}
 
 func (x *SubmitVerificationRequestRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[51]
+	mi := &file_user_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2917,7 +3135,7 @@ func (x *SubmitVerificationRequestRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubmitVerificationRequestRequest.ProtoReflect.Descriptor instead.
 func (*SubmitVerificationRequestRequest) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{51}
+	return file_user_proto_rawDescGZIP(), []int{55}
 }
 
 func (x *SubmitVerificationRequestRequest) GetUserId() int64 {
@@ -2957,7 +3175,7 @@ type SubmitVerificationRequestResponse struct {
 
 func (x *SubmitVerificationRequestResponse) Reset() {
 	*x = SubmitVerificationRequestResponse{}
-	mi := &file_user_proto_msgTypes[52]
+	mi := &file_user_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2969,7 +3187,7 @@ func (x *SubmitVerificationRequestResponse) String() string {
 func (*SubmitVerificationRequestResponse) ProtoMessage() {}
 
 func (x *SubmitVerificationRequestResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[52]
+	mi := &file_user_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2982,7 +3200,7 @@ func (x *SubmitVerificationRequestResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use SubmitVerificationRequestResponse.ProtoReflect.Descriptor instead.
 func (*SubmitVerificationRequestResponse) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{52}
+	return file_user_proto_rawDescGZIP(), []int{56}
 }
 
 func (x *SubmitVerificationRequestResponse) GetRequest() *VerificationRequest {
@@ -3004,7 +3222,7 @@ type GetVerificationRequestsRequest struct {
 
 func (x *GetVerificationRequestsRequest) Reset() {
 	*x = GetVerificationRequestsRequest{}
-	mi := &file_user_proto_msgTypes[53]
+	mi := &file_user_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3016,7 +3234,7 @@ func (x *GetVerificationRequestsRequest) String() string {
 func (*GetVerificationRequestsRequest) ProtoMessage() {}
 
 func (x *GetVerificationRequestsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[53]
+	mi := &file_user_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3029,7 +3247,7 @@ func (x *GetVerificationRequestsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetVerificationRequestsRequest.ProtoReflect.Descriptor instead.
 func (*GetVerificationRequestsRequest) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{53}
+	return file_user_proto_rawDescGZIP(), []int{57}
 }
 
 func (x *GetVerificationRequestsRequest) GetPageSize() int32 {
@@ -3062,7 +3280,7 @@ type GetVerificationRequestsResponse struct {
 
 func (x *GetVerificationRequestsResponse) Reset() {
 	*x = GetVerificationRequestsResponse{}
-	mi := &file_user_proto_msgTypes[54]
+	mi := &file_user_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3074,7 +3292,7 @@ func (x *GetVerificationRequestsResponse) String() string {
 func (*GetVerificationRequestsResponse) ProtoMessage() {}
 
 func (x *GetVerificationRequestsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[54]
+	mi := &file_user_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3087,7 +3305,7 @@ func (x *GetVerificationRequestsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetVerificationRequestsResponse.ProtoReflect.Descriptor instead.
 func (*GetVerificationRequestsResponse) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{54}
+	return file_user_proto_rawDescGZIP(), []int{58}
 }
 
 func (x *GetVerificationRequestsResponse) GetRequests() []*VerificationRequest {
@@ -3108,7 +3326,7 @@ type ResolveVerificationRequestRequest struct {
 
 func (x *ResolveVerificationRequestRequest) Reset() {
 	*x = ResolveVerificationRequestRequest{}
-	mi := &file_user_proto_msgTypes[55]
+	mi := &file_user_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3120,7 +3338,7 @@ func (x *ResolveVerificationRequestRequest) String() string {
 func (*ResolveVerificationRequestRequest) ProtoMessage() {}
 
 func (x *ResolveVerificationRequestRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[55]
+	mi := &file_user_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3133,7 +3351,7 @@ func (x *ResolveVerificationRequestRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use ResolveVerificationRequestRequest.ProtoReflect.Descriptor instead.
 func (*ResolveVerificationRequestRequest) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{55}
+	return file_user_proto_rawDescGZIP(), []int{59}
 }
 
 func (x *ResolveVerificationRequestRequest) GetAdminUserId() int64 {
@@ -3166,7 +3384,7 @@ type ResolveVerificationRequestResponse struct {
 
 func (x *ResolveVerificationRequestResponse) Reset() {
 	*x = ResolveVerificationRequestResponse{}
-	mi := &file_user_proto_msgTypes[56]
+	mi := &file_user_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3178,7 +3396,7 @@ func (x *ResolveVerificationRequestResponse) String() string {
 func (*ResolveVerificationRequestResponse) ProtoMessage() {}
 
 func (x *ResolveVerificationRequestResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[56]
+	mi := &file_user_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3191,7 +3409,7 @@ func (x *ResolveVerificationRequestResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use ResolveVerificationRequestResponse.ProtoReflect.Descriptor instead.
 func (*ResolveVerificationRequestResponse) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{56}
+	return file_user_proto_rawDescGZIP(), []int{60}
 }
 
 func (x *ResolveVerificationRequestResponse) GetMessage() string {
@@ -3215,7 +3433,7 @@ type UserInfo struct {
 
 func (x *UserInfo) Reset() {
 	*x = UserInfo{}
-	mi := &file_user_proto_msgTypes[57]
+	mi := &file_user_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3227,7 +3445,7 @@ func (x *UserInfo) String() string {
 func (*UserInfo) ProtoMessage() {}
 
 func (x *UserInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[57]
+	mi := &file_user_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3240,7 +3458,7 @@ func (x *UserInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserInfo.ProtoReflect.Descriptor instead.
 func (*UserInfo) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{57}
+	return file_user_proto_rawDescGZIP(), []int{61}
 }
 
 func (x *UserInfo) GetUserId() int64 {
@@ -3254,28 +3472,565 @@ func (x *UserInfo) GetUsername() string {
 	if x != nil {
 		return x.Username
 	}
-	return ""
+	return ""
+}
+
+func (x *UserInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UserInfo) GetProfilePictureUrl() string {
+	if x != nil {
+		return x.ProfilePictureUrl
+	}
+	return ""
+}
+
+func (x *UserInfo) GetIsVerified() bool {
+	if x != nil {
+		return x.IsVerified
+	}
+	return false
+}
+
+// --- Batched user lookup (for likers lists etc.) ---
+type GetUserSummariesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserIds       []int64                `protobuf:"varint,1,rep,packed,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	ViewerId      int64                  `protobuf:"varint,2,opt,name=viewer_id,json=viewerId,proto3" json:"viewer_id,omitempty"` // Relationship fields are relative to this user
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserSummariesRequest) Reset() {
+	*x = GetUserSummariesRequest{}
+	mi := &file_user_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserSummariesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserSummariesRequest) ProtoMessage() {}
+
+func (x *GetUserSummariesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserSummariesRequest.ProtoReflect.Descriptor instead.
+func (*GetUserSummariesRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *GetUserSummariesRequest) GetUserIds() []int64 {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+func (x *GetUserSummariesRequest) GetViewerId() int64 {
+	if x != nil {
+		return x.ViewerId
+	}
+	return 0
+}
+
+type UserSummary struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	User                 *UserInfo              `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	IsFollowedByViewer   bool                   `protobuf:"varint,2,opt,name=is_followed_by_viewer,json=isFollowedByViewer,proto3" json:"is_followed_by_viewer,omitempty"`
+	FollowStatus         string                 `protobuf:"bytes,3,opt,name=follow_status,json=followStatus,proto3" json:"follow_status,omitempty"` // pending, approved, or empty if the viewer doesn't follow
+	IsPrivate            bool                   `protobuf:"varint,4,opt,name=is_private,json=isPrivate,proto3" json:"is_private,omitempty"`
+	ActivityStatusHidden bool                   `protobuf:"varint,5,opt,name=activity_status_hidden,json=activityStatusHidden,proto3" json:"activity_status_hidden,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *UserSummary) Reset() {
+	*x = UserSummary{}
+	mi := &file_user_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserSummary) ProtoMessage() {}
+
+func (x *UserSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserSummary.ProtoReflect.Descriptor instead.
+func (*UserSummary) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *UserSummary) GetUser() *UserInfo {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *UserSummary) GetIsFollowedByViewer() bool {
+	if x != nil {
+		return x.IsFollowedByViewer
+	}
+	return false
+}
+
+func (x *UserSummary) GetFollowStatus() string {
+	if x != nil {
+		return x.FollowStatus
+	}
+	return ""
+}
+
+func (x *UserSummary) GetIsPrivate() bool {
+	if x != nil {
+		return x.IsPrivate
+	}
+	return false
+}
+
+func (x *UserSummary) GetActivityStatusHidden() bool {
+	if x != nil {
+		return x.ActivityStatusHidden
+	}
+	return false
+}
+
+type GetUserSummariesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// In request order. Users blocked by or blocking the viewer, banned users
+	// and unknown IDs are left out.
+	Users         []*UserSummary `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserSummariesResponse) Reset() {
+	*x = GetUserSummariesResponse{}
+	mi := &file_user_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserSummariesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserSummariesResponse) ProtoMessage() {}
+
+func (x *GetUserSummariesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserSummariesResponse.ProtoReflect.Descriptor instead.
+func (*GetUserSummariesResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *GetUserSummariesResponse) GetUsers() []*UserSummary {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+// --- Batched relationship lookup (for privacy checks) ---
+type GetRelationshipsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ViewerId      int64                  `protobuf:"varint,1,opt,name=viewer_id,json=viewerId,proto3" json:"viewer_id,omitempty"`
+	TargetIds     []int64                `protobuf:"varint,2,rep,packed,name=target_ids,json=targetIds,proto3" json:"target_ids,omitempty"` // Max 500
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRelationshipsRequest) Reset() {
+	*x = GetRelationshipsRequest{}
+	mi := &file_user_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRelationshipsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRelationshipsRequest) ProtoMessage() {}
+
+func (x *GetRelationshipsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRelationshipsRequest.ProtoReflect.Descriptor instead.
+func (*GetRelationshipsRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *GetRelationshipsRequest) GetViewerId() int64 {
+	if x != nil {
+		return x.ViewerId
+	}
+	return 0
+}
+
+func (x *GetRelationshipsRequest) GetTargetIds() []int64 {
+	if x != nil {
+		return x.TargetIds
+	}
+	return nil
+}
+
+type Relationship struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	TargetId             int64                  `protobuf:"varint,1,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	Exists               bool                   `protobuf:"varint,2,opt,name=exists,proto3" json:"exists,omitempty"`   // False for unknown or banned users
+	Blocked              bool                   `protobuf:"varint,3,opt,name=blocked,proto3" json:"blocked,omitempty"` // Either user blocked the other
+	IsPrivate            bool                   `protobuf:"varint,4,opt,name=is_private,json=isPrivate,proto3" json:"is_private,omitempty"`
+	ViewerFollows        bool                   `protobuf:"varint,5,opt,name=viewer_follows,json=viewerFollows,proto3" json:"viewer_follows,omitempty"`                          // Approved follow from the viewer to the target
+	FollowPending        bool                   `protobuf:"varint,6,opt,name=follow_pending,json=followPending,proto3" json:"follow_pending,omitempty"`                          // The viewer has a pending request to the target
+	FollowsViewer        bool                   `protobuf:"varint,7,opt,name=follows_viewer,json=followsViewer,proto3" json:"follows_viewer,omitempty"`                          // Approved follow from the target to the viewer
+	ViewerIsCloseFriend  bool                   `protobuf:"varint,8,opt,name=viewer_is_close_friend,json=viewerIsCloseFriend,proto3" json:"viewer_is_close_friend,omitempty"`    // The target lists the viewer as a close friend
+	HidesStoryFromViewer bool                   `protobuf:"varint,9,opt,name=hides_story_from_viewer,json=hidesStoryFromViewer,proto3" json:"hides_story_from_viewer,omitempty"` // The target hid their stories from the viewer
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *Relationship) Reset() {
+	*x = Relationship{}
+	mi := &file_user_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Relationship) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Relationship) ProtoMessage() {}
+
+func (x *Relationship) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Relationship.ProtoReflect.Descriptor instead.
+func (*Relationship) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *Relationship) GetTargetId() int64 {
+	if x != nil {
+		return x.TargetId
+	}
+	return 0
+}
+
+func (x *Relationship) GetExists() bool {
+	if x != nil {
+		return x.Exists
+	}
+	return false
+}
+
+func (x *Relationship) GetBlocked() bool {
+	if x != nil {
+		return x.Blocked
+	}
+	return false
+}
+
+func (x *Relationship) GetIsPrivate() bool {
+	if x != nil {
+		return x.IsPrivate
+	}
+	return false
+}
+
+func (x *Relationship) GetViewerFollows() bool {
+	if x != nil {
+		return x.ViewerFollows
+	}
+	return false
+}
+
+func (x *Relationship) GetFollowPending() bool {
+	if x != nil {
+		return x.FollowPending
+	}
+	return false
+}
+
+func (x *Relationship) GetFollowsViewer() bool {
+	if x != nil {
+		return x.FollowsViewer
+	}
+	return false
+}
+
+func (x *Relationship) GetViewerIsCloseFriend() bool {
+	if x != nil {
+		return x.ViewerIsCloseFriend
+	}
+	return false
+}
+
+func (x *Relationship) GetHidesStoryFromViewer() bool {
+	if x != nil {
+		return x.HidesStoryFromViewer
+	}
+	return false
+}
+
+type GetRelationshipsResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Relationships map[int64]*Relationship `protobuf:"bytes,1,rep,name=relationships,proto3" json:"relationships,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Keyed by target_id, one entry per requested ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRelationshipsResponse) Reset() {
+	*x = GetRelationshipsResponse{}
+	mi := &file_user_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRelationshipsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRelationshipsResponse) ProtoMessage() {}
+
+func (x *GetRelationshipsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRelationshipsResponse.ProtoReflect.Descriptor instead.
+func (*GetRelationshipsResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *GetRelationshipsResponse) GetRelationships() map[int64]*Relationship {
+	if x != nil {
+		return x.Relationships
+	}
+	return nil
+}
+
+// --- Follower growth (for account insights) ---
+type GetFollowerGrowthRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Since         string                 `protobuf:"bytes,2,opt,name=since,proto3" json:"since,omitempty"` // YYYY-MM-DD, inclusive
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFollowerGrowthRequest) Reset() {
+	*x = GetFollowerGrowthRequest{}
+	mi := &file_user_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFollowerGrowthRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFollowerGrowthRequest) ProtoMessage() {}
+
+func (x *GetFollowerGrowthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFollowerGrowthRequest.ProtoReflect.Descriptor instead.
+func (*GetFollowerGrowthRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *GetFollowerGrowthRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetFollowerGrowthRequest) GetSince() string {
+	if x != nil {
+		return x.Since
+	}
+	return ""
+}
+
+type DailyCount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"` // YYYY-MM-DD
+	Count         int64                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DailyCount) Reset() {
+	*x = DailyCount{}
+	mi := &file_user_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DailyCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DailyCount) ProtoMessage() {}
+
+func (x *DailyCount) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DailyCount.ProtoReflect.Descriptor instead.
+func (*DailyCount) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *DailyCount) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *DailyCount) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type GetFollowerGrowthResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FollowerCount int64                  `protobuf:"varint,1,opt,name=follower_count,json=followerCount,proto3" json:"follower_count,omitempty"` // Current approved followers
+	Days          []*DailyCount          `protobuf:"bytes,2,rep,name=days,proto3" json:"days,omitempty"`                                         // Approved follows by the day they were requested; days without any are omitted
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFollowerGrowthResponse) Reset() {
+	*x = GetFollowerGrowthResponse{}
+	mi := &file_user_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFollowerGrowthResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFollowerGrowthResponse) ProtoMessage() {}
+
+func (x *GetFollowerGrowthResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x *UserInfo) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+// Deprecated: Use GetFollowerGrowthResponse.ProtoReflect.Descriptor instead.
+func (*GetFollowerGrowthResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *UserInfo) GetProfilePictureUrl() string {
+func (x *GetFollowerGrowthResponse) GetFollowerCount() int64 {
 	if x != nil {
-		return x.ProfilePictureUrl
+		return x.FollowerCount
 	}
-	return ""
+	return 0
 }
 
-func (x *UserInfo) GetIsVerified() bool {
+func (x *GetFollowerGrowthResponse) GetDays() []*DailyCount {
 	if x != nil {
-		return x.IsVerified
+		return x.Days
 	}
-	return false
+	return nil
 }
 
 // --- Close Friends ---
@@ -3289,7 +4044,7 @@ type AddCloseFriendRequest struct {
 
 func (x *AddCloseFriendRequest) Reset() {
 	*x = AddCloseFriendRequest{}
-	mi := &file_user_proto_msgTypes[58]
+	mi := &file_user_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3301,7 +4056,7 @@ func (x *AddCloseFriendRequest) String() string {
 func (*AddCloseFriendRequest) ProtoMessage() {}
 
 func (x *AddCloseFriendRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[58]
+	mi := &file_user_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3314,7 +4069,7 @@ func (x *AddCloseFriendRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddCloseFriendRequest.ProtoReflect.Descriptor instead.
 func (*AddCloseFriendRequest) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{58}
+	return file_user_proto_rawDescGZIP(), []int{71}
 }
 
 func (x *AddCloseFriendRequest) GetUserId() int64 {
@@ -3340,7 +4095,7 @@ type AddCloseFriendResponse struct {
 
 func (x *AddCloseFriendResponse) Reset() {
 	*x = AddCloseFriendResponse{}
-	mi := &file_user_proto_msgTypes[59]
+	mi := &file_user_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3352,7 +4107,7 @@ func (x *AddCloseFriendResponse) String() string {
 func (*AddCloseFriendResponse) ProtoMessage() {}
 
 func (x *AddCloseFriendResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[59]
+	mi := &file_user_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3365,7 +4120,7 @@ func (x *AddCloseFriendResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddCloseFriendResponse.ProtoReflect.Descriptor instead.
 func (*AddCloseFriendResponse) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{59}
+	return file_user_proto_rawDescGZIP(), []int{72}
 }
 
 func (x *AddCloseFriendResponse) GetMessage() string {
@@ -3385,7 +4140,7 @@ type RemoveCloseFriendRequest struct {
 
 func (x *RemoveCloseFriendRequest) Reset() {
 	*x = RemoveCloseFriendRequest{}
-	mi := &file_user_proto_msgTypes[60]
+	mi := &file_user_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3397,7 +4152,7 @@ func (x *RemoveCloseFriendRequest) String() string {
 func (*RemoveCloseFriendRequest) ProtoMessage() {}
 
 func (x *RemoveCloseFriendRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[60]
+	mi := &file_user_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3410,7 +4165,7 @@ func (x *RemoveCloseFriendRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveCloseFriendRequest.ProtoReflect.Descriptor instead.
 func (*RemoveCloseFriendRequest) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{60}
+	return file_user_proto_rawDescGZIP(), []int{73}
 }
 
 func (x *RemoveCloseFriendRequest) GetUserId() int64 {
@@ -3436,7 +4191,7 @@ type RemoveCloseFriendResponse struct {
 
 func (x *RemoveCloseFriendResponse) Reset() {
 	*x = RemoveCloseFriendResponse{}
-	mi := &file_user_proto_msgTypes[61]
+	mi := &file_user_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3448,7 +4203,7 @@ func (x *RemoveCloseFriendResponse) String() string {
 func (*RemoveCloseFriendResponse) ProtoMessage() {}
 
 func (x *RemoveCloseFriendResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[61]
+	mi := &file_user_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3461,7 +4216,7 @@ func (x *RemoveCloseFriendResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveCloseFriendResponse.ProtoReflect.Descriptor instead.
 func (*RemoveCloseFriendResponse) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{61}
+	return file_user_proto_rawDescGZIP(), []int{74}
 }
 
 func (x *RemoveCloseFriendResponse) GetMessage() string {
@@ -3480,7 +4235,7 @@ type GetCloseFriendsRequest struct {
 
 func (x *GetCloseFriendsRequest) Reset() {
 	*x = GetCloseFriendsRequest{}
-	mi := &file_user_proto_msgTypes[62]
+	mi := &file_user_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3492,7 +4247,7 @@ func (x *GetCloseFriendsRequest) String() string {
 func (*GetCloseFriendsRequest) ProtoMessage() {}
 
 func (x *GetCloseFriendsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[62]
+	mi := &file_user_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3505,7 +4260,7 @@ func (x *GetCloseFriendsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCloseFriendsRequest.ProtoReflect.Descriptor instead.
 func (*GetCloseFriendsRequest) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{62}
+	return file_user_proto_rawDescGZIP(), []int{75}
 }
 
 func (x *GetCloseFriendsRequest) GetUserId() int64 {
@@ -3524,7 +4279,7 @@ type GetCloseFriendsResponse struct {
 
 func (x *GetCloseFriendsResponse) Reset() {
 	*x = GetCloseFriendsResponse{}
-	mi := &file_user_proto_msgTypes[63]
+	mi := &file_user_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3536,7 +4291,7 @@ func (x *GetCloseFriendsResponse) String() string {
 func (*GetCloseFriendsResponse) ProtoMessage() {}
 
 func (x *GetCloseFriendsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[63]
+	mi := &file_user_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3549,7 +4304,7 @@ func (x *GetCloseFriendsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCloseFriendsResponse.ProtoReflect.Descriptor instead.
 func (*GetCloseFriendsResponse) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{63}
+	return file_user_proto_rawDescGZIP(), []int{76}
 }
 
 func (x *GetCloseFriendsResponse) GetFriends() []*UserInfo {
@@ -3570,7 +4325,7 @@ type AddHiddenStoryUserRequest struct {
 
 func (x *AddHiddenStoryUserRequest) Reset() {
 	*x = AddHiddenStoryUserRequest{}
-	mi := &file_user_proto_msgTypes[64]
+	mi := &file_user_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3582,7 +4337,7 @@ func (x *AddHiddenStoryUserRequest) String() string {
 func (*AddHiddenStoryUserRequest) ProtoMessage() {}
 
 func (x *AddHiddenStoryUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[64]
+	mi := &file_user_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3595,7 +4350,7 @@ func (x *AddHiddenStoryUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddHiddenStoryUserRequest.ProtoReflect.Descriptor instead.
 func (*AddHiddenStoryUserRequest) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{64}
+	return file_user_proto_rawDescGZIP(), []int{77}
 }
 
 func (x *AddHiddenStoryUserRequest) GetUserId() int64 {
@@ -3621,7 +4376,7 @@ type AddHiddenStoryUserResponse struct {
 
 func (x *AddHiddenStoryUserResponse) Reset() {
 	*x = AddHiddenStoryUserResponse{}
-	mi := &file_user_proto_msgTypes[65]
+	mi := &file_user_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3633,7 +4388,7 @@ func (x *AddHiddenStoryUserResponse) String() string {
 func (*AddHiddenStoryUserResponse) ProtoMessage() {}
 
 func (x *AddHiddenStoryUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[65]
+	mi := &file_user_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3646,7 +4401,7 @@ func (x *AddHiddenStoryUserResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddHiddenStoryUserResponse.ProtoReflect.Descriptor instead.
 func (*AddHiddenStoryUserResponse) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{65}
+	return file_user_proto_rawDescGZIP(), []int{78}
 }
 
 func (x *AddHiddenStoryUserResponse) GetMessage() string {
@@ -3666,7 +4421,7 @@ type RemoveHiddenStoryUserRequest struct {
 
 func (x *RemoveHiddenStoryUserRequest) Reset() {
 	*x = RemoveHiddenStoryUserRequest{}
-	mi := &file_user_proto_msgTypes[66]
+	mi := &file_user_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3678,7 +4433,7 @@ func (x *RemoveHiddenStoryUserRequest) String() string {
 func (*RemoveHiddenStoryUserRequest) ProtoMessage() {}
 
 func (x *RemoveHiddenStoryUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[66]
+	mi := &file_user_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3691,7 +4446,7 @@ func (x *RemoveHiddenStoryUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveHiddenStoryUserRequest.ProtoReflect.Descriptor instead.
 func (*RemoveHiddenStoryUserRequest) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{66}
+	return file_user_proto_rawDescGZIP(), []int{79}
 }
 
 func (x *RemoveHiddenStoryUserRequest) GetUserId() int64 {
@@ -3717,7 +4472,7 @@ type RemoveHiddenStoryUserResponse struct {
 
 func (x *RemoveHiddenStoryUserResponse) Reset() {
 	*x = RemoveHiddenStoryUserResponse{}
-	mi := &file_user_proto_msgTypes[67]
+	mi := &file_user_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3729,7 +4484,7 @@ func (x *RemoveHiddenStoryUserResponse) String() string {
 func (*RemoveHiddenStoryUserResponse) ProtoMessage() {}
 
 func (x *RemoveHiddenStoryUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[67]
+	mi := &file_user_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3742,7 +4497,7 @@ func (x *RemoveHiddenStoryUserResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveHiddenStoryUserResponse.ProtoReflect.Descriptor instead.
 func (*RemoveHiddenStoryUserResponse) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{67}
+	return file_user_proto_rawDescGZIP(), []int{80}
 }
 
 func (x *RemoveHiddenStoryUserResponse) GetMessage() string {
@@ -3761,7 +4516,7 @@ type GetHiddenStoryUsersRequest struct {
 
 func (x *GetHiddenStoryUsersRequest) Reset() {
 	*x = GetHiddenStoryUsersRequest{}
-	mi := &file_user_proto_msgTypes[68]
+	mi := &file_user_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3773,7 +4528,7 @@ func (x *GetHiddenStoryUsersRequest) String() string {
 func (*GetHiddenStoryUsersRequest) ProtoMessage() {}
 
 func (x *GetHiddenStoryUsersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[68]
+	mi := &file_user_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3786,7 +4541,7 @@ func (x *GetHiddenStoryUsersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetHiddenStoryUsersRequest.ProtoReflect.Descriptor instead.
 func (*GetHiddenStoryUsersRequest) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{68}
+	return file_user_proto_rawDescGZIP(), []int{81}
 }
 
 func (x *GetHiddenStoryUsersRequest) GetUserId() int64 {
@@ -3805,7 +4560,7 @@ type GetHiddenStoryUsersResponse struct {
 
 func (x *GetHiddenStoryUsersResponse) Reset() {
 	*x = GetHiddenStoryUsersResponse{}
-	mi := &file_user_proto_msgTypes[69]
+	mi := &file_user_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3817,7 +4572,7 @@ func (x *GetHiddenStoryUsersResponse) String() string {
 func (*GetHiddenStoryUsersResponse) ProtoMessage() {}
 
 func (x *GetHiddenStoryUsersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[69]
+	mi := &file_user_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3830,7 +4585,7 @@ func (x *GetHiddenStoryUsersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetHiddenStoryUsersResponse.ProtoReflect.Descriptor instead.
 func (*GetHiddenStoryUsersResponse) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{69}
+	return file_user_proto_rawDescGZIP(), []int{82}
 }
 
 func (x *GetHiddenStoryUsersResponse) GetHiddenUsers() []*UserInfo {
@@ -3852,7 +4607,7 @@ type UpdateNotificationSettingsRequest struct {
 
 func (x *UpdateNotificationSettingsRequest) Reset() {
 	*x = UpdateNotificationSettingsRequest{}
-	mi := &file_user_proto_msgTypes[70]
+	mi := &file_user_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3864,7 +4619,7 @@ func (x *UpdateNotificationSettingsRequest) String() string {
 func (*UpdateNotificationSettingsRequest) ProtoMessage() {}
 
 func (x *UpdateNotificationSettingsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[70]
+	mi := &file_user_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3877,7 +4632,7 @@ func (x *UpdateNotificationSettingsRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use UpdateNotificationSettingsRequest.ProtoReflect.Descriptor instead.
 func (*UpdateNotificationSettingsRequest) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{70}
+	return file_user_proto_rawDescGZIP(), []int{83}
 }
 
 func (x *UpdateNotificationSettingsRequest) GetUserId() int64 {
@@ -3910,7 +4665,7 @@ type UpdateNotificationSettingsResponse struct {
 
 func (x *UpdateNotificationSettingsResponse) Reset() {
 	*x = UpdateNotificationSettingsResponse{}
-	mi := &file_user_proto_msgTypes[71]
+	mi := &file_user_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3922,7 +4677,7 @@ func (x *UpdateNotificationSettingsResponse) String() string {
 func (*UpdateNotificationSettingsResponse) ProtoMessage() {}
 
 func (x *UpdateNotificationSettingsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[71]
+	mi := &file_user_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3935,7 +4690,7 @@ func (x *UpdateNotificationSettingsResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use UpdateNotificationSettingsResponse.ProtoReflect.Descriptor instead.
 func (*UpdateNotificationSettingsResponse) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{71}
+	return file_user_proto_rawDescGZIP(), []int{84}
 }
 
 func (x *UpdateNotificationSettingsResponse) GetMessage() string {
@@ -3954,7 +4709,7 @@ type GetNotificationSettingsRequest struct {
 
 func (x *GetNotificationSettingsRequest) Reset() {
 	*x = GetNotificationSettingsRequest{}
-	mi := &file_user_proto_msgTypes[72]
+	mi := &file_user_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3966,7 +4721,7 @@ func (x *GetNotificationSettingsRequest) String() string {
 func (*GetNotificationSettingsRequest) ProtoMessage() {}
 
 func (x *GetNotificationSettingsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[72]
+	mi := &file_user_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3979,7 +4734,7 @@ func (x *GetNotificationSettingsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetNotificationSettingsRequest.ProtoReflect.Descriptor instead.
 func (*GetNotificationSettingsRequest) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{72}
+	return file_user_proto_rawDescGZIP(), []int{85}
 }
 
 func (x *GetNotificationSettingsRequest) GetUserId() int64 {
@@ -3999,7 +4754,7 @@ type GetNotificationSettingsResponse struct {
 
 func (x *GetNotificationSettingsResponse) Reset() {
 	*x = GetNotificationSettingsResponse{}
-	mi := &file_user_proto_msgTypes[73]
+	mi := &file_user_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4011,7 +4766,7 @@ func (x *GetNotificationSettingsResponse) String() string {
 func (*GetNotificationSettingsResponse) ProtoMessage() {}
 
 func (x *GetNotificationSettingsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[73]
+	mi := &file_user_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4024,7 +4779,7 @@ func (x *GetNotificationSettingsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetNotificationSettingsResponse.ProtoReflect.Descriptor instead.
 func (*GetNotificationSettingsResponse) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{73}
+	return file_user_proto_rawDescGZIP(), []int{86}
 }
 
 func (x *GetNotificationSettingsResponse) GetPushEnabled() bool {
@@ -4041,6 +4796,199 @@ func (x *GetNotificationSettingsResponse) GetEmailEnabled() bool {
 	return false
 }
 
+// --- Comment Keyword Filter ---
+type SetCommentFilterKeywordsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Keywords      []string               `protobuf:"bytes,2,rep,name=keywords,proto3" json:"keywords,omitempty"` // Replaces the whole list
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetCommentFilterKeywordsRequest) Reset() {
+	*x = SetCommentFilterKeywordsRequest{}
+	mi := &file_user_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCommentFilterKeywordsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCommentFilterKeywordsRequest) ProtoMessage() {}
+
+func (x *SetCommentFilterKeywordsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCommentFilterKeywordsRequest.ProtoReflect.Descriptor instead.
+func (*SetCommentFilterKeywordsRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *SetCommentFilterKeywordsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SetCommentFilterKeywordsRequest) GetKeywords() []string {
+	if x != nil {
+		return x.Keywords
+	}
+	return nil
+}
+
+type SetCommentFilterKeywordsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Keywords      []string               `protobuf:"bytes,2,rep,name=keywords,proto3" json:"keywords,omitempty"` // The normalized list that was saved
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetCommentFilterKeywordsResponse) Reset() {
+	*x = SetCommentFilterKeywordsResponse{}
+	mi := &file_user_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCommentFilterKeywordsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCommentFilterKeywordsResponse) ProtoMessage() {}
+
+func (x *SetCommentFilterKeywordsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCommentFilterKeywordsResponse.ProtoReflect.Descriptor instead.
+func (*SetCommentFilterKeywordsResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *SetCommentFilterKeywordsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SetCommentFilterKeywordsResponse) GetKeywords() []string {
+	if x != nil {
+		return x.Keywords
+	}
+	return nil
+}
+
+type GetCommentFilterKeywordsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCommentFilterKeywordsRequest) Reset() {
+	*x = GetCommentFilterKeywordsRequest{}
+	mi := &file_user_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCommentFilterKeywordsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCommentFilterKeywordsRequest) ProtoMessage() {}
+
+func (x *GetCommentFilterKeywordsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCommentFilterKeywordsRequest.ProtoReflect.Descriptor instead.
+func (*GetCommentFilterKeywordsRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *GetCommentFilterKeywordsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type GetCommentFilterKeywordsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Keywords      []string               `protobuf:"bytes,1,rep,name=keywords,proto3" json:"keywords,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCommentFilterKeywordsResponse) Reset() {
+	*x = GetCommentFilterKeywordsResponse{}
+	mi := &file_user_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCommentFilterKeywordsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCommentFilterKeywordsResponse) ProtoMessage() {}
+
+func (x *GetCommentFilterKeywordsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCommentFilterKeywordsResponse.ProtoReflect.Descriptor instead.
+func (*GetCommentFilterKeywordsResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *GetCommentFilterKeywordsResponse) GetKeywords() []string {
+	if x != nil {
+		return x.Keywords
+	}
+	return nil
+}
+
 // --- Follow Requests ---
 type ApproveFollowRequestRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -4052,7 +5000,7 @@ type ApproveFollowRequestRequest struct {
 
 func (x *ApproveFollowRequestRequest) Reset() {
 	*x = ApproveFollowRequestRequest{}
-	mi := &file_user_proto_msgTypes[74]
+	mi := &file_user_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4064,7 +5012,7 @@ func (x *ApproveFollowRequestRequest) String() string {
 func (*ApproveFollowRequestRequest) ProtoMessage() {}
 
 func (x *ApproveFollowRequestRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[74]
+	mi := &file_user_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4077,7 +5025,7 @@ func (x *ApproveFollowRequestRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApproveFollowRequestRequest.ProtoReflect.Descriptor instead.
 func (*ApproveFollowRequestRequest) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{74}
+	return file_user_proto_rawDescGZIP(), []int{91}
 }
 
 func (x *ApproveFollowRequestRequest) GetUserId() int64 {
@@ -4103,7 +5051,7 @@ type ApproveFollowRequestResponse struct {
 
 func (x *ApproveFollowRequestResponse) Reset() {
 	*x = ApproveFollowRequestResponse{}
-	mi := &file_user_proto_msgTypes[75]
+	mi := &file_user_proto_msgTypes[92]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4115,7 +5063,7 @@ func (x *ApproveFollowRequestResponse) String() string {
 func (*ApproveFollowRequestResponse) ProtoMessage() {}
 
 func (x *ApproveFollowRequestResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[75]
+	mi := &file_user_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4128,7 +5076,7 @@ func (x *ApproveFollowRequestResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApproveFollowRequestResponse.ProtoReflect.Descriptor instead.
 func (*ApproveFollowRequestResponse) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{75}
+	return file_user_proto_rawDescGZIP(), []int{92}
 }
 
 func (x *ApproveFollowRequestResponse) GetMessage() string {
@@ -4148,7 +5096,7 @@ type RejectFollowRequestRequest struct {
 
 func (x *RejectFollowRequestRequest) Reset() {
 	*x = RejectFollowRequestRequest{}
-	mi := &file_user_proto_msgTypes[76]
+	mi := &file_user_proto_msgTypes[93]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4160,7 +5108,7 @@ func (x *RejectFollowRequestRequest) String() string {
 func (*RejectFollowRequestRequest) ProtoMessage() {}
 
 func (x *RejectFollowRequestRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[76]
+	mi := &file_user_proto_msgTypes[93]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4173,7 +5121,7 @@ func (x *RejectFollowRequestRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RejectFollowRequestRequest.ProtoReflect.Descriptor instead.
 func (*RejectFollowRequestRequest) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{76}
+	return file_user_proto_rawDescGZIP(), []int{93}
 }
 
 func (x *RejectFollowRequestRequest) GetUserId() int64 {
@@ -4199,7 +5147,7 @@ type RejectFollowRequestResponse struct {
 
 func (x *RejectFollowRequestResponse) Reset() {
 	*x = RejectFollowRequestResponse{}
-	mi := &file_user_proto_msgTypes[77]
+	mi := &file_user_proto_msgTypes[94]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4211,7 +5159,7 @@ func (x *RejectFollowRequestResponse) String() string {
 func (*RejectFollowRequestResponse) ProtoMessage() {}
 
 func (x *RejectFollowRequestResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[77]
+	mi := &file_user_proto_msgTypes[94]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4224,7 +5172,7 @@ func (x *RejectFollowRequestResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RejectFollowRequestResponse.ProtoReflect.Descriptor instead.
 func (*RejectFollowRequestResponse) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{77}
+	return file_user_proto_rawDescGZIP(), []int{94}
 }
 
 func (x *RejectFollowRequestResponse) GetMessage() string {
@@ -4243,7 +5191,7 @@ type GetFollowRequestsRequest struct {
 
 func (x *GetFollowRequestsRequest) Reset() {
 	*x = GetFollowRequestsRequest{}
-	mi := &file_user_proto_msgTypes[78]
+	mi := &file_user_proto_msgTypes[95]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4255,7 +5203,7 @@ func (x *GetFollowRequestsRequest) String() string {
 func (*GetFollowRequestsRequest) ProtoMessage() {}
 
 func (x *GetFollowRequestsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[78]
+	mi := &file_user_proto_msgTypes[95]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4268,7 +5216,7 @@ func (x *GetFollowRequestsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFollowRequestsRequest.ProtoReflect.Descriptor instead.
 func (*GetFollowRequestsRequest) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{78}
+	return file_user_proto_rawDescGZIP(), []int{95}
 }
 
 func (x *GetFollowRequestsRequest) GetUserId() int64 {
@@ -4287,7 +5235,7 @@ type GetFollowRequestsResponse struct {
 
 func (x *GetFollowRequestsResponse) Reset() {
 	*x = GetFollowRequestsResponse{}
-	mi := &file_user_proto_msgTypes[79]
+	mi := &file_user_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4299,7 +5247,7 @@ func (x *GetFollowRequestsResponse) String() string {
 func (*GetFollowRequestsResponse) ProtoMessage() {}
 
 func (x *GetFollowRequestsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[79]
+	mi := &file_user_proto_msgTypes[96]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4312,7 +5260,7 @@ func (x *GetFollowRequestsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFollowRequestsResponse.ProtoReflect.Descriptor instead.
 func (*GetFollowRequestsResponse) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{79}
+	return file_user_proto_rawDescGZIP(), []int{96}
 }
 
 func (x *GetFollowRequestsResponse) GetRequests() []*UserInfo {
@@ -4391,13 +5339,15 @@ const file_user_proto_rawDesc = "" +
 	"\x15ResetPasswordResponse\x12\x18\n" +
 	"\amessage\x18\x01 \x01(\tR\amessage\"-\n" +
 	"\x12GetUserDataRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x03R\x06userId\"\x92\x01\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\"\x82\x02\n" +
 	"\x13GetUserDataResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1a\n" +
 	"\busername\x18\x02 \x01(\tR\busername\x12.\n" +
 	"\x13profile_picture_url\x18\x03 \x01(\tR\x11profilePictureUrl\x12\x1f\n" +
 	"\vis_verified\x18\x04 \x01(\bR\n" +
-	"isVerified\"W\n" +
+	"isVerified\x128\n" +
+	"\x18default_comment_audience\x18\x05 \x01(\tR\x16defaultCommentAudience\x124\n" +
+	"\x16activity_status_hidden\x18\x06 \x01(\bR\x14activityStatusHidden\"W\n" +
 	"\x11FollowUserRequest\x12\x1f\n" +
 	"\vfollower_id\x18\x01 \x01(\x03R\n" +
 	"followerId\x12!\n" +
@@ -4428,7 +5378,7 @@ const file_user_proto_rawDesc = "" +
 	"\x15GetUserProfileRequest\x12\x1a\n" +
 	"\busername\x18\x01 \x01(\tR\busername\x12 \n" +
 	"\fself_user_id\x18\x02 \x01(\x03R\n" +
-	"selfUserId\"\xf2\x03\n" +
+	"selfUserId\"\xa8\x04\n" +
 	"\x16GetUserProfileResponse\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
@@ -4447,7 +5397,8 @@ const file_user_proto_rawDesc = "" +
 	"is_private\x18\f \x01(\bR\tisPrivate\x12#\n" +
 	"\rfollow_status\x18\r \x01(\tR\ffollowStatus\x12\x1d\n" +
 	"\n" +
-	"is_blocked\x18\x0e \x01(\bR\tisBlocked\"\xa1\x01\n" +
+	"is_blocked\x18\x0e \x01(\bR\tisBlocked\x124\n" +
+	"\x16activity_status_hidden\x18\x0f \x01(\bR\x14activityStatusHidden\"\xa1\x01\n" +
 	"\x18UpdateUserProfileRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x10\n" +
@@ -4466,6 +5417,16 @@ const file_user_proto_rawDesc = "" +
 	"\n" +
 	"is_private\x18\x02 \x01(\bR\tisPrivate\"5\n" +
 	"\x19SetAccountPrivacyResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"W\n" +
+	" SetDefaultCommentAudienceRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1a\n" +
+	"\baudience\x18\x02 \x01(\tR\baudience\"=\n" +
+	"!SetDefaultCommentAudienceResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"G\n" +
+	"\x18SetActivityStatusRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x12\n" +
+	"\x04show\x18\x02 \x01(\bR\x04show\"5\n" +
+	"\x19SetActivityStatusResponse\x12\x18\n" +
 	"\amessage\x18\x01 \x01(\tR\amessage\"P\n" +
 	"\x10BlockUserRequest\x12\x1d\n" +
 	"\n" +
@@ -4553,7 +5514,49 @@ const file_user_proto_rawDesc = "" +
 	"\x04name\x18\x03 \x01(\tR\x04name\x12.\n" +
 	"\x13profile_picture_url\x18\x04 \x01(\tR\x11profilePictureUrl\x12\x1f\n" +
 	"\vis_verified\x18\x05 \x01(\bR\n" +
-	"isVerified\"M\n" +
+	"isVerified\"Q\n" +
+	"\x17GetUserSummariesRequest\x12\x19\n" +
+	"\buser_ids\x18\x01 \x03(\x03R\auserIds\x12\x1b\n" +
+	"\tviewer_id\x18\x02 \x01(\x03R\bviewerId\"\xde\x01\n" +
+	"\vUserSummary\x12\"\n" +
+	"\x04user\x18\x01 \x01(\v2\x0e.user.UserInfoR\x04user\x121\n" +
+	"\x15is_followed_by_viewer\x18\x02 \x01(\bR\x12isFollowedByViewer\x12#\n" +
+	"\rfollow_status\x18\x03 \x01(\tR\ffollowStatus\x12\x1d\n" +
+	"\n" +
+	"is_private\x18\x04 \x01(\bR\tisPrivate\x124\n" +
+	"\x16activity_status_hidden\x18\x05 \x01(\bR\x14activityStatusHidden\"C\n" +
+	"\x18GetUserSummariesResponse\x12'\n" +
+	"\x05users\x18\x01 \x03(\v2\x11.user.UserSummaryR\x05users\"U\n" +
+	"\x17GetRelationshipsRequest\x12\x1b\n" +
+	"\tviewer_id\x18\x01 \x01(\x03R\bviewerId\x12\x1d\n" +
+	"\n" +
+	"target_ids\x18\x02 \x03(\x03R\ttargetIds\"\xdd\x02\n" +
+	"\fRelationship\x12\x1b\n" +
+	"\ttarget_id\x18\x01 \x01(\x03R\btargetId\x12\x16\n" +
+	"\x06exists\x18\x02 \x01(\bR\x06exists\x12\x18\n" +
+	"\ablocked\x18\x03 \x01(\bR\ablocked\x12\x1d\n" +
+	"\n" +
+	"is_private\x18\x04 \x01(\bR\tisPrivate\x12%\n" +
+	"\x0eviewer_follows\x18\x05 \x01(\bR\rviewerFollows\x12%\n" +
+	"\x0efollow_pending\x18\x06 \x01(\bR\rfollowPending\x12%\n" +
+	"\x0efollows_viewer\x18\a \x01(\bR\rfollowsViewer\x123\n" +
+	"\x16viewer_is_close_friend\x18\b \x01(\bR\x13viewerIsCloseFriend\x125\n" +
+	"\x17hides_story_from_viewer\x18\t \x01(\bR\x14hidesStoryFromViewer\"\xc9\x01\n" +
+	"\x18GetRelationshipsResponse\x12W\n" +
+	"\rrelationships\x18\x01 \x03(\v21.user.GetRelationshipsResponse.RelationshipsEntryR\rrelationships\x1aT\n" +
+	"\x12RelationshipsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x03R\x03key\x12(\n" +
+	"\x05value\x18\x02 \x01(\v2\x12.user.RelationshipR\x05value:\x028\x01\"I\n" +
+	"\x18GetFollowerGrowthRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x14\n" +
+	"\x05since\x18\x02 \x01(\tR\x05since\"6\n" +
+	"\n" +
+	"DailyCount\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\"h\n" +
+	"\x19GetFollowerGrowthResponse\x12%\n" +
+	"\x0efollower_count\x18\x01 \x01(\x03R\rfollowerCount\x12$\n" +
+	"\x04days\x18\x02 \x03(\v2\x10.user.DailyCountR\x04days\"M\n" +
 	"\x15AddCloseFriendRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1b\n" +
 	"\tfriend_id\x18\x02 \x01(\x03R\bfriendId\"2\n" +
@@ -4592,7 +5595,17 @@ const file_user_proto_rawDesc = "" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\"i\n" +
 	"\x1fGetNotificationSettingsResponse\x12!\n" +
 	"\fpush_enabled\x18\x01 \x01(\bR\vpushEnabled\x12#\n" +
-	"\remail_enabled\x18\x02 \x01(\bR\femailEnabled\"W\n" +
+	"\remail_enabled\x18\x02 \x01(\bR\femailEnabled\"V\n" +
+	"\x1fSetCommentFilterKeywordsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1a\n" +
+	"\bkeywords\x18\x02 \x03(\tR\bkeywords\"X\n" +
+	" SetCommentFilterKeywordsResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x1a\n" +
+	"\bkeywords\x18\x02 \x03(\tR\bkeywords\":\n" +
+	"\x1fGetCommentFilterKeywordsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\">\n" +
+	" GetCommentFilterKeywordsResponse\x12\x1a\n" +
+	"\bkeywords\x18\x01 \x03(\tR\bkeywords\"W\n" +
 	"\x1bApproveFollowRequestRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1f\n" +
 	"\vfollower_id\x18\x02 \x01(\x03R\n" +
@@ -4608,7 +5621,7 @@ const file_user_proto_rawDesc = "" +
 	"\x18GetFollowRequestsRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\"G\n" +
 	"\x19GetFollowRequestsResponse\x12*\n" +
-	"\brequests\x18\x01 \x03(\v2\x0e.user.UserInfoR\brequests2\xa4\x19\n" +
+	"\brequests\x18\x01 \x03(\v2\x0e.user.UserInfoR\brequests2\xba\x1e\n" +
 	"\vUserService\x12E\n" +
 	"\fRegisterUser\x12\x19.user.RegisterUserRequest\x1a\x1a.user.RegisterUserResponse\x12B\n" +
 	"\x13SendRegistrationOtp\x12\x14.user.SendOtpRequest\x1a\x15.user.SendOtpResponse\x12`\n" +
@@ -4630,11 +5643,16 @@ const file_user_proto_rawDesc = "" +
 	"\x0eGetUserProfile\x12\x1b.user.GetUserProfileRequest\x1a\x1c.user.GetUserProfileResponse\x12Q\n" +
 	"\x11UpdateUserProfile\x12\x1e.user.UpdateUserProfileRequest\x1a\x1c.user.GetUserProfileResponse\x12N\n" +
 	"\x0fCompleteProfile\x12\x1c.user.CompleteProfileRequest\x1a\x1d.user.CompleteProfileResponse\x12T\n" +
-	"\x11SetAccountPrivacy\x12\x1e.user.SetAccountPrivacyRequest\x1a\x1f.user.SetAccountPrivacyResponse\x12<\n" +
+	"\x11SetAccountPrivacy\x12\x1e.user.SetAccountPrivacyRequest\x1a\x1f.user.SetAccountPrivacyResponse\x12l\n" +
+	"\x19SetDefaultCommentAudience\x12&.user.SetDefaultCommentAudienceRequest\x1a'.user.SetDefaultCommentAudienceResponse\x12T\n" +
+	"\x11SetActivityStatus\x12\x1e.user.SetActivityStatusRequest\x1a\x1f.user.SetActivityStatusResponse\x12<\n" +
 	"\tBlockUser\x12\x16.user.BlockUserRequest\x1a\x17.user.BlockUserResponse\x12B\n" +
 	"\vUnblockUser\x12\x18.user.UnblockUserRequest\x1a\x19.user.UnblockUserResponse\x12<\n" +
 	"\tIsBlocked\x12\x16.user.IsBlockedRequest\x1a\x17.user.IsBlockedResponse\x12N\n" +
-	"\x0fGetBlockedUsers\x12\x1c.user.GetBlockedUsersRequest\x1a\x1d.user.GetBlockedUsersResponse\x12B\n" +
+	"\x0fGetBlockedUsers\x12\x1c.user.GetBlockedUsersRequest\x1a\x1d.user.GetBlockedUsersResponse\x12Q\n" +
+	"\x10GetUserSummaries\x12\x1d.user.GetUserSummariesRequest\x1a\x1e.user.GetUserSummariesResponse\x12Q\n" +
+	"\x10GetRelationships\x12\x1d.user.GetRelationshipsRequest\x1a\x1e.user.GetRelationshipsResponse\x12T\n" +
+	"\x11GetFollowerGrowth\x12\x1e.user.GetFollowerGrowthRequest\x1a\x1f.user.GetFollowerGrowthResponse\x12B\n" +
 	"\vSearchUsers\x12\x18.user.SearchUsersRequest\x1a\x19.user.SearchUsersResponse\x126\n" +
 	"\aBanUser\x12\x14.user.BanUserRequest\x1a\x15.user.BanUserResponse\x12<\n" +
 	"\tUnbanUser\x12\x16.user.UnbanUserRequest\x1a\x17.user.UnbanUserResponse\x12K\n" +
@@ -4649,7 +5667,9 @@ const file_user_proto_rawDesc = "" +
 	"\x15RemoveHiddenStoryUser\x12\".user.RemoveHiddenStoryUserRequest\x1a#.user.RemoveHiddenStoryUserResponse\x12Z\n" +
 	"\x13GetHiddenStoryUsers\x12 .user.GetHiddenStoryUsersRequest\x1a!.user.GetHiddenStoryUsersResponse\x12o\n" +
 	"\x1aUpdateNotificationSettings\x12'.user.UpdateNotificationSettingsRequest\x1a(.user.UpdateNotificationSettingsResponse\x12f\n" +
-	"\x17GetNotificationSettings\x12$.user.GetNotificationSettingsRequest\x1a%.user.GetNotificationSettingsResponse\x12F\n" +
+	"\x17GetNotificationSettings\x12$.user.GetNotificationSettingsRequest\x1a%.user.GetNotificationSettingsResponse\x12i\n" +
+	"\x18SetCommentFilterKeywords\x12%.user.SetCommentFilterKeywordsRequest\x1a&.user.SetCommentFilterKeywordsResponse\x12i\n" +
+	"\x18GetCommentFilterKeywords\x12%.user.GetCommentFilterKeywordsRequest\x1a&.user.GetCommentFilterKeywordsResponse\x12F\n" +
 	"\x10HandleGoogleAuth\x12\x1d.user.HandleGoogleAuthRequest\x1a\x13.user.LoginResponseB,Z*github.com/hoshibmatchi/user-service/protob\x06proto3"
 
 var (
@@ -4664,7 +5684,7 @@ func file_user_proto_rawDescGZIP() []byte {
 	return file_user_proto_rawDescData
 }
 
-var file_user_proto_msgTypes = make([]protoimpl.MessageInfo, 80)
+var file_user_proto_msgTypes = make([]protoimpl.MessageInfo, 98)
 var file_user_proto_goTypes = []any{
 	(*RegisterUserRequest)(nil),                // 0: user.RegisterUserRequest
 	(*RegisterUserResponse)(nil),               // 1: user.RegisterUserResponse
@@ -4700,146 +5720,183 @@ var file_user_proto_goTypes = []any{
 	(*CompleteProfileResponse)(nil),            // 31: user.CompleteProfileResponse
 	(*SetAccountPrivacyRequest)(nil),           // 32: user.SetAccountPrivacyRequest
 	(*SetAccountPrivacyResponse)(nil),          // 33: user.SetAccountPrivacyResponse
-	(*BlockUserRequest)(nil),                   // 34: user.BlockUserRequest
-	(*BlockUserResponse)(nil),                  // 35: user.BlockUserResponse
-	(*UnblockUserRequest)(nil),                 // 36: user.UnblockUserRequest
-	(*UnblockUserResponse)(nil),                // 37: user.UnblockUserResponse
-	(*IsBlockedRequest)(nil),                   // 38: user.IsBlockedRequest
-	(*IsBlockedResponse)(nil),                  // 39: user.IsBlockedResponse
-	(*GetBlockedUsersRequest)(nil),             // 40: user.GetBlockedUsersRequest
-	(*GetBlockedUsersResponse)(nil),            // 41: user.GetBlockedUsersResponse
-	(*SearchUsersRequest)(nil),                 // 42: user.SearchUsersRequest
-	(*SearchUsersResponse)(nil),                // 43: user.SearchUsersResponse
-	(*BanUserRequest)(nil),                     // 44: user.BanUserRequest
-	(*BanUserResponse)(nil),                    // 45: user.BanUserResponse
-	(*UnbanUserRequest)(nil),                   // 46: user.UnbanUserRequest
-	(*UnbanUserResponse)(nil),                  // 47: user.UnbanUserResponse
-	(*SendNewsletterRequest)(nil),              // 48: user.SendNewsletterRequest
-	(*SendNewsletterResponse)(nil),             // 49: user.SendNewsletterResponse
-	(*VerificationRequest)(nil),                // 50: user.VerificationRequest
-	(*SubmitVerificationRequestRequest)(nil),   // 51: user.SubmitVerificationRequestRequest
-	(*SubmitVerificationRequestResponse)(nil),  // 52: user.SubmitVerificationRequestResponse
-	(*GetVerificationRequestsRequest)(nil),     // 53: user.GetVerificationRequestsRequest
-	(*GetVerificationRequestsResponse)(nil),    // 54: user.GetVerificationRequestsResponse
-	(*ResolveVerificationRequestRequest)(nil),  // 55: user.ResolveVerificationRequestRequest
-	(*ResolveVerificationRequestResponse)(nil), // 56: user.ResolveVerificationRequestResponse
-	(*UserInfo)(nil),                           // 57: user.UserInfo
-	(*AddCloseFriendRequest)(nil),              // 58: user.AddCloseFriendRequest
-	(*AddCloseFriendResponse)(nil),             // 59: user.AddCloseFriendResponse
-	(*RemoveCloseFriendRequest)(nil),           // 60: user.RemoveCloseFriendRequest
-	(*RemoveCloseFriendResponse)(nil),          // 61: user.RemoveCloseFriendResponse
-	(*GetCloseFriendsRequest)(nil),             // 62: user.GetCloseFriendsRequest
-	(*GetCloseFriendsResponse)(nil),            // 63: user.GetCloseFriendsResponse
-	(*AddHiddenStoryUserRequest)(nil),          // 64: user.AddHiddenStoryUserRequest
-	(*AddHiddenStoryUserResponse)(nil),         // 65: user.AddHiddenStoryUserResponse
-	(*RemoveHiddenStoryUserRequest)(nil),       // 66: user.RemoveHiddenStoryUserRequest
-	(*RemoveHiddenStoryUserResponse)(nil),      // 67: user.RemoveHiddenStoryUserResponse
-	(*GetHiddenStoryUsersRequest)(nil),         // 68: user.GetHiddenStoryUsersRequest
-	(*GetHiddenStoryUsersResponse)(nil),        // 69: user.GetHiddenStoryUsersResponse
-	(*UpdateNotificationSettingsRequest)(nil),  // 70: user.UpdateNotificationSettingsRequest
-	(*UpdateNotificationSettingsResponse)(nil), // 71: user.UpdateNotificationSettingsResponse
-	(*GetNotificationSettingsRequest)(nil),     // 72: user.GetNotificationSettingsRequest
-	(*GetNotificationSettingsResponse)(nil),    // 73: user.GetNotificationSettingsResponse
-	(*ApproveFollowRequestRequest)(nil),        // 74: user.ApproveFollowRequestRequest
-	(*ApproveFollowRequestResponse)(nil),       // 75: user.ApproveFollowRequestResponse
-	(*RejectFollowRequestRequest)(nil),         // 76: user.RejectFollowRequestRequest
-	(*RejectFollowRequestResponse)(nil),        // 77: user.RejectFollowRequestResponse
-	(*GetFollowRequestsRequest)(nil),           // 78: user.GetFollowRequestsRequest
-	(*GetFollowRequestsResponse)(nil),          // 79: user.GetFollowRequestsResponse
+	(*SetDefaultCommentAudienceRequest)(nil),   // 34: user.SetDefaultCommentAudienceRequest
+	(*SetDefaultCommentAudienceResponse)(nil),  // 35: user.SetDefaultCommentAudienceResponse
+	(*SetActivityStatusRequest)(nil),           // 36: user.SetActivityStatusRequest
+	(*SetActivityStatusResponse)(nil),          // 37: user.SetActivityStatusResponse
+	(*BlockUserRequest)(nil),                   // 38: user.BlockUserRequest
+	(*BlockUserResponse)(nil),                  // 39: user.BlockUserResponse
+	(*UnblockUserRequest)(nil),                 // 40: user.UnblockUserRequest
+	(*UnblockUserResponse)(nil),                // 41: user.UnblockUserResponse
+	(*IsBlockedRequest)(nil),                   // 42: user.IsBlockedRequest
+	(*IsBlockedResponse)(nil),                  // 43: user.IsBlockedResponse
+	(*GetBlockedUsersRequest)(nil),             // 44: user.GetBlockedUsersRequest
+	(*GetBlockedUsersResponse)(nil),            // 45: user.GetBlockedUsersResponse
+	(*SearchUsersRequest)(nil),                 // 46: user.SearchUsersRequest
+	(*SearchUsersResponse)(nil),                // 47: user.SearchUsersResponse
+	(*BanUserRequest)(nil),                     // 48: user.BanUserRequest
+	(*BanUserResponse)(nil),                    // 49: user.BanUserResponse
+	(*UnbanUserRequest)(nil),                   // 50: user.UnbanUserRequest
+	(*UnbanUserResponse)(nil),                  // 51: user.UnbanUserResponse
+	(*SendNewsletterRequest)(nil),              // 52: user.SendNewsletterRequest
+	(*SendNewsletterResponse)(nil),             // 53: user.SendNewsletterResponse
+	(*VerificationRequest)(nil),                // 54: user.VerificationRequest
+	(*SubmitVerificationRequestRequest)(nil),   // 55: user.SubmitVerificationRequestRequest
+	(*SubmitVerificationRequestResponse)(nil),  // 56: user.SubmitVerificationRequestResponse
+	(*GetVerificationRequestsRequest)(nil),     // 57: user.GetVerificationRequestsRequest
+	(*GetVerificationRequestsResponse)(nil),    // 58: user.GetVerificationRequestsResponse
+	(*ResolveVerificationRequestRequest)(nil),  // 59: user.ResolveVerificationRequestRequest
+	(*ResolveVerificationRequestResponse)(nil), // 60: user.ResolveVerificationRequestResponse
+	(*UserInfo)(nil),                           // 61: user.UserInfo
+	(*GetUserSummariesRequest)(nil),            // 62: user.GetUserSummariesRequest
+	(*UserSummary)(nil),                        // 63: user.UserSummary
+	(*GetUserSummariesResponse)(nil),           // 64: user.GetUserSummariesResponse
+	(*GetRelationshipsRequest)(nil),            // 65: user.GetRelationshipsRequest
+	(*Relationship)(nil),                       // 66: user.Relationship
+	(*GetRelationshipsResponse)(nil),           // 67: user.GetRelationshipsResponse
+	(*GetFollowerGrowthRequest)(nil),           // 68: user.GetFollowerGrowthRequest
+	(*DailyCount)(nil),                         // 69: user.DailyCount
+	(*GetFollowerGrowthResponse)(nil),          // 70: user.GetFollowerGrowthResponse
+	(*AddCloseFriendRequest)(nil),              // 71: user.AddCloseFriendRequest
+	(*AddCloseFriendResponse)(nil),             // 72: user.AddCloseFriendResponse
+	(*RemoveCloseFriendRequest)(nil),           // 73: user.RemoveCloseFriendRequest
+	(*RemoveCloseFriendResponse)(nil),          // 74: user.RemoveCloseFriendResponse
+	(*GetCloseFriendsRequest)(nil),             // 75: user.GetCloseFriendsRequest
+	(*GetCloseFriendsResponse)(nil),            // 76: user.GetCloseFriendsResponse
+	(*AddHiddenStoryUserRequest)(nil),          // 77: user.AddHiddenStoryUserRequest
+	(*AddHiddenStoryUserResponse)(nil),         // 78: user.AddHiddenStoryUserResponse
+	(*RemoveHiddenStoryUserRequest)(nil),       // 79: user.RemoveHiddenStoryUserRequest
+	(*RemoveHiddenStoryUserResponse)(nil),      // 80: user.RemoveHiddenStoryUserResponse
+	(*GetHiddenStoryUsersRequest)(nil),         // 81: user.GetHiddenStoryUsersRequest
+	(*GetHiddenStoryUsersResponse)(nil),        // 82: user.GetHiddenStoryUsersResponse
+	(*UpdateNotificationSettingsRequest)(nil),  // 83: user.UpdateNotificationSettingsRequest
+	(*UpdateNotificationSettingsResponse)(nil), // 84: user.UpdateNotificationSettingsResponse
+	(*GetNotificationSettingsRequest)(nil),     // 85: user.GetNotificationSettingsRequest
+	(*GetNotificationSettingsResponse)(nil),    // 86: user.GetNotificationSettingsResponse
+	(*SetCommentFilterKeywordsRequest)(nil),    // 87: user.SetCommentFilterKeywordsRequest
+	(*SetCommentFilterKeywordsResponse)(nil),   // 88: user.SetCommentFilterKeywordsResponse
+	(*GetCommentFilterKeywordsRequest)(nil),    // 89: user.GetCommentFilterKeywordsRequest
+	(*GetCommentFilterKeywordsResponse)(nil),   // 90: user.GetCommentFilterKeywordsResponse
+	(*ApproveFollowRequestRequest)(nil),        // 91: user.ApproveFollowRequestRequest
+	(*ApproveFollowRequestResponse)(nil),       // 92: user.ApproveFollowRequestResponse
+	(*RejectFollowRequestRequest)(nil),         // 93: user.RejectFollowRequestRequest
+	(*RejectFollowRequestResponse)(nil),        // 94: user.RejectFollowRequestResponse
+	(*GetFollowRequestsRequest)(nil),           // 95: user.GetFollowRequestsRequest
+	(*GetFollowRequestsResponse)(nil),          // 96: user.GetFollowRequestsResponse
+	nil,                                        // 97: user.GetRelationshipsResponse.RelationshipsEntry
 }
 var file_user_proto_depIdxs = []int32{
-	57, // 0: user.GetBlockedUsersResponse.blocked_users:type_name -> user.UserInfo
+	61, // 0: user.GetBlockedUsersResponse.blocked_users:type_name -> user.UserInfo
 	28, // 1: user.SearchUsersResponse.users:type_name -> user.GetUserProfileResponse
-	50, // 2: user.SubmitVerificationRequestResponse.request:type_name -> user.VerificationRequest
-	50, // 3: user.GetVerificationRequestsResponse.requests:type_name -> user.VerificationRequest
-	57, // 4: user.GetCloseFriendsResponse.friends:type_name -> user.UserInfo
-	57, // 5: user.GetHiddenStoryUsersResponse.hidden_users:type_name -> user.UserInfo
-	57, // 6: user.GetFollowRequestsResponse.requests:type_name -> user.UserInfo
-	0,  // 7: user.UserService.RegisterUser:input_type -> user.RegisterUserRequest
-	2,  // 8: user.UserService.SendRegistrationOtp:input_type -> user.SendOtpRequest
-	5,  // 9: user.UserService.VerifyRegistrationOtp:input_type -> user.VerifyRegistrationOtpRequest
-	7,  // 10: user.UserService.LoginUser:input_type -> user.LoginRequest
-	9,  // 11: user.UserService.Verify2FA:input_type -> user.Verify2FARequest
-	11, // 12: user.UserService.SendPasswordReset:input_type -> user.SendPasswordResetRequest
-	13, // 13: user.UserService.ResetPassword:input_type -> user.ResetPasswordRequest
-	15, // 14: user.UserService.GetUserData:input_type -> user.GetUserDataRequest
-	17, // 15: user.UserService.FollowUser:input_type -> user.FollowUserRequest
-	19, // 16: user.UserService.UnfollowUser:input_type -> user.UnfollowUserRequest
-	21, // 17: user.UserService.IsFollowing:input_type -> user.IsFollowingRequest
-	74, // 18: user.UserService.ApproveFollowRequest:input_type -> user.ApproveFollowRequestRequest
-	76, // 19: user.UserService.RejectFollowRequest:input_type -> user.RejectFollowRequestRequest
-	78, // 20: user.UserService.GetFollowRequests:input_type -> user.GetFollowRequestsRequest
-	23, // 21: user.UserService.GetFollowingList:input_type -> user.GetFollowingListRequest
-	25, // 22: user.UserService.GetFollowersList:input_type -> user.GetFollowersListRequest
-	27, // 23: user.UserService.GetUserProfile:input_type -> user.GetUserProfileRequest
-	29, // 24: user.UserService.UpdateUserProfile:input_type -> user.UpdateUserProfileRequest
-	30, // 25: user.UserService.CompleteProfile:input_type -> user.CompleteProfileRequest
-	32, // 26: user.UserService.SetAccountPrivacy:input_type -> user.SetAccountPrivacyRequest
-	34, // 27: user.UserService.BlockUser:input_type -> user.BlockUserRequest
-	36, // 28: user.UserService.UnblockUser:input_type -> user.UnblockUserRequest
-	38, // 29: user.UserService.IsBlocked:input_type -> user.IsBlockedRequest
-	40, // 30: user.UserService.GetBlockedUsers:input_type -> user.GetBlockedUsersRequest
-	42, // 31: user.UserService.SearchUsers:input_type -> user.SearchUsersRequest
-	44, // 32: user.UserService.BanUser:input_type -> user.BanUserRequest
-	46, // 33: user.UserService.UnbanUser:input_type -> user.UnbanUserRequest
-	48, // 34: user.UserService.SendNewsletter:input_type -> user.SendNewsletterRequest
-	51, // 35: user.UserService.SubmitVerificationRequest:input_type -> user.SubmitVerificationRequestRequest
-	53, // 36: user.UserService.GetVerificationRequests:input_type -> user.GetVerificationRequestsRequest
-	55, // 37: user.UserService.ResolveVerificationRequest:input_type -> user.ResolveVerificationRequestRequest
-	58, // 38: user.UserService.AddCloseFriend:input_type -> user.AddCloseFriendRequest
-	60, // 39: user.UserService.RemoveCloseFriend:input_type -> user.RemoveCloseFriendRequest
-	62, // 40: user.UserService.GetCloseFriends:input_type -> user.GetCloseFriendsRequest
-	64, // 41: user.UserService.AddHiddenStoryUser:input_type -> user.AddHiddenStoryUserRequest
-	66, // 42: user.UserService.RemoveHiddenStoryUser:input_type -> user.RemoveHiddenStoryUserRequest
-	68, // 43: user.UserService.GetHiddenStoryUsers:input_type -> user.GetHiddenStoryUsersRequest
-	70, // 44: user.UserService.UpdateNotificationSettings:input_type -> user.UpdateNotificationSettingsRequest
-	72, // 45: user.UserService.GetNotificationSettings:input_type -> user.GetNotificationSettingsRequest
-	4,  // 46: user.UserService.HandleGoogleAuth:input_type -> user.HandleGoogleAuthRequest
-	1,  // 47: user.UserService.RegisterUser:output_type -> user.RegisterUserResponse
-	3,  // 48: user.UserService.SendRegistrationOtp:output_type -> user.SendOtpResponse
-	6,  // 49: user.UserService.VerifyRegistrationOtp:output_type -> user.VerifyRegistrationOtpResponse
-	8,  // 50: user.UserService.LoginUser:output_type -> user.LoginResponse
-	10, // 51: user.UserService.Verify2FA:output_type -> user.Verify2FAResponse
-	12, // 52: user.UserService.SendPasswordReset:output_type -> user.SendPasswordResetResponse
-	14, // 53: user.UserService.ResetPassword:output_type -> user.ResetPasswordResponse
-	16, // 54: user.UserService.GetUserData:output_type -> user.GetUserDataResponse
-	18, // 55: user.UserService.FollowUser:output_type -> user.FollowUserResponse
-	20, // 56: user.UserService.UnfollowUser:output_type -> user.UnfollowUserResponse
-	22, // 57: user.UserService.IsFollowing:output_type -> user.IsFollowingResponse
-	75, // 58: user.UserService.ApproveFollowRequest:output_type -> user.ApproveFollowRequestResponse
-	77, // 59: user.UserService.RejectFollowRequest:output_type -> user.RejectFollowRequestResponse
-	79, // 60: user.UserService.GetFollowRequests:output_type -> user.GetFollowRequestsResponse
-	24, // 61: user.UserService.GetFollowingList:output_type -> user.GetFollowingListResponse
-	26, // 62: user.UserService.GetFollowersList:output_type -> user.GetFollowersListResponse
-	28, // 63: user.UserService.GetUserProfile:output_type -> user.GetUserProfileResponse
-	28, // 64: user.UserService.UpdateUserProfile:output_type -> user.GetUserProfileResponse
-	31, // 65: user.UserService.CompleteProfile:output_type -> user.CompleteProfileResponse
-	33, // 66: user.UserService.SetAccountPrivacy:output_type -> user.SetAccountPrivacyResponse
-	35, // 67: user.UserService.BlockUser:output_type -> user.BlockUserResponse
-	37, // 68: user.UserService.UnblockUser:output_type -> user.UnblockUserResponse
-	39, // 69: user.UserService.IsBlocked:output_type -> user.IsBlockedResponse
-	41, // 70: user.UserService.GetBlockedUsers:output_type -> user.GetBlockedUsersResponse
-	43, // 71: user.UserService.SearchUsers:output_type -> user.SearchUsersResponse
-	45, // 72: user.UserService.BanUser:output_type -> user.BanUserResponse
-	47, // 73: user.UserService.UnbanUser:output_type -> user.UnbanUserResponse
-	49, // 74: user.UserService.SendNewsletter:output_type -> user.SendNewsletterResponse
-	52, // 75: user.UserService.SubmitVerificationRequest:output_type -> user.SubmitVerificationRequestResponse
-	54, // 76: user.UserService.GetVerificationRequests:output_type -> user.GetVerificationRequestsResponse
-	56, // 77: user.UserService.ResolveVerificationRequest:output_type -> user.ResolveVerificationRequestResponse
-	59, // 78: user.UserService.AddCloseFriend:output_type -> user.AddCloseFriendResponse
-	61, // 79: user.UserService.RemoveCloseFriend:output_type -> user.RemoveCloseFriendResponse
-	63, // 80: user.UserService.GetCloseFriends:output_type -> user.GetCloseFriendsResponse
-	65, // 81: user.UserService.AddHiddenStoryUser:output_type -> user.AddHiddenStoryUserResponse
-	67, // 82: user.UserService.RemoveHiddenStoryUser:output_type -> user.RemoveHiddenStoryUserResponse
-	69, // 83: user.UserService.GetHiddenStoryUsers:output_type -> user.GetHiddenStoryUsersResponse
-	71, // 84: user.UserService.UpdateNotificationSettings:output_type -> user.UpdateNotificationSettingsResponse
-	73, // 85: user.UserService.GetNotificationSettings:output_type -> user.GetNotificationSettingsResponse
-	8,  // 86: user.UserService.HandleGoogleAuth:output_type -> user.LoginResponse
-	47, // [47:87] is the sub-list for method output_type
-	7,  // [7:47] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+	54, // 2: user.SubmitVerificationRequestResponse.request:type_name -> user.VerificationRequest
+	54, // 3: user.GetVerificationRequestsResponse.requests:type_name -> user.VerificationRequest
+	61, // 4: user.UserSummary.user:type_name -> user.UserInfo
+	63, // 5: user.GetUserSummariesResponse.users:type_name -> user.UserSummary
+	97, // 6: user.GetRelationshipsResponse.relationships:type_name -> user.GetRelationshipsResponse.RelationshipsEntry
+	69, // 7: user.GetFollowerGrowthResponse.days:type_name -> user.DailyCount
+	61, // 8: user.GetCloseFriendsResponse.friends:type_name -> user.UserInfo
+	61, // 9: user.GetHiddenStoryUsersResponse.hidden_users:type_name -> user.UserInfo
+	61, // 10: user.GetFollowRequestsResponse.requests:type_name -> user.UserInfo
+	66, // 11: user.GetRelationshipsResponse.RelationshipsEntry.value:type_name -> user.Relationship
+	0,  // 12: user.UserService.RegisterUser:input_type -> user.RegisterUserRequest
+	2,  // 13: user.UserService.SendRegistrationOtp:input_type -> user.SendOtpRequest
+	5,  // 14: user.UserService.VerifyRegistrationOtp:input_type -> user.VerifyRegistrationOtpRequest
+	7,  // 15: user.UserService.LoginUser:input_type -> user.LoginRequest
+	9,  // 16: user.UserService.Verify2FA:input_type -> user.Verify2FARequest
+	11, // 17: user.UserService.SendPasswordReset:input_type -> user.SendPasswordResetRequest
+	13, // 18: user.UserService.ResetPassword:input_type -> user.ResetPasswordRequest
+	15, // 19: user.UserService.GetUserData:input_type -> user.GetUserDataRequest
+	17, // 20: user.UserService.FollowUser:input_type -> user.FollowUserRequest
+	19, // 21: user.UserService.UnfollowUser:input_type -> user.UnfollowUserRequest
+	21, // 22: user.UserService.IsFollowing:input_type -> user.IsFollowingRequest
+	91, // 23: user.UserService.ApproveFollowRequest:input_type -> user.ApproveFollowRequestRequest
+	93, // 24: user.UserService.RejectFollowRequest:input_type -> user.RejectFollowRequestRequest
+	95, // 25: user.UserService.GetFollowRequests:input_type -> user.GetFollowRequestsRequest
+	23, // 26: user.UserService.GetFollowingList:input_type -> user.GetFollowingListRequest
+	25, // 27: user.UserService.GetFollowersList:input_type -> user.GetFollowersListRequest
+	27, // 28: user.UserService.GetUserProfile:input_type -> user.GetUserProfileRequest
+	29, // 29: user.UserService.UpdateUserProfile:input_type -> user.UpdateUserProfileRequest
+	30, // 30: user.UserService.CompleteProfile:input_type -> user.CompleteProfileRequest
+	32, // 31: user.UserService.SetAccountPrivacy:input_type -> user.SetAccountPrivacyRequest
+	34, // 32: user.UserService.SetDefaultCommentAudience:input_type -> user.SetDefaultCommentAudienceRequest
+	36, // 33: user.UserService.SetActivityStatus:input_type -> user.SetActivityStatusRequest
+	38, // 34: user.UserService.BlockUser:input_type -> user.BlockUserRequest
+	40, // 35: user.UserService.UnblockUser:input_type -> user.UnblockUserRequest
+	42, // 36: user.UserService.IsBlocked:input_type -> user.IsBlockedRequest
+	44, // 37: user.UserService.GetBlockedUsers:input_type -> user.GetBlockedUsersRequest
+	62, // 38: user.UserService.GetUserSummaries:input_type -> user.GetUserSummariesRequest
+	65, // 39: user.UserService.GetRelationships:input_type -> user.GetRelationshipsRequest
+	68, // 40: user.UserService.GetFollowerGrowth:input_type -> user.GetFollowerGrowthRequest
+	46, // 41: user.UserService.SearchUsers:input_type -> user.SearchUsersRequest
+	48, // 42: user.UserService.BanUser:input_type -> user.BanUserRequest
+	50, // 43: user.UserService.UnbanUser:input_type -> user.UnbanUserRequest
+	52, // 44: user.UserService.SendNewsletter:input_type -> user.SendNewsletterRequest
+	55, // 45: user.UserService.SubmitVerificationRequest:input_type -> user.SubmitVerificationRequestRequest
+	57, // 46: user.UserService.GetVerificationRequests:input_type -> user.GetVerificationRequestsRequest
+	59, // 47: user.UserService.ResolveVerificationRequest:input_type -> user.ResolveVerificationRequestRequest
+	71, // 48: user.UserService.AddCloseFriend:input_type -> user.AddCloseFriendRequest
+	73, // 49: user.UserService.RemoveCloseFriend:input_type -> user.RemoveCloseFriendRequest
+	75, // 50: user.UserService.GetCloseFriends:input_type -> user.GetCloseFriendsRequest
+	77, // 51: user.UserService.AddHiddenStoryUser:input_type -> user.AddHiddenStoryUserRequest
+	79, // 52: user.UserService.RemoveHiddenStoryUser:input_type -> user.RemoveHiddenStoryUserRequest
+	81, // 53: user.UserService.GetHiddenStoryUsers:input_type -> user.GetHiddenStoryUsersRequest
+	83, // 54: user.UserService.UpdateNotificationSettings:input_type -> user.UpdateNotificationSettingsRequest
+	85, // 55: user.UserService.GetNotificationSettings:input_type -> user.GetNotificationSettingsRequest
+	87, // 56: user.UserService.SetCommentFilterKeywords:input_type -> user.SetCommentFilterKeywordsRequest
+	89, // 57: user.UserService.GetCommentFilterKeywords:input_type -> user.GetCommentFilterKeywordsRequest
+	4,  // 58: user.UserService.HandleGoogleAuth:input_type -> user.HandleGoogleAuthRequest
+	1,  // 59: user.UserService.RegisterUser:output_type -> user.RegisterUserResponse
+	3,  // 60: user.UserService.SendRegistrationOtp:output_type -> user.SendOtpResponse
+	6,  // 61: user.UserService.VerifyRegistrationOtp:output_type -> user.VerifyRegistrationOtpResponse
+	8,  // 62: user.UserService.LoginUser:output_type -> user.LoginResponse
+	10, // 63: user.UserService.Verify2FA:output_type -> user.Verify2FAResponse
+	12, // 64: user.UserService.SendPasswordReset:output_type -> user.SendPasswordResetResponse
+	14, // 65: user.UserService.ResetPassword:output_type -> user.ResetPasswordResponse
+	16, // 66: user.UserService.GetUserData:output_type -> user.GetUserDataResponse
+	18, // 67: user.UserService.FollowUser:output_type -> user.FollowUserResponse
+	20, // 68: user.UserService.UnfollowUser:output_type -> user.UnfollowUserResponse
+	22, // 69: user.UserService.IsFollowing:output_type -> user.IsFollowingResponse
+	92, // 70: user.UserService.ApproveFollowRequest:output_type -> user.ApproveFollowRequestResponse
+	94, // 71: user.UserService.RejectFollowRequest:output_type -> user.RejectFollowRequestResponse
+	96, // 72: user.UserService.GetFollowRequests:output_type -> user.GetFollowRequestsResponse
+	24, // 73: user.UserService.GetFollowingList:output_type -> user.GetFollowingListResponse
+	26, // 74: user.UserService.GetFollowersList:output_type -> user.GetFollowersListResponse
+	28, // 75: user.UserService.GetUserProfile:output_type -> user.GetUserProfileResponse
+	28, // 76: user.UserService.UpdateUserProfile:output_type -> user.GetUserProfileResponse
+	31, // 77: user.UserService.CompleteProfile:output_type -> user.CompleteProfileResponse
+	33, // 78: user.UserService.SetAccountPrivacy:output_type -> user.SetAccountPrivacyResponse
+	35, // 79: user.UserService.SetDefaultCommentAudience:output_type -> user.SetDefaultCommentAudienceResponse
+	37, // 80: user.UserService.SetActivityStatus:output_type -> user.SetActivityStatusResponse
+	39, // 81: user.UserService.BlockUser:output_type -> user.BlockUserResponse
+	41, // 82: user.UserService.UnblockUser:output_type -> user.UnblockUserResponse
+	43, // 83: user.UserService.IsBlocked:output_type -> user.IsBlockedResponse
+	45, // 84: user.UserService.GetBlockedUsers:output_type -> user.GetBlockedUsersResponse
+	64, // 85: user.UserService.GetUserSummaries:output_type -> user.GetUserSummariesResponse
+	67, // 86: user.UserService.GetRelationships:output_type -> user.GetRelationshipsResponse
+	70, // 87: user.UserService.GetFollowerGrowth:output_type -> user.GetFollowerGrowthResponse
+	47, // 88: user.UserService.SearchUsers:output_type -> user.SearchUsersResponse
+	49, // 89: user.UserService.BanUser:output_type -> user.BanUserResponse
+	51, // 90: user.UserService.UnbanUser:output_type -> user.UnbanUserResponse
+	53, // 91: user.UserService.SendNewsletter:output_type -> user.SendNewsletterResponse
+	56, // 92: user.UserService.SubmitVerificationRequest:output_type -> user.SubmitVerificationRequestResponse
+	58, // 93: user.UserService.GetVerificationRequests:output_type -> user.GetVerificationRequestsResponse
+	60, // 94: user.UserService.ResolveVerificationRequest:output_type -> user.ResolveVerificationRequestResponse
+	72, // 95: user.UserService.AddCloseFriend:output_type -> user.AddCloseFriendResponse
+	74, // 96: user.UserService.RemoveCloseFriend:output_type -> user.RemoveCloseFriendResponse
+	76, // 97: user.UserService.GetCloseFriends:output_type -> user.GetCloseFriendsResponse
+	78, // 98: user.UserService.AddHiddenStoryUser:output_type -> user.AddHiddenStoryUserResponse
+	80, // 99: user.UserService.RemoveHiddenStoryUser:output_type -> user.RemoveHiddenStoryUserResponse
+	82, // 100: user.UserService.GetHiddenStoryUsers:output_type -> user.GetHiddenStoryUsersResponse
+	84, // 101: user.UserService.UpdateNotificationSettings:output_type -> user.UpdateNotificationSettingsResponse
+	86, // 102: user.UserService.GetNotificationSettings:output_type -> user.GetNotificationSettingsResponse
+	88, // 103: user.UserService.SetCommentFilterKeywords:output_type -> user.SetCommentFilterKeywordsResponse
+	90, // 104: user.UserService.GetCommentFilterKeywords:output_type -> user.GetCommentFilterKeywordsResponse
+	8,  // 105: user.UserService.HandleGoogleAuth:output_type -> user.LoginResponse
+	59, // [59:106] is the sub-list for method output_type
+	12, // [12:59] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_user_proto_init() }
@@ -4853,7 +5910,7 @@ func file_user_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_user_proto_rawDesc), len(file_user_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   80,
+			NumMessages:   98,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -39,10 +39,15 @@ const (
 	UserService_UpdateUserProfile_FullMethodName          = "/user.UserService/UpdateUserProfile"
 	UserService_CompleteProfile_FullMethodName            = "/user.UserService/CompleteProfile"
 	UserService_SetAccountPrivacy_FullMethodName          = "/user.UserService/SetAccountPrivacy"
+	UserService_SetDefaultCommentAudience_FullMethodName  = "/user.UserService/SetDefaultCommentAudience"
+	UserService_SetActivityStatus_FullMethodName          = "/user.UserService/SetActivityStatus"
 	UserService_BlockUser_FullMethodName                  = "/user.UserService/BlockUser"
 	UserService_UnblockUser_FullMethodName                = "/user.UserService/UnblockUser"
 	UserService_IsBlocked_FullMethodName                  = "/user.UserService/IsBlocked"
 	UserService_GetBlockedUsers_FullMethodName            = "/user.UserService/GetBlockedUsers"
+	UserService_GetUserSummaries_FullMethodName           = "/user.UserService/GetUserSummaries"
+	UserService_GetRelationships_FullMethodName           = "/user.UserService/GetRelationships"
+	UserService_GetFollowerGrowth_FullMethodName          = "/user.UserService/GetFollowerGrowth"
 	UserService_SearchUsers_FullMethodName                = "/user.UserService/SearchUsers"
 	UserService_BanUser_FullMethodName                    = "/user.UserService/BanUser"
 	UserService_UnbanUser_FullMethodName                  = "/user.UserService/UnbanUser"
@@ -58,6 +63,8 @@ const (
 	UserService_GetHiddenStoryUsers_FullMethodName        = "/user.UserService/GetHiddenStoryUsers"
 	UserService_UpdateNotificationSettings_FullMethodName = "/user.UserService/UpdateNotificationSettings"
 	UserService_GetNotificationSettings_FullMethodName    = "/user.UserService/GetNotificationSettings"
+	UserService_SetCommentFilterKeywords_FullMethodName   = "/user.UserService/SetCommentFilterKeywords"
+	UserService_GetCommentFilterKeywords_FullMethodName   = "/user.UserService/GetCommentFilterKeywords"
 	UserService_HandleGoogleAuth_FullMethodName           = "/user.UserService/HandleGoogleAuth"
 )
 
@@ -91,10 +98,18 @@ type UserServiceClient interface {
 	UpdateUserProfile(ctx context.Context, in *UpdateUserProfileRequest, opts ...grpc.CallOption) (*GetUserProfileResponse, error)
 	CompleteProfile(ctx context.Context, in *CompleteProfileRequest, opts ...grpc.CallOption) (*CompleteProfileResponse, error)
 	SetAccountPrivacy(ctx context.Context, in *SetAccountPrivacyRequest, opts ...grpc.CallOption) (*SetAccountPrivacyResponse, error)
+	SetDefaultCommentAudience(ctx context.Context, in *SetDefaultCommentAudienceRequest, opts ...grpc.CallOption) (*SetDefaultCommentAudienceResponse, error)
+	// Show or hide when you're online and were last active in messages
+	SetActivityStatus(ctx context.Context, in *SetActivityStatusRequest, opts ...grpc.CallOption) (*SetActivityStatusResponse, error)
 	BlockUser(ctx context.Context, in *BlockUserRequest, opts ...grpc.CallOption) (*BlockUserResponse, error)
 	UnblockUser(ctx context.Context, in *UnblockUserRequest, opts ...grpc.CallOption) (*UnblockUserResponse, error)
 	IsBlocked(ctx context.Context, in *IsBlockedRequest, opts ...grpc.CallOption) (*IsBlockedResponse, error)
 	GetBlockedUsers(ctx context.Context, in *GetBlockedUsersRequest, opts ...grpc.CallOption) (*GetBlockedUsersResponse, error)
+	GetUserSummaries(ctx context.Context, in *GetUserSummariesRequest, opts ...grpc.CallOption) (*GetUserSummariesResponse, error)
+	// Viewer's relationship with many users in one call (privacy checks for feeds and stories)
+	GetRelationships(ctx context.Context, in *GetRelationshipsRequest, opts ...grpc.CallOption) (*GetRelationshipsResponse, error)
+	// New followers per day, for account insights
+	GetFollowerGrowth(ctx context.Context, in *GetFollowerGrowthRequest, opts ...grpc.CallOption) (*GetFollowerGrowthResponse, error)
 	SearchUsers(ctx context.Context, in *SearchUsersRequest, opts ...grpc.CallOption) (*SearchUsersResponse, error)
 	// Admin controls
 	BanUser(ctx context.Context, in *BanUserRequest, opts ...grpc.CallOption) (*BanUserResponse, error)
@@ -116,6 +131,9 @@ type UserServiceClient interface {
 	// Notification Settings
 	UpdateNotificationSettings(ctx context.Context, in *UpdateNotificationSettingsRequest, opts ...grpc.CallOption) (*UpdateNotificationSettingsResponse, error)
 	GetNotificationSettings(ctx context.Context, in *GetNotificationSettingsRequest, opts ...grpc.CallOption) (*GetNotificationSettingsResponse, error)
+	// Comment keyword filter (applied by post-service to comments on the user's posts)
+	SetCommentFilterKeywords(ctx context.Context, in *SetCommentFilterKeywordsRequest, opts ...grpc.CallOption) (*SetCommentFilterKeywordsResponse, error)
+	GetCommentFilterKeywords(ctx context.Context, in *GetCommentFilterKeywordsRequest, opts ...grpc.CallOption) (*GetCommentFilterKeywordsResponse, error)
 	// Google OAuth
 	HandleGoogleAuth(ctx context.Context, in *HandleGoogleAuthRequest, opts ...grpc.CallOption) (*LoginResponse, error)
 }
@@ -328,6 +346,26 @@ func (c *userServiceClient) SetAccountPrivacy(ctx context.Context, in *SetAccoun
 	return out, nil
 }
 
+func (c *userServiceClient) SetDefaultCommentAudience(ctx context.Context, in *SetDefaultCommentAudienceRequest, opts ...grpc.CallOption) (*SetDefaultCommentAudienceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetDefaultCommentAudienceResponse)
+	err := c.cc.Invoke(ctx, UserService_SetDefaultCommentAudience_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) SetActivityStatus(ctx context.Context, in *SetActivityStatusRequest, opts ...grpc.CallOption) (*SetActivityStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetActivityStatusResponse)
+	err := c.cc.Invoke(ctx, UserService_SetActivityStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) BlockUser(ctx context.Context, in *BlockUserRequest, opts ...grpc.CallOption) (*BlockUserResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(BlockUserResponse)
@@ -368,6 +406,36 @@ func (c *userServiceClient) GetBlockedUsers(ctx context.Context, in *GetBlockedU
 	return out, nil
 }
 
+func (c *userServiceClient) GetUserSummaries(ctx context.Context, in *GetUserSummariesRequest, opts ...grpc.CallOption) (*GetUserSummariesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUserSummariesResponse)
+	err := c.cc.Invoke(ctx, UserService_GetUserSummaries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetRelationships(ctx context.Context, in *GetRelationshipsRequest, opts ...grpc.CallOption) (*GetRelationshipsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRelationshipsResponse)
+	err := c.cc.Invoke(ctx, UserService_GetRelationships_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetFollowerGrowth(ctx context.Context, in *GetFollowerGrowthRequest, opts ...grpc.CallOption) (*GetFollowerGrowthResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFollowerGrowthResponse)
+	err := c.cc.Invoke(ctx, UserService_GetFollowerGrowth_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) SearchUsers(ctx context.Context, in *SearchUsersRequest, opts ...grpc.CallOption) (*SearchUsersResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(SearchUsersResponse)
@@ -518,6 +586,26 @@ func (c *userServiceClient) GetNotificationSettings(ctx context.Context, in *Get
 	return out, nil
 }
 
+func (c *userServiceClient) SetCommentFilterKeywords(ctx context.Context, in *SetCommentFilterKeywordsRequest, opts ...grpc.CallOption) (*SetCommentFilterKeywordsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetCommentFilterKeywordsResponse)
+	err := c.cc.Invoke(ctx, UserService_SetCommentFilterKeywords_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetCommentFilterKeywords(ctx context.Context, in *GetCommentFilterKeywordsRequest, opts ...grpc.CallOption) (*GetCommentFilterKeywordsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCommentFilterKeywordsResponse)
+	err := c.cc.Invoke(ctx, UserService_GetCommentFilterKeywords_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) HandleGoogleAuth(ctx context.Context, in *HandleGoogleAuthRequest, opts ...grpc.CallOption) (*LoginResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(LoginResponse)
@@ -558,10 +646,18 @@ type UserServiceServer interface {
 	UpdateUserProfile(context.Context, *UpdateUserProfileRequest) (*GetUserProfileResponse, error)
 	CompleteProfile(context.Context, *CompleteProfileRequest) (*CompleteProfileResponse, error)
 	SetAccountPrivacy(context.Context, *SetAccountPrivacyRequest) (*SetAccountPrivacyResponse, error)
+	SetDefaultCommentAudience(context.Context, *SetDefaultCommentAudienceRequest) (*SetDefaultCommentAudienceResponse, error)
+	// Show or hide when you're online and were last active in messages
+	SetActivityStatus(context.Context, *SetActivityStatusRequest) (*SetActivityStatusResponse, error)
 	BlockUser(context.Context, *BlockUserRequest) (*BlockUserResponse, error)
 	UnblockUser(context.Context, *UnblockUserRequest) (*UnblockUserResponse, error)
 	IsBlocked(context.Context, *IsBlockedRequest) (*IsBlockedResponse, error)
 	GetBlockedUsers(context.Context, *GetBlockedUsersRequest) (*GetBlockedUsersResponse, error)
+	GetUserSummaries(context.Context, *GetUserSummariesRequest) (*GetUserSummariesResponse, error)
+	// Viewer's relationship with many users in one call (privacy checks for feeds and stories)
+	GetRelationships(context.Context, *GetRelationshipsRequest) (*GetRelationshipsResponse, error)
+	// New followers per day, for account insights
+	GetFollowerGrowth(context.Context, *GetFollowerGrowthRequest) (*GetFollowerGrowthResponse, error)
 	SearchUsers(context.Context, *SearchUsersRequest) (*SearchUsersResponse, error)
 	// Admin controls
 	BanUser(context.Context, *BanUserRequest) (*BanUserResponse, error)
@@ -583,6 +679,9 @@ type UserServiceServer interface {
 	// Notification Settings
 	UpdateNotificationSettings(context.Context, *UpdateNotificationSettingsRequest) (*UpdateNotificationSettingsResponse, error)
 	GetNotificationSettings(context.Context, *GetNotificationSettingsRequest) (*GetNotificationSettingsResponse, error)
+	// Comment keyword filter (applied by post-service to comments on the user's posts)
+	SetCommentFilterKeywords(context.Context, *SetCommentFilterKeywordsRequest) (*SetCommentFilterKeywordsResponse, error)
+	GetCommentFilterKeywords(context.Context, *GetCommentFilterKeywordsRequest) (*GetCommentFilterKeywordsResponse, error)
 	// Google OAuth
 	HandleGoogleAuth(context.Context, *HandleGoogleAuthRequest) (*LoginResponse, error)
 	mustEmbedUnimplementedUserServiceServer()
@@ -655,6 +754,12 @@ func (UnimplementedUserServiceServer) CompleteProfile(context.Context, *Complete
 func (UnimplementedUserServiceServer) SetAccountPrivacy(context.Context, *SetAccountPrivacyRequest) (*SetAccountPrivacyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetAccountPrivacy not implemented")
 }
+func (UnimplementedUserServiceServer) SetDefaultCommentAudience(context.Context, *SetDefaultCommentAudienceRequest) (*SetDefaultCommentAudienceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDefaultCommentAudience not implemented")
+}
+func (UnimplementedUserServiceServer) SetActivityStatus(context.Context, *SetActivityStatusRequest) (*SetActivityStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetActivityStatus not implemented")
+}
 func (UnimplementedUserServiceServer) BlockUser(context.Context, *BlockUserRequest) (*BlockUserResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BlockUser not implemented")
 }
@@ -667,6 +772,15 @@ func (UnimplementedUserServiceServer) IsBlocked(context.Context, *IsBlockedReque
 func (UnimplementedUserServiceServer) GetBlockedUsers(context.Context, *GetBlockedUsersRequest) (*GetBlockedUsersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBlockedUsers not implemented")
 }
+func (UnimplementedUserServiceServer) GetUserSummaries(context.Context, *GetUserSummariesRequest) (*GetUserSummariesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserSummaries not implemented")
+}
+func (UnimplementedUserServiceServer) GetRelationships(context.Context, *GetRelationshipsRequest) (*GetRelationshipsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRelationships not implemented")
+}
+func (UnimplementedUserServiceServer) GetFollowerGrowth(context.Context, *GetFollowerGrowthRequest) (*GetFollowerGrowthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFollowerGrowth not implemented")
+}
 func (UnimplementedUserServiceServer) SearchUsers(context.Context, *SearchUsersRequest) (*SearchUsersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SearchUsers not implemented")
 }
@@ -712,6 +826,12 @@ func (UnimplementedUserServiceServer) UpdateNotificationSettings(context.Context
 func (UnimplementedUserServiceServer) GetNotificationSettings(context.Context, *GetNotificationSettingsRequest) (*GetNotificationSettingsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetNotificationSettings not implemented")
 }
+func (UnimplementedUserServiceServer) SetCommentFilterKeywords(context.Context, *SetCommentFilterKeywordsRequest) (*SetCommentFilterKeywordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCommentFilterKeywords not implemented")
+}
+func (UnimplementedUserServiceServer) GetCommentFilterKeywords(context.Context, *GetCommentFilterKeywordsRequest) (*GetCommentFilterKeywordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCommentFilterKeywords not implemented")
+}
 func (UnimplementedUserServiceServer) HandleGoogleAuth(context.Context, *HandleGoogleAuthRequest) (*LoginResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method HandleGoogleAuth not implemented")
 }
@@ -1096,6 +1216,42 @@ func _UserService_SetAccountPrivacy_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_SetDefaultCommentAudience_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDefaultCommentAudienceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SetDefaultCommentAudience(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SetDefaultCommentAudience_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SetDefaultCommentAudience(ctx, req.(*SetDefaultCommentAudienceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_SetActivityStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetActivityStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SetActivityStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SetActivityStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SetActivityStatus(ctx, req.(*SetActivityStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserService_BlockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(BlockUserRequest)
 	if err := dec(in); err != nil {
@@ -1168,6 +1324,60 @@ func _UserService_GetBlockedUsers_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_GetUserSummaries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserSummariesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetUserSummaries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetUserSummaries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetUserSummaries(ctx, req.(*GetUserSummariesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetRelationships_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRelationshipsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetRelationships(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetRelationships_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetRelationships(ctx, req.(*GetRelationshipsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetFollowerGrowth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFollowerGrowthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetFollowerGrowth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetFollowerGrowth_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetFollowerGrowth(ctx, req.(*GetFollowerGrowthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserService_SearchUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SearchUsersRequest)
 	if err := dec(in); err != nil {
@@ -1438,6 +1648,42 @@ func _UserService_GetNotificationSettings_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_SetCommentFilterKeywords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCommentFilterKeywordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SetCommentFilterKeywords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SetCommentFilterKeywords_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SetCommentFilterKeywords(ctx, req.(*SetCommentFilterKeywordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetCommentFilterKeywords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCommentFilterKeywordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetCommentFilterKeywords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetCommentFilterKeywords_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetCommentFilterKeywords(ctx, req.(*GetCommentFilterKeywordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserService_HandleGoogleAuth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(HandleGoogleAuthRequest)
 	if err := dec(in); err != nil {
@@ -1543,6 +1789,14 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetAccountPrivacy",
 			Handler:    _UserService_SetAccountPrivacy_Handler,
 		},
+		{
+			MethodName: "SetDefaultCommentAudience",
+			Handler:    _UserService_SetDefaultCommentAudience_Handler,
+		},
+		{
+			MethodName: "SetActivityStatus",
+			Handler:    _UserService_SetActivityStatus_Handler,
+		},
 		{
 			MethodName: "BlockUser",
 			Handler:    _UserService_BlockUser_Handler,
@@ -1559,6 +1813,18 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetBlockedUsers",
 			Handler:    _UserService_GetBlockedUsers_Handler,
 		},
+		{
+			MethodName: "GetUserSummaries",
+			Handler:    _UserService_GetUserSummaries_Handler,
+		},
+		{
+			MethodName: "GetRelationships",
+			Handler:    _UserService_GetRelationships_Handler,
+		},
+		{
+			MethodName: "GetFollowerGrowth",
+			Handler:    _UserService_GetFollowerGrowth_Handler,
+		},
 		{
 			MethodName: "SearchUsers",
 			Handler:    _UserService_SearchUsers_Handler,
@@ -1619,6 +1885,14 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetNotificationSettings",
 			Handler:    _UserService_GetNotificationSettings_Handler,
 		},
+		{
+			MethodName: "SetCommentFilterKeywords",
+			Handler:    _UserService_SetCommentFilterKeywords_Handler,
+		},
+		{
+			MethodName: "GetCommentFilterKeywords",
+			Handler:    _UserService_GetCommentFilterKeywords_Handler,
+		},
 		{
 			MethodName: "HandleGoogleAuth",
 			Handler:    _UserService_HandleGoogleAuth_Handler,
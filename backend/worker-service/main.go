@@ -1,6 +1,7 @@
 package main
 
-// Worker Service: Handles background jobs for story deletion, video transcoding, and hashtag processing
+// Worker Service: Handles background jobs for story deletion, video transcoding, hashtag processing,
+// home timeline fan-out and purging posts that have sat in Recently Deleted past the retention window
 
 import (
 	"context"
@@ -26,6 +27,7 @@ import (
 
 	// gRPC Clients
 	hashtagPb "github.com/hoshibmatchi/hashtag-service/proto"
+	postPb "github.com/hoshibmatchi/post-service/proto"
 )
 
 // --- GORM Models ---
@@ -43,12 +45,28 @@ type Post struct {
 	ThumbnailURL string         `gorm:"type:text"`
 }
 
+// trashRetention must match post-service: deleted posts can be restored for this long
+const trashRetention = 30 * 24 * time.Hour
+
+const (
+	trashPurgeInterval  = time.Hour
+	trashPurgeBatchSize = 100
+)
+
+// post-service keeps like_count and comment_count up to date as engagement happens;
+// this job recounts them in ID ranges to repair any drift
+const (
+	counterReconcileInterval  = 6 * time.Hour
+	counterReconcileBatchSize = 1000
+)
+
 // server struct holds all our connections
 type server struct {
 	storyDB       *gorm.DB // Connection to story-db
 	postDB        *gorm.DB // Connection to post-db
 	amqpCh        *amqp.Channel
 	hashtagClient hashtagPb.HashtagServiceClient
+	postClient    postPb.PostServiceClient
 	minioClient   *minio.Client
 }
 
@@ -142,6 +160,15 @@ func main() {
 	hashtagClient := hashtagPb.NewHashtagServiceClient(hashtagConn)
 	log.Println("Worker successfully connected to hashtag-service")
 
+	// --- Step 3.2: Connect to Post Service (gRPC Client) ---
+	postConn, err := grpc.Dial("post-service:9001", grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatalf("Failed to connect to post-service: %v", err)
+	}
+	defer postConn.Close()
+	postClient := postPb.NewPostServiceClient(postConn)
+	log.Println("Worker successfully connected to post-service")
+
 	// --- Step 3.5: Connect to MinIO ---
 	// Get MinIO credentials from environment
 	minioEndpoint := os.Getenv("MINIO_ENDPOINT")
@@ -172,6 +199,7 @@ func main() {
 		postDB:        postDB,
 		amqpCh:        amqpCh,
 		hashtagClient: hashtagClient,
+		postClient:    postClient,
 		minioClient:   minioClient,
 	}
 
@@ -192,6 +220,17 @@ func main() {
 		log.Fatalf("Worker failed to declare hashtag_queue: %v", err)
 	}
 
+	// Home timeline updates from post-service and user-service
+	feedQ, err := amqpCh.QueueDeclare("feed_fanout_queue", true, false, false, false, nil)
+	if err != nil {
+		log.Fatalf("Worker failed to declare feed_fanout_queue: %v", err)
+	}
+
+	// Post lifecycle events (post.deleted) for other services
+	if _, err := amqpCh.QueueDeclare("post_events_queue", true, false, false, false, nil); err != nil {
+		log.Fatalf("Worker failed to declare post_events_queue: %v", err)
+	}
+
 	// --- Step 6: Start Consuming from ALL queues ---
 	storyMsgs, err := amqpCh.Consume(storyQ.Name, "story_consumer", false, false, false, false, nil)
 	if err != nil {
@@ -208,6 +247,11 @@ func main() {
 		log.Fatalf("Failed to register hashtag consumer: %v", err)
 	}
 
+	feedMsgs, err := amqpCh.Consume(feedQ.Name, "feed_consumer", false, false, false, false, nil)
+	if err != nil {
+		log.Fatalf("Failed to register feed consumer: %v", err)
+	}
+
 	var forever chan struct{}
 
 	// Goroutine for story deletion jobs
@@ -237,6 +281,35 @@ func main() {
 		}
 	}()
 
+	// Goroutine for home timeline jobs
+	go func() {
+		for d := range feedMsgs {
+			log.Printf("Received a feed job: %s", d.Body)
+			s.processFeedJob(d.Body)
+			d.Ack(false) // Acknowledge the message
+		}
+	}()
+
+	// Goroutine for purging expired posts from Recently Deleted
+	go func() {
+		ticker := time.NewTicker(trashPurgeInterval)
+		defer ticker.Stop()
+		for {
+			s.purgeExpiredPosts()
+			<-ticker.C
+		}
+	}()
+
+	// Goroutine for repairing denormalized post counters
+	go func() {
+		ticker := time.NewTicker(counterReconcileInterval)
+		defer ticker.Stop()
+		for {
+			s.reconcilePostCounters()
+			<-ticker.C
+		}
+	}()
+
 	log.Println("Worker service is running. Waiting for jobs...")
 	forever = make(chan struct{})
 	<-forever // Block forever
@@ -672,3 +745,190 @@ func (s *server) processHashtagJob(body []byte) {
 		log.Printf("Successfully processed hashtag job for post %d", job.PostID)
 	}
 }
+
+// processFeedJob applies a post or follow-graph change to home timelines via post-service
+func (s *server) processFeedJob(body []byte) {
+	var job struct {
+		Type     string `json:"type"`
+		PostID   int64  `json:"post_id"`
+		UserID   int64  `json:"user_id"`
+		AuthorID int64  `json:"author_id"`
+	}
+	if err := json.Unmarshal(body, &job); err != nil {
+		log.Printf("Error decoding feed job: %v", err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	var err error
+	switch job.Type {
+	case "post.created", "post.restored":
+		_, err = s.postClient.FanOutPost(ctx, &postPb.FanOutPostRequest{PostId: job.PostID})
+	case "post.removed":
+		_, err = s.postClient.RetractPost(ctx, &postPb.RetractPostRequest{PostId: job.PostID})
+	case "user.followed":
+		_, err = s.postClient.SyncTimelineAuthor(ctx, &postPb.SyncTimelineAuthorRequest{UserId: job.UserID, AuthorId: job.AuthorID, Following: true})
+	case "user.unfollowed":
+		_, err = s.postClient.SyncTimelineAuthor(ctx, &postPb.SyncTimelineAuthorRequest{UserId: job.UserID, AuthorId: job.AuthorID})
+	case "user.blocked":
+		// A block removes follows in both directions
+		_, err = s.postClient.SyncTimelineAuthor(ctx, &postPb.SyncTimelineAuthorRequest{UserId: job.UserID, AuthorId: job.AuthorID})
+		if err == nil {
+			_, err = s.postClient.SyncTimelineAuthor(ctx, &postPb.SyncTimelineAuthorRequest{UserId: job.AuthorID, AuthorId: job.UserID})
+		}
+	default:
+		log.Printf("Unknown feed job type: %s", job.Type)
+		return
+	}
+
+	if err != nil {
+		log.Printf("Failed to process %s feed job: %v", job.Type, err)
+	}
+}
+
+// purgeExpiredPosts permanently removes posts deleted more than trashRetention ago,
+// along with their engagement rows and media, then announces each with post.deleted
+func (s *server) purgeExpiredPosts() {
+	cutoff := time.Now().Add(-trashRetention)
+
+	for {
+		var posts []Post
+		if err := s.postDB.Unscoped().
+			Where("deleted_at IS NOT NULL AND deleted_at < ?", cutoff).
+			Limit(trashPurgeBatchSize).
+			Find(&posts).Error; err != nil {
+			log.Printf("Failed to find expired posts: %v", err)
+			return
+		}
+		if len(posts) == 0 {
+			return
+		}
+
+		for _, post := range posts {
+			if err := s.purgePost(post); err != nil {
+				log.Printf("Failed to purge post %d: %v", post.ID, err)
+				return // Try again next tick rather than spinning on the same batch
+			}
+		}
+
+		if len(posts) < trashPurgeBatchSize {
+			return
+		}
+	}
+}
+
+// purgePost hard-deletes one post and everything that hangs off it
+func (s *server) purgePost(post Post) error {
+	err := s.postDB.Transaction(func(tx *gorm.DB) error {
+		statements := []string{
+			"DELETE FROM comment_likes WHERE comment_id IN (SELECT id FROM comments WHERE post_id = ?)",
+			"DELETE FROM comments WHERE post_id = ?",
+			"DELETE FROM post_likes WHERE post_id = ?",
+			"DELETE FROM saved_posts WHERE post_id = ?",
+			"UPDATE collections SET cover_post_id = NULL WHERE cover_post_id = ?",
+			"DELETE FROM post_collaborators WHERE post_id = ?",
+			"DELETE FROM shared_posts WHERE original_post_id = ?",
+			"DELETE FROM reel_watches WHERE post_id = ?",
+			"DELETE FROM reel_stats WHERE post_id = ?",
+			"DELETE FROM not_interesteds WHERE kind = 'post' AND target_id = ?",
+			"DELETE FROM post_viewers WHERE post_id = ?",
+			"DELETE FROM post_daily_metrics WHERE post_id = ?",
+		}
+		for _, stmt := range statements {
+			if err := tx.Exec(stmt, post.ID).Error; err != nil {
+				return err
+			}
+		}
+		return tx.Unscoped().Delete(&Post{}, post.ID).Error
+	})
+	if err != nil {
+		return err
+	}
+
+	// Media goes only after the rows are gone, so a failed transaction never leaves a post without files
+	objects := append([]string{}, post.MediaURLs...)
+	if post.ThumbnailURL != "" {
+		objects = append(objects, post.ThumbnailURL)
+	}
+	for _, url := range objects {
+		// MediaURLs store object paths directly (e.g., "user-123/posts/video.mp4");
+		// legacy rows may still hold full URLs
+		objectName := url
+		if strings.Contains(url, "http://") || strings.Contains(url, "https://") {
+			objectName = url[strings.LastIndex(url, "/")+1:]
+		}
+		if objectName == "" {
+			continue
+		}
+		if err := s.minioClient.RemoveObject(context.Background(), "media", objectName, minio.RemoveObjectOptions{}); err != nil {
+			// Do not fail the purge, just log it
+			log.Printf("Failed to delete object %s from MinIO: %v", objectName, err)
+		}
+	}
+
+	// Let hashtag-service (and anyone else) drop its references
+	msgBody, _ := json.Marshal(map[string]interface{}{
+		"type":    "post.deleted",
+		"post_id": post.ID,
+	})
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := s.amqpCh.PublishWithContext(
+		ctx,
+		"",                  // exchange (default)
+		"post_events_queue", // routing key
+		false,               // mandatory
+		false,               // immediate
+		amqp.Publishing{
+			ContentType:  "application/json",
+			DeliveryMode: amqp.Persistent,
+			Body:         msgBody,
+		},
+	); err != nil {
+		log.Printf("Failed to publish post.deleted for post %d: %v", post.ID, err)
+	}
+
+	log.Printf("Purged post %d from Recently Deleted", post.ID)
+	return nil
+}
+
+// reconcilePostCounters recounts like_count and comment_count from post_likes and
+// comments, one ID range at a time so no single statement locks the whole table.
+// Only rows that actually drifted are written.
+func (s *server) reconcilePostCounters() {
+	var maxID uint
+	if err := s.postDB.Unscoped().Model(&Post{}).Select("COALESCE(MAX(id), 0)").Scan(&maxID).Error; err != nil {
+		log.Printf("Failed to read max post ID: %v", err)
+		return
+	}
+
+	var fixed int64
+	for start := uint(1); start <= maxID; start += counterReconcileBatchSize {
+		end := start + counterReconcileBatchSize - 1
+		result := s.postDB.Exec(`
+			UPDATE posts SET
+				like_count = counts.likes,
+				comment_count = counts.comments
+			FROM (
+				SELECT p.id,
+					(SELECT COUNT(*) FROM post_likes WHERE post_likes.post_id = p.id) AS likes,
+					(SELECT COUNT(*) FROM comments WHERE comments.post_id = p.id AND comments.deleted_at IS NULL) AS comments
+				FROM posts p
+				WHERE p.id BETWEEN ? AND ?
+			) AS counts
+			WHERE posts.id = counts.id
+				AND (posts.like_count <> counts.likes OR posts.comment_count <> counts.comments)`,
+			start, end)
+		if result.Error != nil {
+			log.Printf("Failed to reconcile post counters for IDs %d-%d: %v", start, end, result.Error)
+			return
+		}
+		fixed += result.RowsAffected
+	}
+
+	if fixed > 0 {
+		log.Printf("Reconciled counters on %d posts", fixed)
+	}
+}
@@ -0,0 +1,63 @@
+import type { Directive } from 'vue'
+import { insightsAPI } from '@/services/api'
+import type { ImpressionSurface } from '@/services/api'
+
+// Posts seen on screen are batched per surface and reported every few seconds,
+// so scrolling a feed costs one request rather than one per post
+const FLUSH_DELAY_MS = 5000
+const MAX_BATCH = 100
+
+const pending = new Map<ImpressionSurface, Set<number>>()
+let flushTimer: ReturnType<typeof setTimeout> | null = null
+
+const flush = () => {
+  flushTimer = null
+  pending.forEach((ids, surface) => {
+    const postIds = Array.from(ids)
+    for (let i = 0; i < postIds.length; i += MAX_BATCH) {
+      insightsAPI.recordImpressions(surface, postIds.slice(i, i + MAX_BATCH))
+        .catch((error) => console.error('Failed to record impressions:', error))
+    }
+  })
+  pending.clear()
+}
+
+if (typeof window !== 'undefined') {
+  window.addEventListener('pagehide', flush)
+}
+
+export const useImpressions = (surface: ImpressionSurface) => {
+  const recordImpression = (postId: string | number) => {
+    const id = Number(postId)
+    if (!id) return
+    if (!pending.has(surface)) pending.set(surface, new Set())
+    pending.get(surface)!.add(id)
+    if (!flushTimer) flushTimer = setTimeout(flush, FLUSH_DELAY_MS)
+  }
+
+  // Counts an element as seen once half of it has been on screen
+  const observe = (el: Element, postId: string | number) => {
+    const observer = new IntersectionObserver((entries) => {
+      if (entries.some((entry) => entry.isIntersecting)) {
+        recordImpression(postId)
+        observer.disconnect()
+      }
+    }, { threshold: 0.5 })
+    observer.observe(el)
+    return () => observer.disconnect()
+  }
+
+  // For post grids: v-impression="post.id" on each tile
+  const stops = new WeakMap<Element, () => void>()
+  const vImpression: Directive<Element, string | number> = {
+    mounted: (el, binding) => {
+      stops.set(el, observe(el, binding.value))
+    },
+    unmounted: (el) => {
+      stops.get(el)?.()
+      stops.delete(el)
+    }
+  }
+
+  return { recordImpression, observe, vImpression }
+}
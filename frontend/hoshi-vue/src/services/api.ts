@@ -235,19 +235,25 @@ export const userAPI = {
   },
 
   // Get user posts
-  getUserPosts: async (username: string, page: number = 1, limit: number = 12) => {
-    const response = await apiClient.get(`/users/${username}/posts?page=${page}&limit=${limit}`);
+  getUserPosts: async (username: string, cursor: string = "", limit: number = 12) => {
+    const params: Record<string, any> = { limit };
+    if (cursor) params.cursor = cursor;
+    const response = await apiClient.get(`/users/${username}/posts`, { params });
     return response.data;
   },
 
   // Get user reels
-  getUserReels: async (username: string, page: number = 1, limit: number = 12) => {
-    const response = await apiClient.get(`/users/${username}/reels?page=${page}&limit=${limit}`);
+  getUserReels: async (username: string, cursor: string = "", limit: number = 12) => {
+    const params: Record<string, any> = { limit };
+    if (cursor) params.cursor = cursor;
+    const response = await apiClient.get(`/users/${username}/reels`, { params });
     return response.data;
   },
 
-  getUserTagged: async (username: string) => {
-    const response = await apiClient.get(`/users/${username}/tagged`);
+  getUserTagged: async (username: string, cursor: string = "", limit: number = 20) => {
+    const params: Record<string, any> = { limit };
+    if (cursor) params.cursor = cursor;
+    const response = await apiClient.get(`/users/${username}/tagged`, { params });
     return response.data;
   },
 
@@ -357,6 +363,12 @@ export const userAPI = {
     return response.data;
   },
 
+  // Show or hide when you're online and were last active
+  setActivityStatus: async (show: boolean) => {
+    const response = await apiClient.put("/settings/activity-status", { show });
+    return response.data;
+  },
+
   // Close Friends
   addCloseFriend: async (userId: number) => {
     const response = await apiClient.post(`/close-friends/${userId}`);
@@ -458,18 +470,46 @@ export const mediaAPI = {
 
 // Feed APIs
 export const feedAPI = {
-  getHomeFeed: async (page: number = 1, limit: number = 20) => {
-    const response = await apiClient.get(`/feed/home?page=${page}&limit=${limit}`);
+  getHomeFeed: async (cursor: string = "", limit: number = 20) => {
+    const params: Record<string, any> = { limit };
+    if (cursor) params.cursor = cursor;
+    const response = await apiClient.get("/feed/home", { params });
+    return response.data;
+  },
+
+  getExploreFeed: async (cursor: string = "", limit: number = 20) => {
+    const params: Record<string, any> = { limit };
+    if (cursor) params.cursor = cursor;
+    const response = await apiClient.get("/feed/explore", { params });
     return response.data;
   },
 
-  getExploreFeed: async (page: number = 1, limit: number = 20) => {
-    const response = await apiClient.get(`/feed/explore?page=${page}&limit=${limit}`);
+  getReelsFeed: async (cursor: string = "", limit: number = 20) => {
+    const params: Record<string, any> = { limit };
+    if (cursor) params.cursor = cursor;
+    const response = await apiClient.get("/feed/reels", { params });
     return response.data;
   },
 
-  getReelsFeed: async (page: number = 1, limit: number = 20) => {
-    const response = await apiClient.get(`/feed/reels?page=${page}&limit=${limit}`);
+  recordReelWatch: async (postId: string, watch: { watch_ms: number; duration_ms: number; completed: boolean; replays: number; skipped: boolean }) => {
+    const response = await apiClient.post(`/reels/${postId}/watch`, watch);
+    return response.data;
+  },
+
+  markNotInterested: async (signal: { kind: "post" | "author" | "hashtag"; post_id?: number; author_id?: number; hashtag?: string }) => {
+    const response = await apiClient.post("/feed/not-interested", signal);
+    return response.data;
+  },
+
+  getNotInterested: async (cursor: string = "", limit: number = 20) => {
+    const params: Record<string, any> = { limit };
+    if (cursor) params.cursor = cursor;
+    const response = await apiClient.get("/feed/not-interested", { params });
+    return response.data;
+  },
+
+  undoNotInterested: async (signalId: string) => {
+    const response = await apiClient.delete(`/feed/not-interested/${signalId}`);
     return response.data;
   }
 };
@@ -509,10 +549,18 @@ export const postAPI = {
     return response.data;
   },
 
+  // Send a post into DMs; each recipient and conversation succeeds or fails on its own
+  sendToDirect: async (postId: string, data: { recipient_ids?: number[], conversation_ids?: string[], message?: string }) => {
+    const response = await apiClient.post(`/posts/${postId}/send`, data);
+    return response.data.results as DirectSendResult[];
+  },
+
   // Get post likers
-  getPostLikers: async (postId: string) => {
-    const response = await apiClient.get(`/posts/${postId}/likes`);
-    return response.data;
+  getPostLikers: async (postId: string, limit: number = 50, cursor: string = "") => {
+    const params = new URLSearchParams({ limit: String(limit) });
+    if (cursor) params.set("cursor", cursor);
+    const response = await apiClient.get(`/posts/${postId}/likes?${params.toString()}`);
+    return response.data.likers || [];
   },
 
   summarizeCaption: async (postId: string) => {
@@ -575,9 +623,19 @@ export const storyAPI = {
 
 // Comment APIs
 export const commentAPI = {
-  getCommentsByPost: async (postId: number, page: number = 1, limit: number = 20) => {
-    const response = await apiClient.get(`/posts/${postId}/comments?page=${page}&limit=${limit}`);
-    return response.data;
+  getCommentsByPost: async (postId: number, limit: number = 20, cursor: string = "", sort: string = "newest") => {
+    const params = new URLSearchParams({ limit: String(limit), sort });
+    if (cursor) params.set("cursor", cursor);
+    const response = await apiClient.get<{ comments: any[]; next_cursor: string }>(`/posts/${postId}/comments?${params.toString()}`);
+    // Top-level comments only; replies are paged per thread
+    return { comments: response.data.comments || [], next_cursor: response.data.next_cursor || "" };
+  },
+
+  getCommentReplies: async (commentId: string | number, limit: number = 50, cursor: string = "") => {
+    const params = new URLSearchParams({ limit: String(limit) });
+    if (cursor) params.set("cursor", cursor);
+    const response = await apiClient.get<{ comments: any[]; next_cursor: string }>(`/comments/${commentId}/replies?${params.toString()}`);
+    return { comments: response.data.comments || [], next_cursor: response.data.next_cursor || "" };
   },
 
   createComment: async (data: {
@@ -617,8 +675,17 @@ export const collectionAPI = {
     return response.data;
   },
 
-  getPosts: async (collectionId: string, page: number = 1, limit: number = 12) => {
-    const response = await apiClient.get(`/collections/${collectionId}?page=${page}&limit=${limit}`);
+  getPosts: async (collectionId: string, page: number = 1, limit: number = 12, shareToken: string = "") => {
+    const params: Record<string, any> = { page, limit };
+    if (shareToken) params.token = shareToken;
+    const response = await apiClient.get(`/collections/${collectionId}`, { params });
+    return response.data;
+  },
+
+  getDetails: async (collectionId: string, shareToken: string = "") => {
+    const params: Record<string, any> = {};
+    if (shareToken) params.token = shareToken;
+    const response = await apiClient.get(`/collections/${collectionId}/details`, { params });
     return response.data;
   },
 
@@ -645,6 +712,58 @@ export const collectionAPI = {
   rename: async (collectionId: string, newName: string) => {
     const response = await apiClient.put(`/collections/${collectionId}`, { new_name: newName });
     return response.data;
+  },
+
+  setPrivacy: async (collectionId: string, privacy: "private" | "link" | "public", resetShareLink: boolean = false) => {
+    const response = await apiClient.put(`/collections/${collectionId}/privacy`, { privacy, reset_share_link: resetShareLink });
+    return response.data;
+  },
+
+  getMembers: async (collectionId: string) => {
+    const response = await apiClient.get(`/collections/${collectionId}/members`);
+    return response.data.members;
+  },
+
+  inviteMember: async (collectionId: string, userId: number, role: "editor" | "viewer") => {
+    const response = await apiClient.post(`/collections/${collectionId}/members`, { user_id: userId, role });
+    return response.data;
+  },
+
+  removeMember: async (collectionId: string, userId: number) => {
+    const response = await apiClient.delete(`/collections/${collectionId}/members/${userId}`);
+    return response.data;
+  },
+
+  respondToInvite: async (collectionId: string, accept: boolean) => {
+    const response = await apiClient.post(`/collections/${collectionId}/invite`, { accept });
+    return response.data;
+  },
+
+  getInvites: async () => {
+    const response = await apiClient.get("/collections/invites");
+    return response.data.invites;
+  },
+
+  // postId 0 goes back to the automatic cover
+  setCover: async (collectionId: string, postId: number) => {
+    const response = await apiClient.put(`/collections/${collectionId}/cover`, { post_id: postId });
+    return response.data;
+  },
+
+  // Only the given posts move, swapping places among themselves
+  reorderPosts: async (collectionId: string, postIds: number[]) => {
+    const response = await apiClient.put(`/collections/${collectionId}/order`, { post_ids: postIds });
+    return response.data;
+  },
+
+  movePosts: async (fromCollectionId: string, toCollectionId: string, postIds: number[], copy: boolean = false) => {
+    const response = await apiClient.post("/collections/move", {
+      from_collection_id: Number(fromCollectionId),
+      to_collection_id: Number(toCollectionId),
+      post_ids: postIds,
+      copy
+    });
+    return response.data;
   }
 };
 
@@ -666,6 +785,18 @@ export const searchAPI = {
   }
 };
 
+export interface DirectSendResult {
+  recipient_id?: number
+  conversation_id?: string
+  sent?: boolean
+  error?: string
+}
+
+export type MessagePayload =
+  | { type: "story_reply"; story_reply: { story_id: number; story_owner_id: number } }
+  | { type: "profile"; profile: { user_id: number } }
+  | { type: "location"; location: { name: string; address?: string; latitude: number; longitude: number } }
+
 // Message APIs
 export const messageAPI = {
   createConversation: async (data: {
@@ -677,8 +808,10 @@ export const messageAPI = {
     return response.data;
   },
 
-  getConversations: async (page: number = 1, limit: number = 20) => {
-    const response = await apiClient.get(`/conversations?page=${page}&limit=${limit}`);
+  getConversations: async (cursor: string = "", limit: number = 20) => {
+    const params: Record<string, any> = { limit };
+    if (cursor) params.cursor = cursor;
+    const response = await apiClient.get("/conversations", { params });
     return response.data;
   },
 
@@ -692,8 +825,23 @@ export const messageAPI = {
     return response.data;
   },
 
-  sendMessage: async (conversationId: string, content: string) => {
-    const response = await apiClient.post(`/conversations/${conversationId}/messages`, { content });
+  // payload turns the message into a story reply, profile card or location
+  sendMessage: async (conversationId: string, content: string, payload?: MessagePayload, replyToMessageId?: string) => {
+    const body: Record<string, any> = { content, ...payload };
+    if (replyToMessageId) body.reply_to_message_id = replyToMessageId;
+    const response = await apiClient.post(`/conversations/${conversationId}/messages`, body);
+    return response.data;
+  },
+
+  // Read up to messageId, or the latest message when omitted
+  markRead: async (conversationId: string, messageId?: string) => {
+    const response = await apiClient.post(`/conversations/${conversationId}/read`, messageId ? { message_id: messageId } : {});
+    return response.data;
+  },
+
+  // Online status and last seen time, at most 200 users at once
+  getPresence: async (userIds: number[]) => {
+    const response = await apiClient.get("/users/presence", { params: { ids: userIds.join(",") } });
     return response.data;
   },
 
@@ -702,6 +850,22 @@ export const messageAPI = {
     return response.data;
   },
 
+  // One reaction per message; reacting again replaces it
+  reactToMessage: async (messageId: string, emoji: string) => {
+    const response = await apiClient.post(`/messages/${messageId}/reactions`, { emoji });
+    return response.data;
+  },
+
+  removeReaction: async (messageId: string) => {
+    const response = await apiClient.delete(`/messages/${messageId}/reactions`);
+    return response.data;
+  },
+
+  forwardMessage: async (messageId: string, conversationIds: string[]) => {
+    const response = await apiClient.post(`/messages/${messageId}/forward`, { conversation_ids: conversationIds });
+    return response.data;
+  },
+
   deleteConversation: async (conversationId: string) => {
     const response = await apiClient.delete(`/conversations/${conversationId}`);
     return response.data;
@@ -806,15 +970,19 @@ export const adminAPI = {
     return response.data;
   },
 
-  // Reports Management - backend returns arrays directly
-  getPostReports: async () => {
-    const response = await apiClient.get<PostReport[]>("/admin/reports/posts?unresolved_only=false");
-    return { reports: response.data };
+  // Reports Management
+  getPostReports: async (cursor: string = "") => {
+    const params: Record<string, any> = { unresolved_only: false };
+    if (cursor) params.cursor = cursor;
+    const response = await apiClient.get<{ reports: PostReport[]; next_cursor: string }>("/admin/reports/posts", { params });
+    return response.data;
   },
 
-  getUserReports: async () => {
-    const response = await apiClient.get<UserReport[]>("/admin/reports/users?unresolved_only=false");
-    return { reports: response.data };
+  getUserReports: async (cursor: string = "") => {
+    const params: Record<string, any> = { unresolved_only: false };
+    if (cursor) params.cursor = cursor;
+    const response = await apiClient.get<{ reports: UserReport[]; next_cursor: string }>("/admin/reports/users", { params });
+    return response.data;
   },
 
   resolvePostReport: async (reportId: number, action: "ACCEPT" | "REJECT") => {
@@ -879,6 +1047,31 @@ export const notificationAPI = {
   }
 };
 
+// Creator insights
+export type ImpressionSurface = "home" | "explore" | "reels" | "profile" | "hashtag" | "collection";
+
+export const insightsAPI = {
+  recordImpressions: async (surface: ImpressionSurface, postIds: number[]) => {
+    const response = await apiClient.post("/feed/impressions", { surface, post_ids: postIds });
+    return response.data;
+  },
+
+  recordProfileVisit: async (postId: string) => {
+    const response = await apiClient.post(`/posts/${postId}/profile-visit`);
+    return response.data;
+  },
+
+  getPostInsights: async (postId: string, days: number = 30) => {
+    const response = await apiClient.get(`/posts/${postId}/insights`, { params: { days } });
+    return response.data;
+  },
+
+  getAccountInsights: async (days: number = 30) => {
+    const response = await apiClient.get("/insights/account", { params: { days } });
+    return response.data;
+  }
+};
+
 // Helper function to handle API errors
 export const handleApiError = (error: unknown): string => {
   if (axios.isAxiosError(error)) {
@@ -25,8 +25,11 @@ interface FeedState {
   reelsFeed: Post[]
   storyFeed: any[]
   homePage: number
+  homeCursor: string
   explorePage: number
+  exploreCursor: string
   reelsPage: number
+  reelsCursor: string
   loading: boolean
   hasMore: boolean
 }
@@ -38,8 +41,11 @@ export const useFeedStore = defineStore("feed", {
     reelsFeed: [],
     storyFeed: [],
     homePage: 1,
+    homeCursor: "",
     explorePage: 1,
+    exploreCursor: "",
     reelsPage: 1,
+    reelsCursor: "",
     loading: false,
     hasMore: true
   }),
@@ -49,7 +55,7 @@ export const useFeedStore = defineStore("feed", {
       this.loading = true;
       try {
         console.log("Fetching home feed - page:", page, "limit:", limit);
-        const response = await feedAPI.getHomeFeed(page, limit);
+        const response = await feedAPI.getHomeFeed(page === 1 ? "" : this.homeCursor, limit);
         console.log("API response:", response);
         console.log("Response type:", typeof response);
         console.log("Is array?:", Array.isArray(response));
@@ -92,7 +98,8 @@ export const useFeedStore = defineStore("feed", {
           this.homeFeed.push(...posts);
         }
         this.homePage = page;
-        this.hasMore = posts.length === limit;
+        this.homeCursor = response.next_cursor || "";
+        this.hasMore = !!this.homeCursor;
         
         console.log("Home feed after load:", this.homeFeed.length, "posts");
       } catch (error: any) {
@@ -116,7 +123,7 @@ export const useFeedStore = defineStore("feed", {
     async loadExploreFeed(page: number = 1, limit: number = 20) {
       this.loading = true;
       try {
-        const response = await feedAPI.getExploreFeed(page, limit);
+        const response = await feedAPI.getExploreFeed(page === 1 ? "" : this.exploreCursor, limit);
         
         // Handle different possible response structures
         let posts = [];
@@ -158,7 +165,8 @@ export const useFeedStore = defineStore("feed", {
           this.exploreFeed.push(...posts);
         }
         this.explorePage = page;
-        this.hasMore = posts.length === limit;
+        this.exploreCursor = response.next_cursor || "";
+        this.hasMore = !!this.exploreCursor;
       } catch (error: any) {
         console.error("Failed to load explore feed:", error);
         console.error("Error details:", error.response?.data || error.message);
@@ -170,7 +178,7 @@ export const useFeedStore = defineStore("feed", {
     async loadReelsFeed(page: number = 1, limit: number = 20) {
       this.loading = true;
       try {
-        const response = await feedAPI.getReelsFeed(page, limit);
+        const response = await feedAPI.getReelsFeed(page === 1 ? "" : this.reelsCursor, limit);
         
         // Handle different possible response structures
         let posts = [];
@@ -200,7 +208,8 @@ export const useFeedStore = defineStore("feed", {
           this.reelsFeed.push(...posts);
         }
         this.reelsPage = page;
-        this.hasMore = posts.length === limit;
+        this.reelsCursor = response.next_cursor || "";
+        this.hasMore = !!this.reelsCursor;
       } catch (error: any) {
         console.error("Failed to load reels feed:", error);
         console.error("Error details:", error.response?.data || error.message);